@@ -102,6 +102,7 @@ IN_UPGRADE=
 # northd-backoff-interval, in ms
 OVN_NORTHD_BACKOFF_INTERVAL=
 OVN_OBSERV_ENABLE="false"
+OVN_LB_HEALTH_CHECKS_ENABLE="false"
 
 # Parse parameters given as arguments to this script.
 while [ "$1" != "" ]; do
@@ -132,6 +133,9 @@ while [ "$1" != "" ]; do
   --enable-ipsec)
     ENABLE_IPSEC=$VALUE
     ;;
+  --native-ipsec)
+    NATIVE_IPSEC=$VALUE
+    ;;
   --ovn-monitor-all)
     OVN_MONITOR_ALL=$VALUE
     ;;
@@ -270,6 +274,15 @@ while [ "$1" != "" ]; do
   --network-segmentation-enable)
     OVN_NETWORK_SEGMENTATION_ENABLE=$VALUE
     ;;
+  --route-advertisements-enable)
+    OVN_ROUTE_ADVERTISEMENTS_ENABLE=$VALUE
+    ;;
+  --route-advertisements-neighbors)
+    OVN_ROUTE_ADVERTISEMENTS_NEIGHBORS=$VALUE
+    ;;
+  --route-advertisements-neighbor-asn)
+    OVN_ROUTE_ADVERTISEMENTS_NEIGHBOR_ASN=$VALUE
+    ;;
   --egress-service-enable)
     OVN_EGRESSSERVICE_ENABLE=$VALUE
     ;;
@@ -360,6 +373,9 @@ while [ "$1" != "" ]; do
   --enable-observ)
     OVN_OBSERV_ENABLE=$VALUE
     ;;
+  --enable-lb-health-checks)
+    OVN_LB_HEALTH_CHECKS_ENABLE=$VALUE
+    ;;
   --no-hostsubnet-label)
     OVN_NOHOSTSUBNET_LABEL=$VALUE
     ;;
@@ -408,6 +424,9 @@ echo "ovn_dummy_gateway_bridge: ${ovn_dummy_gateway_bridge}"
 enable_ipsec=${ENABLE_IPSEC:-false}
 echo "enable_ipsec: ${enable_ipsec}"
 
+native_ipsec=${NATIVE_IPSEC:-false}
+echo "native_ipsec: ${native_ipsec}"
+
 ovn_db_replicas=${OVN_DB_REPLICAS:-3}
 echo "ovn_db_replicas: ${ovn_db_replicas}"
 ovn_db_minAvailable=$(((${ovn_db_replicas} + 1) / 2))
@@ -456,6 +475,12 @@ ovn_multi_network_enable=${OVN_MULTI_NETWORK_ENABLE}
 echo "ovn_multi_network_enable: ${ovn_multi_network_enable}"
 ovn_network_segmentation_enable=${OVN_NETWORK_SEGMENTATION_ENABLE}
 echo "ovn_network_segmentation_enable: ${ovn_network_segmentation_enable}"
+ovn_route_advertisements_enable=${OVN_ROUTE_ADVERTISEMENTS_ENABLE}
+echo "ovn_route_advertisements_enable: ${ovn_route_advertisements_enable}"
+ovn_route_advertisements_neighbors=${OVN_ROUTE_ADVERTISEMENTS_NEIGHBORS}
+echo "ovn_route_advertisements_neighbors: ${ovn_route_advertisements_neighbors}"
+ovn_route_advertisements_neighbor_asn=${OVN_ROUTE_ADVERTISEMENTS_NEIGHBOR_ASN}
+echo "ovn_route_advertisements_neighbor_asn: ${ovn_route_advertisements_neighbor_asn}"
 ovn_hybrid_overlay_net_cidr=${OVN_HYBRID_OVERLAY_NET_CIDR}
 echo "ovn_hybrid_overlay_net_cidr: ${ovn_hybrid_overlay_net_cidr}"
 ovn_disable_snat_multiple_gws=${OVN_DISABLE_SNAT_MULTIPLE_GWS}
@@ -559,6 +584,9 @@ echo "ovn_enable_dnsnameresolver: ${ovn_enable_dnsnameresolver}"
 ovn_observ_enable=${OVN_OBSERV_ENABLE}
 echo "ovn_observ_enable: ${ovn_observ_enable}"
 
+ovn_lb_health_checks_enable=${OVN_LB_HEALTH_CHECKS_ENABLE}
+echo "ovn_lb_health_checks_enable: ${ovn_lb_health_checks_enable}"
+
 ovn_nohostsubnet_label=${OVN_NOHOSTSUBNET_LABEL}
 echo "ovn_nohostsubnet_label: ${ovn_nohostsubnet_label}"
 
@@ -593,6 +621,9 @@ ovn_image=${ovnkube_image} \
   ovn_egress_ip_healthcheck_port=${ovn_egress_ip_healthcheck_port} \
   ovn_multi_network_enable=${ovn_multi_network_enable} \
   ovn_network_segmentation_enable=${ovn_network_segmentation_enable} \
+  ovn_route_advertisements_enable=${ovn_route_advertisements_enable} \
+  ovn_route_advertisements_neighbors=${ovn_route_advertisements_neighbors} \
+  ovn_route_advertisements_neighbor_asn=${ovn_route_advertisements_neighbor_asn} \
   ovn_egress_service_enable=${ovn_egress_service_enable} \
   ovn_ssl_en=${ovn_ssl_en} \
   ovn_remote_probe_interval=${ovn_remote_probe_interval} \
@@ -614,6 +645,7 @@ ovn_image=${ovnkube_image} \
   ovn_enable_multi_external_gateway=${ovn_enable_multi_external_gateway} \
   ovn_enable_ovnkube_identity=${ovn_enable_ovnkube_identity} \
   ovn_observ_enable=${ovn_observ_enable} \
+  ovn_lb_health_checks_enable=${ovn_lb_health_checks_enable} \
   ovnkube_app_name=ovnkube-node \
   jinjanate ../templates/ovnkube-node.yaml.j2 -o ${output_dir}/ovnkube-node.yaml
 
@@ -666,6 +698,7 @@ ovn_image=${ovnkube_image} \
   ovn_enable_multi_external_gateway=${ovn_enable_multi_external_gateway} \
   ovn_enable_ovnkube_identity=${ovn_enable_ovnkube_identity} \
   ovn_observ_enable=${ovn_observ_enable} \
+  ovn_lb_health_checks_enable=${ovn_lb_health_checks_enable} \
   ovnkube_app_name=ovnkube-node-dpu \
   jinjanate ../templates/ovnkube-node.yaml.j2 -o ${output_dir}/ovnkube-node-dpu.yaml
 
@@ -757,6 +790,7 @@ ovn_image=${ovnkube_image} \
   ovn_enable_svc_template_support=${ovn_enable_svc_template_support} \
   ovn_enable_dnsnameresolver=${ovn_enable_dnsnameresolver} \
   ovn_observ_enable=${ovn_observ_enable} \
+  ovn_lb_health_checks_enable=${ovn_lb_health_checks_enable} \
   ovn_nohostsubnet_label=${ovn_nohostsubnet_label} \
   ovn_disable_requestedchassis=${ovn_disable_requestedchassis} \
   jinjanate ../templates/ovnkube-master.yaml.j2 -o ${output_dir}/ovnkube-master.yaml
@@ -788,6 +822,7 @@ ovn_image=${ovnkube_image} \
   ovn_egress_qos_enable=${ovn_egress_qos_enable} \
   ovn_multi_network_enable=${ovn_multi_network_enable} \
   ovn_network_segmentation_enable=${ovn_network_segmentation_enable} \
+  ovn_route_advertisements_enable=${ovn_route_advertisements_enable} \
   ovn_egress_service_enable=${ovn_egress_service_enable} \
   ovn_ssl_en=${ovn_ssl_en} \
   ovn_master_count=${ovn_master_count} \
@@ -801,6 +836,7 @@ ovn_image=${ovnkube_image} \
   ovn_enable_persistent_ips=${ovn_enable_persistent_ips} \
   ovn_enable_dnsnameresolver=${ovn_enable_dnsnameresolver} \
   ovn_observ_enable=${ovn_observ_enable} \
+  ovn_lb_health_checks_enable=${ovn_lb_health_checks_enable} \
   jinjanate ../templates/ovnkube-control-plane.yaml.j2 -o ${output_dir}/ovnkube-control-plane.yaml
 
 ovn_image=${image} \
@@ -866,6 +902,9 @@ ovn_image=${ovnkube_image} \
   ovn_egress_qos_enable=${ovn_egress_qos_enable} \
   ovn_multi_network_enable=${ovn_multi_network_enable} \
   ovn_network_segmentation_enable=${ovn_network_segmentation_enable} \
+  ovn_route_advertisements_enable=${ovn_route_advertisements_enable} \
+  ovn_route_advertisements_neighbors=${ovn_route_advertisements_neighbors} \
+  ovn_route_advertisements_neighbor_asn=${ovn_route_advertisements_neighbor_asn} \
   ovn_egress_service_enable=${ovn_egress_service_enable} \
   ovn_ssl_en=${ovn_ssl_en} \
   ovn_remote_probe_interval=${ovn_remote_probe_interval} \
@@ -897,6 +936,7 @@ ovn_image=${ovnkube_image} \
   ovn_enable_svc_template_support=${ovn_enable_svc_template_support} \
   ovn_enable_dnsnameresolver=${ovn_enable_dnsnameresolver} \
   ovn_observ_enable=${ovn_observ_enable} \
+  ovn_lb_health_checks_enable=${ovn_lb_health_checks_enable} \
   jinjanate ../templates/ovnkube-single-node-zone.yaml.j2 -o ${output_dir}/ovnkube-single-node-zone.yaml
 
 ovn_image=${ovnkube_image} \
@@ -961,6 +1001,7 @@ ovn_image=${ovnkube_image} \
   ovn_enable_svc_template_support=${ovn_enable_svc_template_support} \
   ovn_enable_dnsnameresolver=${ovn_enable_dnsnameresolver} \
   ovn_observ_enable=${ovn_observ_enable} \
+  ovn_lb_health_checks_enable=${ovn_lb_health_checks_enable} \
   jinjanate ../templates/ovnkube-zone-controller.yaml.j2 -o ${output_dir}/ovnkube-zone-controller.yaml
 
 ovn_image=${image} \
@@ -995,6 +1036,7 @@ ovn_image=${ovnkube_image} \
 
 if ${enable_ipsec}; then
   ovn_image=${image} \
+    native_ipsec=${native_ipsec} \
     jinjanate ../templates/ovn-ipsec.yaml.j2 -o ${output_dir}/ovn-ipsec.yaml
 fi
 
@@ -1036,10 +1078,14 @@ cp ../templates/rbac-ovnkube-db.yaml.j2 ${output_dir}/rbac-ovnkube-db.yaml
 cp ../templates/ovnkube-monitor.yaml.j2 ${output_dir}/ovnkube-monitor.yaml
 cp ../templates/k8s.ovn.org_egressfirewalls.yaml.j2 ${output_dir}/k8s.ovn.org_egressfirewalls.yaml
 cp ../templates/k8s.ovn.org_egressips.yaml.j2 ${output_dir}/k8s.ovn.org_egressips.yaml
+cp ../templates/k8s.ovn.org_egressippools.yaml.j2 ${output_dir}/k8s.ovn.org_egressippools.yaml
 cp ../templates/k8s.ovn.org_egressqoses.yaml.j2 ${output_dir}/k8s.ovn.org_egressqoses.yaml
 cp ../templates/k8s.ovn.org_egressservices.yaml.j2 ${output_dir}/k8s.ovn.org_egressservices.yaml
 cp ../templates/k8s.ovn.org_adminpolicybasedexternalroutes.yaml.j2 ${output_dir}/k8s.ovn.org_adminpolicybasedexternalroutes.yaml
 cp ../templates/k8s.ovn.org_userdefinednetworks.yaml.j2 ${output_dir}/k8s.ovn.org_userdefinednetworks.yaml
 cp ../templates/k8s.ovn.org_clusteruserdefinednetworks.yaml.j2 ${output_dir}/k8s.ovn.org_clusteruserdefinednetworks.yaml
+cp ../templates/k8s.ovn.org_networkpeerings.yaml.j2 ${output_dir}/k8s.ovn.org_networkpeerings.yaml
+cp ../templates/k8s.ovn.org_routeadvertisements.yaml.j2 ${output_dir}/k8s.ovn.org_routeadvertisements.yaml
+cp ../templates/k8s.ovn.org_networkobservabilities.yaml.j2 ${output_dir}/k8s.ovn.org_networkobservabilities.yaml
 
 exit 0
@@ -97,6 +97,7 @@ fi
 # OVN_ENABLE_SVC_TEMPLATE_SUPPORT - enable svc template support
 # OVN_ENABLE_DNSNAMERESOLVER - enable dns name resolver support
 # OVN_OBSERV_ENABLE - enable observability for ovnkube
+# OVN_LB_HEALTH_CHECKS_ENABLE - enable OVN health checks of service backends
 
 # The argument to the command is the operation to be performed
 # ovn-master ovn-controller ovn-node display display_env ovn_debug
@@ -269,6 +270,12 @@ ovn_disable_ovn_iface_id_ver=${OVN_DISABLE_OVN_IFACE_ID_VER:-false}
 ovn_multi_network_enable=${OVN_MULTI_NETWORK_ENABLE:-false}
 #OVN_NETWORK_SEGMENTATION_ENABLE - enable user defined primary networks for ovn-kubernetes
 ovn_network_segmentation_enable=${OVN_NETWORK_SEGMENTATION_ENABLE:=false}
+#OVN_ROUTE_ADVERTISEMENTS_ENABLE - enable BGP advertisement of the cluster networks
+ovn_route_advertisements_enable=${OVN_ROUTE_ADVERTISEMENTS_ENABLE:=false}
+#OVN_ROUTE_ADVERTISEMENTS_NEIGHBORS - comma separated addresses of the BGP neighbors of the nodes
+ovn_route_advertisements_neighbors=${OVN_ROUTE_ADVERTISEMENTS_NEIGHBORS:-}
+#OVN_ROUTE_ADVERTISEMENTS_NEIGHBOR_ASN - ASN of the BGP neighbors, the ASN of the nodes if not set
+ovn_route_advertisements_neighbor_asn=${OVN_ROUTE_ADVERTISEMENTS_NEIGHBOR_ASN:-}
 ovn_acl_logging_rate_limit=${OVN_ACL_LOGGING_RATE_LIMIT:-"20"}
 ovn_netflow_targets=${OVN_NETFLOW_TARGETS:-}
 ovn_sflow_targets=${OVN_SFLOW_TARGETS:-}
@@ -313,6 +320,8 @@ ovn_enable_svc_template_support=${OVN_ENABLE_SVC_TEMPLATE_SUPPORT:-true}
 ovn_enable_dnsnameresolver=${OVN_ENABLE_DNSNAMERESOLVER:-false}
 # OVN_OBSERV_ENABLE - enable observability for ovnkube
 ovn_observ_enable=${OVN_OBSERV_ENABLE:-false}
+# OVN_LB_HEALTH_CHECKS_ENABLE - enable OVN health checks of service backends
+ovn_lb_health_checks_enable=${OVN_LB_HEALTH_CHECKS_ENABLE:-false}
 # OVN_NOHOSTSUBNET_LABEL - node label indicating nodes managing their own network
 ovn_nohostsubnet_label=${OVN_NOHOSTSUBNET_LABEL:-""}
 # OVN_DISABLE_REQUESTEDCHASSIS - disable requested-chassis option during pod creation
@@ -1076,6 +1085,24 @@ ovnkube-identity() {
       ovnkube_enable_hybrid_overlay_flag="--enable-hybrid-overlay"
     fi
 
+    # the validation of the ovn-kubernetes objects depends on the subnets of the cluster
+    ovn_crd_validation_opts="--enable-crd-validation --cluster-subnets=${net_cidr} --k8s-service-cidrs=${svc_cidr}"
+    if [[ -n ${ovn_v4_join_subnet} ]]; then
+      ovn_crd_validation_opts="${ovn_crd_validation_opts} --gateway-v4-join-subnet=${ovn_v4_join_subnet}"
+    fi
+    if [[ -n ${ovn_v6_join_subnet} ]]; then
+      ovn_crd_validation_opts="${ovn_crd_validation_opts} --gateway-v6-join-subnet=${ovn_v6_join_subnet}"
+    fi
+    if [[ -n ${ovn_v4_masquerade_subnet} ]]; then
+      ovn_crd_validation_opts="${ovn_crd_validation_opts} --gateway-v4-masquerade-subnet=${ovn_v4_masquerade_subnet}"
+    fi
+    if [[ -n ${ovn_v6_masquerade_subnet} ]]; then
+      ovn_crd_validation_opts="${ovn_crd_validation_opts} --gateway-v6-masquerade-subnet=${ovn_v6_masquerade_subnet}"
+    fi
+    if [[ ${ovn_enable_dnsnameresolver} == "true" ]]; then
+      ovn_crd_validation_opts="${ovn_crd_validation_opts} --enable-dns-name-resolver"
+    fi
+
     # extra-allowed-user:
     #   ovnkube-master service account - required for compact mode
     #   ovnkube-cluster-manager service account - required for multi-homing
@@ -1083,6 +1110,7 @@ ovnkube-identity() {
     --webhook-cert-dir="/etc/webhook-cert" \
     ${ovnkube_enable_interconnect_flag} \
     ${ovnkube_enable_hybrid_overlay_flag} \
+    ${ovn_crd_validation_opts} \
     --extra-allowed-user="system:serviceaccount:ovn-kubernetes:ovnkube-cluster-manager" \
     --extra-allowed-user="system:serviceaccount:ovn-kubernetes:ovnkube-master" \
     --loglevel="${ovnkube_loglevel}"
@@ -1272,6 +1300,12 @@ ovn-master() {
     ovn_observ_enable_flag="--enable-observability"
   fi
   echo "ovn_observ_enable_flag=${ovn_observ_enable_flag}"
+
+  ovn_lb_health_checks_enable_flag=
+  if [[ ${ovn_lb_health_checks_enable} == "true" ]]; then
+    ovn_lb_health_checks_enable_flag="--enable-lb-health-checks"
+  fi
+  echo "ovn_lb_health_checks_enable_flag=${ovn_lb_health_checks_enable_flag}"
   
   nohostsubnet_label_option=
   if [[ ${ovn_nohostsubnet_label} != "" ]]; then
@@ -1324,6 +1358,7 @@ ovn-master() {
     ${ovn_acl_logging_rate_limit_flag} \
     ${ovn_enable_svc_template_support_flag} \
     ${ovn_observ_enable_flag} \
+    ${ovn_lb_health_checks_enable_flag} \
     ${ovnkube_config_duration_enable_flag} \
     ${ovnkube_enable_multi_external_gateway_flag} \
     ${ovnkube_metrics_scale_enable_flag} \
@@ -1595,6 +1630,12 @@ ovnkube-controller() {
   fi
   echo "ovn_observ_enable_flag=${ovn_observ_enable_flag}"
 
+  ovn_lb_health_checks_enable_flag=
+  if [[ ${ovn_lb_health_checks_enable} == "true" ]]; then
+    ovn_lb_health_checks_enable_flag="--enable-lb-health-checks"
+  fi
+  echo "ovn_lb_health_checks_enable_flag=${ovn_lb_health_checks_enable_flag}"
+
   echo "=============== ovnkube-controller ========== MASTER ONLY"
   /usr/bin/ovnkube --init-ovnkube-controller ${K8S_NODE} \
     ${anp_enabled_flag} \
@@ -1614,6 +1655,7 @@ ovnkube-controller() {
     ${ovn_dbs} \
     ${ovn_enable_svc_template_support_flag} \
     ${ovn_observ_enable_flag} \
+    ${ovn_lb_health_checks_enable_flag} \
     ${ovnkube_config_duration_enable_flag} \
     ${ovnkube_enable_interconnect_flag} \
     ${ovnkube_local_cert_flags} \
@@ -1792,6 +1834,18 @@ ovnkube-controller-with-node() {
   fi
   echo "network_segmentation_enabled_flag=${network_segmentation_enabled_flag}"
 
+  route_advertisements_enabled_flag=
+  if [[ ${ovn_route_advertisements_enable} == "true" ]]; then
+	  route_advertisements_enabled_flag="--enable-route-advertisements"
+	  if [[ -n ${ovn_route_advertisements_neighbors} ]]; then
+		  route_advertisements_enabled_flag="${route_advertisements_enabled_flag} --route-advertisements-neighbors ${ovn_route_advertisements_neighbors}"
+	  fi
+	  if [[ -n ${ovn_route_advertisements_neighbor_asn} ]]; then
+		  route_advertisements_enabled_flag="${route_advertisements_enabled_flag} --route-advertisements-neighbor-asn ${ovn_route_advertisements_neighbor_asn}"
+	  fi
+  fi
+  echo "route_advertisements_enabled_flag=${route_advertisements_enabled_flag}"
+
   egressservice_enabled_flag=
   if [[ ${ovn_egressservice_enable} == "true" ]]; then
 	  egressservice_enabled_flag="--enable-egress-service"
@@ -2001,6 +2055,12 @@ ovnkube-controller-with-node() {
   fi
   echo "ovn_observ_enable_flag=${ovn_observ_enable_flag}"
 
+  ovn_lb_health_checks_enable_flag=
+  if [[ ${ovn_lb_health_checks_enable} == "true" ]]; then
+    ovn_lb_health_checks_enable_flag="--enable-lb-health-checks"
+  fi
+  echo "ovn_lb_health_checks_enable_flag=${ovn_lb_health_checks_enable_flag}"
+
   echo "=============== ovnkube-controller-with-node --init-ovnkube-controller-with-node=========="
   /usr/bin/ovnkube --init-ovnkube-controller ${K8S_NODE} --init-node ${K8S_NODE} \
     ${anp_enabled_flag} \
@@ -2026,12 +2086,14 @@ ovnkube-controller-with-node() {
     ${multicast_enabled_flag} \
     ${multi_network_enabled_flag} \
     ${network_segmentation_enabled_flag} \
+    ${route_advertisements_enabled_flag} \
     ${netflow_targets} \
     ${ofctrl_wait_before_clear} \
     ${ovn_acl_logging_rate_limit_flag} \
     ${ovn_dbs} \
     ${ovn_enable_svc_template_support_flag} \
     ${ovn_observ_enable_flag} \
+    ${ovn_lb_health_checks_enable_flag} \
     ${ovn_encap_ip_flag} \
     ${ovn_encap_port_flag} \
     ${ovnkube_config_duration_enable_flag} \
@@ -2232,6 +2294,12 @@ ovn-cluster-manager() {
   fi
   echo "ovn_enable_dnsnameresolver_flag=${ovn_enable_dnsnameresolver_flag}"
 
+  route_advertisements_enabled_flag=
+  if [[ ${ovn_route_advertisements_enable} == "true" ]]; then
+	  route_advertisements_enabled_flag="--enable-route-advertisements"
+  fi
+  echo "route_advertisements_enabled_flag=${route_advertisements_enabled_flag}"
+
   echo "=============== ovn-cluster-manager ========== MASTER ONLY"
   /usr/bin/ovnkube --init-cluster-manager ${K8S_NODE} \
     ${anp_enabled_flag} \
@@ -2245,6 +2313,7 @@ ovn-cluster-manager() {
     ${multicast_enabled_flag} \
     ${multi_network_enabled_flag} \
     ${network_segmentation_enabled_flag} \
+    ${route_advertisements_enabled_flag} \
     ${persistent_ips_enabled_flag} \
     ${ovnkube_enable_interconnect_flag} \
     ${ovnkube_enable_multi_external_gateway_flag} \
@@ -2412,6 +2481,18 @@ ovn-node() {
 	  network_segmentation_enabled_flag="--enable-multi-network --enable-network-segmentation"
   fi
 
+  route_advertisements_enabled_flag=
+  if [[ ${ovn_route_advertisements_enable} == "true" ]]; then
+	  route_advertisements_enabled_flag="--enable-route-advertisements"
+	  if [[ -n ${ovn_route_advertisements_neighbors} ]]; then
+		  route_advertisements_enabled_flag="${route_advertisements_enabled_flag} --route-advertisements-neighbors ${ovn_route_advertisements_neighbors}"
+	  fi
+	  if [[ -n ${ovn_route_advertisements_neighbor_asn} ]]; then
+		  route_advertisements_enabled_flag="${route_advertisements_enabled_flag} --route-advertisements-neighbor-asn ${ovn_route_advertisements_neighbor_asn}"
+	  fi
+  fi
+  echo "route_advertisements_enabled_flag=${route_advertisements_enabled_flag}"
+
   netflow_targets=
   if [[ -n ${ovn_netflow_targets} ]]; then
       netflow_targets="--netflow-targets ${ovn_netflow_targets}"
@@ -2634,6 +2715,7 @@ ovn-node() {
         ${multicast_enabled_flag} \
         ${multi_network_enabled_flag} \
         ${network_segmentation_enabled_flag} \
+        ${route_advertisements_enabled_flag} \
         ${netflow_targets} \
         ${ofctrl_wait_before_clear} \
         ${ovn_dbs} \
@@ -19,10 +19,14 @@ func main() {
 
 	p := cni.NewCNIPlugin("")
 	c.Action = func(ctx *cli.Context) error {
-		skel.PluginMain(
-			p.CmdAdd,
-			p.CmdCheck,
-			p.CmdDel,
+		skel.PluginMainFuncs(
+			skel.CNIFuncs{
+				Add:    p.CmdAdd,
+				Check:  p.CmdCheck,
+				Del:    p.CmdDel,
+				GC:     p.CmdGC,
+				Status: p.CmdStatus,
+			},
 			version.All,
 			bv.BuildString("ovn-k8s-cni-overlay"))
 		return nil
@@ -18,6 +18,8 @@ import (
 
 	certificatesv1 "k8s.io/api/certificates/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	listers "k8s.io/client-go/listers/core/v1"
@@ -27,6 +29,7 @@ import (
 	"k8s.io/klog/v2"
 	"k8s.io/kubernetes/pkg/probe"
 	httpprober "k8s.io/kubernetes/pkg/probe/http"
+	kexec "k8s.io/utils/exec"
 	utilpointer "k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -36,7 +39,14 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
+	ovnconfig "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	adminpolicybasedrouteapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/adminpolicybasedroute/v1"
+	adminpolicybasedrouteclientset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/adminpolicybasedroute/v1/apis/clientset/versioned"
+	adminpolicybasedrouteinformers "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/adminpolicybasedroute/v1/apis/informers/externalversions"
+	egressfirewallapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressfirewall/v1"
+	egressipapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1/apis/clientset/versioned/scheme"
+	userdefinednetworkapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/csrapprover"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovnwebhook"
 	utilerrors "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util/errors"
@@ -64,6 +74,9 @@ type config struct {
 	csrAcceptanceConditions    []csrapprover.CSRAcceptanceCondition
 	podAdmissionConditionFile  string
 	podAdmissionConditions     []ovnwebhook.PodAdmissionConditionOption
+	ipsecSignerCACert          string
+	ipsecSignerCAKey           string
+	enableCRDValidation        bool
 }
 
 var cliCfg config
@@ -122,6 +135,12 @@ func main() {
 	c.Usage = "run ovn-kubernetes identity manager, this includes the admission webhook and the CertificateSigningRequest approver"
 
 	c.Action = func(c *cli.Context) error {
+		if cliCfg.enableCRDValidation {
+			if _, err := ovnconfig.InitConfig(c, kexec.New(), nil); err != nil {
+				return fmt.Errorf("failed to initialize the ovnkube config: %w", err)
+			}
+		}
+
 		ctrl.SetLogger(logger)
 		var level klog.Level
 		if err := level.Set(strconv.Itoa(cliCfg.logLevel)); err != nil {
@@ -275,7 +294,24 @@ func main() {
 			Usage:       "Configure additional pod validate admission conditions",
 			Destination: &cliCfg.podAdmissionConditionFile,
 		},
+		&cli.StringFlag{
+			Name:        "ipsec-signer-ca-cert",
+			Usage:       "The CA certificate used to sign the IPsec certificates of the nodes. When set together with --ipsec-signer-ca-key, IPsec CSRs are signed",
+			Destination: &cliCfg.ipsecSignerCACert,
+		},
+		&cli.StringFlag{
+			Name:        "ipsec-signer-ca-key",
+			Usage:       "The private key of the CA used to sign the IPsec certificates of the nodes",
+			Destination: &cliCfg.ipsecSignerCAKey,
+		},
+		&cli.BoolFlag{
+			Name:        "enable-crd-validation",
+			Usage:       "Configure to enable the validation of the EgressIP, EgressFirewall, UserDefinedNetwork, ClusterUserDefinedNetwork and AdminPolicyBasedExternalRoute objects",
+			Destination: &cliCfg.enableCRDValidation,
+			Value:       false,
+		},
 	}
+	c.Flags = append(c.Flags, ovnConfigFlags(c.Flags)...)
 	ctx := context.Background()
 
 	// trap SIGHUP, SIGINT, SIGTERM, SIGQUIT and
@@ -309,6 +345,157 @@ func main() {
 	}
 }
 
+// ovnConfigFlagNames are the ovnkube options the validation of the ovn-kubernetes objects depends on
+var ovnConfigFlagNames = sets.New[string](
+	"config-file",
+	"cluster-subnets",
+	"k8s-service-cidrs",
+	"gateway-v4-join-subnet",
+	"gateway-v6-join-subnet",
+	"gateway-v4-masquerade-subnet",
+	"gateway-v6-masquerade-subnet",
+	"enable-dns-name-resolver",
+)
+
+// ovnConfigFlags returns the ovnkube flags that are not already flags of ovnkube-identity, so that the options
+// the validation of the ovn-kubernetes objects depends on are set and parsed the same way as for ovnkube, e.g.
+// from the ovnkube config file. The ovnkube config is initialized from all of its flags: the ones that are not
+// used by ovnkube-identity are hidden and the ones that are already flags of ovnkube-identity keep their default.
+func ovnConfigFlags(identityFlags []cli.Flag) []cli.Flag {
+	identityFlagNames := sets.New[string]()
+	for _, flag := range identityFlags {
+		identityFlagNames.Insert(flag.Names()...)
+	}
+	var flags []cli.Flag
+	for _, flag := range ovnconfig.GetFlags(nil) {
+		name := flag.Names()[0]
+		skip := identityFlagNames.Has(name)
+		hide := !ovnConfigFlagNames.Has(name)
+		switch f := flag.(type) {
+		case *cli.StringFlag:
+			if skip {
+				*f.Destination = f.Value
+			}
+			f.Hidden = hide
+		case *cli.BoolFlag:
+			if skip {
+				*f.Destination = f.Value
+			}
+			f.Hidden = hide
+		case *cli.IntFlag:
+			if skip {
+				*f.Destination = f.Value
+			}
+			f.Hidden = hide
+		case *cli.UintFlag:
+			if skip {
+				*f.Destination = f.Value
+			}
+			f.Hidden = hide
+		case *cli.DurationFlag:
+			if skip {
+				*f.Destination = f.Value
+			}
+			f.Hidden = hide
+		}
+		if !skip {
+			flags = append(flags, flag)
+		}
+	}
+	return flags
+}
+
+// newCRDWebhookHandler returns the handler of the validating webhook of an ovn-kubernetes object
+func newCRDWebhookHandler(crdScheme *runtime.Scheme, obj runtime.Object, validator admission.CustomValidator, name string) (http.Handler, error) {
+	crdWebhook := admission.WithCustomValidator(crdScheme, obj, validator).WithRecoverPanic(true)
+	return admission.StandaloneWebhook(
+		crdWebhook,
+		admission.StandaloneOptions{
+			Logger:      logger.WithName(name),
+			MetricsPath: name,
+		},
+	)
+}
+
+// registerCRDWebhooks registers the validating webhooks of the ovn-kubernetes objects
+func registerCRDWebhooks(ctx context.Context, restCfg *rest.Config, client kubernetes.Interface, webhookMux *http.ServeMux, stopCh <-chan struct{}) error {
+	apbRouteClient, err := adminpolicybasedrouteclientset.NewForConfig(restCfg)
+	if err != nil {
+		return fmt.Errorf("error creating AdminPolicyBasedExternalRoute clientset: %v", err)
+	}
+
+	crdScheme := runtime.NewScheme()
+	for _, addToScheme := range []func(*runtime.Scheme) error{
+		egressipapi.AddToScheme,
+		egressfirewallapi.AddToScheme,
+		userdefinednetworkapi.AddToScheme,
+		adminpolicybasedrouteapi.AddToScheme,
+	} {
+		if err := addToScheme(crdScheme); err != nil {
+			return err
+		}
+	}
+
+	informerFactory := informers.NewSharedInformerFactory(client, 10*time.Minute)
+	nodeInformer := informerFactory.Core().V1().Nodes().Informer()
+	namespaceInformer := informerFactory.Core().V1().Namespaces().Informer()
+	apbRouteInformerFactory := adminpolicybasedrouteinformers.NewSharedInformerFactory(apbRouteClient, 10*time.Minute)
+	apbRouteInformer := apbRouteInformerFactory.K8s().V1().AdminPolicyBasedExternalRoutes().Informer()
+	informerFactory.Start(stopCh)
+	apbRouteInformerFactory.Start(stopCh)
+	klog.Infof("Waiting for caches to sync")
+	cache.WaitForCacheSync(ctx.Done(), nodeInformer.HasSynced, namespaceInformer.HasSynced, apbRouteInformer.HasSynced)
+
+	webhooks := []struct {
+		path      string
+		name      string
+		obj       runtime.Object
+		validator admission.CustomValidator
+	}{
+		{
+			path:      "/egressip",
+			name:      "egressip.network-identity",
+			obj:       &egressipapi.EgressIP{},
+			validator: ovnwebhook.NewEgressIPAdmissionWebhook(listers.NewNodeLister(nodeInformer.GetIndexer())),
+		},
+		{
+			path:      "/egressfirewall",
+			name:      "egressfirewall.network-identity",
+			obj:       &egressfirewallapi.EgressFirewall{},
+			validator: ovnwebhook.NewEgressFirewallAdmissionWebhook(),
+		},
+		{
+			path:      "/userdefinednetwork",
+			name:      "userdefinednetwork.network-identity",
+			obj:       &userdefinednetworkapi.UserDefinedNetwork{},
+			validator: ovnwebhook.NewUserDefinedNetworkAdmissionWebhook(),
+		},
+		{
+			path:      "/clusteruserdefinednetwork",
+			name:      "clusteruserdefinednetwork.network-identity",
+			obj:       &userdefinednetworkapi.ClusterUserDefinedNetwork{},
+			validator: ovnwebhook.NewUserDefinedNetworkAdmissionWebhook(),
+		},
+		{
+			path: "/adminpolicybasedexternalroute",
+			name: "adminpolicybasedexternalroute.network-identity",
+			obj:  &adminpolicybasedrouteapi.AdminPolicyBasedExternalRoute{},
+			validator: ovnwebhook.NewAdminPolicyBasedExternalRouteAdmissionWebhook(
+				apbRouteInformerFactory.K8s().V1().AdminPolicyBasedExternalRoutes().Lister(),
+				listers.NewNamespaceLister(namespaceInformer.GetIndexer()),
+			),
+		},
+	}
+	for _, w := range webhooks {
+		handler, err := newCRDWebhookHandler(crdScheme, w.obj, w.validator, w.name)
+		if err != nil {
+			return fmt.Errorf("failed to setup the %s admission webhook: %w", w.name, err)
+		}
+		webhookMux.Handle(w.path, handler)
+	}
+	return nil
+}
+
 func runWebhook(ctx context.Context, restCfg *rest.Config) error {
 	// We cannot use the default implementation of the webhook server because we need to enable SO_REUSEPORT
 	// on the socket to allow for two instances running at the same time (required during upgrades).
@@ -370,6 +557,12 @@ func runWebhook(ctx context.Context, restCfg *rest.Config) error {
 		webhookMux.Handle("/pod", podHandler)
 	}
 
+	if cliCfg.enableCRDValidation {
+		if err := registerCRDWebhooks(ctx, restCfg, client, webhookMux, stopCh); err != nil {
+			return err
+		}
+	}
+
 	cfg := &tls.Config{
 		NextProtos: []string{"h2"},
 		MinVersion: tls.VersionTLS10,
@@ -468,6 +661,33 @@ func runCSRApproverManager(ctx context.Context, leaderID string, restCfg *rest.C
 		os.Exit(1)
 	}
 
+	if cliCfg.ipsecSignerCACert != "" && cliCfg.ipsecSignerCAKey != "" {
+		signer, err := csrapprover.NewIPsecSigner(
+			mgr.GetClient(),
+			cliCfg.ipsecSignerCACert,
+			cliCfg.ipsecSignerCAKey,
+			csrapprover.MaxDuration,
+			mgr.GetEventRecorderFor(csrapprover.SignerControllerName),
+		)
+		if err != nil {
+			return err
+		}
+		err = ctrl.
+			NewControllerManagedBy(mgr).
+			Named(csrapprover.SignerControllerName).
+			For(&certificatesv1.CertificateSigningRequest{}, builder.WithPredicates(csrapprover.Predicate)).
+			WithOptions(controller.Options{
+				NeedLeaderElection: utilpointer.To(true),
+				RecoverPanic:       utilpointer.To(true),
+			}).
+			Complete(signer)
+		if err != nil {
+			klog.Errorf("Failed to create %s: %v", csrapprover.SignerControllerName, err)
+			os.Exit(1)
+		}
+		klog.Info("Starting IPsec certificate signer")
+	}
+
 	klog.Info("Starting certificate signing request approver")
 	return mgr.Start(ctx)
 }
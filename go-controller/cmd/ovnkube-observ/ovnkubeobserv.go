@@ -7,8 +7,10 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	observ "github.com/ovn-org/ovn-kubernetes/go-controller/observability-lib"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/observability-lib/sink"
 )
 
 func main() {
@@ -31,9 +33,36 @@ func main() {
 	outputFile := flag.String("output-file", "", "Output file to write the samples to.")
 	filterSrcIP := flag.String("filter-src-ip", "", "Filter in only packets from a given source ip.")
 	filterDstIP := flag.String("filter-dst-ip", "", "Filter in only packets to a given destination ip.")
+	outputFormat := flag.String("output-format", observ.OutputFormatText, "Format of the samples written to the output: text or json.")
+	ipfixCollector := flag.String("ipfix-collector", "", "Export the samples to the IPFIX collector listening on the given UDP host:port.")
+	ipfixObsDomainID := flag.Uint("ipfix-observation-domain-id", 0, "Observation domain ID set in the exported IPFIX messages.")
+	otlpEndpoint := flag.String("otlp-endpoint", "", "Export the samples as OpenTelemetry logs to the given OTLP/HTTP endpoint, e.g. http://localhost:4318/v1/logs.")
+	otlpFlushInterval := flag.Duration("otlp-flush-interval", 5*time.Second, "Interval at which the OpenTelemetry logs are sent.")
+	metricsBindAddress := flag.String("metrics-bind-address", "", "Serve the per-policy sample counters on the given host:port at /metrics.")
 	flag.Parse()
 
-	reader := observ.NewSampleReader(*enableDecoder, *logCookie, *printPacket, *addOVSCollector, *filterSrcIP, *filterDstIP, *outputFile)
+	reader := observ.NewSampleReader(*enableDecoder, *logCookie, *printPacket, *addOVSCollector, *filterSrcIP, *filterDstIP, *outputFile, *outputFormat)
+	if *ipfixCollector != "" {
+		exporter, err := sink.NewIPFIXExporter(*ipfixCollector, uint32(*ipfixObsDomainID))
+		if err != nil {
+			fmt.Println(err.Error())
+			os.Exit(1)
+		}
+		reader.AddSinks(exporter)
+	}
+	if *otlpEndpoint != "" {
+		exporter := sink.NewOTLPExporter(*otlpEndpoint, *otlpFlushInterval)
+		exporter.Start()
+		reader.AddSinks(exporter)
+	}
+	if *metricsBindAddress != "" {
+		aggregator := sink.NewMetricsAggregator(*metricsBindAddress)
+		if err := aggregator.Start(); err != nil {
+			fmt.Println(err.Error())
+			os.Exit(1)
+		}
+		reader.AddSinks(aggregator)
+	}
 	err := reader.ReadSamples(ctx)
 	if err != nil {
 		fmt.Println(err.Error())
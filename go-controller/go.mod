@@ -8,7 +8,7 @@ require (
 	github.com/asaskevich/govalidator v0.0.0-20210307081110-f21760c49a8d
 	github.com/bhendo/go-powershell v0.0.0-20190719160123-219e7fb4e41e
 	github.com/cenkalti/backoff/v4 v4.3.0
-	github.com/containernetworking/cni v1.1.2
+	github.com/containernetworking/cni v1.2.3
 	github.com/containernetworking/plugins v1.2.0
 	github.com/coreos/go-iptables v0.6.0
 	github.com/fsnotify/fsnotify v1.7.0
@@ -66,7 +66,7 @@ require (
 )
 
 // OCPHACK
-require sigs.k8s.io/knftables v0.0.16
+require sigs.k8s.io/knftables v0.0.17
 
 require (
 	github.com/Microsoft/go-winio v0.6.0 // indirect
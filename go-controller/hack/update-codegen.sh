@@ -93,11 +93,18 @@ echo "Editing EgressQoS CRD"
 sed -i -e':begin;$!N;s/.*metadata:\n.*type: object/&\n            properties:\n              name:\n                type: string\n                pattern: ^default$/;P;D' \
 	_output/crds/k8s.ovn.org_egressqoses.yaml
 
+echo "Editing NetworkObservability CRD"
+## We desire that only NetworkObservability with the name "default" are accepted by the apiserver.
+sed -i -e':begin;$!N;s/.*metadata:\n.*type: object/&\n            properties:\n              name:\n                type: string\n                pattern: ^default$/;P;D' \
+	_output/crds/k8s.ovn.org_networkobservabilities.yaml
+
 echo "Copying the CRDs to dist/templates as j2 files... Add them to your commit..."
 echo "Copying egressFirewall CRD"
 cp _output/crds/k8s.ovn.org_egressfirewalls.yaml ../dist/templates/k8s.ovn.org_egressfirewalls.yaml.j2
 echo "Copying egressIP CRD"
 cp _output/crds/k8s.ovn.org_egressips.yaml ../dist/templates/k8s.ovn.org_egressips.yaml.j2
+echo "Copying egressIPPool CRD"
+cp _output/crds/k8s.ovn.org_egressippools.yaml ../dist/templates/k8s.ovn.org_egressippools.yaml.j2
 echo "Copying egressQoS CRD"
 cp _output/crds/k8s.ovn.org_egressqoses.yaml ../dist/templates/k8s.ovn.org_egressqoses.yaml.j2
 echo "Copying adminpolicybasedexternalroutes CRD"
@@ -108,3 +115,9 @@ echo "Copying userdefinednetworks CRD"
 cp _output/crds/k8s.ovn.org_userdefinednetworks.yaml ../dist/templates/k8s.ovn.org_userdefinednetworks.yaml.j2
 echo "Copying clusteruserdefinednetworks CRD"
 cp _output/crds/k8s.ovn.org_clusteruserdefinednetworks.yaml ../dist/templates/k8s.ovn.org_clusteruserdefinednetworks.yaml.j2
+echo "Copying networkpeerings CRD"
+cp _output/crds/k8s.ovn.org_networkpeerings.yaml ../dist/templates/k8s.ovn.org_networkpeerings.yaml.j2
+echo "Copying routeadvertisements CRD"
+cp _output/crds/k8s.ovn.org_routeadvertisements.yaml ../dist/templates/k8s.ovn.org_routeadvertisements.yaml.j2
+echo "Copying networkobservabilities CRD"
+cp _output/crds/k8s.ovn.org_networkobservabilities.yaml ../dist/templates/k8s.ovn.org_networkobservabilities.yaml.j2
@@ -12,6 +12,7 @@ import (
 	"os"
 	"strings"
 	"syscall"
+	"time"
 	"unsafe"
 
 	"github.com/google/gopacket"
@@ -20,6 +21,7 @@ import (
 	"github.com/vishvananda/netlink/nl"
 
 	"github.com/ovn-org/ovn-kubernetes/go-controller/observability-lib/sampledecoder"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/observability-lib/sink"
 )
 
 const (
@@ -47,6 +49,13 @@ const (
 	__PSAMPLE_ATTR_MAX
 )
 
+const (
+	// OutputFormatText prints every sample as human-readable lines
+	OutputFormatText = "text"
+	// OutputFormatJSON prints every sample as a JSON object, one per line
+	OutputFormatJSON = "json"
+)
+
 type SampleReader struct {
 	enableDecoder   bool
 	logCookie       bool
@@ -54,12 +63,14 @@ type SampleReader struct {
 	addOVSCollector bool
 	srcIP, dstIP    string
 	outputFile      string
+	outputFormat    string
 
 	decoder   *sampledecoder.SampleDecoder
 	cookieStr []string
+	sinks     []sink.Sink
 }
 
-func NewSampleReader(enableDecoder, logCookie, printFullPacket, addOVSCollector bool, srcIP, dstIP, outputFile string) *SampleReader {
+func NewSampleReader(enableDecoder, logCookie, printFullPacket, addOVSCollector bool, srcIP, dstIP, outputFile, outputFormat string) *SampleReader {
 	r := &SampleReader{
 		enableDecoder:   enableDecoder,
 		logCookie:       logCookie,
@@ -68,6 +79,7 @@ func NewSampleReader(enableDecoder, logCookie, printFullPacket, addOVSCollector
 		srcIP:           srcIP,
 		dstIP:           dstIP,
 		outputFile:      outputFile,
+		outputFormat:    outputFormat,
 	}
 	if logCookie {
 		r.cookieStr = make([]string, 2)
@@ -75,7 +87,23 @@ func NewSampleReader(enableDecoder, logCookie, printFullPacket, addOVSCollector
 	return r
 }
 
+// AddSinks adds destinations every sample is exported to, in addition to the output.
+// The sinks are closed when ReadSamples returns.
+func (r *SampleReader) AddSinks(sinks ...sink.Sink) {
+	r.sinks = append(r.sinks, sinks...)
+}
+
 func (r *SampleReader) ReadSamples(ctx context.Context) error {
+	defer func() {
+		for _, s := range r.sinks {
+			if err := s.Close(); err != nil {
+				fmt.Fprintf(os.Stderr, "Error closing sink: %v\n", err)
+			}
+		}
+	}()
+	if r.outputFormat != OutputFormatText && r.outputFormat != OutputFormatJSON {
+		return fmt.Errorf("unknown output format %q", r.outputFormat)
+	}
 	if r.enableDecoder {
 		var err error
 		// currently only local nbdb connection is supported.
@@ -104,7 +132,15 @@ func (r *SampleReader) ReadSamples(ctx context.Context) error {
 	} else {
 		writer = os.Stdout
 	}
-	l := log.New(writer, "", log.Ldate|log.Ltime|log.Lmicroseconds)
+	// in json format, the output only contains samples and everything else is printed to stderr
+	infoWriter := io.Writer(os.Stdout)
+	logWriter := writer
+	if r.outputFormat == OutputFormatJSON {
+		r.sinks = append(r.sinks, sink.NewJSONSink(writer))
+		infoWriter = os.Stderr
+		logWriter = os.Stderr
+	}
+	l := log.New(logWriter, "", log.Ldate|log.Ltime|log.Lmicroseconds)
 	printlnFunc := func(a ...any) {
 		l.Println(a...)
 	}
@@ -125,7 +161,7 @@ func (r *SampleReader) ReadSamples(ctx context.Context) error {
 	if ovsGroupID == 0 {
 		return fmt.Errorf("no mcast group found for %s", PSAMPLE_NL_MCGRP_SAMPLE_NAME)
 	} else {
-		fmt.Printf("Found group %s, id %d\n", PSAMPLE_NL_MCGRP_SAMPLE_NAME, ovsGroupID)
+		fmt.Fprintf(infoWriter, "Found group %s, id %d\n", PSAMPLE_NL_MCGRP_SAMPLE_NAME, ovsGroupID)
 	}
 	sock, err := nl.Subscribe(nl.GENL_ID_CTRL, uint(ovsGroupID))
 	if err != nil {
@@ -180,9 +216,10 @@ var hostEndian = getHostEndian()
 func (r *SampleReader) parseMsg(msgs []syscall.NetlinkMessage, printlnFunc func(a ...any)) error {
 	for _, msg := range msgs {
 		var packetStr, sampleStr string
+		sample := &sink.Sample{Timestamp: time.Now()}
 		data := msg.Data[nl.SizeofGenlmsg:]
 		for attr := range nl.ParseAttributes(data) {
-			if r.logCookie && attr.Type == PSAMPLE_ATTR_SAMPLE_GROUP {
+			if attr.Type == PSAMPLE_ATTR_SAMPLE_GROUP {
 				if uint64(len(attr.Value)) == 4 {
 					g := uint32(0)
 					// group is encoded using host endian
@@ -190,26 +227,32 @@ func (r *SampleReader) parseMsg(msgs []syscall.NetlinkMessage, printlnFunc func(
 					if err != nil {
 						return err
 					}
-					r.cookieStr[0] = fmt.Sprintf("group_id=%v", g)
+					sample.GroupID = g
+					if r.logCookie {
+						r.cookieStr[0] = fmt.Sprintf("group_id=%v", g)
+					}
 				}
 			}
-			if attr.Type == PSAMPLE_ATTR_USER_COOKIE && (r.logCookie || r.decoder != nil) {
+			if attr.Type == PSAMPLE_ATTR_USER_COOKIE {
 				if uint64(len(attr.Value)) == sampledecoder.CookieSize {
 					c := sampledecoder.Cookie{}
 					err := binary.Read(bytes.NewReader(attr.Value), sampledecoder.SampleEndian, &c)
 					if err != nil {
 						return err
 					}
+					sample.ObsDomainID, sample.ObsPointID = c.ObsDomainID, c.ObsPointID
 					if r.logCookie {
 						r.cookieStr[1] = fmt.Sprintf("obs_domain=%v, obs_point=%v",
 							c.ObsDomainID, c.ObsPointID)
 					}
 					if r.decoder != nil {
-						decoded, err := r.decoder.DecodeCookieIDs(c.ObsDomainID, c.ObsPointID)
+						decoded, err := r.decoder.DecodeCookieIDsStructured(c.ObsDomainID, c.ObsPointID)
 						if err != nil {
+							sample.DecodeError = err.Error()
 							sampleStr = fmt.Sprintf("decoding failed: %v", err)
 						} else {
-							sampleStr = fmt.Sprintf("OVN-K message: %s", decoded)
+							sample.Decoded = decoded
+							sampleStr = fmt.Sprintf("OVN-K message: %s", decoded.Message)
 						}
 					}
 				}
@@ -229,15 +272,23 @@ func (r *SampleReader) parseMsg(msgs []syscall.NetlinkMessage, printlnFunc func(
 				if r.dstIP != "" && r.dstIP != networkLayer.Dst().String() {
 					return nil
 				}
+				sample.SetPacket(packet)
 			}
 		}
-		if r.logCookie {
-			printlnFunc(strings.Join(r.cookieStr, ", "))
+		if r.outputFormat == OutputFormatText {
+			if r.logCookie {
+				printlnFunc(strings.Join(r.cookieStr, ", "))
+			}
+			if r.decoder != nil {
+				printlnFunc(sampleStr)
+			}
+			printlnFunc(packetStr)
 		}
-		if r.decoder != nil {
-			printlnFunc(sampleStr)
+		for _, s := range r.sinks {
+			if err := s.Write(sample); err != nil {
+				printlnFunc("ERROR: writing sample failed:", err)
+			}
 		}
-		printlnFunc(packetStr)
 	}
 	return nil
 }
@@ -117,11 +117,34 @@ func findACLBySample(nbClient client.Client, acl *nbdb.ACL) ([]*nbdb.ACL, error)
 	return found, err
 }
 
+// DecodedSample is the context of the db object that generated a sample.
+type DecodedSample struct {
+	// Action is the action of the ACL, e.g. allow-related or drop
+	Action string `json:"action"`
+	// OwnerType is the type of the object owning the ACL, e.g. NetworkPolicy
+	OwnerType string `json:"ownerType"`
+	// OwnerName is the name of the object owning the ACL, <namespace>:<name> for network policies
+	OwnerName string `json:"ownerName,omitempty"`
+	// Direction is the direction of the policy, Ingress or Egress
+	Direction string `json:"direction,omitempty"`
+	// Message is the human-readable description of the sample
+	Message string `json:"message"`
+}
+
 func (d *SampleDecoder) DecodeCookieIDs(obsDomainID, obsPointID uint32) (string, error) {
+	decoded, err := d.DecodeCookieIDsStructured(obsDomainID, obsPointID)
+	if err != nil {
+		return "", err
+	}
+	return decoded.Message, nil
+}
+
+// DecodeCookieIDsStructured is the same as DecodeCookieIDs, but returns the context of the sample in a structured form.
+func (d *SampleDecoder) DecodeCookieIDsStructured(obsDomainID, obsPointID uint32) (*DecodedSample, error) {
 	// Find sample using obsPointID
 	sample, err := libovsdbops.FindSample(d.nbClient, int(obsPointID))
 	if err != nil || sample == nil {
-		return "", fmt.Errorf("find sample failed: %w", err)
+		return nil, fmt.Errorf("find sample failed: %w", err)
 	}
 	// find db object using observ application ID
 	// Since ACL is indexed both by sample_new and sample_est, when searching by one of them,
@@ -133,29 +156,38 @@ func (d *SampleDecoder) DecodeCookieIDs(obsDomainID, obsPointID uint32) (string,
 	case observability.ACLNewTrafficSamplingID:
 		acls, err := findACLBySample(d.nbClient, &nbdb.ACL{SampleNew: &sample.UUID, SampleEst: &wrongUUID})
 		if err != nil {
-			return "", fmt.Errorf("find acl for sample failed: %w", err)
+			return nil, fmt.Errorf("find acl for sample failed: %w", err)
 		}
 		if len(acls) != 1 {
-			return "", fmt.Errorf("expected 1 ACL, got %d", len(acls))
+			return nil, fmt.Errorf("expected 1 ACL, got %d", len(acls))
 		}
 		dbObj = acls[0]
 	case observability.ACLEstTrafficSamplingID:
 		acls, err := findACLBySample(d.nbClient, &nbdb.ACL{SampleNew: &wrongUUID, SampleEst: &sample.UUID})
 		if err != nil {
-			return "", fmt.Errorf("find acl for sample failed: %w", err)
+			return nil, fmt.Errorf("find acl for sample failed: %w", err)
 		}
 		if len(acls) != 1 {
-			return "", fmt.Errorf("expected 1 ACL, got %d", len(acls))
+			return nil, fmt.Errorf("expected 1 ACL, got %d", len(acls))
 		}
 		dbObj = acls[0]
 	default:
-		return "", fmt.Errorf("unknown app ID: %d", getObservAppID(obsDomainID))
+		return nil, fmt.Errorf("unknown app ID: %d", getObservAppID(obsDomainID))
 	}
 	msg := getMessage(dbObj)
 	if msg == "" {
-		return "", fmt.Errorf("failed to get message for db object %v", dbObj)
+		return nil, fmt.Errorf("failed to get message for db object %v", dbObj)
+	}
+	decoded := &DecodedSample{
+		Message: msg,
+	}
+	if acl, ok := dbObj.(*nbdb.ACL); ok {
+		decoded.Action = acl.Action
+		decoded.OwnerType = acl.ExternalIDs[libovsdbops.OwnerTypeKey.String()]
+		decoded.OwnerName = acl.ExternalIDs[libovsdbops.ObjectNameKey.String()]
+		decoded.Direction = acl.ExternalIDs[libovsdbops.PolicyDirectionKey.String()]
 	}
-	return msg, nil
+	return decoded, nil
 }
 
 func getMessage(dbObj interface{}) string {
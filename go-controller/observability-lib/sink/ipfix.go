@@ -0,0 +1,177 @@
+package sink
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+)
+
+// IPFIX (RFC 7011) constants
+const (
+	ipfixVersion         = 10
+	ipfixTemplateSetID   = 2
+	ipfixMessageHdrLen   = 16
+	ipfixSetHdrLen       = 4
+	ipfixIPv4TemplateID  = 256
+	ipfixIPv6TemplateID  = 257
+	ipfixTemplateRefresh = time.Minute
+
+	// forwardingStatus values, RFC 7270
+	ipfixForwardingStatusUnknown   = 0x00
+	ipfixForwardingStatusForwarded = 0x40
+	ipfixForwardingStatusDropped   = 0x80
+)
+
+// ipfixField is an IANA information element with its encoded length.
+type ipfixField struct {
+	id     uint16
+	length uint16
+}
+
+// IANA information elements, https://www.iana.org/assignments/ipfix/ipfix.xhtml
+var (
+	ipfixFlowStartMilliseconds    = ipfixField{id: 152, length: 8}
+	ipfixObservationDomainID      = ipfixField{id: 149, length: 4}
+	ipfixObservationPointID       = ipfixField{id: 138, length: 4}
+	ipfixSourceIPv4Address        = ipfixField{id: 8, length: 4}
+	ipfixDestinationIPv4Address   = ipfixField{id: 12, length: 4}
+	ipfixSourceIPv6Address        = ipfixField{id: 27, length: 16}
+	ipfixDestinationIPv6Address   = ipfixField{id: 28, length: 16}
+	ipfixProtocolIdentifier       = ipfixField{id: 4, length: 1}
+	ipfixSourceTransportPort      = ipfixField{id: 7, length: 2}
+	ipfixDestinationTransportPort = ipfixField{id: 11, length: 2}
+	ipfixForwardingStatus         = ipfixField{id: 89, length: 1}
+)
+
+var ipfixTemplates = map[uint16][]ipfixField{
+	ipfixIPv4TemplateID: {ipfixFlowStartMilliseconds, ipfixObservationDomainID, ipfixObservationPointID,
+		ipfixSourceIPv4Address, ipfixDestinationIPv4Address, ipfixProtocolIdentifier,
+		ipfixSourceTransportPort, ipfixDestinationTransportPort, ipfixForwardingStatus},
+	ipfixIPv6TemplateID: {ipfixFlowStartMilliseconds, ipfixObservationDomainID, ipfixObservationPointID,
+		ipfixSourceIPv6Address, ipfixDestinationIPv6Address, ipfixProtocolIdentifier,
+		ipfixSourceTransportPort, ipfixDestinationTransportPort, ipfixForwardingStatus},
+}
+
+// IPFIXExporter exports every sample as an IPFIX data record over UDP.
+// The OVN observation domain and point IDs of the sample are exported, so that the collector can correlate the
+// records with the nbdb, together with the addresses, ports and forwarding status (forwarded or dropped).
+// Templates are sent with the first record and refreshed every minute, as required for UDP transport.
+type IPFIXExporter struct {
+	mu                  sync.Mutex
+	conn                net.Conn
+	observationDomainID uint32
+	sequence            uint32
+	templatesSent       time.Time
+	now                 func() time.Time
+}
+
+// NewIPFIXExporter creates an exporter sending IPFIX messages to the collector listening on the given UDP address.
+// observationDomainID is set in the header of every message, to identify the exporting node.
+func NewIPFIXExporter(address string, observationDomainID uint32) (*IPFIXExporter, error) {
+	conn, err := net.Dial("udp", address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to IPFIX collector %s: %w", address, err)
+	}
+	return &IPFIXExporter{
+		conn:                conn,
+		observationDomainID: observationDomainID,
+		now:                 time.Now,
+	}, nil
+}
+
+func (e *IPFIXExporter) Write(sample *Sample) error {
+	var templateID uint16
+	switch {
+	case sample.SrcIP.To4() != nil && sample.DstIP.To4() != nil:
+		templateID = ipfixIPv4TemplateID
+	case sample.SrcIP.To16() != nil && sample.DstIP.To16() != nil:
+		templateID = ipfixIPv6TemplateID
+	default:
+		// only IP packets are exported
+		return nil
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	now := e.now()
+	withTemplates := now.Sub(e.templatesSent) >= ipfixTemplateRefresh
+	msg := e.encodeMessage(sample, templateID, withTemplates, now)
+	if _, err := e.conn.Write(msg); err != nil {
+		return fmt.Errorf("failed to send IPFIX message: %w", err)
+	}
+	if withTemplates {
+		e.templatesSent = now
+	}
+	e.sequence++
+	return nil
+}
+
+func (e *IPFIXExporter) Close() error {
+	return e.conn.Close()
+}
+
+// encodeMessage encodes an IPFIX message with one data record for the sample, preceded by the template set if requested.
+func (e *IPFIXExporter) encodeMessage(sample *Sample, templateID uint16, withTemplates bool, now time.Time) []byte {
+	msg := make([]byte, ipfixMessageHdrLen)
+	if withTemplates {
+		msg = append(msg, encodeTemplateSet()...)
+	}
+	msg = append(msg, encodeDataSet(sample, templateID)...)
+
+	binary.BigEndian.PutUint16(msg[0:], ipfixVersion)
+	binary.BigEndian.PutUint16(msg[2:], uint16(len(msg)))
+	binary.BigEndian.PutUint32(msg[4:], uint32(now.Unix()))
+	// sequence number is the number of data records sent before this message
+	binary.BigEndian.PutUint32(msg[8:], e.sequence)
+	binary.BigEndian.PutUint32(msg[12:], e.observationDomainID)
+	return msg
+}
+
+func encodeTemplateSet() []byte {
+	set := make([]byte, ipfixSetHdrLen)
+	for _, templateID := range []uint16{ipfixIPv4TemplateID, ipfixIPv6TemplateID} {
+		fields := ipfixTemplates[templateID]
+		set = binary.BigEndian.AppendUint16(set, templateID)
+		set = binary.BigEndian.AppendUint16(set, uint16(len(fields)))
+		for _, field := range fields {
+			set = binary.BigEndian.AppendUint16(set, field.id)
+			set = binary.BigEndian.AppendUint16(set, field.length)
+		}
+	}
+	binary.BigEndian.PutUint16(set[0:], ipfixTemplateSetID)
+	binary.BigEndian.PutUint16(set[2:], uint16(len(set)))
+	return set
+}
+
+func encodeDataSet(sample *Sample, templateID uint16) []byte {
+	set := make([]byte, ipfixSetHdrLen)
+	set = binary.BigEndian.AppendUint64(set, uint64(sample.Timestamp.UnixMilli()))
+	set = binary.BigEndian.AppendUint32(set, sample.ObsDomainID)
+	set = binary.BigEndian.AppendUint32(set, sample.ObsPointID)
+	if templateID == ipfixIPv4TemplateID {
+		set = append(set, sample.SrcIP.To4()...)
+		set = append(set, sample.DstIP.To4()...)
+	} else {
+		set = append(set, sample.SrcIP.To16()...)
+		set = append(set, sample.DstIP.To16()...)
+	}
+	set = append(set, sample.Protocol)
+	set = binary.BigEndian.AppendUint16(set, sample.SrcPort)
+	set = binary.BigEndian.AppendUint16(set, sample.DstPort)
+	set = append(set, forwardingStatus(sample))
+	// data set ID is the ID of the template describing its records
+	binary.BigEndian.PutUint16(set[0:], templateID)
+	binary.BigEndian.PutUint16(set[2:], uint16(len(set)))
+	return set
+}
+
+func forwardingStatus(sample *Sample) uint8 {
+	switch sample.Action() {
+	case "allow", "pass":
+		return ipfixForwardingStatusForwarded
+	case "drop":
+		return ipfixForwardingStatusDropped
+	}
+	return ipfixForwardingStatusUnknown
+}
@@ -0,0 +1,45 @@
+package sink
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+)
+
+// JSONSink writes every sample as a JSON object on its own line (newline-delimited JSON).
+type JSONSink struct {
+	mu      sync.Mutex
+	writer  io.Writer
+	encoder *json.Encoder
+}
+
+// NewJSONSink creates a sink writing newline-delimited JSON to writer.
+// If writer is buffered, it is flushed after every sample.
+func NewJSONSink(writer io.Writer) *JSONSink {
+	return &JSONSink{
+		writer:  writer,
+		encoder: json.NewEncoder(writer),
+	}
+}
+
+func (s *JSONSink) Write(sample *Sample) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.encoder.Encode(sample); err != nil {
+		return err
+	}
+	return s.flush()
+}
+
+func (s *JSONSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.flush()
+}
+
+func (s *JSONSink) flush() error {
+	if flusher, ok := s.writer.(interface{ Flush() error }); ok {
+		return flusher.Flush()
+	}
+	return nil
+}
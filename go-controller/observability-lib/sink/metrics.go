@@ -0,0 +1,98 @@
+package sink
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	metricNamespace = "ovnkube"
+	metricSubsystem = "observ"
+)
+
+// MetricsAggregator counts the samples per policy and verdict, and exposes the counters on a Prometheus endpoint.
+// That allows alerting on, for example, network policy drops without exporting every sample.
+type MetricsAggregator struct {
+	samples      *prometheus.CounterVec
+	decodeErrors prometheus.Counter
+	registry     *prometheus.Registry
+	server       *http.Server
+	listener     net.Listener
+}
+
+// NewMetricsAggregator creates an aggregator serving the metrics on bindAddress at /metrics.
+// The endpoint is only served once Start is called.
+func NewMetricsAggregator(bindAddress string) *MetricsAggregator {
+	a := &MetricsAggregator{
+		samples: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricNamespace,
+			Subsystem: metricSubsystem,
+			Name:      "samples_total",
+			Help:      "The number of decoded samples by the verdict and the owner of the ACL that generated them",
+		}, []string{"action", "owner_type", "owner_name", "direction"}),
+		decodeErrors: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: metricNamespace,
+			Subsystem: metricSubsystem,
+			Name:      "sample_decode_errors_total",
+			Help:      "The number of samples that could not be decoded",
+		}),
+		registry: prometheus.NewRegistry(),
+	}
+	a.registry.MustRegister(a.samples, a.decodeErrors)
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(a.registry, promhttp.HandlerOpts{}))
+	a.server = &http.Server{
+		Addr:              bindAddress,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	return a
+}
+
+// Start serves the metrics endpoint in the background.
+func (a *MetricsAggregator) Start() error {
+	listener, err := net.Listen("tcp", a.server.Addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", a.server.Addr, err)
+	}
+	a.listener = listener
+	go func() {
+		if err := a.server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			fmt.Fprintf(os.Stderr, "Error serving metrics: %v\n", err)
+		}
+	}()
+	return nil
+}
+
+// Addr returns the address the metrics are served on, once started.
+func (a *MetricsAggregator) Addr() net.Addr {
+	return a.listener.Addr()
+}
+
+func (a *MetricsAggregator) Write(sample *Sample) error {
+	if sample.Decoded == nil {
+		// samples are not decoded at all when the decoder is disabled, only count the actual failures
+		if sample.DecodeError != "" {
+			a.decodeErrors.Inc()
+		}
+		return nil
+	}
+	a.samples.WithLabelValues(sample.Action(), sample.Decoded.OwnerType, sample.Decoded.OwnerName,
+		sample.Decoded.Direction).Inc()
+	return nil
+}
+
+func (a *MetricsAggregator) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return a.server.Shutdown(ctx)
+}
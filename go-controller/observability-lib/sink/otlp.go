@@ -0,0 +1,223 @@
+package sink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	otlpScopeName      = "ovnkube-observ"
+	otlpMaxBatchSize   = 512
+	otlpRequestTimeout = 10 * time.Second
+	// severityNumber values of the OTLP logs data model
+	otlpSeverityInfo = 9
+	otlpSeverityWarn = 13
+)
+
+// OTLPExporter exports every sample as an OpenTelemetry log record, using the OTLP/HTTP protocol with JSON encoding.
+// Records are batched and sent every flush interval, or as soon as a batch is full.
+type OTLPExporter struct {
+	endpoint      string
+	client        *http.Client
+	flushInterval time.Duration
+	resource      otlpResource
+
+	mu      sync.Mutex
+	records []otlpLogRecord
+
+	flushCh chan struct{}
+	stopCh  chan struct{}
+	wg      sync.WaitGroup
+}
+
+// The following types are the JSON encoding of the OTLP ExportLogsServiceRequest,
+// see https://opentelemetry.io/docs/specs/otlp/#json-protobuf-encoding
+type otlpExportLogsRequest struct {
+	ResourceLogs []otlpResourceLogs `json:"resourceLogs"`
+}
+
+type otlpResourceLogs struct {
+	Resource  otlpResource    `json:"resource"`
+	ScopeLogs []otlpScopeLogs `json:"scopeLogs"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes"`
+}
+
+type otlpScopeLogs struct {
+	Scope      otlpScope       `json:"scope"`
+	LogRecords []otlpLogRecord `json:"logRecords"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpLogRecord struct {
+	// 64-bit integers are encoded as decimal strings
+	TimeUnixNano   string         `json:"timeUnixNano"`
+	SeverityNumber int            `json:"severityNumber"`
+	SeverityText   string         `json:"severityText"`
+	Body           otlpAnyValue   `json:"body"`
+	Attributes     []otlpKeyValue `json:"attributes"`
+}
+
+type otlpKeyValue struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+type otlpAnyValue struct {
+	StringValue *string `json:"stringValue,omitempty"`
+	IntValue    *string `json:"intValue,omitempty"`
+}
+
+func stringAttribute(key, value string) otlpKeyValue {
+	return otlpKeyValue{Key: key, Value: otlpAnyValue{StringValue: &value}}
+}
+
+func intAttribute(key string, value int64) otlpKeyValue {
+	intValue := strconv.FormatInt(value, 10)
+	return otlpKeyValue{Key: key, Value: otlpAnyValue{IntValue: &intValue}}
+}
+
+// NewOTLPExporter creates an exporter posting the records to the OTLP/HTTP logs endpoint,
+// e.g. http://otel-collector:4318/v1/logs. Start must be called to send the records.
+func NewOTLPExporter(endpoint string, flushInterval time.Duration) *OTLPExporter {
+	attributes := []otlpKeyValue{stringAttribute("service.name", otlpScopeName)}
+	if hostname, err := os.Hostname(); err == nil {
+		attributes = append(attributes, stringAttribute("host.name", hostname))
+	}
+	return &OTLPExporter{
+		endpoint:      endpoint,
+		client:        &http.Client{Timeout: otlpRequestTimeout},
+		flushInterval: flushInterval,
+		resource:      otlpResource{Attributes: attributes},
+		flushCh:       make(chan struct{}, 1),
+		stopCh:        make(chan struct{}),
+	}
+}
+
+// Start sends the batched records in the background until Close is called.
+func (e *OTLPExporter) Start() {
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+		ticker := time.NewTicker(e.flushInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-e.stopCh:
+				return
+			case <-ticker.C:
+			case <-e.flushCh:
+			}
+			if err := e.flush(); err != nil {
+				fmt.Fprintf(os.Stderr, "Error exporting OTLP logs: %v\n", err)
+			}
+		}
+	}()
+}
+
+func (e *OTLPExporter) Write(sample *Sample) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.records = append(e.records, newOTLPLogRecord(sample))
+	if len(e.records) >= otlpMaxBatchSize {
+		select {
+		case e.flushCh <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+// Close stops the background sending and sends the remaining records.
+func (e *OTLPExporter) Close() error {
+	close(e.stopCh)
+	e.wg.Wait()
+	return e.flush()
+}
+
+func (e *OTLPExporter) flush() error {
+	e.mu.Lock()
+	records := e.records
+	e.records = nil
+	e.mu.Unlock()
+	if len(records) == 0 {
+		return nil
+	}
+	body, err := json.Marshal(otlpExportLogsRequest{
+		ResourceLogs: []otlpResourceLogs{{
+			Resource: e.resource,
+			ScopeLogs: []otlpScopeLogs{{
+				Scope:      otlpScope{Name: otlpScopeName},
+				LogRecords: records,
+			}},
+		}},
+	})
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), otlpRequestTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send %d records to %s: %w", len(records), e.endpoint, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("failed to send %d records to %s: %s", len(records), e.endpoint, resp.Status)
+	}
+	return nil
+}
+
+func newOTLPLogRecord(sample *Sample) otlpLogRecord {
+	record := otlpLogRecord{
+		TimeUnixNano:   strconv.FormatInt(sample.Timestamp.UnixNano(), 10),
+		SeverityNumber: otlpSeverityInfo,
+		SeverityText:   "INFO",
+		Attributes: []otlpKeyValue{
+			stringAttribute("action", sample.Action()),
+			intAttribute("obs_domain_id", int64(sample.ObsDomainID)),
+			intAttribute("obs_point_id", int64(sample.ObsPointID)),
+		},
+	}
+	message := "sample could not be decoded"
+	if sample.Decoded != nil {
+		message = sample.Decoded.Message
+		record.Attributes = append(record.Attributes,
+			stringAttribute("owner_type", sample.Decoded.OwnerType),
+			stringAttribute("owner_name", sample.Decoded.OwnerName),
+			stringAttribute("direction", sample.Decoded.Direction),
+		)
+	} else if sample.DecodeError != "" {
+		message = sample.DecodeError
+		record.SeverityNumber = otlpSeverityWarn
+		record.SeverityText = "WARN"
+	}
+	record.Body = otlpAnyValue{StringValue: &message}
+	if sample.SrcIP != nil {
+		record.Attributes = append(record.Attributes,
+			stringAttribute("src_ip", sample.SrcIP.String()),
+			stringAttribute("dst_ip", sample.DstIP.String()),
+			intAttribute("protocol", int64(sample.Protocol)),
+			intAttribute("src_port", int64(sample.SrcPort)),
+			intAttribute("dst_port", int64(sample.DstPort)),
+		)
+	}
+	return record
+}
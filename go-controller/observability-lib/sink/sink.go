@@ -0,0 +1,73 @@
+// Package sink provides the destinations decoded samples can be exported to.
+package sink
+
+import (
+	"net"
+	"time"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/observability-lib/sampledecoder"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+)
+
+// Sample is a packet sample received from the kernel, enriched with the nbdb context when decoding is enabled.
+type Sample struct {
+	Timestamp   time.Time `json:"timestamp"`
+	GroupID     uint32    `json:"groupID,omitempty"`
+	ObsDomainID uint32    `json:"obsDomainID"`
+	ObsPointID  uint32    `json:"obsPointID"`
+	SrcIP       net.IP    `json:"srcIP,omitempty"`
+	DstIP       net.IP    `json:"dstIP,omitempty"`
+	// Protocol is the IANA protocol number of the transport layer
+	Protocol uint8  `json:"protocol,omitempty"`
+	SrcPort  uint16 `json:"srcPort,omitempty"`
+	DstPort  uint16 `json:"dstPort,omitempty"`
+	// Decoded is nil when decoding is disabled or failed
+	Decoded     *sampledecoder.DecodedSample `json:"decoded,omitempty"`
+	DecodeError string                       `json:"decodeError,omitempty"`
+}
+
+// Sink is a destination for samples. Write is called for every received sample,
+// and Close when no more samples will be written.
+type Sink interface {
+	Write(sample *Sample) error
+	Close() error
+}
+
+// SetPacket fills the addresses, protocol and ports of the sample from the sampled packet.
+func (s *Sample) SetPacket(packet gopacket.Packet) {
+	switch ip := packet.NetworkLayer().(type) {
+	case *layers.IPv4:
+		s.SrcIP, s.DstIP = ip.SrcIP, ip.DstIP
+		s.Protocol = uint8(ip.Protocol)
+	case *layers.IPv6:
+		s.SrcIP, s.DstIP = ip.SrcIP, ip.DstIP
+		s.Protocol = uint8(ip.NextHeader)
+	}
+	switch transport := packet.TransportLayer().(type) {
+	case *layers.TCP:
+		s.SrcPort, s.DstPort = uint16(transport.SrcPort), uint16(transport.DstPort)
+	case *layers.UDP:
+		s.SrcPort, s.DstPort = uint16(transport.SrcPort), uint16(transport.DstPort)
+	case *layers.SCTP:
+		s.SrcPort, s.DstPort = uint16(transport.SrcPort), uint16(transport.DstPort)
+	}
+}
+
+// Action returns the verdict of the sample: allow, drop, pass or unknown if the sample was not decoded.
+func (s *Sample) Action() string {
+	if s.Decoded == nil {
+		return "unknown"
+	}
+	switch s.Decoded.Action {
+	case nbdb.ACLActionAllow, nbdb.ACLActionAllowRelated, nbdb.ACLActionAllowStateless:
+		return "allow"
+	case nbdb.ACLActionDrop, nbdb.ACLActionReject:
+		return "drop"
+	case nbdb.ACLActionPass:
+		return "pass"
+	}
+	return "unknown"
+}
@@ -0,0 +1,242 @@
+package sink
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/observability-lib/sampledecoder"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+)
+
+func assertNoError(t *testing.T, err error) {
+	t.Helper()
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+}
+
+func assertLen(t *testing.T, object interface{}, length int) {
+	t.Helper()
+	if !assert.Len(t, object, length) {
+		t.FailNow()
+	}
+}
+
+func newTestSample(action string) *Sample {
+	return &Sample{
+		Timestamp:   time.UnixMilli(1700000000123),
+		GroupID:     10,
+		ObsDomainID: 33554432,
+		ObsPointID:  5,
+		SrcIP:       net.ParseIP("10.128.0.5").To4(),
+		DstIP:       net.ParseIP("10.128.1.7").To4(),
+		Protocol:    6,
+		SrcPort:     34567,
+		DstPort:     8080,
+		Decoded: &sampledecoder.DecodedSample{
+			Action:    action,
+			OwnerType: "NetworkPolicy",
+			OwnerName: "ns1:deny-all",
+			Direction: "Ingress",
+			Message:   "Dropped by network policy in namespace ns1, name deny-all, direction Ingress",
+		},
+	}
+}
+
+func TestSampleAction(t *testing.T) {
+	tests := []struct {
+		action   string
+		expected string
+	}{
+		{nbdb.ACLActionAllowRelated, "allow"},
+		{nbdb.ACLActionAllowStateless, "allow"},
+		{nbdb.ACLActionDrop, "drop"},
+		{nbdb.ACLActionReject, "drop"},
+		{nbdb.ACLActionPass, "pass"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, newTestSample(tt.action).Action(), tt.action)
+	}
+	assert.Equal(t, "unknown", (&Sample{DecodeError: "find sample failed"}).Action())
+}
+
+func TestJSONSink(t *testing.T) {
+	buf := &bytes.Buffer{}
+	s := NewJSONSink(buf)
+	assertNoError(t, s.Write(newTestSample(nbdb.ACLActionDrop)))
+	assertNoError(t, s.Write(&Sample{ObsDomainID: 1, ObsPointID: 2, DecodeError: "find sample failed"}))
+	assertNoError(t, s.Close())
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assertLen(t, lines, 2)
+	var decoded map[string]interface{}
+	assertNoError(t, json.Unmarshal([]byte(lines[0]), &decoded))
+	assert.Equal(t, "10.128.0.5", decoded["srcIP"])
+	assert.Equal(t, float64(8080), decoded["dstPort"])
+	assert.Equal(t, map[string]interface{}{
+		"action":    nbdb.ACLActionDrop,
+		"ownerType": "NetworkPolicy",
+		"ownerName": "ns1:deny-all",
+		"direction": "Ingress",
+		"message":   "Dropped by network policy in namespace ns1, name deny-all, direction Ingress",
+	}, decoded["decoded"])
+	assert.JSONEq(t, `{"timestamp":"0001-01-01T00:00:00Z","obsDomainID":1,"obsPointID":2,"decodeError":"find sample failed"}`, lines[1])
+}
+
+func TestIPFIXExporter(t *testing.T) {
+	collector, err := net.ListenPacket("udp", "127.0.0.1:0")
+	assertNoError(t, err)
+	defer collector.Close()
+
+	exporter, err := NewIPFIXExporter(collector.LocalAddr().String(), 42)
+	assertNoError(t, err)
+	defer exporter.Close()
+	now := time.Unix(1700000000, 0)
+	exporter.now = func() time.Time { return now }
+
+	readMessage := func() []byte {
+		assertNoError(t, collector.SetReadDeadline(time.Now().Add(5*time.Second)))
+		buf := make([]byte, 65535)
+		n, _, err := collector.ReadFrom(buf)
+		assertNoError(t, err)
+		return buf[:n]
+	}
+
+	// first message carries the templates
+	assertNoError(t, exporter.Write(newTestSample(nbdb.ACLActionDrop)))
+	msg := readMessage()
+	assert.Equal(t, uint16(ipfixVersion), binary.BigEndian.Uint16(msg[0:]))
+	assert.Equal(t, uint16(len(msg)), binary.BigEndian.Uint16(msg[2:]))
+	assert.Equal(t, uint32(now.Unix()), binary.BigEndian.Uint32(msg[4:]))
+	assert.Equal(t, uint32(0), binary.BigEndian.Uint32(msg[8:]))
+	assert.Equal(t, uint32(42), binary.BigEndian.Uint32(msg[12:]))
+	set := msg[ipfixMessageHdrLen:]
+	assert.Equal(t, uint16(ipfixTemplateSetID), binary.BigEndian.Uint16(set[0:]))
+	templateSetLen := binary.BigEndian.Uint16(set[2:])
+	assert.Equal(t, uint16(ipfixIPv4TemplateID), binary.BigEndian.Uint16(set[4:]))
+	assert.Equal(t, uint16(len(ipfixTemplates[ipfixIPv4TemplateID])), binary.BigEndian.Uint16(set[6:]))
+
+	record := set[templateSetLen:]
+	assert.Equal(t, uint16(ipfixIPv4TemplateID), binary.BigEndian.Uint16(record[0:]))
+	// set header + timestamp + obs IDs + IPv4 addresses + protocol + ports + forwarding status
+	assert.Equal(t, uint16(4+8+4+4+4+4+1+2+2+1), binary.BigEndian.Uint16(record[2:]))
+	assert.Equal(t, uint64(1700000000123), binary.BigEndian.Uint64(record[4:]))
+	assert.Equal(t, uint32(33554432), binary.BigEndian.Uint32(record[12:]))
+	assert.Equal(t, uint32(5), binary.BigEndian.Uint32(record[16:]))
+	assert.Equal(t, net.IP(record[20:24]).String(), "10.128.0.5")
+	assert.Equal(t, net.IP(record[24:28]).String(), "10.128.1.7")
+	assert.Equal(t, uint8(6), record[28])
+	assert.Equal(t, uint16(34567), binary.BigEndian.Uint16(record[29:]))
+	assert.Equal(t, uint16(8080), binary.BigEndian.Uint16(record[31:]))
+	assert.Equal(t, uint8(ipfixForwardingStatusDropped), record[33])
+
+	// next message only carries the IPv6 data record
+	ipv6Sample := newTestSample(nbdb.ACLActionAllowRelated)
+	ipv6Sample.SrcIP, ipv6Sample.DstIP = net.ParseIP("fd00:10:244::5"), net.ParseIP("fd00:10:244::7")
+	assertNoError(t, exporter.Write(ipv6Sample))
+	msg = readMessage()
+	assert.Equal(t, uint32(1), binary.BigEndian.Uint32(msg[8:]))
+	record = msg[ipfixMessageHdrLen:]
+	assert.Equal(t, uint16(ipfixIPv6TemplateID), binary.BigEndian.Uint16(record[0:]))
+	assert.Equal(t, net.IP(record[20:36]).String(), "fd00:10:244::5")
+	assert.Equal(t, uint8(ipfixForwardingStatusForwarded), record[len(record)-1])
+
+	// templates are refreshed
+	now = now.Add(ipfixTemplateRefresh)
+	assertNoError(t, exporter.Write(newTestSample(nbdb.ACLActionDrop)))
+	msg = readMessage()
+	assert.Equal(t, uint32(2), binary.BigEndian.Uint32(msg[8:]))
+	assert.Equal(t, uint16(ipfixTemplateSetID), binary.BigEndian.Uint16(msg[ipfixMessageHdrLen:]))
+}
+
+func TestOTLPExporter(t *testing.T) {
+	requests := make(chan otlpExportLogsRequest, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		var req otlpExportLogsRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		requests <- req
+	}))
+	defer server.Close()
+
+	exporter := NewOTLPExporter(server.URL+"/v1/logs", time.Hour)
+	exporter.Start()
+	assertNoError(t, exporter.Write(newTestSample(nbdb.ACLActionDrop)))
+	assertNoError(t, exporter.Write(&Sample{DecodeError: "find sample failed"}))
+	// records are sent on close
+	assertNoError(t, exporter.Close())
+
+	var req otlpExportLogsRequest
+	select {
+	case req = <-requests:
+	case <-time.After(5 * time.Second):
+		t.Fatal("no OTLP request received")
+	}
+	assertLen(t, req.ResourceLogs, 1)
+	assert.Contains(t, req.ResourceLogs[0].Resource.Attributes, stringAttribute("service.name", otlpScopeName))
+	assertLen(t, req.ResourceLogs[0].ScopeLogs, 1)
+	records := req.ResourceLogs[0].ScopeLogs[0].LogRecords
+	assertLen(t, records, 2)
+
+	assert.Equal(t, "1700000000123000000", records[0].TimeUnixNano)
+	assert.Equal(t, "INFO", records[0].SeverityText)
+	assert.Equal(t, "Dropped by network policy in namespace ns1, name deny-all, direction Ingress", *records[0].Body.StringValue)
+	assert.Contains(t, records[0].Attributes, stringAttribute("action", "drop"))
+	assert.Contains(t, records[0].Attributes, stringAttribute("owner_name", "ns1:deny-all"))
+	assert.Contains(t, records[0].Attributes, stringAttribute("src_ip", "10.128.0.5"))
+	assert.Contains(t, records[0].Attributes, intAttribute("dst_port", 8080))
+
+	assert.Equal(t, "WARN", records[1].SeverityText)
+	assert.Equal(t, "find sample failed", *records[1].Body.StringValue)
+}
+
+func TestMetricsAggregator(t *testing.T) {
+	aggregator := NewMetricsAggregator("127.0.0.1:0")
+	assertNoError(t, aggregator.Start())
+	defer aggregator.Close()
+
+	assertNoError(t, aggregator.Write(newTestSample(nbdb.ACLActionDrop)))
+	assertNoError(t, aggregator.Write(newTestSample(nbdb.ACLActionDrop)))
+	assertNoError(t, aggregator.Write(newTestSample(nbdb.ACLActionAllowRelated)))
+	assertNoError(t, aggregator.Write(&Sample{DecodeError: "find sample failed"}))
+
+	resp, err := http.Get(fmt.Sprintf("http://%s/metrics", aggregator.Addr()))
+	assertNoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	assertNoError(t, err)
+	assert.Contains(t, string(body),
+		`ovnkube_observ_samples_total{action="drop",direction="Ingress",owner_name="ns1:deny-all",owner_type="NetworkPolicy"} 2`)
+	assert.Contains(t, string(body),
+		`ovnkube_observ_samples_total{action="allow",direction="Ingress",owner_name="ns1:deny-all",owner_type="NetworkPolicy"} 1`)
+	assert.Contains(t, string(body), "ovnkube_observ_sample_decode_errors_total 1")
+}
+
+func TestMetricsAggregatorUndecodedSample(t *testing.T) {
+	aggregator := NewMetricsAggregator("127.0.0.1:0")
+	assertNoError(t, aggregator.Start())
+	defer aggregator.Close()
+
+	// a sample read with the decoder disabled is neither counted nor a decode error
+	assertNoError(t, aggregator.Write(&Sample{ObsDomainID: 1, ObsPointID: 2}))
+
+	resp, err := http.Get(fmt.Sprintf("http://%s/metrics", aggregator.Addr()))
+	assertNoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	assertNoError(t, err)
+	assert.NotContains(t, string(body), "ovnkube_observ_samples_total{")
+	assert.Contains(t, string(body), "ovnkube_observ_sample_decode_errors_total 0")
+}
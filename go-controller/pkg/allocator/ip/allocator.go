@@ -36,6 +36,8 @@ type Interface interface {
 	CIDR() net.IPNet
 	Has(ip net.IP) bool
 	Reserved(ip net.IP) bool
+	Free() int
+	Used() int
 }
 
 var (
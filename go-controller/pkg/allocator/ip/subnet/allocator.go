@@ -12,6 +12,7 @@ import (
 	ipallocator "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/allocator/ip"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
 	"k8s.io/klog/v2"
+	utilnet "k8s.io/utils/net"
 )
 
 // Allocator manages the allocation of IP within specific set of subnets
@@ -27,6 +28,7 @@ type Allocator interface {
 	ConditionalIPRelease(name string, ips []*net.IPNet, predicate func() (bool, error)) (bool, error)
 	ForSubnet(name string) NamedAllocator
 	GetSubnetName(subnets []*net.IPNet) (string, bool)
+	GetSubnetsUsage(name string) ([]SubnetUsage, error)
 }
 
 // NamedAllocator manages the allocation of IPs within a specific subnet
@@ -34,11 +36,19 @@ type NamedAllocator interface {
 	AllocateIPs(ips []*net.IPNet) error
 	AllocateNextIPs() ([]*net.IPNet, error)
 	ReleaseIPs(ips []*net.IPNet) error
+	GetSubnets() ([]*net.IPNet, error)
 }
 
 // ErrSubnetNotFound is used to inform the subnet is not being managed
 var ErrSubnetNotFound = errors.New("subnet not found")
 
+// SubnetUsage holds the number of allocated and total IPs of a subnet
+type SubnetUsage struct {
+	Subnet *net.IPNet
+	Used   int
+	Total  int
+}
+
 // subnetInfo contains information corresponding to the subnet. It holds the
 // allocations (v4 and v6) as well as the IPAM allocator instances for each
 // of the managed subnets
@@ -56,6 +66,9 @@ type allocator struct {
 	// A RW mutex which holds subnet information
 	sync.RWMutex
 	ipamFunc ipamFactoryFunc
+	// allocate a single IP of each IP family, from the first subnet of the
+	// family that is not full, instead of an IP from each of the subnets
+	singleIPPerFamily bool
 }
 
 // newIPAMAllocator provides an ipam interface which can be used for IPAM
@@ -77,6 +90,15 @@ func NewAllocator() *allocator {
 	}
 }
 
+// NewHostSubnetAllocator initializes a new subnet IP allocator for the host
+// subnets of nodes: a node can be allocated more than one host subnet of an IP
+// family, from which a single IP of the family is allocated.
+func NewHostSubnetAllocator() *allocator {
+	allocator := NewAllocator()
+	allocator.singleIPPerFamily = true
+	return allocator
+}
+
 // AddOrUpdateSubnet set to the allocator for IPAM management, or update it.
 func (allocator *allocator) AddOrUpdateSubnet(name string, subnets []*net.IPNet, excludeSubnets ...*net.IPNet) error {
 	allocator.Lock()
@@ -84,8 +106,20 @@ func (allocator *allocator) AddOrUpdateSubnet(name string, subnets []*net.IPNet,
 	if subnetInfo, ok := allocator.cache[name]; ok && !reflect.DeepEqual(subnetInfo.subnets, subnets) {
 		klog.Warningf("Replacing subnets %v with %v for %s", util.StringSlice(subnetInfo.subnets), util.StringSlice(subnets), name)
 	}
+	// keep the IPAM of the subnets that are not changed, so that their
+	// allocations are preserved
+	existingIPAMs := map[string]ipallocator.Interface{}
+	if subnetInfo, ok := allocator.cache[name]; ok {
+		for i, subnet := range subnetInfo.subnets {
+			existingIPAMs[subnet.String()] = subnetInfo.ipams[i]
+		}
+	}
 	var ipams []ipallocator.Interface
 	for _, subnet := range subnets {
+		if ipam, ok := existingIPAMs[subnet.String()]; ok {
+			ipams = append(ipams, ipam)
+			continue
+		}
 		ipam, err := allocator.ipamFunc(subnet)
 		if err != nil {
 			return fmt.Errorf("failed to initialize IPAM of subnet %s for %s: %w", subnet, name, err)
@@ -212,7 +246,7 @@ func (allocator *allocator) AllocateIPs(name string, ips []*net.IPNet) error {
 func reserveSubnets(subnet *net.IPNet, ipam ipallocator.Interface) error {
 	// FIXME: allocate IP ranges when https://github.com/ovn-org/ovn-kubernetes/issues/3369 is fixed
 	for ip := subnet.IP; subnet.Contains(ip); ip = iputils.NextIP(ip) {
-		if ipam.Reserved(ip) {
+		if ipam.Reserved(ip) || ipam.Has(ip) {
 			continue
 		}
 		err := ipam.Allocate(ip)
@@ -228,6 +262,7 @@ func (allocator *allocator) AllocateNextIPs(name string) ([]*net.IPNet, error) {
 	allocator.RLock()
 	defer allocator.RUnlock()
 	var ipnets []*net.IPNet
+	var allocatedIPAMs []ipallocator.Interface
 	var ip net.IP
 	var err error
 	subnetInfo, ok := allocator.cache[name]
@@ -250,7 +285,7 @@ func (allocator *allocator) AllocateNextIPs(name string) ([]*net.IPNet, error) {
 			// iterate over range of already allocated indices and release
 			// ips allocated before the error occurred.
 			for relIdx, relIPNet := range ipnets {
-				subnetInfo.ipams[relIdx].Release(relIPNet.IP)
+				allocatedIPAMs[relIdx].Release(relIPNet.IP)
 				if relIPNet.IP != nil {
 					klog.Warningf("Reserved IP %s was released for %s", relIPNet.IP, name)
 				}
@@ -258,8 +293,18 @@ func (allocator *allocator) AllocateNextIPs(name string) ([]*net.IPNet, error) {
 		}
 	}()
 
+	allocatedFamilies := map[bool]bool{}
 	for idx, ipam := range subnetInfo.ipams {
+		isIPv6 := utilnet.IsIPv6CIDR(subnetInfo.subnets[idx])
+		if allocator.singleIPPerFamily && allocatedFamilies[isIPv6] {
+			continue
+		}
 		ip, err = ipam.AllocateNext()
+		if allocator.singleIPPerFamily && errors.Is(err, ipallocator.ErrFull) && hasSubnetOfFamily(subnetInfo.subnets[idx+1:], isIPv6) {
+			// try the next subnet of the family
+			err = nil
+			continue
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -268,10 +313,21 @@ func (allocator *allocator) AllocateNextIPs(name string) ([]*net.IPNet, error) {
 			Mask: subnetInfo.subnets[idx].Mask,
 		}
 		ipnets = append(ipnets, ipnet)
+		allocatedIPAMs = append(allocatedIPAMs, ipam)
+		allocatedFamilies[isIPv6] = true
 	}
 	return ipnets, nil
 }
 
+func hasSubnetOfFamily(subnets []*net.IPNet, isIPv6 bool) bool {
+	for _, subnet := range subnets {
+		if utilnet.IsIPv6CIDR(subnet) == isIPv6 {
+			return true
+		}
+	}
+	return false
+}
+
 // ReleaseIPs marks the IPs in ipnets slice as available for allocation by
 // releasing them from the IPAM pool of allocated IPs of the given subnet set.
 // If there aren't IPs to release the method does not return an error.
@@ -357,6 +413,27 @@ func (allocator *allocator) GetSubnetName(subnets []*net.IPNet) (string, bool) {
 	return "", false
 }
 
+// GetSubnetsUsage returns the number of allocated and total IPs of each of the
+// subnets of the given subnet set
+func (allocator *allocator) GetSubnetsUsage(name string) ([]SubnetUsage, error) {
+	allocator.RLock()
+	defer allocator.RUnlock()
+	subnetInfo, ok := allocator.cache[name]
+	if !ok {
+		return nil, fmt.Errorf("failed to get usage of %s: %w", name, ErrSubnetNotFound)
+	}
+	usage := make([]SubnetUsage, 0, len(subnetInfo.ipams))
+	for i, ipam := range subnetInfo.ipams {
+		subnet := *subnetInfo.subnets[i]
+		usage = append(usage, SubnetUsage{
+			Subnet: &subnet,
+			Used:   ipam.Used(),
+			Total:  ipam.Used() + ipam.Free(),
+		})
+	}
+	return usage, nil
+}
+
 type IPAllocator struct {
 	allocator *allocator
 	name      string
@@ -376,3 +453,8 @@ func (ipAllocator *IPAllocator) AllocateNextIPs() ([]*net.IPNet, error) {
 func (ipAllocator *IPAllocator) ReleaseIPs(ips []*net.IPNet) error {
 	return ipAllocator.allocator.ReleaseIPs(ipAllocator.name, ips)
 }
+
+// GetSubnets returns the subnets IPs are allocated from
+func (ipAllocator *IPAllocator) GetSubnets() ([]*net.IPNet, error) {
+	return ipAllocator.allocator.GetSubnets(ipAllocator.name)
+}
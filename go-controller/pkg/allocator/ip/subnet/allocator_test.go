@@ -68,6 +68,22 @@ var _ = ginkgo.Describe("Subnet IP allocator operations", func() {
 			}
 		})
 
+		ginkgo.It("preserves the allocations of the subnets that are not changed", func() {
+			subnetName := "subnet1"
+			err := allocator.AddOrUpdateSubnet(subnetName, ovntest.MustParseIPNets("10.1.1.0/24"))
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			ips, err := allocator.AllocateNextIPs(subnetName)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(ips).To(gomega.Equal(ovntest.MustParseIPNets("10.1.1.1/24")))
+
+			err = allocator.AddOrUpdateSubnet(subnetName, ovntest.MustParseIPNets("10.1.1.0/24", "10.1.2.0/24"),
+				ovntest.MustParseIPNets("10.1.1.1/32")...)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			ips, err = allocator.AllocateNextIPs(subnetName)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(ips).To(gomega.Equal(ovntest.MustParseIPNets("10.1.1.2/24", "10.1.2.1/24")))
+		})
+
 		ginkgo.It("excludes subnets correctly", func() {
 			subnetName := "subnet1"
 			subnets := []string{
@@ -183,6 +199,42 @@ var _ = ginkgo.Describe("Subnet IP allocator operations", func() {
 			gomega.Expect(ips).To(gomega.BeEmpty())
 		})
 
+		ginkgo.It("allocates a single IP of each family from host subnets", func() {
+			allocator = NewHostSubnetAllocator()
+			subnetName := "subnet1"
+			subnets := []string{
+				"10.1.1.0/29",
+				"2000::/64",
+				"10.1.2.0/29",
+			}
+
+			expectedIPAllocations := [][]string{
+				{"10.1.1.1/29", "2000::1/64"},
+				{"10.1.1.2/29", "2000::2/64"},
+				{"10.1.1.3/29", "2000::3/64"},
+				{"10.1.1.4/29", "2000::4/64"},
+				{"10.1.1.5/29", "2000::5/64"},
+				{"10.1.1.6/29", "2000::6/64"},
+				{"2000::7/64", "10.1.2.1/29"},
+			}
+
+			err := allocator.AddOrUpdateSubnet(subnetName, ovntest.MustParseIPNets(subnets...))
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			for _, expectedIPs := range expectedIPAllocations {
+				ips, err := allocator.AllocateNextIPs(subnetName)
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Expect(ips).To(gomega.Equal(ovntest.MustParseIPNets(expectedIPs...)))
+			}
+
+			usage, err := allocator.GetSubnetsUsage(subnetName)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(usage).To(gomega.HaveLen(3))
+			gomega.Expect(usage[0].Used).To(gomega.Equal(6))
+			gomega.Expect(usage[0].Total).To(gomega.Equal(6))
+			gomega.Expect(usage[2].Used).To(gomega.Equal(1))
+			gomega.Expect(usage[2].Total).To(gomega.Equal(6))
+		})
+
 		ginkgo.It("fails correctly when trying to block a previously allocated IP", func() {
 			subnetName := "subnet1"
 			subnets := []string{
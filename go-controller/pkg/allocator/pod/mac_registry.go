@@ -0,0 +1,63 @@
+package pod
+
+import (
+	"fmt"
+	"net"
+	"sync"
+)
+
+// macRegistry tracks the MAC addresses of the pods of a network, so that a MAC
+// address requested for a pod is not already in use by another pod of the
+// same network.
+type macRegistry struct {
+	sync.Mutex
+	// owners maps a MAC address to the pod interface using it
+	owners map[string]string
+	// macs maps a pod interface to the MAC address it uses
+	macs map[string]string
+}
+
+func newMACRegistry() *macRegistry {
+	return &macRegistry{
+		owners: map[string]string{},
+		macs:   map[string]string{},
+	}
+}
+
+// reserve reserves the MAC address for the given owner, releasing any other
+// MAC address previously reserved for it, which is returned so that it can be
+// reserved again on rollback. Returns an error if the MAC address is reserved
+// for another owner.
+func (r *macRegistry) reserve(owner string, mac net.HardwareAddr) (net.HardwareAddr, error) {
+	r.Lock()
+	defer r.Unlock()
+	key := mac.String()
+	if current, ok := r.owners[key]; ok && current != owner {
+		return nil, fmt.Errorf("MAC address %s is already in use by %s", key, current)
+	}
+	var previousMAC net.HardwareAddr
+	if previous, ok := r.macs[owner]; ok && previous != key {
+		delete(r.owners, previous)
+		previousMAC, _ = net.ParseMAC(previous)
+	}
+	r.owners[key] = owner
+	r.macs[owner] = key
+	return previousMAC, nil
+}
+
+// isReservedBy returns true if the MAC address is reserved for the given owner.
+func (r *macRegistry) isReservedBy(owner string, mac net.HardwareAddr) bool {
+	r.Lock()
+	defer r.Unlock()
+	return r.owners[mac.String()] == owner
+}
+
+// release releases the MAC address reserved for the given owner, if any.
+func (r *macRegistry) release(owner string) {
+	r.Lock()
+	defer r.Unlock()
+	if mac, ok := r.macs[owner]; ok {
+		delete(r.owners, mac)
+		delete(r.macs, owner)
+	}
+}
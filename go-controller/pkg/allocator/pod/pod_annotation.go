@@ -1,11 +1,17 @@
 package pod
 
 import (
+	"errors"
 	"fmt"
 	"net"
+	"slices"
+	"strings"
 
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/util/retry"
 	"k8s.io/klog/v2"
 
 	ipamclaimsapi "github.com/k8snetworkplumbingwg/ipamclaims/pkg/crd/ipamclaims/v1alpha1"
@@ -20,6 +26,25 @@ import (
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
 )
 
+// ErrStaticAddressRequest is returned when the IP or MAC addresses requested
+// for a pod can't be honored, either because they are not valid for the
+// network or because they conflict with the addresses of other pods.
+var ErrStaticAddressRequest = errors.New("static address request cannot be honored")
+
+// IsErrStaticAddressRequest returns true if err is of type ErrStaticAddressRequest
+func IsErrStaticAddressRequest(err error) bool {
+	return errors.Is(err, ErrStaticAddressRequest)
+}
+
+// PodStaticAddressesConditionType is the type of the pod condition reporting
+// whether the IP and MAC addresses requested for the pod have been allocated.
+const PodStaticAddressesConditionType v1.PodConditionType = "k8s.ovn.org/static-addresses-allocated"
+
+const (
+	reasonStaticAddressesAllocated = "StaticAddressesAllocated"
+	reasonStaticAddressesRejected  = "StaticAddressRequestRejected"
+)
+
 // PodAnnotationAllocator is a utility to handle allocation of the PodAnnotation to Pods.
 type PodAnnotationAllocator struct {
 	podLister listers.PodLister
@@ -27,6 +52,9 @@ type PodAnnotationAllocator struct {
 
 	netInfo              util.NetInfo
 	ipamClaimsReconciler persistentips.PersistentAllocations
+	// macs tracks the MAC addresses of the network pods to reject duplicated
+	// MAC requests
+	macs *macRegistry
 }
 
 func NewPodAnnotationAllocator(
@@ -40,7 +68,32 @@ func NewPodAnnotationAllocator(
 		kube:                 kube,
 		netInfo:              netInfo,
 		ipamClaimsReconciler: claimsReconciler,
+		macs:                 newMACRegistry(),
+	}
+}
+
+// ReservePodMAC reserves the MAC address the pod is annotated with on the
+// given NAD, if any, so that it cannot be requested by other pods of the
+// network.
+func (allocator *PodAnnotationAllocator) ReservePodMAC(pod *v1.Pod, nadName string) error {
+	if !allocator.netInfo.IsSecondary() {
+		nadName = types.DefaultNetworkName
+	}
+	podAnnotation, _ := util.UnmarshalPodAnnotation(pod.Annotations, nadName)
+	if podAnnotation == nil || len(podAnnotation.MAC) == 0 {
+		return nil
+	}
+	_, err := allocator.macs.reserve(podInterfaceDesc(nadName, pod), podAnnotation.MAC)
+	return err
+}
+
+// ReleasePodMAC releases the MAC address reserved for the pod on the given NAD
+// so that it can be requested by other pods of the network.
+func (allocator *PodAnnotationAllocator) ReleasePodMAC(pod *v1.Pod, nadName string) {
+	if !allocator.netInfo.IsSecondary() {
+		nadName = types.DefaultNetworkName
 	}
+	allocator.macs.release(podInterfaceDesc(nadName, pod))
 }
 
 // AllocatePodAnnotation allocates the PodAnnotation which includes IPs, a mac
@@ -70,6 +123,7 @@ func (allocator *PodAnnotationAllocator) AllocatePodAnnotation(
 		pod,
 		network,
 		allocator.ipamClaimsReconciler,
+		allocator.macs,
 		reallocateIP,
 		networkRole,
 	)
@@ -83,6 +137,7 @@ func allocatePodAnnotation(
 	pod *v1.Pod,
 	network *nadapi.NetworkSelectionElement,
 	claimsReconciler persistentips.PersistentAllocations,
+	macs *macRegistry,
 	reallocateIP bool,
 	networkRole string) (
 	updatedPod *v1.Pod,
@@ -101,6 +156,7 @@ func allocatePodAnnotation(
 			pod,
 			network,
 			claimsReconciler,
+			macs,
 			reallocateIP,
 			networkRole,
 		)
@@ -115,6 +171,9 @@ func allocatePodAnnotation(
 	)
 
 	if err != nil {
+		if IsErrStaticAddressRequest(err) {
+			reportStaticAddressRequestFailure(podLister, kube, pod, err)
+		}
 		return nil, nil, err
 	}
 
@@ -150,6 +209,7 @@ func (allocator *PodAnnotationAllocator) AllocatePodAnnotationWithTunnelID(
 		pod,
 		network,
 		allocator.ipamClaimsReconciler,
+		allocator.macs,
 		reallocateIP,
 		networkRole,
 	)
@@ -164,6 +224,7 @@ func allocatePodAnnotationWithTunnelID(
 	pod *v1.Pod,
 	network *nadapi.NetworkSelectionElement,
 	claimsReconciler persistentips.PersistentAllocations,
+	macs *macRegistry,
 	reallocateIP bool,
 	networkRole string) (
 	updatedPod *v1.Pod,
@@ -179,6 +240,7 @@ func allocatePodAnnotationWithTunnelID(
 			pod,
 			network,
 			claimsReconciler,
+			macs,
 			reallocateIP,
 			networkRole,
 		)
@@ -193,6 +255,9 @@ func allocatePodAnnotationWithTunnelID(
 	)
 
 	if err != nil {
+		if IsErrStaticAddressRequest(err) {
+			reportStaticAddressRequestFailure(podLister, kube, pod, err)
+		}
 		return nil, nil, err
 	}
 
@@ -223,6 +288,7 @@ func allocatePodAnnotationWithRollback(
 	pod *v1.Pod,
 	network *nadapi.NetworkSelectionElement,
 	claimsReconciler persistentips.PersistentAllocations,
+	macs *macRegistry,
 	reallocateIP bool,
 	networkRole string) (
 	updatedPod *v1.Pod,
@@ -234,7 +300,7 @@ func allocatePodAnnotationWithRollback(
 	if netInfo.IsSecondary() {
 		nadName = util.GetNADName(network.Namespace, network.Name)
 	}
-	podDesc := fmt.Sprintf("%s/%s/%s", nadName, pod.Namespace, pod.Name)
+	podDesc := podInterfaceDesc(nadName, pod)
 
 	// the IPs we allocate in this function need to be released back to the IPAM
 	// pool if there is some error in any step past the point the IPs were
@@ -242,7 +308,20 @@ func allocatePodAnnotationWithRollback(
 	// for defer to work correctly.
 	var releaseIPs []*net.IPNet
 	var releaseID int
+	var releaseMAC bool
+	var previousMAC net.HardwareAddr
 	rollback = func() {
+		if releaseMAC {
+			macs.release(podDesc)
+			klog.V(5).Infof("Released MAC of %s", podDesc)
+			releaseMAC = false
+		}
+		if len(previousMAC) > 0 {
+			if _, err := macs.reserve(podDesc, previousMAC); err != nil {
+				klog.Warningf("Failed to reserve back previous MAC address of %s: %v", podDesc, err)
+			}
+			previousMAC = nil
+		}
 		if releaseID != 0 {
 			idAllocator.ReleaseID()
 			klog.V(5).Infof("Released ID %d", releaseID)
@@ -300,6 +379,7 @@ func allocatePodAnnotationWithRollback(
 	hasIPAM := util.DoesNetworkRequireIPAM(netInfo)
 	hasIPRequest := network != nil && len(network.IPRequest) > 0
 	hasStaticIPRequest := hasIPRequest && !reallocateIP
+	hasStaticMACRequest := network != nil && network.MacRequest != "" && !reallocateIP
 
 	var ipamClaim *ipamclaimsapi.IPAMClaim
 	hasPersistentIPs := netInfo.AllowsPersistentIPs() && hasIPAM && claimsReconciler != nil
@@ -321,19 +401,13 @@ func allocatePodAnnotationWithRollback(
 		}
 		hasIPAMClaim = ipamClaim != nil && len(ipamClaim.Status.IPs) > 0
 	}
-	if hasIPAM && hasStaticIPRequest {
-		// for now we can't tell apart already allocated IPs from IPs excluded
-		// from allocation so we can't really honor static IP requests when
-		// there is IPAM as we don't really know if the requested IP should not
-		// be allocated or was already allocated by the same pod
-		err = fmt.Errorf("cannot allocate a static IP request with IPAM for pod %s", podDesc)
-		return
-	}
-
 	// we need to update the annotation if it is missing IPs or MAC
 	needsIPOrMAC := len(tentative.IPs) == 0 && (hasIPAM || hasIPRequest)
 	needsIPOrMAC = needsIPOrMAC || len(tentative.MAC) == 0
 	reallocateOnNonStaticIPRequest := len(tentative.IPs) == 0 && hasIPRequest && !hasStaticIPRequest
+	// a static IP request of a pod that has not been annotated yet must not
+	// be allocated already, unless it has been persisted in an IPAMClaim
+	allocateStaticIPRequest := len(tentative.IPs) == 0 && hasIPAM && hasStaticIPRequest && !hasIPAMClaim
 
 	if len(tentative.IPs) == 0 {
 		if hasIPRequest {
@@ -341,6 +415,18 @@ func allocatePodAnnotationWithRollback(
 			if err != nil {
 				return
 			}
+			if hasIPAMClaim && !sets.New(util.StringSlice(tentative.IPs)...).Equal(sets.New(ipamClaim.Status.IPs...)) {
+				err = fmt.Errorf("%w for %s: requested IPs %v differ from IPs %v persisted in IPAMClaim %s",
+					ErrStaticAddressRequest, podDesc, network.IPRequest, ipamClaim.Status.IPs, ipamClaim.Name)
+				return
+			}
+			if allocateStaticIPRequest {
+				err = validateStaticIPRequest(ipAllocator, netInfo, tentative.IPs)
+				if err != nil {
+					err = fmt.Errorf("%w for %s: %v", ErrStaticAddressRequest, podDesc, err)
+					return
+				}
+			}
 		} else if hasIPAMClaim {
 			tentative.IPs, err = util.ParseIPNets(ipamClaim.Status.IPs)
 			if err != nil {
@@ -351,7 +437,12 @@ func allocatePodAnnotationWithRollback(
 
 	if hasIPAM {
 		if len(tentative.IPs) > 0 {
-			if err = ipAllocator.AllocateIPs(tentative.IPs); err != nil && !ip.IsErrAllocated(err) {
+			if err = ipAllocator.AllocateIPs(tentative.IPs); err != nil && allocateStaticIPRequest {
+				err = fmt.Errorf("%w for %s: requested IPs %v conflict with IPs already allocated or reserved on the network: %v",
+					ErrStaticAddressRequest, podDesc, util.StringSlice(tentative.IPs), err)
+				return
+			}
+			if err != nil && !ip.IsErrAllocated(err) {
 				err = fmt.Errorf("failed to ensure requested or annotated IPs %v for %s: %w",
 					util.StringSlice(tentative.IPs), podDesc, err)
 				if !reallocateOnNonStaticIPRequest {
@@ -387,6 +478,9 @@ func allocatePodAnnotationWithRollback(
 		// handle mac address
 		if network != nil && network.MacRequest != "" {
 			tentative.MAC, err = net.ParseMAC(network.MacRequest)
+			if err != nil {
+				err = fmt.Errorf("%w for %s: %v", ErrStaticAddressRequest, podDesc, err)
+			}
 		} else if len(tentative.IPs) > 0 {
 			tentative.MAC = util.IPAddrToHWAddr(tentative.IPs[0].IP)
 		} else {
@@ -403,12 +497,31 @@ func allocatePodAnnotationWithRollback(
 		}
 	}
 
+	if macs != nil && len(tentative.MAC) > 0 && !macs.isReservedBy(podDesc, tentative.MAC) {
+		previousMAC, err = macs.reserve(podDesc, tentative.MAC)
+		switch {
+		case err == nil:
+			releaseMAC = true
+		case needsIPOrMAC && hasStaticMACRequest:
+			err = fmt.Errorf("%w for %s: %v", ErrStaticAddressRequest, podDesc, err)
+			return
+		default:
+			// only requested MACs are rejected, a generated or already
+			// annotated MAC is kept as is
+			klog.Warningf("Failed to reserve MAC address for %s: %v", podDesc, err)
+			err = nil
+		}
+	}
+
 	needsAnnotationUpdate := needsIPOrMAC || needsID
 
 	if needsAnnotationUpdate {
 		updatedPod = pod
 		updatedPod.Annotations, err = util.MarshalPodAnnotation(updatedPod.Annotations, tentative, nadName)
 		podAnnotation = tentative
+		if needsIPOrMAC && (hasStaticIPRequest || hasStaticMACRequest) {
+			setStaticAddressesCondition(updatedPod, podDesc, nil)
+		}
 	}
 
 	if ipamClaim != nil && err == nil {
@@ -419,3 +532,87 @@ func allocatePodAnnotationWithRollback(
 
 	return
 }
+
+// validateStaticIPRequest checks that the requested IPs are within the subnets
+// the IPs are allocated from, and are not excluded from allocation.
+func validateStaticIPRequest(ipAllocator subnet.NamedAllocator, netInfo util.NetInfo, ips []*net.IPNet) error {
+	subnets, err := ipAllocator.GetSubnets()
+	if err != nil {
+		return fmt.Errorf("failed to get the subnets of the network: %v", err)
+	}
+	for _, ipNet := range ips {
+		if !slices.ContainsFunc(subnets, func(subnet *net.IPNet) bool { return subnet.Contains(ipNet.IP) }) {
+			return fmt.Errorf("requested IP %s is not within the network subnets %v", ipNet.IP, util.StringSlice(subnets))
+		}
+		for _, excludeSubnet := range netInfo.ExcludeSubnets() {
+			if excludeSubnet.Contains(ipNet.IP) {
+				return fmt.Errorf("requested IP %s is excluded from allocation by %s", ipNet.IP, excludeSubnet)
+			}
+		}
+	}
+	return nil
+}
+
+// podInterfaceDesc describes the interface of the pod on the given NAD.
+func podInterfaceDesc(nadName string, pod *v1.Pod) string {
+	return fmt.Sprintf("%s/%s/%s", nadName, pod.Namespace, pod.Name)
+}
+
+// setStaticAddressesCondition sets the condition reporting whether the
+// addresses requested for the pod interface have been allocated, given the
+// allocation error if any. A failure reported for another interface of the pod
+// is not overwritten by a success. Returns true if the condition was updated.
+func setStaticAddressesCondition(pod *v1.Pod, podDesc string, allocErr error) bool {
+	condition := v1.PodCondition{
+		Type:    PodStaticAddressesConditionType,
+		Status:  v1.ConditionTrue,
+		Reason:  reasonStaticAddressesAllocated,
+		Message: fmt.Sprintf("Requested addresses allocated for %s", podDesc),
+	}
+	if allocErr != nil {
+		condition.Status = v1.ConditionFalse
+		condition.Reason = reasonStaticAddressesRejected
+		condition.Message = allocErr.Error()
+	}
+	for i := range pod.Status.Conditions {
+		existing := &pod.Status.Conditions[i]
+		if existing.Type != condition.Type {
+			continue
+		}
+		if allocErr == nil && existing.Status == v1.ConditionFalse && !strings.Contains(existing.Message, podDesc) {
+			return false
+		}
+		if existing.Status == condition.Status && existing.Reason == condition.Reason && existing.Message == condition.Message {
+			return false
+		}
+		condition.LastTransitionTime = existing.LastTransitionTime
+		if existing.Status != condition.Status {
+			condition.LastTransitionTime = metav1.Now()
+		}
+		*existing = condition
+		return true
+	}
+	condition.LastTransitionTime = metav1.Now()
+	pod.Status.Conditions = append(pod.Status.Conditions, condition)
+	return true
+}
+
+// reportStaticAddressRequestFailure sets the static addresses condition of the
+// pod to report the addresses requested for it can't be allocated.
+func reportStaticAddressRequestFailure(podLister listers.PodLister, kube kube.Interface, pod *v1.Pod, allocErr error) {
+	err := retry.RetryOnConflict(util.OvnConflictBackoff, func() error {
+		pod, err := podLister.Pods(pod.Namespace).Get(pod.Name)
+		if err != nil {
+			return err
+		}
+		// Informer cache should not be mutated, so copy the object
+		pod = pod.DeepCopy()
+		if !setStaticAddressesCondition(pod, "", allocErr) {
+			return nil
+		}
+		return kube.UpdatePodStatus(pod)
+	})
+	if err != nil {
+		klog.Errorf("Failed to report static address request failure on pod %s/%s: %v", pod.Namespace, pod.Name, err)
+	}
+}
@@ -31,6 +31,7 @@ type ipAllocatorStub struct {
 	netxtIPs         []*net.IPNet
 	allocateIPsError error
 	releasedIPs      []*net.IPNet
+	subnets          []*net.IPNet
 }
 
 func (a *ipAllocatorStub) AllocateIPs(ips []*net.IPNet) error {
@@ -46,6 +47,10 @@ func (a *ipAllocatorStub) ReleaseIPs(ips []*net.IPNet) error {
 	return nil
 }
 
+func (a *ipAllocatorStub) GetSubnets() ([]*net.IPNet, error) {
+	return a.subnets, nil
+}
+
 func (a *ipAllocatorStub) IsErrAllocated(err error) bool {
 	return errors.Is(err, ipam.ErrAllocated)
 }
@@ -115,6 +120,7 @@ func Test_allocatePodAnnotationWithRollback(t *testing.T) {
 		ipam                      bool
 		idAllocation              bool
 		persistentIPAllocation    bool
+		excludeSubnets            string
 		role                      string
 		podAnnotation             *util.PodAnnotation
 		invalidNetworkAnnotation  bool
@@ -125,6 +131,8 @@ func Test_allocatePodAnnotationWithRollback(t *testing.T) {
 		wantReleasedIPsOnRollback []*net.IPNet
 		wantReleaseID             bool
 		wantRelasedIDOnRollback   bool
+		reservedMACs              map[string]string
+		wantReservedMAC           bool
 		wantErr                   bool
 	}{
 		{
@@ -190,17 +198,87 @@ func Test_allocatePodAnnotationWithRollback(t *testing.T) {
 			role: types.NetworkRoleSecondary,
 		},
 		{
-			// on networks with IPAM, expect error if static IP request present
-			// in the network selection annotation
-			name: "expect error, static ip request, IPAM",
+			// on networks with IPAM, honor static IP requests present in the
+			// network selection annotation
+			name: "expect requested static IP, IPAM",
 			ipam: true,
 			args: args{
 				network: &nadapi.NetworkSelectionElement{
-					IPRequest: []string{"192.168.0.3/24"},
+					IPRequest: []string{"192.168.0.4/24"},
+				},
+				ipAllocator: &ipAllocatorStub{
+					subnets: ovntest.MustParseIPNets("192.168.0.0/24"),
 				},
 			},
 			wantUpdatedPod: true,
-			wantErr:        true,
+			wantPodAnnotation: &util.PodAnnotation{
+				IPs:      ovntest.MustParseIPNets("192.168.0.4/24"),
+				MAC:      util.IPAddrToHWAddr(ovntest.MustParseIPNets("192.168.0.4/24")[0].IP),
+				Gateways: []net.IP{ovntest.MustParseIP("192.168.0.1").To4()},
+				Routes: []util.PodRoute{
+					{
+						Dest: &net.IPNet{
+							IP:   ovntest.MustParseIP("169.254.169.5"),
+							Mask: net.CIDRMask(32, 32),
+						},
+						NextHop: ovntest.MustParseIP("192.168.0.1").To4(),
+					},
+					{
+						Dest:    ovntest.MustParseIPNet("100.64.0.0/16"),
+						NextHop: ovntest.MustParseIP("192.168.0.1").To4(),
+					},
+				},
+				Role: types.NetworkRolePrimary,
+			},
+			wantReleasedIPsOnRollback: ovntest.MustParseIPNets("192.168.0.4/24"),
+			role:                      types.NetworkRolePrimary,
+		},
+		{
+			// on networks with IPAM, expect error if the static IP request is
+			// not within the subnets IPs are allocated from
+			name: "expect error, static IP request not within subnets, IPAM",
+			ipam: true,
+			args: args{
+				network: &nadapi.NetworkSelectionElement{
+					IPRequest: []string{"192.168.1.4/24"},
+				},
+				ipAllocator: &ipAllocatorStub{
+					subnets: ovntest.MustParseIPNets("192.168.0.0/24"),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			// on networks with IPAM, expect error if the static IP request is
+			// excluded from allocation
+			name:           "expect error, static IP request excluded, IPAM",
+			ipam:           true,
+			excludeSubnets: "192.168.0.0/29",
+			args: args{
+				network: &nadapi.NetworkSelectionElement{
+					IPRequest: []string{"192.168.0.4/24"},
+				},
+				ipAllocator: &ipAllocatorStub{
+					subnets: ovntest.MustParseIPNets("192.168.0.0/24"),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			// on networks with IPAM, expect error if the static IP request
+			// conflicts with an already allocated IP
+			name: "expect error, static IP request already allocated, IPAM",
+			ipam: true,
+			args: args{
+				network: &nadapi.NetworkSelectionElement{
+					IPRequest: []string{"192.168.0.4/24"},
+				},
+				ipAllocator: &ipAllocatorStub{
+					subnets:          ovntest.MustParseIPNets("192.168.0.0/24"),
+					allocateIPsError: ipam.ErrAllocated,
+				},
+			},
+			wantErr: true,
 		},
 		{
 			// on networks with IPAM, expect a normal IP, MAC and gateway
@@ -429,6 +507,42 @@ func Test_allocatePodAnnotationWithRollback(t *testing.T) {
 			wantErr:         true,
 			wantReleasedIPs: ovntest.MustParseIPNets("192.168.0.3/24"),
 		},
+		{
+			// a MAC request is rejected if the MAC is in use by another pod
+			// of the network
+			name: "expect error, requested MAC in use by another pod",
+			ipam: true,
+			args: args{
+				network: &nadapi.NetworkSelectionElement{
+					MacRequest: requestedMAC,
+				},
+				ipAllocator: &ipAllocatorStub{
+					netxtIPs: ovntest.MustParseIPNets("192.168.0.3/24"),
+				},
+			},
+			reservedMACs:    map[string]string{requestedMAC: "default/namespace/other-pod"},
+			wantErr:         true,
+			wantReleasedIPs: ovntest.MustParseIPNets("192.168.0.3/24"),
+		},
+		{
+			// a MAC already in use by another pod is not rejected if it is
+			// not requested but already annotated
+			name: "expect annotated MAC in use by another pod to be kept",
+			ipam: true,
+			podAnnotation: &util.PodAnnotation{
+				IPs: ovntest.MustParseIPNets("192.168.0.3/24"),
+				MAC: requestedMACParsed,
+			},
+			args: args{
+				ipAllocator: &ipAllocatorStub{},
+			},
+			reservedMACs:              map[string]string{requestedMAC: "default/namespace/other-pod"},
+			wantReleasedIPsOnRollback: ovntest.MustParseIPNets("192.168.0.3/24"),
+			wantPodAnnotation: &util.PodAnnotation{
+				IPs: ovntest.MustParseIPNets("192.168.0.3/24"),
+				MAC: requestedMACParsed,
+			},
+		},
 		{
 			// on networks with IPAM, honor a MAC request through the network
 			// selection element
@@ -442,7 +556,8 @@ func Test_allocatePodAnnotationWithRollback(t *testing.T) {
 					netxtIPs: ovntest.MustParseIPNets("192.168.0.3/24"),
 				},
 			},
-			wantUpdatedPod: true,
+			wantUpdatedPod:  true,
+			wantReservedMAC: true,
 			wantPodAnnotation: &util.PodAnnotation{
 				IPs:      ovntest.MustParseIPNets("192.168.0.3/24"),
 				MAC:      requestedMACParsed,
@@ -465,6 +580,44 @@ func Test_allocatePodAnnotationWithRollback(t *testing.T) {
 			wantReleasedIPsOnRollback: ovntest.MustParseIPNets("192.168.0.3/24"),
 			role:                      types.NetworkRolePrimary, // has to be primary network for default routes to be set
 		},
+		{
+			// the MAC previously reserved for the pod is reserved back on
+			// rollback
+			name: "expect requested MAC, previous MAC reserved back on rollback",
+			ipam: true,
+			args: args{
+				network: &nadapi.NetworkSelectionElement{
+					MacRequest: requestedMAC,
+				},
+				ipAllocator: &ipAllocatorStub{
+					netxtIPs: ovntest.MustParseIPNets("192.168.0.3/24"),
+				},
+			},
+			reservedMACs:    map[string]string{randomMac.String(): "default/namespace/pod"},
+			wantUpdatedPod:  true,
+			wantReservedMAC: true,
+			wantPodAnnotation: &util.PodAnnotation{
+				IPs:      ovntest.MustParseIPNets("192.168.0.3/24"),
+				MAC:      requestedMACParsed,
+				Gateways: []net.IP{ovntest.MustParseIP("192.168.0.1").To4()},
+				Routes: []util.PodRoute{
+					{
+						Dest: &net.IPNet{
+							IP:   ovntest.MustParseIP("169.254.169.5"),
+							Mask: net.CIDRMask(32, 32),
+						},
+						NextHop: ovntest.MustParseIP("192.168.0.1").To4(),
+					},
+					{
+						Dest:    ovntest.MustParseIPNet("100.64.0.0/16"),
+						NextHop: ovntest.MustParseIP("192.168.0.1").To4(),
+					},
+				},
+				Role: types.NetworkRolePrimary,
+			},
+			wantReleasedIPsOnRollback: ovntest.MustParseIPNets("192.168.0.3/24"),
+			role:                      types.NetworkRolePrimary,
+		},
 		{
 			// on networks with IPAM, expect error on an invalid network
 			// selection element
@@ -566,6 +719,62 @@ func Test_allocatePodAnnotationWithRollback(t *testing.T) {
 			},
 			wantReleasedIPsOnRollback: ovntest.MustParseIPNets("192.168.0.3/24"),
 		},
+		{
+			// on networks with IPAM, and persistent IPs, expect to honor a
+			// static IP request matching the IPAMClaim, which is already
+			// allocated
+			name:                   "IPAM persistent IPs, static IP request matching IPAMClaim",
+			ipam:                   true,
+			persistentIPAllocation: true,
+			args: args{
+				network: &nadapi.NetworkSelectionElement{
+					IPAMClaimReference: "my-ipam-claim",
+					IPRequest:          []string{"192.168.0.200/24"},
+				},
+				ipamClaim: &ipamclaimsapi.IPAMClaim{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "my-ipam-claim",
+					},
+					Status: ipamclaimsapi.IPAMClaimStatus{
+						IPs: []string{"192.168.0.200/24"},
+					},
+				},
+				ipAllocator: &ipAllocatorStub{
+					subnets:          ovntest.MustParseIPNets("192.168.0.0/24"),
+					allocateIPsError: ipam.ErrAllocated,
+				},
+			},
+			wantUpdatedPod: true,
+			wantPodAnnotation: &util.PodAnnotation{
+				IPs: ovntest.MustParseIPNets("192.168.0.200/24"),
+				MAC: util.IPAddrToHWAddr(ovntest.MustParseIPNets("192.168.0.200/24")[0].IP),
+			},
+		},
+		{
+			// on networks with IPAM, and persistent IPs, expect error if the
+			// static IP request differs from the IPAMClaim
+			name:                   "IPAM persistent IPs, expect error, static IP request differs from IPAMClaim",
+			ipam:                   true,
+			persistentIPAllocation: true,
+			args: args{
+				network: &nadapi.NetworkSelectionElement{
+					IPAMClaimReference: "my-ipam-claim",
+					IPRequest:          []string{"192.168.0.4/24"},
+				},
+				ipamClaim: &ipamclaimsapi.IPAMClaim{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "my-ipam-claim",
+					},
+					Status: ipamclaimsapi.IPAMClaimStatus{
+						IPs: []string{"192.168.0.200/24"},
+					},
+				},
+				ipAllocator: &ipAllocatorStub{
+					subnets: ovntest.MustParseIPNets("192.168.0.0/24"),
+				},
+			},
+			wantErr: true,
+		},
 		{
 			// on networks with ID allocation, expect allocated ID
 			name:         "expect ID allocation",
@@ -667,7 +876,7 @@ func Test_allocatePodAnnotationWithRollback(t *testing.T) {
 			var netInfo util.NetInfo
 			netInfo = &util.DefaultNetInfo{}
 			nadName := types.DefaultNetworkName
-			if !tt.ipam || tt.idAllocation || tt.persistentIPAllocation || tt.args.ipamClaim != nil {
+			if !tt.ipam || tt.idAllocation || tt.persistentIPAllocation || tt.args.ipamClaim != nil || tt.excludeSubnets != "" {
 				nadName = util.GetNADName(network.Namespace, network.Name)
 				var subnets string
 				if tt.ipam {
@@ -680,6 +889,7 @@ func Test_allocatePodAnnotationWithRollback(t *testing.T) {
 					},
 					NADName:            nadName,
 					Subnets:            subnets,
+					ExcludeSubnets:     tt.excludeSubnets,
 					AllowPersistentIPs: tt.persistentIPAllocation,
 					Role:               tt.role,
 				})
@@ -720,6 +930,13 @@ func Test_allocatePodAnnotationWithRollback(t *testing.T) {
 				datastore: dummyDatastore,
 			}
 
+			macs := newMACRegistry()
+			for mac, owner := range tt.reservedMACs {
+				_, err := macs.reserve(owner, ovntest.MustParseMAC(mac))
+				g.Expect(err).NotTo(gomega.HaveOccurred())
+			}
+
+			podDesc := podInterfaceDesc(nadName, pod)
 			pod, podAnnotation, rollback, err := allocatePodAnnotationWithRollback(
 				tt.args.ipAllocator,
 				tt.args.idAllocator,
@@ -727,10 +944,15 @@ func Test_allocatePodAnnotationWithRollback(t *testing.T) {
 				pod,
 				network,
 				claimsReconciler,
+				macs,
 				tt.args.reallocate,
 				tt.role,
 			)
 
+			if tt.wantReservedMAC {
+				g.Expect(macs.isReservedBy(podDesc, podAnnotation.MAC)).To(gomega.BeTrue(), "Expected MAC to be reserved")
+			}
+
 			if tt.args.ipAllocator != nil {
 				releasedIPs := tt.args.ipAllocator.(*ipAllocatorStub).releasedIPs
 				g.Expect(releasedIPs).To(gomega.Equal(tt.wantReleasedIPs), "Release IP on error behaved unexpectedly")
@@ -750,6 +972,14 @@ func Test_allocatePodAnnotationWithRollback(t *testing.T) {
 				g.Expect(releasedIPs).To(gomega.Equal(tt.wantReleasedIPsOnRollback), "Release IP on rollback behaved unexpectedly")
 			}
 
+			if tt.wantReservedMAC {
+				g.Expect(macs.isReservedBy(podDesc, podAnnotation.MAC)).To(gomega.BeFalse(), "Expected MAC to be released on rollback")
+			}
+
+			for mac, owner := range tt.reservedMACs {
+				g.Expect(macs.isReservedBy(owner, ovntest.MustParseMAC(mac))).To(gomega.BeTrue(), "Expected MAC reserved by another pod to be kept")
+			}
+
 			if tt.args.idAllocator != nil {
 				releasedID := tt.args.idAllocator.(*idAllocatorStub).releasedID
 				g.Expect(releasedID).To(gomega.Equal(tt.wantRelasedIDOnRollback), "Release ID on rollback behaved unexpectedly")
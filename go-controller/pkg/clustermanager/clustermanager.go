@@ -13,6 +13,8 @@ import (
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/clustermanager/dnsnameresolver"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/clustermanager/egressservice"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/clustermanager/endpointslicemirror"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/clustermanager/networkpeering"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/clustermanager/routeadvertisements"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/clustermanager/status_manager"
 	udncontroller "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/clustermanager/userdefinednetwork"
 	udntemplate "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/clustermanager/userdefinednetwork/template"
@@ -47,6 +49,10 @@ type ClusterManager struct {
 	dnsNameResolverController *dnsnameresolver.Controller
 	// Controller for managing user-defined-network CRD
 	userDefinedNetworkController *udncontroller.Controller
+	// Controller for validating and allocating the network peerings
+	networkPeeringController *networkpeering.Controller
+	// Controller for rendering the route advertisements of the nodes
+	routeAdvertisementsController *routeadvertisements.Controller
 	// event recorder used to post events to k8s
 	recorder record.EventRecorder
 
@@ -136,12 +142,27 @@ func NewClusterManager(ovnClient *util.OVNClusterManagerClientset, wf *factory.W
 			udntemplate.RenderNetAttachDefManifest,
 			wf.PodCoreInformer(),
 			wf.NamespaceInformer(),
+			wf.NodeCoreInformer(),
 			cm.recorder,
 		)
 		cm.userDefinedNetworkController = udnController
 		if cm.secondaryNetClusterManager != nil {
 			cm.secondaryNetClusterManager.SetNetworkStatusReporter(udnController.UpdateSubsystemCondition)
 		}
+		cm.networkPeeringController, err = networkpeering.NewController(
+			ovnClient.UserDefinedNetworkClient,
+			wf.NetworkPeeringInformer(),
+			wf.UserDefinedNetworkInformer(),
+			wf.ClusterUserDefinedNetworkInformer(),
+			wf.NodeCoreInformer(),
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if config.OVNKubernetesFeature.EnableRouteAdvertisements {
+		cm.routeAdvertisementsController = routeadvertisements.NewController(ovnClient, wf)
 	}
 
 	return cm, nil
@@ -202,6 +223,15 @@ func (cm *ClusterManager) Start(ctx context.Context) error {
 		if err := cm.userDefinedNetworkController.Run(); err != nil {
 			return err
 		}
+		if err := cm.networkPeeringController.Start(); err != nil {
+			return err
+		}
+	}
+
+	if config.OVNKubernetesFeature.EnableRouteAdvertisements {
+		if err := cm.routeAdvertisementsController.Start(); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -229,5 +259,9 @@ func (cm *ClusterManager) Stop() {
 	}
 	if util.IsNetworkSegmentationSupportEnabled() {
 		cm.userDefinedNetworkController.Shutdown()
+		cm.networkPeeringController.Stop()
+	}
+	if config.OVNKubernetesFeature.EnableRouteAdvertisements {
+		cm.routeAdvertisementsController.Stop()
 	}
 }
@@ -16,7 +16,9 @@ import (
 
 	ocpcloudnetworkapi "github.com/openshift/api/cloudnetwork/v1"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/controller"
 	egressipv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1"
+	egressipapply "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1/apis/applyconfiguration/egressip/v1"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/kube"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/metrics"
@@ -27,8 +29,11 @@ import (
 	utilerrors "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util/errors"
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
+	metaapplyv1 "k8s.io/client-go/applyconfigurations/meta/v1"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
 	"k8s.io/klog/v2"
@@ -37,6 +42,13 @@ import (
 
 const (
 	egressIPReachabilityCheckInterval = 5 * time.Second
+
+	egressIPAssignedReason              = "EgressIPsAssigned"
+	egressIPNotAssignedReason           = "EgressIPsNotAssigned"
+	egressIPCloudAssignedReason         = "CloudAssignmentSucceeded"
+	egressIPCloudPendingReason          = "CloudAssignmentPending"
+	egressIPCloudFailedReason           = "CloudAssignmentFailed"
+	egressIPConditionFieldManagerPrefix = "cluster-manager-egressip-"
 )
 
 type egressIPHealthcheckClientAllocator struct{}
@@ -116,37 +128,201 @@ func (e *egressNode) getAllocationCountForEgressIP(name string) (count int) {
 	return
 }
 
-type EgressIPPatchStatus struct {
-	Op    string                    `json:"op"`
-	Path  string                    `json:"path"`
-	Value egressipv1.EgressIPStatus `json:"value"`
-}
-
-// patchReplaceEgressIPStatus performs a replace patch operation of the egress
-// IP status by replacing the status with the provided value. This allows us to
-// update only the status field, without overwriting any other. This is
-// important because processing egress IPs can take a while (when running on a
-// public cloud and in the worst case), hence we don't want to perform a full
-// object update which risks resetting the EgressIP object's fields to the state
-// they had when we started processing the change.
+// patchReplaceEgressIPStatus performs a merge patch of the egress IP status by
+// replacing the status items with the provided value. This allows us to update
+// only the status items, without overwriting any other field or the status
+// conditions. This is important because processing egress IPs can take a while
+// (when running on a public cloud and in the worst case), hence we don't want
+// to perform a full object update which risks resetting the EgressIP object's
+// fields to the state they had when we started processing the change.
 func (eIPC *egressIPClusterController) patchReplaceEgressIPStatus(name string, statusItems []egressipv1.EgressIPStatusItem) error {
 	klog.Infof("Patching status on EgressIP %s: %v", name, statusItems)
+	if statusItems == nil {
+		statusItems = []egressipv1.EgressIPStatusItem{}
+	}
+	return eIPC.mergePatchEgressIPStatus(name, map[string]interface{}{"items": statusItems})
+}
+
+func (eIPC *egressIPClusterController) mergePatchEgressIPStatus(name string, status map[string]interface{}) error {
+	patch, err := json.Marshal(map[string]interface{}{"status": status})
+	if err != nil {
+		return fmt.Errorf("error serializing status patch: %+v, err: %v", status, err)
+	}
 	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
-		t := []EgressIPPatchStatus{
-			{
-				Op:   "replace",
-				Path: "/status",
-				Value: egressipv1.EgressIPStatus{
-					Items: statusItems,
-				},
-			},
-		}
-		op, err := json.Marshal(&t)
+		return eIPC.kube.MergePatchEgressIPStatus(name, patch)
+	})
+}
+
+// egressIPWarning is a warning recorded for an EgressIP
+type egressIPWarning struct {
+	reason  string
+	message string
+}
+
+// recordEgressIPWarning emits a warning event for the EgressIP with the given
+// name. The first warning recorded since the last call to popEgressIPWarning
+// for that EgressIP is kept to be reported in its Assigned condition.
+func (eIPC *egressIPClusterController) recordEgressIPWarning(name, reason, messageFmt string, args ...interface{}) {
+	eIPRef := v1.ObjectReference{
+		Kind: "EgressIP",
+		Name: name,
+	}
+	eIPC.recorder.Eventf(&eIPRef, v1.EventTypeWarning, reason, messageFmt, args...)
+	eIPC.egressIPWarningsMutex.Lock()
+	defer eIPC.egressIPWarningsMutex.Unlock()
+	if _, exists := eIPC.egressIPWarnings[name]; !exists {
+		eIPC.egressIPWarnings[name] = egressIPWarning{reason: reason, message: fmt.Sprintf(messageFmt, args...)}
+	}
+}
+
+// popEgressIPWarning returns and forgets the warning kept for the EgressIP with
+// the given name, if any.
+func (eIPC *egressIPClusterController) popEgressIPWarning(name string) (egressIPWarning, bool) {
+	eIPC.egressIPWarningsMutex.Lock()
+	defer eIPC.egressIPWarningsMutex.Unlock()
+	warning, exists := eIPC.egressIPWarnings[name]
+	delete(eIPC.egressIPWarnings, name)
+	return warning, exists
+}
+
+// updateEgressIPAssignedCondition sets the Assigned condition of the EgressIP
+// given the number of its egress IPs assigned to a node and the warning
+// recorded while reconciling the assignment, if any.
+func (eIPC *egressIPClusterController) updateEgressIPAssignedCondition(eIP *egressipv1.EgressIP, assigned int, warning *egressIPWarning) error {
+	requested := getRequestedEgressIPCount(eIP)
+	condition := metav1.Condition{
+		Type: egressipv1.EgressIPConditionAssigned,
+	}
+	switch {
+	case warning != nil:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = warning.reason
+		condition.Message = warning.message
+	case requested == 0:
+		// nothing to report until egress IPs are requested
+	case assigned < requested:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = egressIPNotAssignedReason
+		condition.Message = fmt.Sprintf("%d out of %d egress IPs are assigned", assigned, requested)
+	default:
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = egressIPAssignedReason
+		condition.Message = fmt.Sprintf("%d out of %d egress IPs are assigned", assigned, requested)
+	}
+	return eIPC.applyEgressIPCondition(eIP, condition)
+}
+
+// updateEgressIPCloudAssignedCondition sets the CloudAssigned condition of the
+// EgressIP given the CloudPrivateIPConfigs of the egress IPs assigned to its
+// nodes.
+func (eIPC *egressIPClusterController) updateEgressIPCloudAssignedCondition(eIP *egressipv1.EgressIP) error {
+	condition := metav1.Condition{
+		Type: egressipv1.EgressIPConditionCloudAssigned,
+	}
+	assignments := eIPC.getEgressIPAssignments(eIP.Name)
+	var pending int
+	for _, egressIP := range sets.List(sets.KeySet(assignments)) {
+		node := assignments[egressIP]
+		cloudPrivateIPConfig, err := eIPC.watchFactory.GetCloudPrivateIPConfig(ipStringToCloudPrivateIPConfigName(egressIP))
 		if err != nil {
-			return fmt.Errorf("error serializing status patch operation: %+v, err: %v", statusItems, err)
+			if !apierrors.IsNotFound(err) {
+				return err
+			}
+			pending++
+			continue
 		}
-		return eIPC.kube.PatchEgressIP(name, op)
-	})
+		if cloudPrivateIPConfig.Spec.Node != node || len(cloudPrivateIPConfig.Status.Conditions) == 0 {
+			pending++
+			continue
+		}
+		cloudCondition := cloudPrivateIPConfig.Status.Conditions[0]
+		if cloudCondition.Status == metav1.ConditionFalse {
+			condition.Status = metav1.ConditionFalse
+			condition.Reason = egressIPCloudFailedReason
+			condition.Message = fmt.Sprintf("egress IP: %s could not be assigned to node: %s by the cloud provider: %s: %s",
+				egressIP, node, cloudCondition.Reason, cloudCondition.Message)
+			break
+		}
+		if cloudCondition.Status != metav1.ConditionTrue || cloudPrivateIPConfig.Status.Node != node {
+			pending++
+		}
+	}
+	switch {
+	case condition.Status != "":
+		// a cloud assignment failed
+	case len(assignments) == 0:
+		// nothing to report until egress IPs are assigned
+	case pending > 0:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = egressIPCloudPendingReason
+		condition.Message = fmt.Sprintf("%d out of %d egress IPs are pending assignment by the cloud provider", pending, len(assignments))
+	default:
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = egressIPCloudAssignedReason
+		condition.Message = fmt.Sprintf("%d out of %d egress IPs are assigned by the cloud provider", len(assignments), len(assignments))
+	}
+	return eIPC.applyEgressIPCondition(eIP, condition)
+}
+
+// applyEgressIPCondition applies the status condition to the EgressIP, or
+// removes the condition of that type when it has no status. Each condition is
+// applied with its own field manager so that it is not reset when the others,
+// or the conditions the zones and the status manager own, are applied. Nothing
+// is applied when the latest EgressIP already has the condition.
+func (eIPC *egressIPClusterController) applyEgressIPCondition(eIP *egressipv1.EgressIP, condition metav1.Condition) error {
+	latest, err := eIPC.watchFactory.GetEgressIP(eIP.Name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	existing := meta.FindStatusCondition(latest.Status.Conditions, condition.Type)
+	applyStatus := egressipapply.EgressIPStatus()
+	if condition.Status == "" {
+		if existing == nil {
+			return nil
+		}
+	} else {
+		if existing != nil && existing.Status == condition.Status && existing.Reason == condition.Reason &&
+			existing.Message == condition.Message {
+			return nil
+		}
+		condition.LastTransitionTime = metav1.NewTime(time.Now())
+		if existing != nil && existing.Status == condition.Status {
+			condition.LastTransitionTime = existing.LastTransitionTime
+		}
+		applyStatus.WithConditions(&metaapplyv1.ConditionApplyConfiguration{
+			Type:               &condition.Type,
+			Status:             &condition.Status,
+			LastTransitionTime: &condition.LastTransitionTime,
+			Reason:             &condition.Reason,
+			Message:            &condition.Message,
+		})
+	}
+	klog.Infof("Applying %s status condition on EgressIP %s: %+v", condition.Type, eIP.Name, condition)
+	opts := metav1.ApplyOptions{
+		FieldManager: egressIPConditionFieldManagerPrefix + strings.ToLower(condition.Type),
+		Force:        true,
+	}
+	_, err = eIPC.kube.EIPClient.K8sV1().EgressIPs().ApplyStatus(context.TODO(), egressipapply.EgressIP(eIP.Name).WithStatus(applyStatus), opts)
+	return err
+}
+
+// getEgressIPAssignments returns the nodes the egress IPs of the EgressIP with
+// the given name are assigned to, by egress IP.
+func (eIPC *egressIPClusterController) getEgressIPAssignments(name string) map[string]string {
+	eIPC.allocator.Lock()
+	defer eIPC.allocator.Unlock()
+	assignments := map[string]string{}
+	for _, eNode := range eIPC.allocator.cache {
+		for egressIP, egressIPName := range eNode.allocations {
+			if egressIPName == name {
+				assignments[egressIP] = eNode.name
+			}
+		}
+	}
+	return assignments
 }
 
 func (eIPC *egressIPClusterController) getAllocationTotalCount() float64 {
@@ -365,6 +541,11 @@ type egressIPClusterController struct {
 	// - On update: once we finish processing the add - which comes after the
 	// delete.
 	pendingCloudPrivateIPConfigsOps map[string]map[string]*cloudPrivateIPConfigOp
+	// egressIPWarningsMutex protects egressIPWarnings
+	egressIPWarningsMutex *sync.Mutex
+	// egressIPWarnings holds, per EgressIP, the first warning recorded while
+	// reconciling its assignment, to be reported in its Assigned condition.
+	egressIPWarnings map[string]egressIPWarning
 	// allocator is a cache of egress IP centric data needed to when both route
 	// health-checking and tracking allocations made
 	allocator allocator
@@ -388,6 +569,9 @@ type egressIPClusterController struct {
 	egressIPHandler *factory.Handler
 	// cloudPrivateIPConfig events factory handler
 	cloudPrivateIPConfigHandler *factory.Handler
+	// egressIPPoolController reconciles the EgressIPs requesting addresses
+	// from a pool on EgressIPPool events
+	egressIPPoolController controller.Controller
 }
 
 func newEgressIPController(ovnClient *util.OVNClusterManagerClientset, wf *factory.WatchFactory, recorder record.EventRecorder) *egressIPClusterController {
@@ -403,6 +587,8 @@ func newEgressIPController(ovnClient *util.OVNClusterManagerClientset, wf *facto
 		egressIPAssignmentMutex:           &sync.Mutex{},
 		pendingCloudPrivateIPConfigsMutex: &sync.Mutex{},
 		pendingCloudPrivateIPConfigsOps:   make(map[string]map[string]*cloudPrivateIPConfigOp),
+		egressIPWarningsMutex:             &sync.Mutex{},
+		egressIPWarnings:                  make(map[string]egressIPWarning),
 		allocator:                         allocator{&sync.Mutex{}, make(map[string]*egressNode)},
 		watchFactory:                      wf,
 		recorder:                          recorder,
@@ -412,6 +598,7 @@ func newEgressIPController(ovnClient *util.OVNClusterManagerClientset, wf *facto
 		stopChan:                          make(chan struct{}),
 	}
 	eIPC.initRetryFramework()
+	eIPC.egressIPPoolController = eIPC.newEgressIPPoolController()
 	return eIPC
 }
 
@@ -448,6 +635,9 @@ func (eIPC *egressIPClusterController) Start() error {
 	if eIPC.egressIPHandler, err = eIPC.WatchEgressIP(); err != nil {
 		return err
 	}
+	if err = controller.Start(eIPC.egressIPPoolController); err != nil {
+		return fmt.Errorf("unable to start EgressIPPool controller: %w", err)
+	}
 	if util.PlatformTypeIsEgressIPCloudProvider() {
 		if eIPC.cloudPrivateIPConfigHandler, err = eIPC.WatchCloudPrivateIPConfig(); err != nil {
 			return err
@@ -482,6 +672,7 @@ func (eIPC *egressIPClusterController) WatchEgressIP() (*factory.Handler, error)
 }
 
 func (eIPC *egressIPClusterController) Stop() {
+	controller.Stop(eIPC.egressIPPoolController)
 	close(eIPC.stopChan)
 	eIPC.wg.Wait()
 	if eIPC.egressNodeHandler != nil {
@@ -757,7 +948,7 @@ func (eIPC *egressIPClusterController) addEgressNode(nodeName string) error {
 	}
 	for _, egressIP := range egressIPs {
 		egressIP := *egressIP
-		if len(egressIP.Spec.EgressIPs) != len(egressIP.Status.Items) {
+		if getRequestedEgressIPCount(&egressIP) != len(egressIP.Status.Items) {
 			// Send a "synthetic update" on all egress IPs which are not fully
 			// assigned, the reconciliation loop for WatchEgressIP will try to
 			// assign stuff to this new node. The workqueue's delta FIFO
@@ -830,6 +1021,7 @@ func (eIPC *egressIPClusterController) initEgressIPAllocator(node *v1.Node) (err
 	if err != nil {
 		return fmt.Errorf("failed to parse node %s subnets annotation %v", node.Name, err)
 	}
+	nodeSubnets = util.GetNodePrimaryHostSubnets(nodeSubnets)
 	mgmtIPs := make([]net.IP, len(nodeSubnets))
 	for i, subnet := range nodeSubnets {
 		mgmtIPs[i] = util.GetNodeManagementIfAddr(subnet).IP
@@ -897,6 +1089,30 @@ func (eIPC *egressIPClusterController) reconcileEgressIP(old, new *egressipv1.Eg
 	defer eIPC.egressIPAssignmentMutex.Unlock()
 
 	name := ""
+	if new != nil {
+		// Discard the warnings recorded outside of the reconciliation of the
+		// EgressIP and report those recorded now in its Assigned condition.
+		eIPC.popEgressIPWarning(new.Name)
+		defer func() {
+			warning, exists := eIPC.popEgressIPWarning(new.Name)
+			if err != nil && !exists {
+				return
+			}
+			var w *egressIPWarning
+			if exists {
+				w = &warning
+			}
+			if condErr := eIPC.updateEgressIPAssignedCondition(new, len(eIPC.getEgressIPAssignments(new.Name)), w); condErr != nil {
+				err = utilerrors.Join(err, fmt.Errorf("failed to update conditions of EgressIP %s: %w", new.Name, condErr))
+			}
+			if err != nil || !util.PlatformTypeIsEgressIPCloudProvider() {
+				return
+			}
+			if condErr := eIPC.updateEgressIPCloudAssignedCondition(new); condErr != nil {
+				err = fmt.Errorf("failed to update conditions of EgressIP %s: %w", new.Name, condErr)
+			}
+		}()
+	}
 
 	// Initialize a status which will be used to compare against
 	// new.spec.egressIPs and decide on what from the status should get deleted
@@ -914,13 +1130,37 @@ func (eIPC *egressIPClusterController) reconcileEgressIP(old, new *egressipv1.Eg
 		name = old.Name
 		status = old.Status.Items
 		staleEgressIPs.Insert(old.Spec.EgressIPs...)
+		// Release the addresses allocated from a pool the EgressIP does not
+		// request addresses from anymore.
+		if old.Spec.PoolRequest != nil && (new == nil || new.Spec.PoolRequest == nil ||
+			new.Spec.PoolRequest.PoolName != old.Spec.PoolRequest.PoolName) {
+			released, err := eIPC.releaseEgressIPPoolAllocations(old.Spec.PoolRequest.PoolName, old.Name)
+			if err != nil {
+				return err
+			}
+			staleEgressIPs.Insert(released...)
+		}
 	}
+	// The egress IPs requested are either listed in the spec or allocated from
+	// the pool the EgressIP requests addresses from, in which case they may only
+	// be assigned to the nodes selected by the pool.
+	requestedEgressIPs := newEIP.Spec.EgressIPs
+	nodeSelector := labels.Everything()
 	if new != nil {
 		newEIP = new
 		name = newEIP.Name
 		status = newEIP.Status.Items
+		requestedEgressIPs = newEIP.Spec.EgressIPs
+		if newEIP.Spec.PoolRequest != nil {
+			var released []string
+			requestedEgressIPs, released, nodeSelector, err = eIPC.allocateEgressIPsFromPool(name, newEIP.Spec.PoolRequest)
+			if err != nil {
+				return fmt.Errorf("failed to allocate egress IPs for EgressIP %s: %w", name, err)
+			}
+			staleEgressIPs.Insert(released...)
+		}
 		if staleEgressIPs.Len() > 0 {
-			for _, egressIP := range newEIP.Spec.EgressIPs {
+			for _, egressIP := range requestedEgressIPs {
 				if staleEgressIPs.Has(egressIP) {
 					staleEgressIPs.Delete(egressIP)
 				}
@@ -931,17 +1171,22 @@ func (eIPC *egressIPClusterController) reconcileEgressIP(old, new *egressipv1.Eg
 	// Validate the spec and use only the valid egress IPs when performing any
 	// successive operations, theoretically: the user could specify invalid IP
 	// addresses, which would break us.
-	validSpecIPs, err := eIPC.validateEgressIPSpec(name, newEIP.Spec.EgressIPs)
+	validSpecIPs, err := eIPC.validateEgressIPSpec(name, requestedEgressIPs)
 	if err != nil {
 		return fmt.Errorf("invalid EgressIP spec, err: %v", err)
 	}
+	placement, err := newEgressIPPlacement(newEIP, nodeSelector)
+	if err != nil {
+		eIPC.recordEgressIPWarning(name, "InvalidEgressIP", "EgressIP: %s has an invalid node preference: %v", name, err)
+		return fmt.Errorf("invalid EgressIP spec, err: %v", err)
+	}
 
 	// Validate the status, on restart it could be the case that what might have
 	// been assigned when ovnkube-master last ran is not a valid assignment
 	// anymore (specifically if ovnkube-master has been crashing for a while).
 	// Any invalid status at this point in time needs to be removed and assigned
 	// to a valid node.
-	validStatus, invalidStatus := eIPC.validateEgressIPStatus(name, status)
+	validStatus, invalidStatus := eIPC.validateEgressIPStatus(name, status, nodeSelector)
 	for status := range validStatus {
 		// If the spec has changed and an egress IP has been removed by the
 		// user: we need to un-assign that egress IP
@@ -1010,7 +1255,7 @@ func (eIPC *egressIPClusterController) reconcileEgressIP(old, new *egressipv1.Eg
 			eIPC.deleteAllocatorEgressIPAssignments(statusToRemove)
 		}
 		if len(ipsToAssign) > 0 {
-			statusToAdd = eIPC.assignEgressIPs(name, ipsToAssign.UnsortedList())
+			statusToAdd = eIPC.assignEgressIPs(name, sets.List(ipsToAssign), placement)
 			statusToKeep = append(statusToKeep, statusToAdd...)
 		}
 		// Add all assignments which are to be kept to the allocator cache,
@@ -1074,7 +1319,7 @@ func (eIPC *egressIPClusterController) reconcileEgressIP(old, new *egressipv1.Eg
 		// processing the answer from the requests we make here, and update OVN
 		// accordingly when we know what the outcome is.
 		if len(ipsToAssign) > 0 {
-			statusToAdd = eIPC.assignEgressIPs(name, ipsToAssign.UnsortedList())
+			statusToAdd = eIPC.assignEgressIPs(name, sets.List(ipsToAssign), placement)
 			statusToKeep = append(statusToKeep, statusToAdd...)
 		}
 		// Same as above: Add all assignments which are to be kept to the
@@ -1171,17 +1416,19 @@ func (eIPC *egressIPClusterController) getCloudPrivateIPConfigMap(objs []interfa
 // time, this does not guarantee complete balance, but mostly complete.
 // For Egress IPs that are hosted by secondary host networks, there must be at least
 // one node that hosts the network and exposed via the nodes host-cidrs annotation.
-func (eIPC *egressIPClusterController) assignEgressIPs(name string, egressIPs []string) []egressipv1.EgressIPStatusItem {
+// The placement further restricts the nodes each egress IP may be assigned to
+// and the order in which they are tried, according to the node selector of the
+// pool the egress IPs are allocated from and the topology spread constraints
+// and node preferences of the EgressIP.
+func (eIPC *egressIPClusterController) assignEgressIPs(name string, egressIPs []string, placement *egressIPPlacement) []egressipv1.EgressIPStatusItem {
 	eIPC.allocator.Lock()
 	defer eIPC.allocator.Unlock()
 	assignments := []egressipv1.EgressIPStatusItem{}
 	assignableNodes, existingAllocations := eIPC.getSortedEgressData()
+	nodeLabels := eIPC.getEgressNodeLabels(assignableNodes)
+	assignableNodes = placement.filterEgressNodes(assignableNodes, nodeLabels)
 	if len(assignableNodes) == 0 {
-		eIPRef := v1.ObjectReference{
-			Kind: "EgressIP",
-			Name: name,
-		}
-		eIPC.recorder.Eventf(&eIPRef, v1.EventTypeWarning, "NoMatchingNodeFound", "no assignable nodes for EgressIP: %s, please tag at least one node with label: %s", name, util.GetNodeEgressLabel())
+		eIPC.recordEgressIPWarning(name, "NoMatchingNodeFound", "no assignable nodes for EgressIP: %s, please tag at least one node with label: %s", name, util.GetNodeEgressLabel())
 		klog.Errorf("No assignable nodes found for EgressIP: %s and requested IPs: %v", name, egressIPs)
 		return assignments
 	}
@@ -1196,11 +1443,7 @@ func (eIPC *egressIPClusterController) assignEgressIPs(name string, egressIPs []
 			klog.Errorf("Egress IP: %v failed to check if EgressIP already is assigned on any interface throughout the cluster: %v", eIP, err)
 			return assignments
 		} else if isIPConflict {
-			eIPRef := v1.ObjectReference{
-				Kind: "EgressIP",
-				Name: name,
-			}
-			eIPC.recorder.Eventf(&eIPRef, v1.EventTypeWarning, "EgressIPConflict", "Egress IP %s with IP "+
+			eIPC.recordEgressIPWarning(name, "EgressIPConflict", "Egress IP %s with IP "+
 				"%v is conflicting with a host (%s) IP address and will not be assigned", name, eIP, conflictedHost)
 			klog.Errorf("Egress IP: %v address is already assigned on an interface on node %s", eIP, conflictedHost)
 			return assignments
@@ -1245,15 +1488,8 @@ func (eIPC *egressIPClusterController) assignEgressIPs(name string, egressIPs []
 				})
 				continue
 			} else {
-				eIPC.recorder.Eventf(
-					&v1.ObjectReference{
-						Kind: "EgressIP",
-						Name: name,
-					},
-					v1.EventTypeWarning,
-					"UnsupportedRequest",
-					"IP: %q for EgressIP: %s is already allocated for EgressIP: %s on %s", egressIP, name, status.Name, status.Node,
-				)
+				eIPC.recordEgressIPWarning(name, "UnsupportedRequest",
+					"IP: %q for EgressIP: %s is already allocated for EgressIP: %s on %s", egressIP, name, status.Name, status.Node)
 				klog.Errorf("IP: %q for EgressIP: %s is already allocated for EgressIP: %s on %s", egressIP, name, status.Name, status.Node)
 				return assignments
 			}
@@ -1292,9 +1528,10 @@ func (eIPC *egressIPClusterController) assignEgressIPs(name string, egressIPs []
 			}
 		}
 
+		candidateNodes := placement.orderEgressNodes(name, assignableNodes, nodeLabels)
 		var assignmentSuccessful bool
-		for i := 0; i < len(assignableNodes) && !assignmentSuccessful; i++ {
-			eNode := assignableNodes[i]
+		for i := 0; i < len(candidateNodes) && !assignmentSuccessful; i++ {
+			eNode := candidateNodes[i]
 			klog.V(5).Infof("Attempting assignment on egress node: %+v", eNode)
 			if eNode.getAllocationCountForEgressIP(name) > 0 {
 				klog.V(5).Infof("Node: %s is already in use by another egress IP for this EgressIP: %s, trying another node", eNode.name, name)
@@ -1341,26 +1578,36 @@ func (eIPC *egressIPClusterController) assignEgressIPs(name string, egressIPs []
 			klog.Infof("Successful assignment of egress IP: %s to network %s on node: %+v", egressIP, egressIPNetwork, eNode)
 			break
 		}
+		if !assignmentSuccessful && len(candidateNodes) < len(assignableNodes) {
+			eIPC.recordEgressIPWarning(name, "TopologySpreadConstraintsUnsatisfiable", "Egress IP: %s for EgressIP: %s "+
+				"can't be assigned to any node without violating its topology spread constraints", egressIP, name)
+		}
 	}
 	if len(assignments) == 0 {
-		eIPRef := v1.ObjectReference{
-			Kind: "EgressIP",
-			Name: name,
-		}
-		eIPC.recorder.Eventf(&eIPRef, v1.EventTypeWarning, "NoMatchingNodeFound", "No matching nodes found, which can host any of the egress IPs: %v for object EgressIP: %s", egressIPs, name)
+		eIPC.recordEgressIPWarning(name, "NoMatchingNodeFound", "No matching nodes found, which can host any of the egress IPs: %v for object EgressIP: %s", egressIPs, name)
 		klog.Errorf("No matching host found for EgressIP: %s", name)
 		return assignments
 	}
 	if len(assignments) < len(egressIPs) {
-		eIPRef := v1.ObjectReference{
-			Kind: "EgressIP",
-			Name: name,
-		}
-		eIPC.recorder.Eventf(&eIPRef, v1.EventTypeWarning, "UnassignedRequest", "Not all egress IPs for EgressIP: %s could be assigned, please tag more nodes", name)
+		eIPC.recordEgressIPWarning(name, "UnassignedRequest", "Not all egress IPs for EgressIP: %s could be assigned, please tag more nodes", name)
 	}
 	return assignments
 }
 
+// getEgressNodeLabels returns the labels of the egress nodes, by node name.
+func (eIPC *egressIPClusterController) getEgressNodeLabels(eNodes []*egressNode) map[string]labels.Set {
+	nodeLabels := make(map[string]labels.Set, len(eNodes))
+	for _, eNode := range eNodes {
+		node, err := eIPC.watchFactory.GetNode(eNode.name)
+		if err != nil {
+			klog.Errorf("Failed to get labels of node %s because lookup of kubernetes object failed: %v", eNode.name, err)
+			continue
+		}
+		nodeLabels[eNode.name] = labels.Set(node.Labels)
+	}
+	return nodeLabels
+}
+
 func getIPFamilyAllocationCount(allocations map[string]string, isIPv6 bool) (count int) {
 	for allocation := range allocations {
 		if utilnet.IsIPv4String(allocation) && !isIPv6 {
@@ -1378,11 +1625,7 @@ func (eIPC *egressIPClusterController) validateEgressIPSpec(name string, egressI
 	for _, egressIP := range egressIPs {
 		ip := net.ParseIP(egressIP)
 		if ip == nil {
-			eIPRef := v1.ObjectReference{
-				Kind: "EgressIP",
-				Name: name,
-			}
-			eIPC.recorder.Eventf(&eIPRef, v1.EventTypeWarning, "InvalidEgressIP", "egress IP: %s for object EgressIP: %s is not a valid IP address", egressIP, name)
+			eIPC.recordEgressIPWarning(name, "InvalidEgressIP", "egress IP: %s for object EgressIP: %s is not a valid IP address", egressIP, name)
 			return nil, fmt.Errorf("unable to parse provided EgressIP: %s, invalid", egressIP)
 		}
 		validatedEgressIPs.Insert(ip.String())
@@ -1397,29 +1640,21 @@ func (eIPC *egressIPClusterController) isEgressIPAddrConflict(egressIP net.IP) (
 	if err != nil {
 		return false, "", fmt.Errorf("failed to get nodes: %v", err)
 	}
-	// iterate through the nodes and ensure no host IP address conflicts with EIP. Note that host-cidrs annotation
-	// does not contain EgressIPs that are assigned to interfaces.
-	for _, node := range nodes {
-		// EgressIP is not supported on hybrid overlay nodes, and OVNNodeHostCIDRs annotation is not present
-		if util.NoHostSubnet(node) {
-			continue
-		}
-		nodeHostAddrsSet, err := util.ParseNodeHostCIDRsDropNetMask(node)
-		if err != nil {
-			return false, "", fmt.Errorf("failed to parse node host cidrs for node %s: %v", node.Name, err)
-		}
-		if nodeHostAddrsSet.Has(egressIP.String()) {
-			return true, node.Name, nil
-		}
+	// ensure no host IP address conflicts with EIP. Note that host-cidrs annotation does not contain EgressIPs
+	// that are assigned to interfaces. EgressIP is not supported on hybrid overlay nodes, they are ignored.
+	nodeName, err := util.GetNodeWithHostAddr(nodes, egressIP)
+	if err != nil {
+		return false, "", err
 	}
-	return false, "", nil
+	return nodeName != "", nodeName, nil
 }
 
 // validateEgressIPStatus validates if the statuses are valid given what the
-// cache knows about all egress nodes. WatchEgressNodes is initialized before
-// any other egress IP handler, so the cache should be warm and correct once we
-// start going this.
-func (eIPC *egressIPClusterController) validateEgressIPStatus(name string, items []egressipv1.EgressIPStatusItem) (map[egressipv1.EgressIPStatusItem]string, map[egressipv1.EgressIPStatusItem]string) {
+// cache knows about all egress nodes and the selector of the nodes the egress
+// IPs may be assigned to. WatchEgressNodes is initialized before any other
+// egress IP handler, so the cache should be warm and correct once we start
+// going this.
+func (eIPC *egressIPClusterController) validateEgressIPStatus(name string, items []egressipv1.EgressIPStatusItem, nodeSelector labels.Selector) (map[egressipv1.EgressIPStatusItem]string, map[egressipv1.EgressIPStatusItem]string) {
 	eIPC.allocator.Lock()
 	defer eIPC.allocator.Unlock()
 	valid, invalid := make(map[egressipv1.EgressIPStatusItem]string), make(map[egressipv1.EgressIPStatusItem]string)
@@ -1454,6 +1689,9 @@ func (eIPC *egressIPClusterController) validateEgressIPStatus(name string, items
 			if err != nil {
 				klog.Errorf("Allocator error: failed to validate and will not consider node %s for egress IP %s: %v",
 					eNode.name, name, err)
+			} else if !nodeSelector.Matches(labels.Set(node.Labels)) {
+				klog.Errorf("Allocator error: EgressIP: %s assigned to node: %s which does not match the node selector of its EgressIPPool, will attempt rebalancing", name, eIPStatus.Node)
+				validAssignment = false
 			}
 			isOVNNetwork := util.IsOVNNetwork(eNode.egressIPConfig, ip)
 			isSecondaryHostNetwork, err := util.IsSecondaryHostNetworkContainingIP(node, ip)
@@ -1475,7 +1713,7 @@ func (eIPC *egressIPClusterController) validateEgressIPStatus(name string, items
 	return valid, invalid
 }
 
-func (eIPC *egressIPClusterController) reconcileCloudPrivateIPConfig(old, new *ocpcloudnetworkapi.CloudPrivateIPConfig) error {
+func (eIPC *egressIPClusterController) reconcileCloudPrivateIPConfig(old, new *ocpcloudnetworkapi.CloudPrivateIPConfig) (err error) {
 	oldCloudPrivateIPConfig, newCloudPrivateIPConfig := &ocpcloudnetworkapi.CloudPrivateIPConfig{}, &ocpcloudnetworkapi.CloudPrivateIPConfig{}
 	shouldDelete, shouldAdd := false, false
 	nodeToDelete := ""
@@ -1520,6 +1758,14 @@ func (eIPC *egressIPClusterController) reconcileCloudPrivateIPConfig(old, new *o
 	if reflect.DeepEqual(oldCloudPrivateIPConfig.Status, newCloudPrivateIPConfig.Status) {
 		return nil
 	}
+	// Report the outcome of the cloud assignment, including failures which
+	// don't require any further processing, on the owning EgressIP.
+	defer func() {
+		if err != nil {
+			return
+		}
+		err = eIPC.updateCloudPrivateIPConfigOwnerCondition(oldCloudPrivateIPConfig, newCloudPrivateIPConfig)
+	}()
 
 	if shouldDelete {
 		// Get the EgressIP owner reference
@@ -1642,6 +1888,29 @@ func (eIPC *egressIPClusterController) reconcileCloudPrivateIPConfig(old, new *o
 	return nil
 }
 
+// updateCloudPrivateIPConfigOwnerCondition updates the CloudAssigned condition
+// of the EgressIP owning the CloudPrivateIPConfig.
+func (eIPC *egressIPClusterController) updateCloudPrivateIPConfigOwnerCondition(old, new *ocpcloudnetworkapi.CloudPrivateIPConfig) error {
+	egressIPName, exists := new.Annotations[util.OVNEgressIPOwnerRefLabel]
+	if !exists {
+		egressIPName, exists = old.Annotations[util.OVNEgressIPOwnerRefLabel]
+	}
+	if !exists {
+		return nil
+	}
+	egressIP, err := eIPC.kube.GetEgressIP(egressIPName)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if err := eIPC.updateEgressIPCloudAssignedCondition(egressIP); err != nil {
+		return fmt.Errorf("failed to update conditions of EgressIP %s: %w", egressIPName, err)
+	}
+	return nil
+}
+
 // cloudPrivateIPConfigNameToIPString converts the resource name to the string
 // representation of net.IP. Given a limitation in the Kubernetes API server
 // (see: https://github.com/kubernetes/kubernetes/pull/100950)
@@ -1706,7 +1975,7 @@ func (eIPC *egressIPClusterController) removePendingOpsAndGetResyncs(egressIPNam
 		if egressIP.Name == egressIPName {
 			continue
 		}
-		unassigned := len(egressIP.Spec.EgressIPs) - len(egressIP.Status.Items)
+		unassigned := getRequestedEgressIPCount(&egressIP) - len(egressIP.Status.Items)
 		ops, pending := eIPC.pendingCloudPrivateIPConfigsOps[egressIP.Name]
 		// If the EgressIP was never added to the pending cache to begin
 		// with, but has un-assigned egress IPs, try it.
@@ -2,8 +2,10 @@ package clustermanager
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net"
+	"sync"
 	"time"
 
 	"github.com/onsi/ginkgo/v2"
@@ -11,14 +13,19 @@ import (
 	ocpcloudnetworkapi "github.com/openshift/api/cloudnetwork/v1"
 	ocpconfigapi "github.com/openshift/api/config/v1"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/controller"
 	egressipv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1"
+	egressipfake "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1/apis/clientset/versioned/fake"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/healthcheck"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
 	"github.com/urfave/cli/v2"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	k8stypes "k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	clienttesting "k8s.io/client-go/testing"
 	utilnet "k8s.io/utils/net"
 )
 
@@ -1734,7 +1741,7 @@ var _ = ginkgo.Describe("OVN cluster-manager EgressIP Operations", func() {
 						EgressIPs: []string{egressIP},
 					},
 				}
-				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
+				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs, nil)
 				gomega.Expect(assignedStatuses).To(gomega.HaveLen(1))
 				gomega.Expect(assignedStatuses[0].Node).To(gomega.Equal(egressNode2.name))
 				gomega.Expect(assignedStatuses[0].EgressIP).To(gomega.Equal(net.ParseIP(egressIP).String()))
@@ -1816,7 +1823,7 @@ var _ = ginkgo.Describe("OVN cluster-manager EgressIP Operations", func() {
 				fakeClusterManagerOVN.eIPC.allocator.cache[egressNode1.name] = &egressNode1
 				fakeClusterManagerOVN.eIPC.allocator.cache[egressNode2.name] = &egressNode2
 
-				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
+				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs, nil)
 				gomega.Expect(assignedStatuses).To(gomega.HaveLen(2))
 				gomega.Expect(assignedStatuses[0].Node).To(gomega.Equal(egressNode2.name))
 				gomega.Expect(assignedStatuses[0].EgressIP).To(gomega.Equal(net.ParseIP(egressIP1).String()))
@@ -1903,7 +1910,7 @@ var _ = ginkgo.Describe("OVN cluster-manager EgressIP Operations", func() {
 
 				gomega.Expect(fakeClusterManagerOVN.eIPC.initEgressIPAllocator(&node1)).To(gomega.Succeed())
 				gomega.Expect(fakeClusterManagerOVN.eIPC.initEgressIPAllocator(&node2)).To(gomega.Succeed())
-				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
+				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs, nil)
 				gomega.Expect(assignedStatuses).To(gomega.HaveLen(2))
 				gomega.Expect(assignedStatuses[0].Node).To(gomega.Equal(egressNode2.name))
 				gomega.Expect(assignedStatuses[0].EgressIP).To(gomega.Equal(net.ParseIP(egressIP1).String()))
@@ -1997,7 +2004,7 @@ var _ = ginkgo.Describe("OVN cluster-manager EgressIP Operations", func() {
 
 				gomega.Expect(fakeClusterManagerOVN.eIPC.initEgressIPAllocator(&node1)).To(gomega.Succeed())
 				gomega.Expect(fakeClusterManagerOVN.eIPC.initEgressIPAllocator(&node2)).To(gomega.Succeed())
-				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
+				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs, nil)
 				gomega.Expect(assignedStatuses).To(gomega.HaveLen(2))
 				gomega.Expect(assignedStatuses[0].Node).To(gomega.Equal(egressNode2.name))
 				gomega.Expect(assignedStatuses[0].EgressIP).To(gomega.Equal(net.ParseIP(egressIP1SecondaryHost).String()))
@@ -2083,10 +2090,10 @@ var _ = ginkgo.Describe("OVN cluster-manager EgressIP Operations", func() {
 
 				gomega.Expect(fakeClusterManagerOVN.eIPC.initEgressIPAllocator(&node1)).To(gomega.Succeed())
 				gomega.Expect(fakeClusterManagerOVN.eIPC.initEgressIPAllocator(&node2)).To(gomega.Succeed())
-				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
+				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs, nil)
 				gomega.Expect(assignedStatuses).To(gomega.HaveLen(1))
 				gomega.Expect(assignedStatuses[0].Node).To(gomega.Equal(node2Name))
-				assignedStatuses = fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
+				assignedStatuses = fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs, nil)
 				gomega.Expect(assignedStatuses).To(gomega.HaveLen(1))
 				gomega.Expect(assignedStatuses[0].Node).To(gomega.Equal(node2Name))
 				return nil
@@ -2168,7 +2175,7 @@ var _ = ginkgo.Describe("OVN cluster-manager EgressIP Operations", func() {
 				fakeClusterManagerOVN.eIPC.allocator.cache[egressNode1.name] = &egressNode1
 				fakeClusterManagerOVN.eIPC.allocator.cache[egressNode2.name] = &egressNode2
 
-				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
+				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs, nil)
 				gomega.Expect(assignedStatuses).To(gomega.HaveLen(0))
 
 				return nil
@@ -2251,7 +2258,7 @@ var _ = ginkgo.Describe("OVN cluster-manager EgressIP Operations", func() {
 
 				gomega.Expect(fakeClusterManagerOVN.eIPC.initEgressIPAllocator(&node1)).To(gomega.Succeed())
 				gomega.Expect(fakeClusterManagerOVN.eIPC.initEgressIPAllocator(&node2)).To(gomega.Succeed())
-				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
+				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs, nil)
 				gomega.Expect(assignedStatuses).To(gomega.HaveLen(0))
 
 				return nil
@@ -2330,7 +2337,7 @@ var _ = ginkgo.Describe("OVN cluster-manager EgressIP Operations", func() {
 				fakeClusterManagerOVN.eIPC.allocator.cache[egressNode1.name] = &egressNode1
 				fakeClusterManagerOVN.eIPC.allocator.cache[egressNode2.name] = &egressNode2
 
-				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
+				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs, nil)
 				gomega.Expect(assignedStatuses).To(gomega.HaveLen(0))
 				return nil
 			}
@@ -2409,7 +2416,7 @@ var _ = ginkgo.Describe("OVN cluster-manager EgressIP Operations", func() {
 				fakeClusterManagerOVN.eIPC.allocator.cache[egressNode1.name] = &egressNode1
 				fakeClusterManagerOVN.eIPC.allocator.cache[egressNode2.name] = &egressNode2
 
-				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
+				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs, nil)
 				gomega.Expect(assignedStatuses).To(gomega.HaveLen(0))
 				return nil
 			}
@@ -2488,7 +2495,7 @@ var _ = ginkgo.Describe("OVN cluster-manager EgressIP Operations", func() {
 				fakeClusterManagerOVN.eIPC.allocator.cache[egressNode1.name] = &egressNode1
 				fakeClusterManagerOVN.eIPC.allocator.cache[egressNode2.name] = &egressNode2
 
-				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
+				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs, nil)
 				gomega.Expect(assignedStatuses).To(gomega.HaveLen(1))
 				gomega.Expect(assignedStatuses[0].Node).To(gomega.Equal(egressNode2.name))
 				gomega.Expect(assignedStatuses[0].EgressIP).To(gomega.Equal(net.ParseIP(egressIP).String()))
@@ -2569,7 +2576,7 @@ var _ = ginkgo.Describe("OVN cluster-manager EgressIP Operations", func() {
 				fakeClusterManagerOVN.eIPC.allocator.cache[egressNode1.name] = &egressNode1
 				fakeClusterManagerOVN.eIPC.allocator.cache[egressNode2.name] = &egressNode2
 
-				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
+				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs, nil)
 				gomega.Expect(assignedStatuses).To(gomega.HaveLen(0))
 				return nil
 			}
@@ -2725,7 +2732,7 @@ var _ = ginkgo.Describe("OVN cluster-manager EgressIP Operations", func() {
 				fakeClusterManagerOVN.eIPC.allocator.cache[egressNode1.name] = &egressNode1
 				fakeClusterManagerOVN.eIPC.allocator.cache[egressNode2.name] = &egressNode2
 
-				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs)
+				assignedStatuses := fakeClusterManagerOVN.eIPC.assignEgressIPs(eIP.Name, eIP.Spec.EgressIPs, nil)
 				gomega.Expect(assignedStatuses).To(gomega.HaveLen(1))
 				gomega.Expect(assignedStatuses[0].Node).To(gomega.Equal(egressNode2.name))
 				gomega.Expect(assignedStatuses[0].EgressIP).To(gomega.Equal(net.ParseIP(egressIP).String()))
@@ -4054,4 +4061,523 @@ var _ = ginkgo.Describe("OVN cluster-manager EgressIP Operations", func() {
 			gomega.Expect(err).NotTo(gomega.HaveOccurred())
 		})
 	})
+
+	ginkgo.Context("EgressIPPool", func() {
+		const (
+			poolName  = "pool1"
+			node1IPv4 = "192.168.126.12/24"
+			node2IPv4 = "192.168.126.51/24"
+		)
+
+		newEgressAssignableNode := func(name, nodeIPv4 string, extraLabels map[string]string) v1.Node {
+			labels := map[string]string{
+				"k8s.ovn.org/egress-assignable": "",
+			}
+			for k, v := range extraLabels {
+				labels[k] = v
+			}
+			return v1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: name,
+					Annotations: map[string]string{
+						"k8s.ovn.org/node-primary-ifaddr": fmt.Sprintf("{\"ipv4\": \"%s\", \"ipv6\": \"%s\"}", nodeIPv4, ""),
+						"k8s.ovn.org/node-subnets":        fmt.Sprintf("{\"default\":[\"%s\", \"%s\"]}", v4NodeSubnet, v6NodeSubnet),
+						util.OVNNodeHostCIDRs:             fmt.Sprintf("[\"%s\"]", nodeIPv4),
+					},
+					Labels: labels,
+				},
+				Status: v1.NodeStatus{
+					Conditions: []v1.NodeCondition{
+						{
+							Type:   v1.NodeReady,
+							Status: v1.ConditionTrue,
+						},
+					},
+				},
+			}
+		}
+
+		newEgressIPPool := func(nodeSelector metav1.LabelSelector, ranges ...string) egressipv1.EgressIPPool {
+			return egressipv1.EgressIPPool{
+				ObjectMeta: metav1.ObjectMeta{Name: poolName},
+				Spec: egressipv1.EgressIPPoolSpec{
+					Ranges:       ranges,
+					NodeSelector: nodeSelector,
+				},
+			}
+		}
+
+		newPoolEgressIP := func(count int) egressipv1.EgressIP {
+			return egressipv1.EgressIP{
+				ObjectMeta: newEgressIPMeta(egressIPName),
+				Spec: egressipv1.EgressIPSpec{
+					PoolRequest: &egressipv1.EgressIPPoolRequest{
+						PoolName: poolName,
+						Count:    count,
+					},
+				},
+			}
+		}
+
+		getEgressIPPoolAllocations := func() []egressipv1.EgressIPPoolAllocation {
+			pool, err := fakeClusterManagerOVN.fakeClient.EgressIPClient.K8sV1().EgressIPPools().Get(context.TODO(), poolName, metav1.GetOptions{})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			return pool.Status.Allocations
+		}
+
+		ginkgo.It("should allocate egress IPs from the pool and assign them to the nodes it selects", func() {
+			app.Action = func(ctx *cli.Context) error {
+				node1 := newEgressAssignableNode(node1Name, node1IPv4, nil)
+				node2 := newEgressAssignableNode(node2Name, node2IPv4, map[string]string{"egress-pool": poolName})
+				pool := newEgressIPPool(metav1.LabelSelector{MatchLabels: map[string]string{"egress-pool": poolName}}, "192.168.126.200/30")
+				eIP := newPoolEgressIP(1)
+
+				fakeClusterManagerOVN.start(
+					&v1.NodeList{Items: []v1.Node{node1, node2}},
+					&egressipv1.EgressIPPoolList{Items: []egressipv1.EgressIPPool{pool}},
+					&egressipv1.EgressIPList{Items: []egressipv1.EgressIP{eIP}},
+				)
+
+				// node1 has fewer allocations but is not selected by the pool
+				egressNode1 := setupNode(node1Name, []string{node1IPv4}, map[string]string{})
+				egressNode2 := setupNode(node2Name, []string{node2IPv4}, map[string]string{"192.168.126.68": "bogus3"})
+				fakeClusterManagerOVN.eIPC.allocator.cache[egressNode1.name] = &egressNode1
+				fakeClusterManagerOVN.eIPC.allocator.cache[egressNode2.name] = &egressNode2
+
+				_, err := fakeClusterManagerOVN.eIPC.WatchEgressIP()
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+				gomega.Eventually(getEgressIPStatusLen(egressIPName)).Should(gomega.Equal(1))
+				egressIPs, nodes := getEgressIPStatus(egressIPName)
+				// the network address of the range is not allocated
+				gomega.Expect(egressIPs).To(gomega.ConsistOf("192.168.126.201"))
+				gomega.Expect(nodes).To(gomega.ConsistOf(node2Name))
+				gomega.Expect(getEgressIPPoolAllocations()).To(gomega.ConsistOf(
+					egressipv1.EgressIPPoolAllocation{EgressIP: "192.168.126.201", EgressIPName: egressIPName},
+				))
+				return nil
+			}
+
+			err := app.Run([]string{app.Name})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+
+		ginkgo.It("should release the egress IPs when fewer are requested and when the EgressIP is deleted", func() {
+			app.Action = func(ctx *cli.Context) error {
+				node1 := newEgressAssignableNode(node1Name, node1IPv4, nil)
+				node2 := newEgressAssignableNode(node2Name, node2IPv4, nil)
+				pool := newEgressIPPool(metav1.LabelSelector{}, "192.168.126.200-192.168.126.201")
+				eIP := newPoolEgressIP(2)
+
+				fakeClusterManagerOVN.start(
+					&v1.NodeList{Items: []v1.Node{node1, node2}},
+					&egressipv1.EgressIPPoolList{Items: []egressipv1.EgressIPPool{pool}},
+					&egressipv1.EgressIPList{Items: []egressipv1.EgressIP{eIP}},
+				)
+
+				egressNode1 := setupNode(node1Name, []string{node1IPv4}, map[string]string{})
+				egressNode2 := setupNode(node2Name, []string{node2IPv4}, map[string]string{})
+				fakeClusterManagerOVN.eIPC.allocator.cache[egressNode1.name] = &egressNode1
+				fakeClusterManagerOVN.eIPC.allocator.cache[egressNode2.name] = &egressNode2
+
+				_, err := fakeClusterManagerOVN.eIPC.WatchEgressIP()
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+				gomega.Eventually(getEgressIPStatusLen(egressIPName)).Should(gomega.Equal(2))
+				egressIPs, nodes := getEgressIPStatus(egressIPName)
+				gomega.Expect(egressIPs).To(gomega.ConsistOf("192.168.126.200", "192.168.126.201"))
+				gomega.Expect(nodes).To(gomega.ConsistOf(node1Name, node2Name))
+				gomega.Expect(getEgressIPPoolAllocations()).To(gomega.HaveLen(2))
+
+				eIPToUpdate, err := fakeClusterManagerOVN.fakeClient.EgressIPClient.K8sV1().EgressIPs().Get(context.TODO(), egressIPName, metav1.GetOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				eIPToUpdate.Spec.PoolRequest.Count = 1
+				_, err = fakeClusterManagerOVN.fakeClient.EgressIPClient.K8sV1().EgressIPs().Update(context.TODO(), eIPToUpdate, metav1.UpdateOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+				gomega.Eventually(getEgressIPStatusLen(egressIPName)).Should(gomega.Equal(1))
+				gomega.Eventually(getEgressIPPoolAllocations).Should(gomega.ConsistOf(
+					egressipv1.EgressIPPoolAllocation{EgressIP: "192.168.126.200", EgressIPName: egressIPName},
+				))
+				egressIPs, _ = getEgressIPStatus(egressIPName)
+				gomega.Expect(egressIPs).To(gomega.ConsistOf("192.168.126.200"))
+
+				err = fakeClusterManagerOVN.fakeClient.EgressIPClient.K8sV1().EgressIPs().Delete(context.TODO(), egressIPName, metav1.DeleteOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Eventually(getEgressIPPoolAllocations).Should(gomega.BeEmpty())
+				return nil
+			}
+
+			err := app.Run([]string{app.Name})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+
+		ginkgo.It("should not allocate addresses in use by other EgressIPs or nodes and should wait for the pool to be created", func() {
+			app.Action = func(ctx *cli.Context) error {
+				node1 := newEgressAssignableNode(node1Name, node1IPv4, nil)
+				node2 := newEgressAssignableNode(node2Name, node2IPv4, nil)
+				otherEIP := egressipv1.EgressIP{
+					ObjectMeta: newEgressIPMeta("other-egressip"),
+					Spec: egressipv1.EgressIPSpec{
+						EgressIPs: []string{"192.168.126.50"},
+					},
+				}
+				eIP := newPoolEgressIP(1)
+
+				fakeClusterManagerOVN.start(
+					&v1.NodeList{Items: []v1.Node{node1, node2}},
+					&egressipv1.EgressIPList{Items: []egressipv1.EgressIP{otherEIP, eIP}},
+				)
+
+				egressNode1 := setupNode(node1Name, []string{node1IPv4}, map[string]string{})
+				egressNode2 := setupNode(node2Name, []string{node2IPv4}, map[string]string{})
+				fakeClusterManagerOVN.eIPC.allocator.cache[egressNode1.name] = &egressNode1
+				fakeClusterManagerOVN.eIPC.allocator.cache[egressNode2.name] = &egressNode2
+
+				_, err := fakeClusterManagerOVN.eIPC.WatchEgressIP()
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				err = controller.Start(fakeClusterManagerOVN.eIPC.egressIPPoolController)
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+				gomega.Eventually(getEgressIPStatusLen("other-egressip")).Should(gomega.Equal(1))
+				gomega.Consistently(getEgressIPStatusLen(egressIPName)).Should(gomega.Equal(0))
+
+				// 192.168.126.50 is requested by the other EgressIP and
+				// 192.168.126.51 is a host address of node2
+				pool := newEgressIPPool(metav1.LabelSelector{}, "192.168.126.50-192.168.126.52")
+				_, err = fakeClusterManagerOVN.fakeClient.EgressIPClient.K8sV1().EgressIPPools().Create(context.TODO(), &pool, metav1.CreateOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+				gomega.Eventually(getEgressIPStatusLen(egressIPName)).Should(gomega.Equal(1))
+				egressIPs, _ := getEgressIPStatus(egressIPName)
+				gomega.Expect(egressIPs).To(gomega.ConsistOf("192.168.126.52"))
+				gomega.Expect(getEgressIPPoolAllocations()).To(gomega.ConsistOf(
+					egressipv1.EgressIPPoolAllocation{EgressIP: "192.168.126.52", EgressIPName: egressIPName},
+				))
+				return nil
+			}
+
+			err := app.Run([]string{app.Name})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+	})
+
+	ginkgo.Context("EgressIP placement", func() {
+		const (
+			node3Name = "node3"
+			node1IPv4 = "192.168.126.12/24"
+			node2IPv4 = "192.168.126.51/24"
+			node3IPv4 = "192.168.126.61/24"
+			zoneKey   = "topology.kubernetes.io/zone"
+		)
+
+		newEgressAssignableNode := func(name, nodeIPv4 string, extraLabels map[string]string) v1.Node {
+			labels := map[string]string{
+				"k8s.ovn.org/egress-assignable": "",
+			}
+			for k, v := range extraLabels {
+				labels[k] = v
+			}
+			return v1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: name,
+					Annotations: map[string]string{
+						"k8s.ovn.org/node-primary-ifaddr": fmt.Sprintf("{\"ipv4\": \"%s\", \"ipv6\": \"%s\"}", nodeIPv4, ""),
+						"k8s.ovn.org/node-subnets":        fmt.Sprintf("{\"default\":[\"%s\", \"%s\"]}", v4NodeSubnet, v6NodeSubnet),
+						util.OVNNodeHostCIDRs:             fmt.Sprintf("[\"%s\"]", nodeIPv4),
+					},
+					Labels: labels,
+				},
+				Status: v1.NodeStatus{
+					Conditions: []v1.NodeCondition{
+						{
+							Type:   v1.NodeReady,
+							Status: v1.ConditionTrue,
+						},
+					},
+				},
+			}
+		}
+
+		getEgressIPAssignedCondition := func() *metav1.Condition {
+			eIP, err := fakeClusterManagerOVN.fakeClient.EgressIPClient.K8sV1().EgressIPs().Get(context.TODO(), egressIPName, metav1.GetOptions{})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			return meta.FindStatusCondition(eIP.Status.Conditions, egressipv1.EgressIPConditionAssigned)
+		}
+
+		ginkgo.It("should spread the egress IPs across zones", func() {
+			app.Action = func(ctx *cli.Context) error {
+				node1 := newEgressAssignableNode(node1Name, node1IPv4, map[string]string{zoneKey: "zone-a"})
+				node2 := newEgressAssignableNode(node2Name, node2IPv4, map[string]string{zoneKey: "zone-a"})
+				node3 := newEgressAssignableNode(node3Name, node3IPv4, map[string]string{zoneKey: "zone-b"})
+				eIP := egressipv1.EgressIP{
+					ObjectMeta: newEgressIPMeta(egressIPName),
+					Spec: egressipv1.EgressIPSpec{
+						EgressIPs: []string{"192.168.126.101", "192.168.126.102"},
+						TopologySpreadConstraints: []egressipv1.EgressIPTopologySpreadConstraint{
+							{TopologyKey: zoneKey, MaxSkew: 1, WhenUnsatisfiable: egressipv1.DoNotSchedule},
+						},
+					},
+				}
+
+				fakeClusterManagerOVN.start(
+					&v1.NodeList{Items: []v1.Node{node1, node2, node3}},
+					&egressipv1.EgressIPList{Items: []egressipv1.EgressIP{eIP}},
+				)
+
+				// node3 has the most allocations, which would leave it last
+				// without the spread constraint
+				egressNode1 := setupNode(node1Name, []string{node1IPv4}, map[string]string{"192.168.126.201": "bogus1"})
+				egressNode2 := setupNode(node2Name, []string{node2IPv4}, map[string]string{})
+				egressNode3 := setupNode(node3Name, []string{node3IPv4}, map[string]string{"192.168.126.202": "bogus2", "192.168.126.203": "bogus3"})
+				fakeClusterManagerOVN.eIPC.allocator.cache[egressNode1.name] = &egressNode1
+				fakeClusterManagerOVN.eIPC.allocator.cache[egressNode2.name] = &egressNode2
+				fakeClusterManagerOVN.eIPC.allocator.cache[egressNode3.name] = &egressNode3
+
+				_, err := fakeClusterManagerOVN.eIPC.WatchEgressIP()
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+				gomega.Eventually(getEgressIPStatusLen(egressIPName)).Should(gomega.Equal(2))
+				_, nodes := getEgressIPStatus(egressIPName)
+				gomega.Expect(nodes).To(gomega.ConsistOf(node2Name, node3Name))
+				gomega.Eventually(getEgressIPAssignedCondition).Should(gomega.And(
+					gomega.Not(gomega.BeNil()),
+					gomega.HaveField("Status", metav1.ConditionTrue),
+					gomega.HaveField("Reason", "EgressIPsAssigned"),
+				))
+				return nil
+			}
+
+			err := app.Run([]string{app.Name})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+
+		ginkgo.It("should report in the Assigned condition egress IPs that can't be spread", func() {
+			app.Action = func(ctx *cli.Context) error {
+				node1 := newEgressAssignableNode(node1Name, node1IPv4, map[string]string{zoneKey: "zone-a"})
+				// node2 is not in any zone
+				node2 := newEgressAssignableNode(node2Name, node2IPv4, nil)
+				eIP := egressipv1.EgressIP{
+					ObjectMeta: newEgressIPMeta(egressIPName),
+					Spec: egressipv1.EgressIPSpec{
+						EgressIPs: []string{"192.168.126.101", "192.168.126.102"},
+						TopologySpreadConstraints: []egressipv1.EgressIPTopologySpreadConstraint{
+							{TopologyKey: zoneKey},
+						},
+					},
+				}
+
+				fakeClusterManagerOVN.start(
+					&v1.NodeList{Items: []v1.Node{node1, node2}},
+					&egressipv1.EgressIPList{Items: []egressipv1.EgressIP{eIP}},
+				)
+
+				egressNode1 := setupNode(node1Name, []string{node1IPv4}, map[string]string{})
+				egressNode2 := setupNode(node2Name, []string{node2IPv4}, map[string]string{})
+				fakeClusterManagerOVN.eIPC.allocator.cache[egressNode1.name] = &egressNode1
+				fakeClusterManagerOVN.eIPC.allocator.cache[egressNode2.name] = &egressNode2
+
+				_, err := fakeClusterManagerOVN.eIPC.WatchEgressIP()
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+				gomega.Eventually(getEgressIPAssignedCondition).Should(gomega.And(
+					gomega.Not(gomega.BeNil()),
+					gomega.HaveField("Status", metav1.ConditionFalse),
+					gomega.HaveField("Reason", "TopologySpreadConstraintsUnsatisfiable"),
+				))
+				gomega.Expect(getEgressIPStatusLen(egressIPName)()).To(gomega.Equal(1))
+				_, nodes := getEgressIPStatus(egressIPName)
+				gomega.Expect(nodes).To(gomega.ConsistOf(node1Name))
+
+				eIPToUpdate, err := fakeClusterManagerOVN.fakeClient.EgressIPClient.K8sV1().EgressIPs().Get(context.TODO(), egressIPName, metav1.GetOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				eIPToUpdate.Spec.TopologySpreadConstraints[0].WhenUnsatisfiable = egressipv1.ScheduleAnyway
+				_, err = fakeClusterManagerOVN.fakeClient.EgressIPClient.K8sV1().EgressIPs().Update(context.TODO(), eIPToUpdate, metav1.UpdateOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+				gomega.Eventually(getEgressIPStatusLen(egressIPName)).Should(gomega.Equal(2))
+				_, nodes = getEgressIPStatus(egressIPName)
+				gomega.Expect(nodes).To(gomega.ConsistOf(node1Name, node2Name))
+				gomega.Eventually(getEgressIPAssignedCondition).Should(gomega.HaveField("Status", metav1.ConditionTrue))
+				return nil
+			}
+
+			err := app.Run([]string{app.Name})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+
+		ginkgo.It("should assign the egress IPs to the preferred nodes and fall back to the others when they fail", func() {
+			app.Action = func(ctx *cli.Context) error {
+				node1 := newEgressAssignableNode(node1Name, node1IPv4, nil)
+				node2 := newEgressAssignableNode(node2Name, node2IPv4, map[string]string{"egress-tier": "primary"})
+				eIP := egressipv1.EgressIP{
+					ObjectMeta: newEgressIPMeta(egressIPName),
+					Spec: egressipv1.EgressIPSpec{
+						EgressIPs: []string{"192.168.126.101"},
+						NodePreferences: []egressipv1.EgressIPNodePreference{
+							{
+								Weight:       50,
+								NodeSelector: metav1.LabelSelector{MatchLabels: map[string]string{"egress-tier": "primary"}},
+							},
+						},
+					},
+				}
+
+				fakeClusterManagerOVN.start(
+					&v1.NodeList{Items: []v1.Node{node1, node2}},
+					&egressipv1.EgressIPList{Items: []egressipv1.EgressIP{eIP}},
+				)
+
+				// node2 is preferred even though it has more allocations
+				egressNode1 := setupNode(node1Name, []string{node1IPv4}, map[string]string{})
+				egressNode2 := setupNode(node2Name, []string{node2IPv4}, map[string]string{"192.168.126.201": "bogus1"})
+				fakeClusterManagerOVN.eIPC.allocator.cache[egressNode1.name] = &egressNode1
+				fakeClusterManagerOVN.eIPC.allocator.cache[egressNode2.name] = &egressNode2
+
+				_, err := fakeClusterManagerOVN.eIPC.WatchEgressIP()
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+				gomega.Eventually(getEgressIPStatusLen(egressIPName)).Should(gomega.Equal(1))
+				_, nodes := getEgressIPStatus(egressIPName)
+				gomega.Expect(nodes).To(gomega.ConsistOf(node2Name))
+
+				fakeClusterManagerOVN.eIPC.setNodeEgressReady(node2Name, false)
+				err = fakeClusterManagerOVN.eIPC.deleteEgressNode(node2Name)
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+				gomega.Eventually(func() []string {
+					_, nodes := getEgressIPStatus(egressIPName)
+					return nodes
+				}).Should(gomega.ConsistOf(node1Name))
+				gomega.Eventually(getEgressIPAssignedCondition).Should(gomega.HaveField("Status", metav1.ConditionTrue))
+				return nil
+			}
+
+			err := app.Run([]string{app.Name})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+	})
+
+	ginkgo.Context("EgressIP status conditions", func() {
+		ginkgo.It("should report in the CloudAssigned condition the assignment of the egress IPs by the cloud provider", func() {
+			app.Action = func(ctx *cli.Context) error {
+				config.Kubernetes.PlatformType = string(ocpconfigapi.AWSPlatformType)
+				node1IPv4 := "192.168.126.12/24"
+				egressIP := "192.168.126.101"
+				node1 := v1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: node1Name,
+						Annotations: map[string]string{
+							"k8s.ovn.org/node-primary-ifaddr": fmt.Sprintf("{\"ipv4\": \"%s\", \"ipv6\": \"%s\"}", node1IPv4, ""),
+							"k8s.ovn.org/node-subnets":        fmt.Sprintf("{\"default\":[\"%s\", \"%s\"]}", v4NodeSubnet, v6NodeSubnet),
+							util.OVNNodeHostCIDRs:             fmt.Sprintf("[\"%s\"]", node1IPv4),
+						},
+						Labels: map[string]string{
+							"k8s.ovn.org/egress-assignable": "",
+						},
+					},
+					Status: v1.NodeStatus{
+						Conditions: []v1.NodeCondition{
+							{
+								Type:   v1.NodeReady,
+								Status: v1.ConditionTrue,
+							},
+						},
+					},
+				}
+				eIP := egressipv1.EgressIP{
+					ObjectMeta: newEgressIPMeta(egressIPName),
+					Spec: egressipv1.EgressIPSpec{
+						EgressIPs: []string{egressIP},
+					},
+				}
+
+				fakeClusterManagerOVN.start(
+					&v1.NodeList{Items: []v1.Node{node1}},
+					&egressipv1.EgressIPList{Items: []egressipv1.EgressIP{eIP}},
+				)
+
+				egressNode1 := setupNode(node1Name, []string{node1IPv4}, map[string]string{})
+				fakeClusterManagerOVN.eIPC.allocator.cache[egressNode1.name] = &egressNode1
+
+				// the fake client doesn't merge the conditions applied by the
+				// different field managers, so track the applied ones
+				var appliedConditionsLock sync.Mutex
+				var appliedCloudAssignedCondition *metav1.Condition
+				fakeClusterManagerOVN.fakeClient.EgressIPClient.(*egressipfake.Clientset).PrependReactor("patch", "egressips",
+					func(action clienttesting.Action) (bool, runtime.Object, error) {
+						patchAction := action.(clienttesting.PatchAction)
+						if patchAction.GetPatchType() != k8stypes.ApplyPatchType {
+							return false, nil, nil
+						}
+						applied := egressipv1.EgressIP{}
+						gomega.Expect(json.Unmarshal(patchAction.GetPatch(), &applied)).To(gomega.Succeed())
+						appliedConditionsLock.Lock()
+						defer appliedConditionsLock.Unlock()
+						if condition := meta.FindStatusCondition(applied.Status.Conditions, egressipv1.EgressIPConditionCloudAssigned); condition != nil {
+							appliedCloudAssignedCondition = condition
+						}
+						return false, nil, nil
+					})
+				getCloudAssignedCondition := func() *metav1.Condition {
+					appliedConditionsLock.Lock()
+					defer appliedConditionsLock.Unlock()
+					return appliedCloudAssignedCondition
+				}
+
+				_, err := fakeClusterManagerOVN.eIPC.WatchCloudPrivateIPConfig()
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				_, err = fakeClusterManagerOVN.eIPC.WatchEgressIP()
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+				updateCloudPrivateIPConfigStatus := func(status ocpcloudnetworkapi.CloudPrivateIPConfigStatus) {
+					cloudPrivateIPConfig, err := fakeClusterManagerOVN.fakeClient.CloudNetworkClient.CloudV1().CloudPrivateIPConfigs().
+						Get(context.TODO(), ipStringToCloudPrivateIPConfigName(egressIP), metav1.GetOptions{})
+					gomega.Expect(err).NotTo(gomega.HaveOccurred())
+					cloudPrivateIPConfig.Status = status
+					_, err = fakeClusterManagerOVN.fakeClient.CloudNetworkClient.CloudV1().CloudPrivateIPConfigs().
+						UpdateStatus(context.TODO(), cloudPrivateIPConfig, metav1.UpdateOptions{})
+					gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				}
+
+				// the cloud provider did not process the CloudPrivateIPConfig yet
+				gomega.Eventually(getCloudAssignedCondition).Should(gomega.And(
+					gomega.Not(gomega.BeNil()),
+					gomega.HaveField("Status", metav1.ConditionFalse),
+					gomega.HaveField("Reason", "CloudAssignmentPending"),
+				))
+
+				updateCloudPrivateIPConfigStatus(ocpcloudnetworkapi.CloudPrivateIPConfigStatus{
+					Conditions: []metav1.Condition{{
+						Type:    string(ocpcloudnetworkapi.Assigned),
+						Status:  metav1.ConditionFalse,
+						Reason:  "CloudResponseError",
+						Message: "quota exceeded",
+					}},
+				})
+				gomega.Eventually(getCloudAssignedCondition).Should(gomega.And(
+					gomega.HaveField("Status", metav1.ConditionFalse),
+					gomega.HaveField("Reason", "CloudAssignmentFailed"),
+					gomega.HaveField("Message", gomega.ContainSubstring("quota exceeded")),
+				))
+
+				updateCloudPrivateIPConfigStatus(ocpcloudnetworkapi.CloudPrivateIPConfigStatus{
+					Node: node1Name,
+					Conditions: []metav1.Condition{{
+						Type:   string(ocpcloudnetworkapi.Assigned),
+						Status: metav1.ConditionTrue,
+					}},
+				})
+				gomega.Eventually(getCloudAssignedCondition).Should(gomega.And(
+					gomega.HaveField("Status", metav1.ConditionTrue),
+					gomega.HaveField("Reason", "CloudAssignmentSucceeded"),
+				))
+				gomega.Eventually(getEgressIPStatusLen(egressIPName)).Should(gomega.Equal(1))
+				return nil
+			}
+
+			err := app.Run([]string{app.Name})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+	})
 })
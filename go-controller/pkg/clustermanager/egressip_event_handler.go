@@ -25,6 +25,24 @@ type egressIPClusterControllerEventHandler struct {
 
 // egressIPClusterControllerEventHandler functions
 
+// AreResourcesEqual returns true if, given two objects of a known resource type, the update logic for this resource
+// type considers them equal and therefore no update is needed. Updates of EgressIP objects only changing their status
+// conditions are skipped, all the other updates take the update path.
+func (h *egressIPClusterControllerEventHandler) AreResourcesEqual(obj1, obj2 interface{}) (bool, error) {
+	if h.objType != factory.EgressIPType {
+		return false, nil
+	}
+	oldEIP, ok := obj1.(*egressipv1.EgressIP)
+	if !ok {
+		return false, fmt.Errorf("could not cast obj1 of type %T to *egressipv1.EgressIP", obj1)
+	}
+	newEIP, ok := obj2.(*egressipv1.EgressIP)
+	if !ok {
+		return false, fmt.Errorf("could not cast obj2 of type %T to *egressipv1.EgressIP", obj2)
+	}
+	return util.IsEgressIPConditionsOnlyUpdate(oldEIP, newEIP), nil
+}
+
 // AddResource adds the specified object to the cluster according to its type and
 // returns the error, if any, yielded during object creation.
 func (h *egressIPClusterControllerEventHandler) AddResource(obj interface{}, fromRetryLoop bool) error {
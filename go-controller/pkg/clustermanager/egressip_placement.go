@@ -0,0 +1,157 @@
+package clustermanager
+
+import (
+	"fmt"
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/klog/v2"
+
+	egressipv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1"
+)
+
+// egressIPNodePreference is a parsed EgressIPNodePreference
+type egressIPNodePreference struct {
+	weight       int32
+	nodeSelector labels.Selector
+}
+
+// egressIPPlacement holds the constraints on the egress assignable nodes the
+// egress IPs of an EgressIP may be assigned to, and the order in which those
+// nodes are considered. A nil placement allows any egress assignable node to be
+// considered in ascending order of allocations.
+type egressIPPlacement struct {
+	// nodeSelector selects the nodes the egress IPs may be assigned to
+	nodeSelector labels.Selector
+	// spreadConstraints are the topology spread constraints of the EgressIP
+	spreadConstraints []egressipv1.EgressIPTopologySpreadConstraint
+	// nodePreferences are the node preferences of the EgressIP
+	nodePreferences []egressIPNodePreference
+}
+
+// newEgressIPPlacement builds the placement of the egress IPs of eIP on the
+// nodes selected by nodeSelector.
+func newEgressIPPlacement(eIP *egressipv1.EgressIP, nodeSelector labels.Selector) (*egressIPPlacement, error) {
+	placement := &egressIPPlacement{
+		nodeSelector:      nodeSelector,
+		spreadConstraints: eIP.Spec.TopologySpreadConstraints,
+	}
+	for _, preference := range eIP.Spec.NodePreferences {
+		selector, err := metav1.LabelSelectorAsSelector(&preference.NodeSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid node preference selector %s: %w", preference.NodeSelector.String(), err)
+		}
+		placement.nodePreferences = append(placement.nodePreferences, egressIPNodePreference{
+			weight:       preference.Weight,
+			nodeSelector: selector,
+		})
+	}
+	return placement, nil
+}
+
+// filterEgressNodes returns the egress nodes matching the node selector of the
+// placement.
+func (p *egressIPPlacement) filterEgressNodes(eNodes []*egressNode, nodeLabels map[string]labels.Set) []*egressNode {
+	if p == nil || p.nodeSelector == nil || p.nodeSelector.Empty() {
+		return eNodes
+	}
+	filtered := make([]*egressNode, 0, len(eNodes))
+	for _, eNode := range eNodes {
+		if p.nodeSelector.Matches(nodeLabels[eNode.name]) {
+			filtered = append(filtered, eNode)
+		}
+	}
+	return filtered
+}
+
+// orderEgressNodes returns the egress nodes an egress IP of the EgressIP with
+// the given name may be assigned to, in the order they should be considered.
+// Nodes that would violate a DoNotSchedule spread constraint are left out.
+// Nodes violating fewer ScheduleAnyway spread constraints come first, then
+// nodes with a greater node preference weight. Otherwise the order of eNodes is
+// preserved.
+func (p *egressIPPlacement) orderEgressNodes(name string, eNodes []*egressNode, nodeLabels map[string]labels.Set) []*egressNode {
+	if p == nil || (len(p.spreadConstraints) == 0 && len(p.nodePreferences) == 0) {
+		return eNodes
+	}
+	violations := make(map[string]int, len(eNodes))
+	candidates := make([]*egressNode, 0, len(eNodes))
+	for _, eNode := range eNodes {
+		violations[eNode.name] = 0
+		candidates = append(candidates, eNode)
+	}
+	for _, constraint := range p.spreadConstraints {
+		violating := getSpreadConstraintViolations(name, constraint, candidates, nodeLabels)
+		if constraint.WhenUnsatisfiable == egressipv1.ScheduleAnyway {
+			for node := range violating {
+				violations[node]++
+			}
+			continue
+		}
+		// DoNotSchedule is the default
+		filtered := make([]*egressNode, 0, len(candidates))
+		for _, eNode := range candidates {
+			if !violating[eNode.name] {
+				filtered = append(filtered, eNode)
+			}
+		}
+		candidates = filtered
+	}
+	weights := make(map[string]int32, len(candidates))
+	for _, eNode := range candidates {
+		for _, preference := range p.nodePreferences {
+			if preference.nodeSelector.Matches(nodeLabels[eNode.name]) {
+				weights[eNode.name] += preference.weight
+			}
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if violations[candidates[i].name] != violations[candidates[j].name] {
+			return violations[candidates[i].name] < violations[candidates[j].name]
+		}
+		return weights[candidates[i].name] > weights[candidates[j].name]
+	})
+	return candidates
+}
+
+// getSpreadConstraintViolations returns the egress nodes on which assigning an
+// additional egress IP of the EgressIP with the given name would violate the
+// spread constraint. As for pods, the skew of a failure domain is the number of
+// egress IPs of the EgressIP assigned to its nodes minus the minimum number of
+// those assigned to the nodes of any failure domain of eNodes. Nodes without
+// the topology key label are considered in violation.
+func getSpreadConstraintViolations(name string, constraint egressipv1.EgressIPTopologySpreadConstraint, eNodes []*egressNode, nodeLabels map[string]labels.Set) map[string]bool {
+	maxSkew := constraint.MaxSkew
+	if maxSkew < 1 {
+		maxSkew = 1
+	}
+	domainCounts := map[string]int{}
+	for _, eNode := range eNodes {
+		domain, ok := nodeLabels[eNode.name][constraint.TopologyKey]
+		if !ok {
+			continue
+		}
+		domainCounts[domain] += eNode.getAllocationCountForEgressIP(name)
+	}
+	minCount := -1
+	for _, count := range domainCounts {
+		if minCount < 0 || count < minCount {
+			minCount = count
+		}
+	}
+	violating := map[string]bool{}
+	for _, eNode := range eNodes {
+		domain, ok := nodeLabels[eNode.name][constraint.TopologyKey]
+		if !ok {
+			violating[eNode.name] = true
+			continue
+		}
+		if domainCounts[domain]+1-minCount > maxSkew {
+			klog.V(5).Infof("Assigning an egress IP of EgressIP %s to node %s would exceed the max skew %d of %s domain %s",
+				name, eNode.name, maxSkew, constraint.TopologyKey, domain)
+			violating[eNode.name] = true
+		}
+	}
+	return violating
+}
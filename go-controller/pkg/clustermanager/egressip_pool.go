@@ -0,0 +1,325 @@
+package clustermanager
+
+import (
+	"fmt"
+	"math/big"
+	"net"
+	"reflect"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+	utilnet "k8s.io/utils/net"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/controller"
+	egressipv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1"
+	utilerrors "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util/errors"
+)
+
+// egressIPPoolRange is an inclusive range of addresses egress IPs can be
+// allocated from.
+type egressIPPoolRange struct {
+	first  *big.Int
+	last   *big.Int
+	isIPv6 bool
+}
+
+func (r egressIPPoolRange) contains(ip net.IP) bool {
+	if utilnet.IsIPv6(ip) != r.isIPv6 {
+		return false
+	}
+	i := utilnet.BigForIP(ip)
+	return i.Cmp(r.first) >= 0 && i.Cmp(r.last) <= 0
+}
+
+// parseEgressIPPoolRanges parses the ranges of an EgressIPPool, each of them
+// being either a CIDR or an inclusive "<first>-<last>" range of addresses of
+// the same IP family. The network and broadcast addresses of IPv4 CIDRs are
+// excluded.
+func parseEgressIPPoolRanges(ranges []string) ([]egressIPPoolRange, error) {
+	parsed := make([]egressIPPoolRange, 0, len(ranges))
+	for _, r := range ranges {
+		if _, ipNet, err := net.ParseCIDR(r); err == nil {
+			ones, bits := ipNet.Mask.Size()
+			first := utilnet.BigForIP(ipNet.IP)
+			last := new(big.Int).Lsh(big.NewInt(1), uint(bits-ones))
+			last.Add(last, first).Sub(last, big.NewInt(1))
+			isIPv6 := utilnet.IsIPv6CIDR(ipNet)
+			if !isIPv6 && bits-ones > 1 {
+				first.Add(first, big.NewInt(1))
+				last.Sub(last, big.NewInt(1))
+			}
+			parsed = append(parsed, egressIPPoolRange{first: first, last: last, isIPv6: isIPv6})
+			continue
+		}
+		bounds := strings.Split(r, "-")
+		if len(bounds) != 2 {
+			return nil, fmt.Errorf("invalid range %q: expected a CIDR or a <first>-<last> range of addresses", r)
+		}
+		firstIP := net.ParseIP(strings.TrimSpace(bounds[0]))
+		lastIP := net.ParseIP(strings.TrimSpace(bounds[1]))
+		if firstIP == nil || lastIP == nil {
+			return nil, fmt.Errorf("invalid range %q: unable to parse its addresses", r)
+		}
+		if utilnet.IsIPv6(firstIP) != utilnet.IsIPv6(lastIP) {
+			return nil, fmt.Errorf("invalid range %q: addresses are of different IP families", r)
+		}
+		first, last := utilnet.BigForIP(firstIP), utilnet.BigForIP(lastIP)
+		if first.Cmp(last) > 0 {
+			return nil, fmt.Errorf("invalid range %q: first address is greater than the last one", r)
+		}
+		parsed = append(parsed, egressIPPoolRange{first: first, last: last, isIPv6: utilnet.IsIPv6(firstIP)})
+	}
+	return parsed, nil
+}
+
+func egressIPPoolRangesContain(ranges []egressIPPoolRange, ip net.IP) bool {
+	for _, r := range ranges {
+		if r.contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// getRequestedEgressIPCount returns the number of egress IPs requested by the
+// EgressIP, either listed in its spec or to be allocated from a pool.
+func getRequestedEgressIPCount(eIP *egressipv1.EgressIP) int {
+	if eIP.Spec.PoolRequest != nil {
+		return eIP.Spec.PoolRequest.Count
+	}
+	return len(eIP.Spec.EgressIPs)
+}
+
+// getEgressIPsInUse returns the egress IPs requested or assigned by the
+// EgressIP objects other than the one with the given name. Those addresses
+// can't be allocated from a pool to that EgressIP.
+func (eIPC *egressIPClusterController) getEgressIPsInUse(name string) (sets.Set[string], error) {
+	egressIPs, err := eIPC.watchFactory.GetEgressIPs()
+	if err != nil {
+		return nil, fmt.Errorf("unable to list EgressIPs: %w", err)
+	}
+	inUse := sets.New[string]()
+	for _, egressIP := range egressIPs {
+		if egressIP.Name == name {
+			continue
+		}
+		for _, ip := range egressIP.Spec.EgressIPs {
+			if parsedIP := net.ParseIP(ip); parsedIP != nil {
+				inUse.Insert(parsedIP.String())
+			}
+		}
+		for _, status := range egressIP.Status.Items {
+			inUse.Insert(status.EgressIP)
+		}
+	}
+	return inUse, nil
+}
+
+// allocateEgressIPsFromPool allocates the egress IPs requested by the EgressIP
+// from the pool it references, persisting the allocations in the status of the
+// pool. Addresses previously allocated to the EgressIP are kept as long as they
+// are still part of the pool and requested, the ones that are not are released.
+// It returns the addresses allocated to the EgressIP, the ones released and the
+// selector of the nodes those addresses may be assigned to. It must be called
+// with the egressIPAssignmentMutex held.
+func (eIPC *egressIPClusterController) allocateEgressIPsFromPool(name string, request *egressipv1.EgressIPPoolRequest) ([]string, []string, labels.Selector, error) {
+	pool, err := eIPC.kube.GetEgressIPPool(request.PoolName)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			eIPC.recordEgressIPWarning(name, "EgressIPPoolNotFound", "EgressIPPool %s requested by EgressIP: %s does not exist", request.PoolName, name)
+			klog.Errorf("EgressIPPool %s requested by EgressIP %s does not exist", request.PoolName, name)
+			return nil, nil, labels.Nothing(), nil
+		}
+		return nil, nil, nil, fmt.Errorf("unable to get EgressIPPool %s: %w", request.PoolName, err)
+	}
+	ranges, err := parseEgressIPPoolRanges(pool.Spec.Ranges)
+	if err != nil {
+		eIPC.recordEgressIPWarning(name, "InvalidEgressIPPool", "EgressIPPool %s requested by EgressIP: %s is invalid: %v", pool.Name, name, err)
+		return nil, nil, nil, fmt.Errorf("invalid EgressIPPool %s: %w", pool.Name, err)
+	}
+	nodeSelector, err := metav1.LabelSelectorAsSelector(&pool.Spec.NodeSelector)
+	if err != nil {
+		eIPC.recordEgressIPWarning(name, "InvalidEgressIPPool", "EgressIPPool %s requested by EgressIP: %s has an invalid node selector: %v", pool.Name, name, err)
+		return nil, nil, nil, fmt.Errorf("invalid node selector for EgressIPPool %s: %w", pool.Name, err)
+	}
+
+	allocated := make([]string, 0, request.Count)
+	var released []string
+	allocations := make([]egressipv1.EgressIPPoolAllocation, 0, len(pool.Status.Allocations))
+	allocatedFromPool := sets.New[string]()
+	for _, allocation := range pool.Status.Allocations {
+		if allocation.EgressIPName != name {
+			allocatedFromPool.Insert(allocation.EgressIP)
+			allocations = append(allocations, allocation)
+			continue
+		}
+		ip := net.ParseIP(allocation.EgressIP)
+		if ip == nil || !egressIPPoolRangesContain(ranges, ip) || len(allocated) >= request.Count {
+			released = append(released, allocation.EgressIP)
+			continue
+		}
+		allocatedFromPool.Insert(ip.String())
+		allocated = append(allocated, ip.String())
+		allocations = append(allocations, allocation)
+	}
+
+	if len(allocated) < request.Count {
+		inUse, err := eIPC.getEgressIPsInUse(name)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		for _, r := range ranges {
+			for next := new(big.Int).Set(r.first); next.Cmp(r.last) <= 0 && len(allocated) < request.Count; next.Add(next, big.NewInt(1)) {
+				ip := utilnet.AddIPOffset(next, 0)
+				if allocatedFromPool.Has(ip.String()) || inUse.Has(ip.String()) {
+					continue
+				}
+				// do not allocate addresses already assigned to a network
+				// interface throughout the cluster
+				if isIPConflict, conflictedHost, err := eIPC.isEgressIPAddrConflict(ip); err != nil {
+					return nil, nil, nil, fmt.Errorf("failed to check if IP %s of EgressIPPool %s is conflicting with a host address: %w", ip, pool.Name, err)
+				} else if isIPConflict {
+					klog.V(5).Infof("Skipping IP %s of EgressIPPool %s assigned to an interface of node %s", ip, pool.Name, conflictedHost)
+					continue
+				}
+				allocatedFromPool.Insert(ip.String())
+				allocated = append(allocated, ip.String())
+				allocations = append(allocations, egressipv1.EgressIPPoolAllocation{
+					EgressIP:     ip.String(),
+					EgressIPName: name,
+				})
+			}
+		}
+		if len(allocated) < request.Count {
+			eIPC.recordEgressIPWarning(name, "EgressIPPoolExhausted", "Only %d out of %d egress IPs requested by EgressIP: %s could be allocated from EgressIPPool %s",
+				len(allocated), request.Count, name, pool.Name)
+			klog.Errorf("EgressIPPool %s exhausted: only %d out of %d egress IPs requested by EgressIP %s could be allocated",
+				pool.Name, len(allocated), request.Count, name)
+		}
+	}
+
+	if !reflect.DeepEqual(allocations, pool.Status.Allocations) {
+		pool.Status.Allocations = allocations
+		if err := eIPC.kube.UpdateEgressIPPoolStatus(pool); err != nil {
+			return nil, nil, nil, fmt.Errorf("unable to update the allocations of EgressIPPool %s: %w", pool.Name, err)
+		}
+	}
+	return allocated, released, nodeSelector, nil
+}
+
+// releaseEgressIPPoolAllocations releases the addresses allocated from the pool
+// to the EgressIP with the given name and returns them. It must be called with
+// the egressIPAssignmentMutex held.
+func (eIPC *egressIPClusterController) releaseEgressIPPoolAllocations(poolName, name string) ([]string, error) {
+	pool, err := eIPC.kube.GetEgressIPPool(poolName)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to get EgressIPPool %s: %w", poolName, err)
+	}
+	var released []string
+	allocations := make([]egressipv1.EgressIPPoolAllocation, 0, len(pool.Status.Allocations))
+	for _, allocation := range pool.Status.Allocations {
+		if allocation.EgressIPName == name {
+			released = append(released, allocation.EgressIP)
+			continue
+		}
+		allocations = append(allocations, allocation)
+	}
+	if len(released) == 0 {
+		return nil, nil
+	}
+	pool.Status.Allocations = allocations
+	if err := eIPC.kube.UpdateEgressIPPoolStatus(pool); err != nil {
+		return nil, fmt.Errorf("unable to update the allocations of EgressIPPool %s: %w", poolName, err)
+	}
+	klog.Infof("Released egress IPs %v of EgressIPPool %s allocated to EgressIP %s", released, poolName, name)
+	return released, nil
+}
+
+// releaseStaleEgressIPPoolAllocations releases the addresses of the pool
+// allocated to EgressIP objects that do not exist or do not request addresses
+// from the pool anymore.
+func (eIPC *egressIPClusterController) releaseStaleEgressIPPoolAllocations(poolName string) error {
+	eIPC.egressIPAssignmentMutex.Lock()
+	defer eIPC.egressIPAssignmentMutex.Unlock()
+	pool, err := eIPC.kube.GetEgressIPPool(poolName)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("unable to get EgressIPPool %s: %w", poolName, err)
+	}
+	allocations := make([]egressipv1.EgressIPPoolAllocation, 0, len(pool.Status.Allocations))
+	for _, allocation := range pool.Status.Allocations {
+		eIP, err := eIPC.watchFactory.GetEgressIP(allocation.EgressIPName)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("unable to get EgressIP %s: %w", allocation.EgressIPName, err)
+		}
+		if eIP == nil || eIP.Spec.PoolRequest == nil || eIP.Spec.PoolRequest.PoolName != poolName {
+			klog.Infof("Releasing stale egress IP %s of EgressIPPool %s allocated to EgressIP %s",
+				allocation.EgressIP, poolName, allocation.EgressIPName)
+			continue
+		}
+		allocations = append(allocations, allocation)
+	}
+	if len(allocations) == len(pool.Status.Allocations) {
+		return nil
+	}
+	pool.Status.Allocations = allocations
+	if err := eIPC.kube.UpdateEgressIPPoolStatus(pool); err != nil {
+		return fmt.Errorf("unable to update the allocations of EgressIPPool %s: %w", poolName, err)
+	}
+	return nil
+}
+
+func (eIPC *egressIPClusterController) newEgressIPPoolController() controller.Controller {
+	poolConfig := &controller.ControllerConfig[egressipv1.EgressIPPool]{
+		RateLimiter:    workqueue.DefaultTypedControllerRateLimiter[string](),
+		Informer:       eIPC.watchFactory.EgressIPPoolInformer().Informer(),
+		Lister:         eIPC.watchFactory.EgressIPPoolInformer().Lister().List,
+		ObjNeedsUpdate: egressIPPoolNeedsUpdate,
+		Reconcile:      eIPC.reconcileEgressIPPool,
+		Threadiness:    1,
+	}
+	return controller.NewController[egressipv1.EgressIPPool]("egressip-pool-controller", poolConfig)
+}
+
+// egressIPPoolNeedsUpdate ignores the status updates of the pools, which are
+// performed by this controller.
+func egressIPPoolNeedsUpdate(oldObj, newObj *egressipv1.EgressIPPool) bool {
+	if oldObj == nil || newObj == nil {
+		return true
+	}
+	return !reflect.DeepEqual(oldObj.Spec, newObj.Spec)
+}
+
+// reconcileEgressIPPool reconciles the EgressIP objects requesting addresses
+// from the pool when the pool is created, updated or deleted, so that their
+// allocations honor the current definition of the pool, and releases the stale
+// allocations of the pool.
+func (eIPC *egressIPClusterController) reconcileEgressIPPool(key string) error {
+	egressIPs, err := eIPC.watchFactory.GetEgressIPs()
+	if err != nil {
+		return fmt.Errorf("unable to list EgressIPs: %w", err)
+	}
+	var errs []error
+	for _, egressIP := range egressIPs {
+		if egressIP.Spec.PoolRequest == nil || egressIP.Spec.PoolRequest.PoolName != key {
+			continue
+		}
+		if err := eIPC.reconcileEgressIP(nil, egressIP.DeepCopy()); err != nil {
+			errs = append(errs, fmt.Errorf("failed to reconcile EgressIP %s for EgressIPPool %s: %w", egressIP.Name, key, err))
+		}
+	}
+	if err := eIPC.releaseStaleEgressIPPoolAllocations(key); err != nil {
+		errs = append(errs, err)
+	}
+	return utilerrors.Join(errs...)
+}
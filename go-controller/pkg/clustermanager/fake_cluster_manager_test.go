@@ -51,6 +51,8 @@ func (o *FakeClusterManager) start(objects ...runtime.Object) {
 	for _, object := range objects {
 		if _, isEgressIPObject := object.(*egressip.EgressIPList); isEgressIPObject {
 			egressIPObjects = append(egressIPObjects, object)
+		} else if _, isEgressIPPoolObject := object.(*egressip.EgressIPPoolList); isEgressIPPoolObject {
+			egressIPObjects = append(egressIPObjects, object)
 		} else if _, isEgressSVCObj := object.(*egresssvc.EgressServiceList); isEgressSVCObj {
 			egressSvcObjects = append(egressSvcObjects, object)
 		} else if _, isCloudPrivateIPConfig := object.(*ocpcloudnetworkapi.CloudPrivateIPConfigList); isCloudPrivateIPConfig {
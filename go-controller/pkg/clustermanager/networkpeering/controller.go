@@ -0,0 +1,545 @@
+package networkpeering
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"reflect"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+	utilnet "k8s.io/utils/net"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/allocator/id"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/clustermanager/userdefinednetwork/template"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/controller"
+	udnv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1"
+	udnclientset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1/apis/clientset/versioned"
+	udninformer "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1/apis/informers/externalversions/userdefinednetwork/v1"
+	udnlister "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1/apis/listers/userdefinednetwork/v1"
+	ipgenerator "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/generator/ip"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+const (
+	// maxNetworkPeeringIDs is the maximum number of NetworkPeerings; every
+	// NetworkPeering takes two addresses of the network peering subnets
+	maxNetworkPeeringIDs = 4096
+
+	conditionTypeReady     = "Ready"
+	reasonNetworksPeered   = "NetworksPeered"
+	reasonInvalidNetworks  = "InvalidNetworks"
+	reasonAllocationFailed = "AllocationFailed"
+
+	// networkReadyCondition is the condition of the UserDefinedNetwork and
+	// ClusterUserDefinedNetwork set once their network is rendered
+	networkReadyCondition = "NetworkReady"
+)
+
+// Controller validates the NetworkPeerings and allocates the addresses of the
+// links connecting the routers of the peered networks. The configuration of a
+// valid NetworkPeering is set in its OvnNetworkPeering annotation, from which
+// every zone connects the routers of the peered networks.
+type Controller struct {
+	udnClient udnclientset.Interface
+
+	peeringLister udnlister.NetworkPeeringLister
+	udnLister     udnlister.UserDefinedNetworkLister
+	cudnLister    udnlister.ClusterUserDefinedNetworkLister
+	nodeLister    corelisters.NodeLister
+
+	peeringController controller.Controller
+	udnController     controller.Controller
+	cudnController    controller.Controller
+	nodeController    controller.Controller
+
+	idAllocator id.Allocator
+	// link IP generators, nil if the cluster does not support the IP family
+	v4LinkIPGenerator *ipgenerator.IPGenerator
+	v6LinkIPGenerator *ipgenerator.IPGenerator
+}
+
+// NewController returns a new NetworkPeering controller.
+func NewController(
+	udnClient udnclientset.Interface,
+	peeringInformer udninformer.NetworkPeeringInformer,
+	udnInformer udninformer.UserDefinedNetworkInformer,
+	cudnInformer udninformer.ClusterUserDefinedNetworkInformer,
+	nodeInformer coreinformers.NodeInformer,
+) (*Controller, error) {
+	idAllocator, err := id.NewIDAllocator("NetworkPeeringIDs", maxNetworkPeeringIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create an ID allocator for the network peerings: %w", err)
+	}
+	// ID 0 would take the network address of the network peering subnets
+	if err := idAllocator.ReserveID("zero", 0); err != nil {
+		return nil, fmt.Errorf("failed to reserve network peering ID 0: %w", err)
+	}
+
+	c := &Controller{
+		udnClient:     udnClient,
+		peeringLister: peeringInformer.Lister(),
+		udnLister:     udnInformer.Lister(),
+		cudnLister:    cudnInformer.Lister(),
+		nodeLister:    nodeInformer.Lister(),
+		idAllocator:   idAllocator,
+	}
+
+	if config.IPv4Mode {
+		c.v4LinkIPGenerator, err = ipgenerator.NewIPGenerator(config.ClusterManager.V4NetworkPeeringSubnet)
+		if err != nil {
+			return nil, fmt.Errorf("error creating IP Generator for v4 network peering subnet %s: %w", config.ClusterManager.V4NetworkPeeringSubnet, err)
+		}
+	}
+	if config.IPv6Mode {
+		c.v6LinkIPGenerator, err = ipgenerator.NewIPGenerator(config.ClusterManager.V6NetworkPeeringSubnet)
+		if err != nil {
+			return nil, fmt.Errorf("error creating IP Generator for v6 network peering subnet %s: %w", config.ClusterManager.V6NetworkPeeringSubnet, err)
+		}
+	}
+
+	peeringConfig := &controller.ControllerConfig[udnv1.NetworkPeering]{
+		RateLimiter:    workqueue.NewTypedItemFastSlowRateLimiter[string](time.Second, 5*time.Second, 5),
+		Informer:       peeringInformer.Informer(),
+		Lister:         c.peeringLister.List,
+		ObjNeedsUpdate: peeringNeedsUpdate,
+		Reconcile:      c.reconcileNetworkPeering,
+		Threadiness:    1,
+	}
+	c.peeringController = controller.NewController[udnv1.NetworkPeering]("cm-network-peering-controller", peeringConfig)
+
+	udnConfig := &controller.ControllerConfig[udnv1.UserDefinedNetwork]{
+		RateLimiter:    workqueue.DefaultTypedControllerRateLimiter[string](),
+		Informer:       udnInformer.Informer(),
+		Lister:         c.udnLister.List,
+		ObjNeedsUpdate: udnNeedsUpdate,
+		Reconcile:      c.reconcileAllNetworkPeerings,
+		Threadiness:    1,
+	}
+	c.udnController = controller.NewController[udnv1.UserDefinedNetwork]("cm-network-peering-udn-controller", udnConfig)
+
+	cudnConfig := &controller.ControllerConfig[udnv1.ClusterUserDefinedNetwork]{
+		RateLimiter:    workqueue.DefaultTypedControllerRateLimiter[string](),
+		Informer:       cudnInformer.Informer(),
+		Lister:         c.cudnLister.List,
+		ObjNeedsUpdate: cudnNeedsUpdate,
+		Reconcile:      c.reconcileAllNetworkPeerings,
+		Threadiness:    1,
+	}
+	c.cudnController = controller.NewController[udnv1.ClusterUserDefinedNetwork]("cm-network-peering-cudn-controller", cudnConfig)
+
+	// Layer2 networks can only be peered while every zone has a single node
+	nodeConfig := &controller.ControllerConfig[corev1.Node]{
+		RateLimiter:    workqueue.DefaultTypedControllerRateLimiter[string](),
+		Informer:       nodeInformer.Informer(),
+		Lister:         c.nodeLister.List,
+		ObjNeedsUpdate: nodeNeedsUpdate,
+		Reconcile:      c.reconcileAllNetworkPeerings,
+		Threadiness:    1,
+	}
+	c.nodeController = controller.NewController[corev1.Node]("cm-network-peering-node-controller", nodeConfig)
+
+	return c, nil
+}
+
+// Start starts the NetworkPeering controller.
+func (c *Controller) Start() error {
+	klog.Info("Starting cluster manager network peering controller")
+	return controller.StartWithInitialSync(c.initialSync, c.peeringController, c.udnController, c.cudnController, c.nodeController)
+}
+
+// Stop stops the NetworkPeering controller.
+func (c *Controller) Stop() {
+	klog.Info("Stopping cluster manager network peering controller")
+	controller.Stop(c.peeringController, c.udnController, c.cudnController, c.nodeController)
+}
+
+func peeringNeedsUpdate(oldObj, newObj *udnv1.NetworkPeering) bool {
+	return oldObj == nil || newObj == nil || oldObj.Generation != newObj.Generation ||
+		oldObj.Annotations[util.OvnNetworkPeering] != newObj.Annotations[util.OvnNetworkPeering]
+}
+
+func udnNeedsUpdate(oldObj, newObj *udnv1.UserDefinedNetwork) bool {
+	return oldObj == nil || newObj == nil || !reflect.DeepEqual(oldObj.Spec, newObj.Spec) ||
+		meta.IsStatusConditionTrue(oldObj.Status.Conditions, networkReadyCondition) !=
+			meta.IsStatusConditionTrue(newObj.Status.Conditions, networkReadyCondition)
+}
+
+func cudnNeedsUpdate(oldObj, newObj *udnv1.ClusterUserDefinedNetwork) bool {
+	return oldObj == nil || newObj == nil || !reflect.DeepEqual(oldObj.Spec.Network, newObj.Spec.Network) ||
+		meta.IsStatusConditionTrue(oldObj.Status.Conditions, networkReadyCondition) !=
+			meta.IsStatusConditionTrue(newObj.Status.Conditions, networkReadyCondition)
+}
+
+func nodeNeedsUpdate(oldObj, newObj *corev1.Node) bool {
+	return oldObj == nil || newObj == nil || util.NodeZoneAnnotationChanged(oldObj, newObj)
+}
+
+// initialSync reserves the IDs of the NetworkPeerings already configured so
+// that their link addresses do not change across restarts.
+func (c *Controller) initialSync() error {
+	peerings, err := c.peeringLister.List(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("failed to list network peerings: %w", err)
+	}
+	for _, peering := range peerings {
+		peeringConfig, err := util.ParseNetworkPeeringAnnotation(peering)
+		if err != nil {
+			if !util.IsAnnotationNotSetError(err) {
+				klog.Warningf("Ignoring invalid configuration of network peering %s: %v", peering.Name, err)
+			}
+			continue
+		}
+		if err := c.idAllocator.ReserveID(peering.Name, peeringConfig.ID); err != nil {
+			// the network peering will be allocated a new ID when reconciled
+			klog.Warningf("Failed to reserve ID %d of network peering %s: %v", peeringConfig.ID, peering.Name, err)
+		}
+	}
+	return nil
+}
+
+func (c *Controller) reconcileAllNetworkPeerings(_ string) error {
+	c.peeringController.ReconcileAll()
+	return nil
+}
+
+// reconcileNetworkPeering validates the peered networks of the NetworkPeering,
+// sets its configuration in the OvnNetworkPeering annotation if they are valid
+// or removes it otherwise, and updates its status accordingly.
+func (c *Controller) reconcileNetworkPeering(key string) error {
+	peering, err := c.peeringLister.Get(key)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			c.idAllocator.ReleaseID(key)
+			// a network peering conflicting with this one might be valid now
+			c.peeringController.ReconcileAll()
+			return nil
+		}
+		return fmt.Errorf("failed to get NetworkPeering %q from cache: %w", key, err)
+	}
+
+	condition := metav1.Condition{
+		Type:    conditionTypeReady,
+		Status:  metav1.ConditionTrue,
+		Reason:  reasonNetworksPeered,
+		Message: "Networks have been peered",
+	}
+	var peeringConfig *util.NetworkPeeringConfig
+	networks, err := c.getPeeredNetworks(peering)
+	if err != nil {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = reasonInvalidNetworks
+		condition.Message = err.Error()
+	} else if peeringConfig, err = c.allocatePeeringConfig(peering.Name, networks); err != nil {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = reasonAllocationFailed
+		condition.Message = err.Error()
+	}
+	condition.ObservedGeneration = peering.Generation
+
+	if peeringConfig == nil {
+		c.idAllocator.ReleaseID(peering.Name)
+	}
+	updated, err := c.updateNetworkPeeringAnnotation(peering, peeringConfig)
+	if err != nil {
+		return err
+	}
+	if updated && peeringConfig == nil {
+		// a network peering conflicting with this one might be valid now
+		c.peeringController.ReconcileAll()
+	}
+
+	return c.updateNetworkPeeringStatus(peering, condition)
+}
+
+// peeredNetwork is a validated network of a NetworkPeering.
+type peeredNetwork struct {
+	name    string
+	subnets []*net.IPNet
+}
+
+// getPeeredNetworks returns the networks of the NetworkPeering, or an error if
+// they can't be peered.
+func (c *Controller) getPeeredNetworks(peering *udnv1.NetworkPeering) ([]*peeredNetwork, error) {
+	if len(peering.Spec.Networks) != 2 {
+		return nil, fmt.Errorf("expected 2 networks, got %d", len(peering.Spec.Networks))
+	}
+	networks := make([]*peeredNetwork, 0, len(peering.Spec.Networks))
+	for _, ref := range peering.Spec.Networks {
+		network, err := c.getPeeredNetwork(ref)
+		if err != nil {
+			return nil, err
+		}
+		networks = append(networks, network)
+	}
+	if networks[0].name == networks[1].name {
+		return nil, fmt.Errorf("network %s cannot be peered with itself", networks[0].name)
+	}
+	if err := checkOverlap(networks[0], networks[1].subnets); err != nil {
+		return nil, err
+	}
+	if len(commonIPFamilies(networks[0].subnets, networks[1].subnets)) == 0 {
+		return nil, fmt.Errorf("networks %s and %s have no IP family in common", networks[0].name, networks[1].name)
+	}
+
+	// the routes to the peers of a network must not conflict with each other:
+	// the networks already peered with one of the networks of this peering
+	// must not overlap the other network
+	others, err := c.peeringLister.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list network peerings: %w", err)
+	}
+	for _, other := range others {
+		if other.Name == peering.Name {
+			continue
+		}
+		otherConfig, err := util.ParseNetworkPeeringAnnotation(other)
+		if err != nil {
+			continue
+		}
+		for i, network := range networks {
+			peer := networks[1-i]
+			for j, otherNetwork := range otherConfig.Networks {
+				if otherNetwork.Name != network.name {
+					continue
+				}
+				otherPeer := otherConfig.Networks[1-j]
+				subnets, _ := util.ParseIPNets(otherPeer.Subnets)
+				if err := checkOverlap(peer, subnets); err != nil {
+					return nil, fmt.Errorf("network %s is already peered with network %s by network peering %s: %w",
+						network.name, otherPeer.Name, other.Name, err)
+				}
+			}
+		}
+	}
+	return networks, nil
+}
+
+func (c *Controller) getPeeredNetwork(ref udnv1.PeeredNetwork) (*peeredNetwork, error) {
+	var spec template.SpecGetter
+	var conditions []metav1.Condition
+	var netName string
+	switch ref.Kind {
+	case udnv1.PeeredNetworkKindUserDefinedNetwork:
+		udn, err := c.udnLister.UserDefinedNetworks(ref.Namespace).Get(ref.Name)
+		if err != nil {
+			if kerrors.IsNotFound(err) {
+				return nil, fmt.Errorf("UserDefinedNetwork %s/%s not found", ref.Namespace, ref.Name)
+			}
+			return nil, fmt.Errorf("failed to get UserDefinedNetwork %s/%s: %w", ref.Namespace, ref.Name, err)
+		}
+		spec = &udn.Spec
+		conditions = udn.Status.Conditions
+		netName = template.UserDefinedNetworkName(udn.Namespace, udn.Name)
+	case udnv1.PeeredNetworkKindClusterUserDefinedNetwork:
+		cudn, err := c.cudnLister.Get(ref.Name)
+		if err != nil {
+			if kerrors.IsNotFound(err) {
+				return nil, fmt.Errorf("ClusterUserDefinedNetwork %s not found", ref.Name)
+			}
+			return nil, fmt.Errorf("failed to get ClusterUserDefinedNetwork %s: %w", ref.Name, err)
+		}
+		spec = &cudn.Spec.Network
+		conditions = cudn.Status.Conditions
+		netName = template.ClusterUserDefinedNetworkName(cudn.Name)
+	default:
+		return nil, fmt.Errorf("unsupported network kind %q", ref.Kind)
+	}
+
+	var role udnv1.NetworkRole
+	var cidrs []udnv1.CIDR
+	switch spec.GetTopology() {
+	case udnv1.NetworkTopologyLayer3:
+		if l3 := spec.GetLayer3(); l3 != nil {
+			role = l3.Role
+			for _, subnet := range l3.Subnets {
+				cidrs = append(cidrs, subnet.CIDR)
+			}
+		}
+	case udnv1.NetworkTopologyLayer2:
+		if l2 := spec.GetLayer2(); l2 != nil {
+			role = l2.Role
+			cidrs = l2.Subnets
+		}
+		// the Layer2 network router connected to the peer is the gateway
+		// router of the node of the zone, so there must be only one
+		if err := c.checkSingleNodeZones(); err != nil {
+			return nil, fmt.Errorf("network %s has topology Layer2, which can only be peered when every zone has a single node: %w",
+				netName, err)
+		}
+	default:
+		return nil, fmt.Errorf("network %s has unsupported topology %s, only Layer3 and Layer2 networks can be peered",
+			netName, spec.GetTopology())
+	}
+	if role != udnv1.NetworkRolePrimary {
+		return nil, fmt.Errorf("network %s has role %s, only primary networks can be peered", netName, role)
+	}
+	if !meta.IsStatusConditionTrue(conditions, networkReadyCondition) {
+		return nil, fmt.Errorf("network %s is not ready", netName)
+	}
+
+	network := &peeredNetwork{name: netName}
+	for _, cidr := range cidrs {
+		_, subnet, err := net.ParseCIDR(string(cidr))
+		if err != nil {
+			return nil, fmt.Errorf("network %s has invalid subnet %q: %w", netName, cidr, err)
+		}
+		network.subnets = append(network.subnets, subnet)
+	}
+	return network, nil
+}
+
+// checkSingleNodeZones returns an error if a zone has more than one node.
+func (c *Controller) checkSingleNodeZones() error {
+	nodes, err := c.nodeLister.List(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("failed to list nodes: %w", err)
+	}
+	zoneNodes := map[string]string{}
+	for _, node := range nodes {
+		zone := util.GetNodeZone(node)
+		if other, ok := zoneNodes[zone]; ok {
+			return fmt.Errorf("zone %s has nodes %s and %s", zone, other, node.Name)
+		}
+		zoneNodes[zone] = node.Name
+	}
+	return nil
+}
+
+func checkOverlap(network *peeredNetwork, subnets []*net.IPNet) error {
+	for _, subnet := range network.subnets {
+		for _, other := range subnets {
+			if subnet.Contains(other.IP) || other.Contains(subnet.IP) {
+				return fmt.Errorf("subnet %s of network %s overlaps subnet %s", subnet, network.name, other)
+			}
+		}
+	}
+	return nil
+}
+
+func commonIPFamilies(subnets1, subnets2 []*net.IPNet) []utilnet.IPFamily {
+	var families []utilnet.IPFamily
+	for _, family := range []utilnet.IPFamily{utilnet.IPv4, utilnet.IPv6} {
+		hasFamily := func(subnet *net.IPNet) bool { return utilnet.IPFamilyOfCIDR(subnet) == family }
+		if containsFunc(subnets1, hasFamily) && containsFunc(subnets2, hasFamily) {
+			families = append(families, family)
+		}
+	}
+	return families
+}
+
+func containsFunc(subnets []*net.IPNet, f func(*net.IPNet) bool) bool {
+	for _, subnet := range subnets {
+		if f(subnet) {
+			return true
+		}
+	}
+	return false
+}
+
+// allocatePeeringConfig allocates the NetworkPeering ID and returns its
+// configuration, with the link addresses of both networks derived from it.
+func (c *Controller) allocatePeeringConfig(peeringName string, networks []*peeredNetwork) (*util.NetworkPeeringConfig, error) {
+	peeringID, err := c.idAllocator.AllocateID(peeringName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to allocate network peering ID: %w", err)
+	}
+	peeringConfig := &util.NetworkPeeringConfig{
+		ID:       peeringID,
+		Networks: make([]util.PeeredNetworkConfig, len(networks)),
+	}
+	for i, network := range networks {
+		peeringConfig.Networks[i].Name = network.name
+		peeringConfig.Networks[i].Subnets = util.StringSlice(network.subnets)
+	}
+	for _, family := range commonIPFamilies(networks[0].subnets, networks[1].subnets) {
+		generator, prefixLength, bits := c.v4LinkIPGenerator, 31, 32
+		if family == utilnet.IPv6 {
+			generator, prefixLength, bits = c.v6LinkIPGenerator, 127, 128
+		}
+		if generator == nil {
+			continue
+		}
+		for i := range networks {
+			linkIP, err := generator.GenerateIP(2*peeringID + i)
+			if err != nil {
+				return nil, fmt.Errorf("failed to generate link address: %w", err)
+			}
+			linkIP.Mask = net.CIDRMask(prefixLength, bits)
+			peeringConfig.Networks[i].LinkIPs = append(peeringConfig.Networks[i].LinkIPs, linkIP.String())
+		}
+	}
+	if len(peeringConfig.Networks[0].LinkIPs) == 0 {
+		return nil, errors.New("networks have no IP family in common with the cluster")
+	}
+	return peeringConfig, nil
+}
+
+// updateNetworkPeeringAnnotation sets the configuration of the NetworkPeering
+// in its annotation, or removes it if the configuration is nil. It returns
+// whether the annotation has been updated.
+func (c *Controller) updateNetworkPeeringAnnotation(peering *udnv1.NetworkPeering, peeringConfig *util.NetworkPeeringConfig) (bool, error) {
+	current, isSet := peering.Annotations[util.OvnNetworkPeering]
+	var annotation string
+	if peeringConfig != nil {
+		var err error
+		annotation, err = util.CreateNetworkPeeringAnnotation(peeringConfig)
+		if err != nil {
+			return false, err
+		}
+		if isSet && current == annotation {
+			return false, nil
+		}
+	} else if !isSet {
+		return false, nil
+	}
+
+	updated := peering.DeepCopy()
+	if peeringConfig != nil {
+		if updated.Annotations == nil {
+			updated.Annotations = map[string]string{}
+		}
+		updated.Annotations[util.OvnNetworkPeering] = annotation
+	} else {
+		delete(updated.Annotations, util.OvnNetworkPeering)
+	}
+	_, err := c.udnClient.K8sV1().NetworkPeerings().Update(context.TODO(), updated, metav1.UpdateOptions{})
+	if err != nil {
+		return false, fmt.Errorf("failed to update annotation of NetworkPeering %q: %w", peering.Name, err)
+	}
+	klog.Infof("Updated configuration of network peering %s: %s", peering.Name, annotation)
+	return true, nil
+}
+
+func (c *Controller) updateNetworkPeeringStatus(peering *udnv1.NetworkPeering, condition metav1.Condition) error {
+	updated := peering.DeepCopy()
+	meta.SetStatusCondition(&updated.Status.Conditions, condition)
+	if reflect.DeepEqual(peering.Status, updated.Status) {
+		return nil
+	}
+	// only the status is updated, the annotation may have been updated already
+	latest, err := c.udnClient.K8sV1().NetworkPeerings().Get(context.TODO(), peering.Name, metav1.GetOptions{})
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get NetworkPeering %q: %w", peering.Name, err)
+	}
+	latest = latest.DeepCopy()
+	latest.Status = updated.Status
+	_, err = c.udnClient.K8sV1().NetworkPeerings().UpdateStatus(context.TODO(), latest, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to update status of NetworkPeering %q: %w", peering.Name, err)
+	}
+	return nil
+}
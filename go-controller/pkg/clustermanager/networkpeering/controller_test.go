@@ -0,0 +1,267 @@
+package networkpeering
+
+import (
+	"context"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	udnv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ = ginkgo.Describe("Cluster manager Network Peering Controller operations", func() {
+	var (
+		peeringController *Controller
+		wf                *factory.WatchFactory
+		fakeClient        *util.OVNClusterManagerClientset
+	)
+
+	start := func(objects ...runtime.Object) {
+		fakeClient = util.GetOVNClientset(objects...).GetClusterManagerClientset()
+		var err error
+		wf, err = factory.NewClusterManagerWatchFactory(fakeClient)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		peeringController, err = NewController(
+			fakeClient.UserDefinedNetworkClient,
+			wf.NetworkPeeringInformer(),
+			wf.UserDefinedNetworkInformer(),
+			wf.ClusterUserDefinedNetworkInformer(),
+			wf.NodeCoreInformer(),
+		)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		err = wf.Start()
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		err = peeringController.Start()
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	}
+
+	readyCondition := []metav1.Condition{{Type: networkReadyCondition, Status: metav1.ConditionTrue, Reason: "NetworkAttachmentDefinitionReady"}}
+
+	buildUDN := func(namespace, name string, role udnv1.NetworkRole, subnet string) *udnv1.UserDefinedNetwork {
+		return &udnv1.UserDefinedNetwork{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Spec: udnv1.UserDefinedNetworkSpec{
+				Topology: udnv1.NetworkTopologyLayer3,
+				Layer3: &udnv1.Layer3Config{
+					Role:    role,
+					Subnets: []udnv1.Layer3Subnet{{CIDR: udnv1.CIDR(subnet)}},
+				},
+			},
+			Status: udnv1.UserDefinedNetworkStatus{Conditions: readyCondition},
+		}
+	}
+
+	buildCUDN := func(name string, subnet string) *udnv1.ClusterUserDefinedNetwork {
+		return &udnv1.ClusterUserDefinedNetwork{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: udnv1.ClusterUserDefinedNetworkSpec{
+				Network: udnv1.NetworkSpec{
+					Topology: udnv1.NetworkTopologyLayer2,
+					Layer2: &udnv1.Layer2Config{
+						Role:    udnv1.NetworkRolePrimary,
+						Subnets: udnv1.DualStackCIDRs{udnv1.CIDR(subnet)},
+					},
+				},
+			},
+			Status: udnv1.ClusterUserDefinedNetworkStatus{Conditions: readyCondition},
+		}
+	}
+
+	udnRef := func(namespace, name string) udnv1.PeeredNetwork {
+		return udnv1.PeeredNetwork{Kind: udnv1.PeeredNetworkKindUserDefinedNetwork, Namespace: namespace, Name: name}
+	}
+
+	cudnRef := func(name string) udnv1.PeeredNetwork {
+		return udnv1.PeeredNetwork{Kind: udnv1.PeeredNetworkKindClusterUserDefinedNetwork, Name: name}
+	}
+
+	buildNetworkPeering := func(name string, networks ...udnv1.PeeredNetwork) *udnv1.NetworkPeering {
+		return &udnv1.NetworkPeering{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       udnv1.NetworkPeeringSpec{Networks: networks},
+		}
+	}
+
+	getPeeringConfig := func(name string) func() *util.NetworkPeeringConfig {
+		return func() *util.NetworkPeeringConfig {
+			peering, err := fakeClient.UserDefinedNetworkClient.K8sV1().NetworkPeerings().Get(context.TODO(), name, metav1.GetOptions{})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			peeringConfig, err := util.ParseNetworkPeeringAnnotation(peering)
+			if util.IsAnnotationNotSetError(err) {
+				return nil
+			}
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			return peeringConfig
+		}
+	}
+
+	getReadyCondition := func(name string) func() *metav1.Condition {
+		return func() *metav1.Condition {
+			peering, err := fakeClient.UserDefinedNetworkClient.K8sV1().NetworkPeerings().Get(context.TODO(), name, metav1.GetOptions{})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			return meta.FindStatusCondition(peering.Status.Conditions, conditionTypeReady)
+		}
+	}
+
+	ginkgo.BeforeEach(func() {
+		gomega.Expect(config.PrepareTestConfig()).To(gomega.Succeed())
+		config.OVNKubernetesFeature.EnableMultiNetwork = true
+		config.OVNKubernetesFeature.EnableNetworkSegmentation = true
+		config.IPv4Mode = true
+		config.IPv6Mode = false
+		wf = nil
+		peeringController = nil
+	})
+
+	ginkgo.AfterEach(func() {
+		if wf != nil {
+			wf.Shutdown()
+		}
+		if peeringController != nil {
+			peeringController.Stop()
+		}
+	})
+
+	ginkgo.It("peers a UserDefinedNetwork with a ClusterUserDefinedNetwork", func() {
+		start(
+			buildUDN("blue", "tenant", udnv1.NetworkRolePrimary, "10.10.0.0/16"),
+			buildCUDN("shared", "10.20.0.0/16"),
+			buildNetworkPeering("peering", udnRef("blue", "tenant"), cudnRef("shared")),
+		)
+
+		gomega.Eventually(getPeeringConfig("peering")).Should(gomega.Equal(&util.NetworkPeeringConfig{
+			ID: 1,
+			Networks: []util.PeeredNetworkConfig{
+				{Name: "blue.tenant", LinkIPs: []string{"100.91.0.2/31"}, Subnets: []string{"10.10.0.0/16"}},
+				{Name: "cluster.udn.shared", LinkIPs: []string{"100.91.0.3/31"}, Subnets: []string{"10.20.0.0/16"}},
+			},
+		}))
+		gomega.Eventually(getReadyCondition("peering")).Should(gomega.And(
+			gomega.Not(gomega.BeNil()),
+			gomega.HaveField("Status", metav1.ConditionTrue),
+			gomega.HaveField("Reason", reasonNetworksPeered),
+		))
+	})
+
+	ginkgo.It("rejects networks that can't be peered", func() {
+		start(
+			buildUDN("blue", "tenant", udnv1.NetworkRolePrimary, "10.10.0.0/16"),
+			buildUDN("red", "tenant", udnv1.NetworkRolePrimary, "10.10.128.0/17"),
+			buildUDN("green", "tenant", udnv1.NetworkRoleSecondary, "10.30.0.0/16"),
+			buildNetworkPeering("overlap", udnRef("blue", "tenant"), udnRef("red", "tenant")),
+			buildNetworkPeering("secondary", udnRef("blue", "tenant"), udnRef("green", "tenant")),
+			buildNetworkPeering("missing", udnRef("blue", "tenant"), cudnRef("missing")),
+		)
+
+		for _, name := range []string{"overlap", "secondary", "missing"} {
+			gomega.Eventually(getReadyCondition(name)).Should(gomega.And(
+				gomega.Not(gomega.BeNil()),
+				gomega.HaveField("Status", metav1.ConditionFalse),
+				gomega.HaveField("Reason", reasonInvalidNetworks),
+			))
+			gomega.Expect(getPeeringConfig(name)()).To(gomega.BeNil())
+		}
+	})
+
+	ginkgo.It("peers the networks once they become valid", func() {
+		udn := buildUDN("blue", "tenant", udnv1.NetworkRolePrimary, "10.10.0.0/16")
+		udn.Status.Conditions = nil
+		start(
+			udn,
+			buildCUDN("shared", "10.20.0.0/16"),
+			buildNetworkPeering("peering", udnRef("blue", "tenant"), cudnRef("shared")),
+		)
+
+		gomega.Eventually(getReadyCondition("peering")).Should(gomega.HaveField("Reason", reasonInvalidNetworks))
+
+		udn.Status.Conditions = readyCondition
+		_, err := fakeClient.UserDefinedNetworkClient.K8sV1().UserDefinedNetworks(udn.Namespace).UpdateStatus(context.TODO(), udn, metav1.UpdateOptions{})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		gomega.Eventually(getPeeringConfig("peering")).ShouldNot(gomega.BeNil())
+		gomega.Eventually(getReadyCondition("peering")).Should(gomega.HaveField("Reason", reasonNetworksPeered))
+	})
+
+	ginkgo.It("rejects a Layer2 network while a zone has more than one node", func() {
+		buildNode := func(name, zone string) *corev1.Node {
+			return &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Annotations: map[string]string{util.OvnNodeZoneName: zone},
+			}}
+		}
+		start(
+			buildNode("node1", "zone1"),
+			buildNode("node2", "zone1"),
+			buildUDN("blue", "tenant", udnv1.NetworkRolePrimary, "10.10.0.0/16"),
+			buildCUDN("shared", "10.20.0.0/16"),
+			buildNetworkPeering("peering", udnRef("blue", "tenant"), cudnRef("shared")),
+		)
+
+		gomega.Eventually(getReadyCondition("peering")).Should(gomega.And(
+			gomega.Not(gomega.BeNil()),
+			gomega.HaveField("Reason", reasonInvalidNetworks),
+			gomega.HaveField("Message", gomega.ContainSubstring("zone zone1 has nodes")),
+		))
+		gomega.Expect(getPeeringConfig("peering")()).To(gomega.BeNil())
+
+		_, err := fakeClient.KubeClient.CoreV1().Nodes().Update(context.TODO(), buildNode("node2", "zone2"), metav1.UpdateOptions{})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		gomega.Eventually(getPeeringConfig("peering")).ShouldNot(gomega.BeNil())
+		gomega.Eventually(getReadyCondition("peering")).Should(gomega.HaveField("Reason", reasonNetworksPeered))
+	})
+
+	ginkgo.It("rejects a peering conflicting with an existing one until the latter is deleted", func() {
+		start(
+			buildUDN("blue", "tenant", udnv1.NetworkRolePrimary, "10.10.0.0/16"),
+			buildCUDN("shared", "10.20.0.0/16"),
+			buildNetworkPeering("first", udnRef("blue", "tenant"), cudnRef("shared")),
+		)
+		gomega.Eventually(getPeeringConfig("first")).ShouldNot(gomega.BeNil())
+
+		_, err := fakeClient.UserDefinedNetworkClient.K8sV1().NetworkPeerings().Create(context.TODO(),
+			buildNetworkPeering("second", cudnRef("shared"), udnRef("blue", "tenant")), metav1.CreateOptions{})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Eventually(getReadyCondition("second")).Should(gomega.And(
+			gomega.Not(gomega.BeNil()),
+			gomega.HaveField("Reason", reasonInvalidNetworks),
+			gomega.HaveField("Message", gomega.ContainSubstring("already peered")),
+		))
+		gomega.Expect(getPeeringConfig("second")()).To(gomega.BeNil())
+
+		err = fakeClient.UserDefinedNetworkClient.K8sV1().NetworkPeerings().Delete(context.TODO(), "first", metav1.DeleteOptions{})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		gomega.Eventually(getPeeringConfig("second")).ShouldNot(gomega.BeNil())
+		gomega.Eventually(getReadyCondition("second")).Should(gomega.HaveField("Reason", reasonNetworksPeered))
+	})
+
+	ginkgo.It("keeps the IDs of the existing peerings", func() {
+		existing := buildNetworkPeering("existing", udnRef("blue", "tenant"), cudnRef("shared"))
+		existing.Annotations = map[string]string{
+			util.OvnNetworkPeering: `{"id":1,"networks":[` +
+				`{"name":"blue.tenant","linkIPs":["100.91.0.2/31"],"subnets":["10.10.0.0/16"]},` +
+				`{"name":"cluster.udn.shared","linkIPs":["100.91.0.3/31"],"subnets":["10.20.0.0/16"]}]}`,
+		}
+		start(
+			buildUDN("blue", "tenant", udnv1.NetworkRolePrimary, "10.10.0.0/16"),
+			buildUDN("red", "tenant", udnv1.NetworkRolePrimary, "10.30.0.0/16"),
+			buildCUDN("shared", "10.20.0.0/16"),
+			existing,
+			buildNetworkPeering("new", udnRef("red", "tenant"), cudnRef("shared")),
+		)
+
+		gomega.Eventually(getPeeringConfig("new")).Should(gomega.HaveField("ID", 2))
+		gomega.Expect(getPeeringConfig("existing")()).To(gomega.HaveField("ID", 1))
+	})
+})
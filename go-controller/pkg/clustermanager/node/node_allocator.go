@@ -1,6 +1,7 @@
 package node
 
 import (
+	"errors"
 	"fmt"
 	"net"
 
@@ -154,7 +155,8 @@ func (na *NodeAllocator) hybridOverlayNodeEnsureSubnet(node *corev1.Node, annota
 
 	// Allocate a new host subnet for this node
 	// FIXME: hybrid overlay is only IPv4 for now due to limitations on the Windows side
-	hostSubnets, allocatedSubnets, err := na.allocateNodeSubnets(na.hybridOverlaySubnetAllocator, node.Name, existingSubnets, true, false)
+	hostSubnets, allocatedSubnets, err := na.allocateNodeSubnets(na.hybridOverlaySubnetAllocator, node.Name, existingSubnets, true, false,
+		util.NodeSubnetRequest{}, 1)
 	if err != nil {
 		return nil, fmt.Errorf("error allocating hybrid overlay HostSubnet for node %s: %v", node.Name, err)
 	}
@@ -260,12 +262,20 @@ func (na *NodeAllocator) syncNodeNetworkAnnotations(node *corev1.Node) error {
 			klog.Warningf("Failed to get node %s host subnets annotations for network %s : %v", node.Name, networkName, err)
 		}
 
+		// The zone controller of the node requests more subnets when the node
+		// runs out of IPs.
+		request, err := util.ParseNodeSubnetRequestAnnotation(node, networkName)
+		if err != nil && !util.IsAnnotationNotSetError(err) {
+			klog.Warningf("Failed to get node %s subnet requests annotation for network %s : %v", node.Name, networkName, err)
+		}
+
 		// On return validExistingSubnets will contain any valid subnets that
 		// were already assigned to the node. allocatedSubnets will contain
 		// any newly allocated subnets required to ensure that the node has one subnet
-		// from each enabled IP family.
+		// from each enabled IP family, plus the additional subnets requested.
 		ipv4Mode, ipv6Mode := na.netInfo.IPMode()
-		validExistingSubnets, allocatedSubnets, err = na.allocateNodeSubnets(na.clusterSubnetAllocator, node.Name, existingSubnets, ipv4Mode, ipv6Mode)
+		validExistingSubnets, allocatedSubnets, err = na.allocateNodeSubnets(na.clusterSubnetAllocator, node.Name, existingSubnets, ipv4Mode, ipv6Mode,
+			request, config.Default.MaxHostSubnets)
 		if err != nil {
 			return err
 		}
@@ -410,6 +420,14 @@ func (na *NodeAllocator) updateNodeNetworkAnnotationsWithRetry(nodeName string,
 			return fmt.Errorf("failed to update node %q network id annotation %d for network %s: %w",
 				node.Name, networkId, networkName, err)
 		}
+		if networkId == util.InvalidID {
+			// the network is removed, drop the requests for more subnets too
+			cnode.Annotations, err = util.UpdateNodeSubnetRequestAnnotation(cnode.Annotations, util.NodeSubnetRequest{}, networkName)
+			if err != nil {
+				return fmt.Errorf("failed to update node %q subnet requests annotation for network %s: %w",
+					node.Name, networkName, err)
+			}
+		}
 		if tunnelID != util.NoID {
 			cnode.Annotations, err = util.UpdateUDNLayer2NodeGRLRPTunnelIDs(cnode.Annotations, networkName, tunnelID)
 			if err != nil {
@@ -460,38 +478,45 @@ func (na *NodeAllocator) Cleanup() error {
 }
 
 // allocateNodeSubnets either validates existing node subnets against the allocators
-// ranges, or allocates new subnets if the node doesn't have any yet, or returns an error
-func (na *NodeAllocator) allocateNodeSubnets(allocator SubnetAllocator, nodeName string, existingSubnets []*net.IPNet, ipv4Mode, ipv6Mode bool) ([]*net.IPNet, []*net.IPNet, error) {
+// ranges, or allocates new subnets if the node doesn't have any yet, or returns an error.
+// A node has one subnet of each enabled IP family, or up to maxSubnets of them if
+// more are requested.
+func (na *NodeAllocator) allocateNodeSubnets(allocator SubnetAllocator, nodeName string, existingSubnets []*net.IPNet, ipv4Mode, ipv6Mode bool,
+	request util.NodeSubnetRequest, maxSubnets int) ([]*net.IPNet, []*net.IPNet, error) {
 	allocatedSubnets := []*net.IPNet{}
 
-	// OVN can work in single-stack or dual-stack only.
-	expectedHostSubnets := 1
-	// if dual-stack mode we expect one subnet per each IP family
-	if ipv4Mode && ipv6Mode {
-		expectedHostSubnets = 2
+	// OVN can work in single-stack or dual-stack only; the node needs at least
+	// one subnet of each enabled IP family.
+	wantedIPv4, wantedIPv6 := 0, 0
+	if ipv4Mode {
+		wantedIPv4 = min(max(request.IPv4, 1), maxSubnets)
+	}
+	if ipv6Mode {
+		wantedIPv6 = min(max(request.IPv6, 1), maxSubnets)
 	}
 
-	klog.Infof("Expected %d subnets on node %s, found %d: %v", expectedHostSubnets, nodeName, len(existingSubnets), existingSubnets)
+	klog.Infof("Expected %d IPv4 and %d IPv6 subnets on node %s, found %d: %v", wantedIPv4, wantedIPv6, nodeName, len(existingSubnets), existingSubnets)
 
 	// If any existing subnets the node has are valid, mark them as reserved.
 	// The node might have invalid or already-reserved subnets, or it might
 	// have more subnets than configured in OVN (like for dual-stack to/from
-	// single-stack conversion).
+	// single-stack conversion). The additional subnets the node was allocated
+	// are kept as they may be in use.
 	// filter in place slice
 	// https://github.com/golang/go/wiki/SliceTricks#filter-in-place
-	foundIPv4 := false
-	foundIPv6 := false
+	foundIPv4 := 0
+	foundIPv6 := 0
 	n := 0
 	for _, subnet := range existingSubnets {
-		if (ipv4Mode && utilnet.IsIPv4CIDR(subnet) && !foundIPv4) || (ipv6Mode && utilnet.IsIPv6CIDR(subnet) && !foundIPv6) {
+		if (ipv4Mode && utilnet.IsIPv4CIDR(subnet) && foundIPv4 < maxSubnets) || (ipv6Mode && utilnet.IsIPv6CIDR(subnet) && foundIPv6 < maxSubnets) {
 			if err := allocator.MarkAllocatedNetworks(nodeName, subnet); err == nil {
 				klog.Infof("Valid subnet %v allocated on node %s", subnet, nodeName)
 				existingSubnets[n] = subnet
 				n++
 				if utilnet.IsIPv4CIDR(subnet) {
-					foundIPv4 = true
+					foundIPv4++
 				} else if utilnet.IsIPv6CIDR(subnet) {
-					foundIPv6 = true
+					foundIPv6++
 				}
 				continue
 			}
@@ -506,7 +531,7 @@ func (na *NodeAllocator) allocateNodeSubnets(allocator SubnetAllocator, nodeName
 	existingSubnets = existingSubnets[:n]
 
 	// Node has enough valid subnets already allocated
-	if len(existingSubnets) == expectedHostSubnets {
+	if foundIPv4 >= wantedIPv4 && foundIPv6 >= wantedIPv6 {
 		klog.Infof("Allowed existing subnets %v on node %s", existingSubnets, nodeName)
 		return existingSubnets, allocatedSubnets, nil
 	}
@@ -524,14 +549,28 @@ func (na *NodeAllocator) allocateNodeSubnets(allocator SubnetAllocator, nodeName
 		}
 	}()
 
-	// allocateOneSubnet is a helper to process the result of a subnet allocation
-	allocateOneSubnet := func(allocatedHostSubnet *net.IPNet, allocErr error) error {
-		if allocErr != nil {
-			return fmt.Errorf("error allocating network for node %s: %v", nodeName, allocErr)
-		}
-		// the allocator returns nil if it can't provide a subnet
-		// we should filter them out or they will be appended to the slice
-		if allocatedHostSubnet != nil {
+	// allocateSubnets is a helper allocating the subnets of an IP family the
+	// node is missing
+	allocateSubnets := func(allocate, allocateAdditional func(string) (*net.IPNet, error), found, wanted int) error {
+		for ; found < wanted; found++ {
+			if found > 0 {
+				allocate = allocateAdditional
+			}
+			allocatedHostSubnet, allocErr := allocate(nodeName)
+			if allocErr != nil {
+				if found > 0 && errors.Is(allocErr, ErrSubnetAllocatorFull) {
+					// the node has a subnet of the family already, it
+					// just can't get more of them
+					klog.Warningf("Cannot allocate more subnets to node %s: %v", nodeName, allocErr)
+					return nil
+				}
+				return fmt.Errorf("error allocating network for node %s: %v", nodeName, allocErr)
+			}
+			// the allocator returns nil if it can't provide a subnet
+			// we should filter them out or they will be appended to the slice
+			if allocatedHostSubnet == nil {
+				return fmt.Errorf("error allocating networks for node %s: no subnet available", nodeName)
+			}
 			klog.V(5).Infof("Allocating subnet %v on node %s", allocatedHostSubnet, nodeName)
 			allocatedSubnets = append(allocatedSubnets, allocatedHostSubnet)
 		}
@@ -539,26 +578,15 @@ func (na *NodeAllocator) allocateNodeSubnets(allocator SubnetAllocator, nodeName
 	}
 
 	// allocate new subnets if needed
-	if ipv4Mode && !foundIPv4 {
-		if err := allocateOneSubnet(allocator.AllocateIPv4Network(nodeName)); err != nil {
-			return nil, nil, err
-		}
-	}
-	if ipv6Mode && !foundIPv6 {
-		if err := allocateOneSubnet(allocator.AllocateIPv6Network(nodeName)); err != nil {
-			return nil, nil, err
-		}
+	if err := allocateSubnets(allocator.AllocateIPv4Network, allocator.AllocateAdditionalIPv4Network, foundIPv4, wantedIPv4); err != nil {
+		return nil, nil, err
 	}
-
-	// check if we were able to allocate the new subnets require
-	// this can only happen if OVN is not configured correctly
-	// so it will require a reconfiguration and restart.
-	wantedSubnets := expectedHostSubnets - len(existingSubnets)
-	if wantedSubnets > 0 && len(allocatedSubnets) != wantedSubnets {
-		return nil, nil, fmt.Errorf("error allocating networks for node %s: %d subnets expected only new %d subnets allocated",
-			nodeName, expectedHostSubnets, len(allocatedSubnets))
+	if err := allocateSubnets(allocator.AllocateIPv6Network, allocator.AllocateAdditionalIPv6Network, foundIPv6, wantedIPv6); err != nil {
+		return nil, nil, err
 	}
 
+	// the new subnets are appended so that the first subnet of each IP family
+	// remains the primary one
 	hostSubnets := append(existingSubnets, allocatedSubnets...)
 	klog.Infof("Allocated Subnets %v on Node %s", hostSubnets, nodeName)
 
@@ -44,6 +44,8 @@ func TestController_allocateNodeSubnets(t *testing.T) {
 		configIPv6    bool
 		existingNets  []*net.IPNet
 		alreadyOwned  *existingAllocation
+		request       util.NodeSubnetRequest
+		maxSubnets    int
 		// to be converted during the test to []*net.IPNet
 		wantStr   []string
 		allocated int
@@ -204,6 +206,50 @@ func TestController_allocateNodeSubnets(t *testing.T) {
 			wantStr:   []string{"172.16.0.0/24", "2001:db2:1:2::/64"},
 			allocated: 0,
 		},
+		{
+			name:          "existing annotated node requesting another IPv4 subnet, dual stack cluster",
+			networkRanges: []string{"172.16.0.0/16", "2001:db2:1::/56"},
+			networkLens:   []int{24, 64},
+			configIPv4:    true,
+			configIPv6:    true,
+			existingNets:  ovntest.MustParseIPNets("172.16.0.0/24", "2001:db2:1:2::/64"),
+			request:       util.NodeSubnetRequest{IPv4: 2, IPv6: 1},
+			maxSubnets:    3,
+			wantStr:       []string{"172.16.0.0/24", "2001:db2:1:2::/64", "172.16.1.0/24"},
+			allocated:     1,
+		},
+		{
+			name:          "existing annotated node requesting more subnets than allowed",
+			networkRanges: []string{"172.16.0.0/16"},
+			networkLens:   []int{24},
+			configIPv4:    true,
+			existingNets:  ovntest.MustParseIPNets("172.16.0.0/24"),
+			request:       util.NodeSubnetRequest{IPv4: 4},
+			maxSubnets:    2,
+			wantStr:       []string{"172.16.0.0/24", "172.16.1.0/24"},
+			allocated:     1,
+		},
+		{
+			name:          "existing annotated node keeps its additional subnets",
+			networkRanges: []string{"172.16.0.0/16"},
+			networkLens:   []int{24},
+			configIPv4:    true,
+			existingNets:  ovntest.MustParseIPNets("172.16.0.0/24", "172.16.5.0/24"),
+			maxSubnets:    2,
+			wantStr:       []string{"172.16.0.0/24", "172.16.5.0/24"},
+			allocated:     0,
+		},
+		{
+			name:          "existing annotated node requesting another subnet, cluster CIDR exhausted",
+			networkRanges: []string{"172.16.0.0/24"},
+			networkLens:   []int{24},
+			configIPv4:    true,
+			existingNets:  ovntest.MustParseIPNets("172.16.0.0/24"),
+			request:       util.NodeSubnetRequest{IPv4: 2},
+			maxSubnets:    2,
+			wantStr:       []string{"172.16.0.0/24"},
+			allocated:     0,
+		},
 	}
 
 	for _, tt := range tests {
@@ -239,8 +285,14 @@ func TestController_allocateNodeSubnets(t *testing.T) {
 				}
 			}
 
+			maxSubnets := tt.maxSubnets
+			if maxSubnets == 0 {
+				maxSubnets = 1
+			}
+
 			// test network allocation works correctly
-			got, allocated, err := na.allocateNodeSubnets(na.clusterSubnetAllocator, "testnode", tt.existingNets, tt.configIPv4, tt.configIPv6)
+			got, allocated, err := na.allocateNodeSubnets(na.clusterSubnetAllocator, "testnode", tt.existingNets, tt.configIPv4, tt.configIPv6,
+				tt.request, maxSubnets)
 			if (err != nil) != tt.wantErr {
 				t.Fatalf("Controller.addNode() error = %v, wantErr %v", err, tt.wantErr)
 			}
@@ -304,7 +356,7 @@ func TestController_allocateNodeSubnets_ReleaseOnError(t *testing.T) {
 
 	// test network allocation works correctly
 	v4usedBefore, v6usedBefore := na.clusterSubnetAllocator.Usage()
-	got, allocated, err := na.allocateNodeSubnets(na.clusterSubnetAllocator, "testNode", nil, true, true)
+	got, allocated, err := na.allocateNodeSubnets(na.clusterSubnetAllocator, "testNode", nil, true, true, util.NodeSubnetRequest{}, 1)
 	if err == nil {
 		t.Fatalf("allocateNodeSubnets() expected error but got success")
 	}
@@ -22,6 +22,10 @@ type SubnetAllocator interface {
 	AllocateNetworks(string) ([]*net.IPNet, error)
 	AllocateIPv4Network(string) (*net.IPNet, error)
 	AllocateIPv6Network(string) (*net.IPNet, error)
+	// AllocateAdditionalIPv4Network and AllocateAdditionalIPv6Network
+	// allocate a network to an owner in addition to the ones it already owns
+	AllocateAdditionalIPv4Network(string) (*net.IPNet, error)
+	AllocateAdditionalIPv6Network(string) (*net.IPNet, error)
 	// ReleaseNetworks releases the given networks if they are owned by the
 	// given owner
 	ReleaseNetworks(string, ...*net.IPNet) error
@@ -161,7 +165,7 @@ func (sna *BaseSubnetAllocator) AllocateIPv4Network(owner string) (*net.IPNet, e
 		return nil, nil
 	}
 	for _, snr := range sna.v4ranges {
-		sn := snr.allocateNetwork(owner)
+		sn := snr.allocateNetwork(owner, false)
 		if sn != nil {
 			return sn, nil
 		}
@@ -177,7 +181,41 @@ func (sna *BaseSubnetAllocator) AllocateIPv6Network(owner string) (*net.IPNet, e
 		return nil, nil
 	}
 	for _, snr := range sna.v6ranges {
-		sn := snr.allocateNetwork(owner)
+		sn := snr.allocateNetwork(owner, false)
+		if sn != nil {
+			return sn, nil
+		}
+	}
+	return nil, ErrSubnetAllocatorFull
+}
+
+// AllocateAdditionalIPv4Network tries to allocate an IPv4 network to an owner
+// that may already own some, if there are ranges available
+func (sna *BaseSubnetAllocator) AllocateAdditionalIPv4Network(owner string) (*net.IPNet, error) {
+	sna.Lock()
+	defer sna.Unlock()
+	if len(sna.v4ranges) == 0 {
+		return nil, nil
+	}
+	for _, snr := range sna.v4ranges {
+		sn := snr.allocateNetwork(owner, true)
+		if sn != nil {
+			return sn, nil
+		}
+	}
+	return nil, ErrSubnetAllocatorFull
+}
+
+// AllocateAdditionalIPv6Network tries to allocate an IPv6 network to an owner
+// that may already own some, if there are ranges available
+func (sna *BaseSubnetAllocator) AllocateAdditionalIPv6Network(owner string) (*net.IPNet, error) {
+	sna.Lock()
+	defer sna.Unlock()
+	if len(sna.v6ranges) == 0 {
+		return nil, nil
+	}
+	for _, snr := range sna.v6ranges {
+		sn := snr.allocateNetwork(owner, true)
 		if sn != nil {
 			return sn, nil
 		}
@@ -358,12 +396,14 @@ func (snr *subnetAllocatorRange) markAllocatedNetwork(owner string, network *net
 	return false, alreadyOwnedError{str, existingOwner}
 }
 
-// allocateNetwork returns a new subnet, or nil if the range is full
-func (snr *subnetAllocatorRange) allocateNetwork(owner string) *net.IPNet {
+// allocateNetwork returns a new subnet, or nil if the range is full. Unless an
+// additional subnet is requested, it returns the subnet already allocated to
+// the owner, if any.
+func (snr *subnetAllocatorRange) allocateNetwork(owner string, additional bool) *net.IPNet {
 	// Return an already allocated subnet instead of creating a new one if a
 	// combination of subnet and node name already exists in the cache
 	for nodeSubnet, nodeName := range snr.allocMap {
-		if nodeName == owner {
+		if nodeName == owner && !additional {
 			_, subnet, err := net.ParseCIDR(nodeSubnet)
 			if err != nil {
 				klog.Errorf("Failed to parse subnet %s for node %s: %v", nodeSubnet, nodeName, err)
@@ -16,7 +16,7 @@ import (
 
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/allocator/id"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/allocator/ip/subnet"
-	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/allocator/pod"
+	annotationalloc "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/allocator/pod"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 	nad "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/network-attach-def-controller"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/persistentips"
@@ -37,7 +37,7 @@ type PodAllocator struct {
 	idAllocator id.Allocator
 
 	// An utility to allocate the PodAnnotation to pods
-	podAnnotationAllocator *pod.PodAnnotationAllocator
+	podAnnotationAllocator *annotationalloc.PodAnnotationAllocator
 
 	ipamClaimsReconciler persistentips.PersistentAllocations
 
@@ -55,7 +55,7 @@ type PodAllocator struct {
 // NewPodAllocator builds a new PodAllocator
 func NewPodAllocator(
 	netInfo util.NetInfo,
-	podAnnotationAllocator *pod.PodAnnotationAllocator,
+	podAnnotationAllocator *annotationalloc.PodAnnotationAllocator,
 	ipAllocator subnet.Allocator,
 	claimsReconciler persistentips.PersistentAllocations,
 	nadController nad.NADController,
@@ -172,12 +172,27 @@ func (a *PodAllocator) Sync(objs []interface{}) error {
 	// completed pods that might be being used by other pods
 	releaseFromAllocator := false
 
+	pods := make([]*corev1.Pod, 0, len(objs))
 	for _, obj := range objs {
 		pod, ok := obj.(*corev1.Pod)
 		if !ok {
 			klog.Errorf("Could not cast %T object to *corev1.Pod", obj)
 			continue
 		}
+		pods = append(pods, pod)
+	}
+
+	// reserve the MAC addresses the pods are already annotated with before
+	// allocating any, so that a MAC address requested by a pod is not given
+	// to it if in use by a pod synced later on
+	for _, pod := range pods {
+		err := a.reservePodMACs(pod)
+		if err != nil {
+			klog.Errorf("Failed to reserve the MAC addresses of pod %s/%s: %v", pod.Namespace, pod.Name, err)
+		}
+	}
+
+	for _, pod := range pods {
 		err := a.reconcile(nil, pod, releaseFromAllocator)
 		if err != nil {
 			klog.Errorf("Failed to sync pod %s/%s: %v", pod.Namespace, pod.Name, err)
@@ -187,6 +202,37 @@ func (a *PodAllocator) Sync(objs []interface{}) error {
 	return nil
 }
 
+// reservePodMACs reserves the MAC addresses the given pod is annotated with on
+// the NADs of this network.
+func (a *PodAllocator) reservePodMACs(pod *corev1.Pod) error {
+	if !util.PodScheduled(pod) || util.PodWantsHostNetwork(pod) || util.PodCompleted(pod) {
+		return nil
+	}
+
+	activeNetwork, err := a.getActiveNetworkForPod(pod)
+	if err != nil {
+		return fmt.Errorf("failed looking for an active network: %w", err)
+	}
+
+	onNetwork, networkMap, err := util.GetPodNADToNetworkMappingWithActiveNetwork(pod, a.netInfo, activeNetwork)
+	if err != nil {
+		return fmt.Errorf("failed to get NAD to network mapping: %w", err)
+	}
+
+	if !onNetwork {
+		return nil
+	}
+
+	for nadName := range networkMap {
+		err = a.podAnnotationAllocator.ReservePodMAC(pod, nadName)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (a *PodAllocator) reconcile(old, new *corev1.Pod, releaseFromAllocator bool) error {
 	var pod *corev1.Pod
 	if old != nil {
@@ -262,6 +308,9 @@ func (a *PodAllocator) releasePodOnNAD(pod *corev1.Pod, nad string, network *net
 
 	uid := string(pod.UID)
 
+	// a completed or deleted pod no longer uses its MAC address
+	a.podAnnotationAllocator.ReleasePodMAC(pod, nad)
+
 	hasIPAM := util.DoesNetworkRequireIPAM(a.netInfo)
 	hasIDAllocation := util.DoesNetworkRequireTunnelIDs(a.netInfo)
 
@@ -355,6 +404,9 @@ func (a *PodAllocator) allocatePodOnNAD(pod *corev1.Pod, nad string, network *ne
 	)
 
 	if err != nil {
+		if annotationalloc.IsErrStaticAddressRequest(err) {
+			a.recordPodErrorEvent(pod, err)
+		}
 		return err
 	}
 
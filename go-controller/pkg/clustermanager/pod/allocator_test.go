@@ -123,6 +123,10 @@ func (a *ipAllocatorStub) GetSubnetName([]*net.IPNet) (string, bool) {
 	panic("not implemented") // TODO: Implement
 }
 
+func (a *ipAllocatorStub) GetSubnetsUsage(name string) ([]subnet.SubnetUsage, error) {
+	panic("not implemented") // TODO: Implement
+}
+
 type idAllocatorStub struct {
 	released bool
 }
@@ -162,6 +166,10 @@ func (nas *namedAllocatorStub) ReleaseIPs(ips []*net.IPNet) error {
 	return nil
 }
 
+func (nas *namedAllocatorStub) GetSubnets() ([]*net.IPNet, error) {
+	return ovntest.MustParseIPNets("10.1.130.0/24"), nil
+}
+
 func TestPodAllocator_reconcileForNAD(t *testing.T) {
 	type args struct {
 		old       *testPod
@@ -182,6 +190,7 @@ func TestPodAllocator_reconcileForNAD(t *testing.T) {
 		expectIDRelease bool
 		expectTracked   bool
 		expectEvents    []string
+		expectCondition *corev1.PodCondition
 		expectError     string
 	}{
 		{
@@ -512,6 +521,48 @@ func TestPodAllocator_reconcileForNAD(t *testing.T) {
 			expectError:  "failed to get NAD to network mapping: unexpected primary network \"\" specified with a NetworkSelectionElement &{Name:nad Namespace:namespace IPRequest:[] MacRequest: InfinibandGUIDRequest: InterfaceRequest: PortMappingsRequest:[] BandwidthRequest:<nil> CNIArgs:<nil> GatewayRequest:[] IPAMClaimReference:}",
 			expectEvents: []string{"Warning ErrorAllocatingPod unexpected primary network \"\" specified with a NetworkSelectionElement &{Name:nad Namespace:namespace IPRequest:[] MacRequest: InfinibandGUIDRequest: InterfaceRequest: PortMappingsRequest:[] BandwidthRequest:<nil> CNIArgs:<nil> GatewayRequest:[] IPAMClaimReference:}"},
 		},
+		{
+			name: "Pod with static IP request not within the network subnets, expect event and error",
+			ipam: true,
+			args: args{
+				new: &testPod{
+					scheduled: true,
+					network: &nadapi.NetworkSelectionElement{
+						Name:      "nad",
+						IPRequest: []string{"10.1.131.5/24"},
+					},
+				},
+			},
+			expectError:  "requested IP 10.1.131.5 is not within the network subnets [10.1.130.0/24]",
+			expectEvents: []string{"Warning ErrorAllocatingPod failed to update pod namespace/pod: static address request cannot be honored for namespace/nad/namespace/pod: requested IP 10.1.131.5 is not within the network subnets [10.1.130.0/24]"},
+			expectCondition: &corev1.PodCondition{
+				Type:    pod.PodStaticAddressesConditionType,
+				Status:  corev1.ConditionFalse,
+				Reason:  "StaticAddressRequestRejected",
+				Message: "failed to update pod namespace/pod: static address request cannot be honored for namespace/nad/namespace/pod: requested IP 10.1.131.5 is not within the network subnets [10.1.130.0/24]",
+			},
+		},
+		{
+			name: "Pod with static IP and MAC request, expect allocate and condition",
+			ipam: true,
+			args: args{
+				new: &testPod{
+					scheduled: true,
+					network: &nadapi.NetworkSelectionElement{
+						Name:       "nad",
+						IPRequest:  []string{"10.1.130.5/24"},
+						MacRequest: "0a:58:0a:01:82:63",
+					},
+				},
+			},
+			expectAllocate: true,
+			expectCondition: &corev1.PodCondition{
+				Type:    pod.PodStaticAddressesConditionType,
+				Status:  corev1.ConditionTrue,
+				Reason:  "StaticAddressesAllocated",
+				Message: "Requested addresses allocated for namespace/nad/namespace/pod",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -526,9 +577,11 @@ func TestPodAllocator_reconcileForNAD(t *testing.T) {
 			podListerMock.On("Pods", mock.AnythingOfType("string")).Return(podNamespaceLister)
 
 			var allocated bool
+			var updatedPod *corev1.Pod
 			kubeMock.On("UpdatePodStatus", mock.AnythingOfType(fmt.Sprintf("%T", &corev1.Pod{}))).Run(
 				func(args mock.Arguments) {
-					allocated = true
+					updatedPod = args.Get(0).(*corev1.Pod)
+					_, allocated = updatedPod.Annotations[util.OvnPodAnnotationName]
 				},
 			).Return(nil)
 
@@ -647,6 +700,14 @@ func TestPodAllocator_reconcileForNAD(t *testing.T) {
 				t.Errorf("expected pod tracked to be %v but it was %v", tt.expectTracked, a.releasedPods["namespace/nad"].Has("pod"))
 			}
 
+			if tt.expectCondition != nil {
+				g.Expect(updatedPod).NotTo(gomega.BeNil(), "expected the pod to be updated")
+				g.Expect(updatedPod.Status.Conditions).To(gomega.HaveLen(1))
+				condition := updatedPod.Status.Conditions[0]
+				condition.LastTransitionTime = metav1.Time{}
+				g.Expect(condition).To(gomega.Equal(*tt.expectCondition))
+			}
+
 			var obtainedEvents []string
 			for {
 				if len(fakeRecorder.Events) == 0 {
@@ -669,3 +730,76 @@ func generateIPAMClaimsListerAndTeardownFunc(stopChannel <-chan struct{}, ipamCl
 		informerFactory.Shutdown()
 	}
 }
+
+func TestPodAllocator_Sync(t *testing.T) {
+	g := gomega.NewWithT(t)
+	config.IPv4Mode = true
+	config.OVNKubernetesFeature.EnableInterconnect = false
+	config.OVNKubernetesFeature.EnableMultiNetwork = true
+	config.OVNKubernetesFeature.EnableNetworkSegmentation = true
+
+	netInfo, err := util.NewNetInfo(&ovncnitypes.NetConf{
+		Topology: types.Layer2Topology,
+		Subnets:  "10.1.130.0/24",
+	})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	netInfo.AddNADs("namespace/nad")
+
+	mac := "0a:58:0a:01:82:63"
+	newPod := func(name string, network *nadapi.NetworkSelectionElement) *corev1.Pod {
+		bytes, err := json.Marshal([]*nadapi.NetworkSelectionElement{network})
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				UID:         apitypes.UID(name),
+				Namespace:   "namespace",
+				Annotations: map[string]string{nadapi.NetworkAttachmentAnnot: string(bytes)},
+			},
+			Spec: corev1.PodSpec{NodeName: "node"},
+		}
+	}
+	// a pod requesting the MAC address of another pod that is synced later
+	requesting := newPod("requesting", &nadapi.NetworkSelectionElement{Name: "nad", MacRequest: mac})
+	annotated := newPod("annotated", &nadapi.NetworkSelectionElement{Name: "nad"})
+	annotated.Annotations, err = util.MarshalPodAnnotation(annotated.Annotations, &util.PodAnnotation{
+		IPs:  ovntest.MustParseIPNets("10.1.130.5/24"),
+		MAC:  ovntest.MustParseMAC(mac),
+		Role: types.NetworkRoleSecondary,
+	}, "namespace/nad")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	podListerMock := &v1mocks.PodLister{}
+	podNamespaceLister := &v1mocks.PodNamespaceLister{}
+	podListerMock.On("Pods", mock.AnythingOfType("string")).Return(podNamespaceLister)
+	podNamespaceLister.On("Get", requesting.Name).Return(requesting, nil)
+	podNamespaceLister.On("Get", annotated.Name).Return(annotated, nil)
+
+	kubeMock := &kubemocks.InterfaceOVN{}
+	updatedPods := map[string]*corev1.Pod{}
+	kubeMock.On("UpdatePodStatus", mock.AnythingOfType(fmt.Sprintf("%T", &corev1.Pod{}))).Run(
+		func(args mock.Arguments) {
+			pod := args.Get(0).(*corev1.Pod)
+			updatedPods[pod.Name] = pod
+		},
+	).Return(nil)
+
+	a := &PodAllocator{
+		netInfo:                netInfo,
+		ipAllocator:            &ipAllocatorStub{},
+		idAllocator:            &idAllocatorStub{},
+		podAnnotationAllocator: pod.NewPodAnnotationAllocator(netInfo, podListerMock, kubeMock, nil),
+		releasedPods:           map[string]sets.Set[string]{},
+		releasedPodsMutex:      sync.Mutex{},
+		recorder:               record.NewFakeRecorder(10),
+		nadController:          &nad.FakeNADController{PrimaryNetworks: map[string]util.NetInfo{}},
+	}
+
+	g.Expect(a.Sync([]interface{}{requesting, annotated})).To(gomega.Succeed())
+
+	g.Expect(updatedPods).To(gomega.HaveKey(requesting.Name))
+	g.Expect(updatedPods[requesting.Name].Annotations).NotTo(gomega.HaveKey(util.OvnPodAnnotationName))
+	g.Expect(updatedPods[requesting.Name].Status.Conditions).To(gomega.HaveLen(1))
+	g.Expect(updatedPods[requesting.Name].Status.Conditions[0].Reason).To(gomega.Equal("StaticAddressRequestRejected"))
+	g.Expect(updatedPods).NotTo(gomega.HaveKey(annotated.Name))
+}
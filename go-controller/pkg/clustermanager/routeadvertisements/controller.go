@@ -0,0 +1,429 @@
+package routeadvertisements
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	nadv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+	nadlister "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/client/listers/k8s.cni.cncf.io/v1"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/controller"
+	egressipv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1"
+	egressiplister "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1/apis/listers/egressip/v1"
+	ratypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1"
+	raclientset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1/apis/clientset/versioned"
+	ralister "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1/apis/listers/routeadvertisements/v1"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/kube"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+)
+
+const (
+	conditionTypeAccepted = "Accepted"
+	reasonAccepted        = "Accepted"
+	reasonConfigError     = "ConfigurationError"
+)
+
+// Controller renders, from the RouteAdvertisements, the routes each node
+// advertises for each network and sets them on the node through the
+// OvnNodeRouteAdvertisements annotation, from which ovnkube-node configures
+// the local routing daemon.
+type Controller struct {
+	kube     kube.Interface
+	raClient raclientset.Interface
+
+	raLister   ralister.RouteAdvertisementsLister
+	nodeLister corev1listers.NodeLister
+	// nadLister is nil if multi-network is not enabled
+	nadLister nadlister.NetworkAttachmentDefinitionLister
+	// eipLister is nil if egress IP is not enabled
+	eipLister egressiplister.EgressIPLister
+
+	raController   controller.Controller
+	nodeController controller.Controller
+	nadController  controller.Controller
+	eipController  controller.Controller
+}
+
+// NewController returns a new RouteAdvertisements controller.
+func NewController(ovnClient *util.OVNClusterManagerClientset, wf *factory.WatchFactory) *Controller {
+	c := &Controller{
+		kube:       &kube.Kube{KClient: ovnClient.KubeClient},
+		raClient:   ovnClient.RouteAdvertisementsClient,
+		raLister:   wf.RouteAdvertisementsInformer().Lister(),
+		nodeLister: wf.NodeCoreInformer().Lister(),
+	}
+
+	raConfig := &controller.ControllerConfig[ratypes.RouteAdvertisements]{
+		RateLimiter:    workqueue.NewTypedItemFastSlowRateLimiter[string](time.Second, 5*time.Second, 5),
+		Informer:       wf.RouteAdvertisementsInformer().Informer(),
+		Lister:         c.raLister.List,
+		ObjNeedsUpdate: raNeedsUpdate,
+		Reconcile:      c.reconcileRouteAdvertisements,
+		Threadiness:    1,
+	}
+	c.raController = controller.NewController[ratypes.RouteAdvertisements]("cm-route-advertisements-controller", raConfig)
+
+	nodeConfig := &controller.ControllerConfig[corev1.Node]{
+		RateLimiter:    workqueue.DefaultTypedControllerRateLimiter[string](),
+		Informer:       wf.NodeCoreInformer().Informer(),
+		Lister:         c.nodeLister.List,
+		ObjNeedsUpdate: nodeNeedsUpdate,
+		Reconcile:      c.reconcileNode,
+		Threadiness:    1,
+	}
+	c.nodeController = controller.NewController[corev1.Node]("cm-route-advertisements-node-controller", nodeConfig)
+
+	if config.OVNKubernetesFeature.EnableMultiNetwork {
+		c.nadLister = wf.NADInformer().Lister()
+		nadConfig := &controller.ControllerConfig[nadv1.NetworkAttachmentDefinition]{
+			RateLimiter: workqueue.DefaultTypedControllerRateLimiter[string](),
+			Informer:    wf.NADInformer().Informer(),
+			Lister:      c.nadLister.List,
+			Reconcile:   c.reconcileAllNodes,
+			Threadiness: 1,
+		}
+		c.nadController = controller.NewController[nadv1.NetworkAttachmentDefinition]("cm-route-advertisements-nad-controller", nadConfig)
+	}
+
+	if config.OVNKubernetesFeature.EnableEgressIP {
+		c.eipLister = wf.EgressIPInformer().Lister()
+		eipConfig := &controller.ControllerConfig[egressipv1.EgressIP]{
+			RateLimiter:    workqueue.DefaultTypedControllerRateLimiter[string](),
+			Informer:       wf.EgressIPInformer().Informer(),
+			Lister:         c.eipLister.List,
+			ObjNeedsUpdate: eipNeedsUpdate,
+			Reconcile:      c.reconcileAllNodes,
+			Threadiness:    1,
+		}
+		c.eipController = controller.NewController[egressipv1.EgressIP]("cm-route-advertisements-eip-controller", eipConfig)
+	}
+
+	return c
+}
+
+func (c *Controller) controllers() []controller.Reconciler {
+	controllers := []controller.Reconciler{c.raController, c.nodeController}
+	if c.nadController != nil {
+		controllers = append(controllers, c.nadController)
+	}
+	if c.eipController != nil {
+		controllers = append(controllers, c.eipController)
+	}
+	return controllers
+}
+
+// Start starts the RouteAdvertisements controller.
+func (c *Controller) Start() error {
+	klog.Info("Starting cluster manager route advertisements controller")
+	return controller.Start(c.controllers()...)
+}
+
+// Stop stops the RouteAdvertisements controller.
+func (c *Controller) Stop() {
+	klog.Info("Stopping cluster manager route advertisements controller")
+	controller.Stop(c.controllers()...)
+}
+
+func raNeedsUpdate(oldObj, newObj *ratypes.RouteAdvertisements) bool {
+	return oldObj == nil || newObj == nil || oldObj.Generation != newObj.Generation
+}
+
+func nodeNeedsUpdate(oldObj, newObj *corev1.Node) bool {
+	if oldObj == nil || newObj == nil {
+		return true
+	}
+	return !reflect.DeepEqual(oldObj.Labels, newObj.Labels) ||
+		util.NodeSubnetAnnotationChanged(oldObj, newObj) ||
+		util.NodeNetworkIDsAnnotationChanged(oldObj, newObj) ||
+		util.NodeRouteAdvertisementsAnnotationChanged(oldObj, newObj)
+}
+
+func eipNeedsUpdate(oldObj, newObj *egressipv1.EgressIP) bool {
+	return oldObj == nil || newObj == nil || !reflect.DeepEqual(oldObj.Status, newObj.Status)
+}
+
+func (c *Controller) reconcileAllNodes(_ string) error {
+	c.nodeController.ReconcileAll()
+	return nil
+}
+
+// reconcileRouteAdvertisements validates the RouteAdvertisements, updates its
+// status accordingly and requeues all the nodes for their advertisements to
+// be recomputed.
+func (c *Controller) reconcileRouteAdvertisements(key string) error {
+	defer c.nodeController.ReconcileAll()
+
+	ra, err := c.raLister.Get(key)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get RouteAdvertisements %q from cache: %w", key, err)
+	}
+
+	condition := metav1.Condition{
+		Type:    conditionTypeAccepted,
+		Status:  metav1.ConditionTrue,
+		Reason:  reasonAccepted,
+		Message: "RouteAdvertisements configuration accepted",
+	}
+	status := "Accepted"
+	if err := c.validate(ra); err != nil {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = reasonConfigError
+		condition.Message = err.Error()
+		status = fmt.Sprintf("Not Accepted: %v", err)
+	}
+	condition.ObservedGeneration = ra.Generation
+
+	updated := ra.DeepCopy()
+	updated.Status.Status = status
+	meta.SetStatusCondition(&updated.Status.Conditions, condition)
+	if reflect.DeepEqual(ra.Status, updated.Status) {
+		return nil
+	}
+	_, err = c.raClient.K8sV1().RouteAdvertisements().UpdateStatus(context.TODO(), updated, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to update status of RouteAdvertisements %q: %w", key, err)
+	}
+	return nil
+}
+
+func (c *Controller) validate(ra *ratypes.RouteAdvertisements) error {
+	if ra.Spec.NetworkSelector == nil {
+		if ra.Spec.TargetVRF == ratypes.TargetVRFAuto {
+			return fmt.Errorf("targetVRF %q is only supported for user defined networks", ratypes.TargetVRFAuto)
+		}
+	} else {
+		if !util.IsNetworkSegmentationSupportEnabled() {
+			return fmt.Errorf("network selector requires user defined networks to be enabled")
+		}
+		if _, err := metav1.LabelSelectorAsSelector(ra.Spec.NetworkSelector); err != nil {
+			return fmt.Errorf("invalid network selector: %w", err)
+		}
+	}
+	if _, err := metav1.LabelSelectorAsSelector(&ra.Spec.NodeSelector); err != nil {
+		return fmt.Errorf("invalid node selector: %w", err)
+	}
+	for _, advertisement := range ra.Spec.Advertisements {
+		switch advertisement {
+		case ratypes.PodNetwork:
+		case ratypes.EgressIP:
+			if ra.Spec.NetworkSelector != nil {
+				return fmt.Errorf("%s advertisements are only supported for the default network", ratypes.EgressIP)
+			}
+			if !config.OVNKubernetesFeature.EnableEgressIP {
+				return fmt.Errorf("%s advertisements require egress IP to be enabled", ratypes.EgressIP)
+			}
+		default:
+			return fmt.Errorf("unknown advertisement type %q", advertisement)
+		}
+	}
+	return nil
+}
+
+// reconcileNode computes the route advertisements of the node from all the
+// valid RouteAdvertisements selecting it and updates the node annotation if
+// they changed.
+func (c *Controller) reconcileNode(key string) error {
+	node, err := c.nodeLister.Get(key)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get node %q from cache: %w", key, err)
+	}
+
+	advertisements, err := c.getNodeRouteAdvertisements(node)
+	if err != nil {
+		return fmt.Errorf("failed to compute route advertisements of node %q: %w", key, err)
+	}
+
+	var value interface{}
+	if len(advertisements) > 0 {
+		bytes, err := json.Marshal(advertisements)
+		if err != nil {
+			return fmt.Errorf("failed to marshal route advertisements of node %q: %w", key, err)
+		}
+		if node.Annotations[util.OvnNodeRouteAdvertisements] == string(bytes) {
+			return nil
+		}
+		value = string(bytes)
+	} else if _, ok := node.Annotations[util.OvnNodeRouteAdvertisements]; !ok {
+		return nil
+	}
+
+	return c.kube.SetAnnotationsOnNode(node.Name, map[string]interface{}{util.OvnNodeRouteAdvertisements: value})
+}
+
+func (c *Controller) getNodeRouteAdvertisements(node *corev1.Node) (map[string]util.NodeRouteAdvertisements, error) {
+	ras, err := c.raLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	// process the RouteAdvertisements in a stable order so that conflicts
+	// between them are consistently resolved
+	sort.Slice(ras, func(i, j int) bool { return ras[i].Name < ras[j].Name })
+
+	advertisements := map[string]util.NodeRouteAdvertisements{}
+	for _, ra := range ras {
+		if err := c.validate(ra); err != nil {
+			continue
+		}
+		nodeSelector, _ := metav1.LabelSelectorAsSelector(&ra.Spec.NodeSelector)
+		if !nodeSelector.Matches(labels.Set(node.Labels)) {
+			continue
+		}
+		networks, err := c.getSelectedNetworks(ra)
+		if err != nil {
+			return nil, err
+		}
+		for _, netInfo := range networks {
+			netName := netInfo.GetNetworkName()
+			if _, ok := advertisements[netName]; ok {
+				klog.Warningf("Network %s is selected by more than one RouteAdvertisements, ignoring RouteAdvertisements %s for it",
+					netName, ra.Name)
+				continue
+			}
+			advertisement, err := c.getNetworkRouteAdvertisements(node, netInfo, ra)
+			if err != nil {
+				return nil, err
+			}
+			if advertisement != nil {
+				advertisements[netName] = *advertisement
+			}
+		}
+	}
+	return advertisements, nil
+}
+
+// getSelectedNetworks returns the networks selected by the RouteAdvertisements:
+// the default network or the primary layer3 and layer2 user defined networks
+// whose NetworkAttachmentDefinitions match the network selector.
+func (c *Controller) getSelectedNetworks(ra *ratypes.RouteAdvertisements) ([]util.NetInfo, error) {
+	if ra.Spec.NetworkSelector == nil {
+		return []util.NetInfo{&util.DefaultNetInfo{}}, nil
+	}
+	selector, _ := metav1.LabelSelectorAsSelector(ra.Spec.NetworkSelector)
+	nads, err := c.nadLister.List(selector)
+	if err != nil {
+		return nil, err
+	}
+	networks := []util.NetInfo{}
+	seen := map[string]bool{}
+	for _, nad := range nads {
+		netInfo, err := util.ParseNADInfo(nad)
+		if err != nil {
+			klog.Warningf("Failed to parse network attachment definition %s/%s selected by RouteAdvertisements %s: %v",
+				nad.Namespace, nad.Name, ra.Name, err)
+			continue
+		}
+		if !netInfo.IsPrimaryNetwork() ||
+			(netInfo.TopologyType() != types.Layer3Topology && netInfo.TopologyType() != types.Layer2Topology) {
+			continue
+		}
+		if seen[netInfo.GetNetworkName()] {
+			continue
+		}
+		seen[netInfo.GetNetworkName()] = true
+		networks = append(networks, netInfo)
+	}
+	return networks, nil
+}
+
+func (c *Controller) getNetworkRouteAdvertisements(node *corev1.Node, netInfo util.NetInfo,
+	ra *ratypes.RouteAdvertisements) (*util.NodeRouteAdvertisements, error) {
+	netName := netInfo.GetNetworkName()
+	advertisement := &util.NodeRouteAdvertisements{
+		TargetVRF: ra.Spec.TargetVRF,
+	}
+	if !netInfo.IsDefault() {
+		networkID, err := util.ParseNetworkIDAnnotation(node, netName)
+		if err != nil {
+			if util.IsAnnotationNotSetError(err) {
+				// not yet allocated, the node will be reconciled again once it is
+				return nil, nil
+			}
+			return nil, err
+		}
+		advertisement.NetworkVRF = util.GetVRFDeviceNameForUDN(networkID)
+		if advertisement.TargetVRF == ratypes.TargetVRFAuto {
+			advertisement.TargetVRF = advertisement.NetworkVRF
+		}
+	}
+
+	for _, subnet := range netInfo.Subnets() {
+		advertisement.Subnets = append(advertisement.Subnets, subnet.CIDR.String())
+	}
+
+	for _, advertisementType := range ra.Spec.Advertisements {
+		switch advertisementType {
+		case ratypes.PodNetwork:
+			if netInfo.TopologyType() == types.Layer2Topology {
+				// a layer2 network spans all the nodes, each of them advertises
+				// its whole subnets
+				advertisement.Prefixes = append(advertisement.Prefixes, advertisement.Subnets...)
+				continue
+			}
+			subnets, err := util.ParseNodeHostSubnetAnnotation(node, netName)
+			if err != nil {
+				if util.IsAnnotationNotSetError(err) {
+					continue
+				}
+				return nil, err
+			}
+			for _, subnet := range subnets {
+				advertisement.Prefixes = append(advertisement.Prefixes, subnet.String())
+			}
+		case ratypes.EgressIP:
+			prefixes, err := c.getNodeEgressIPPrefixes(node.Name)
+			if err != nil {
+				return nil, err
+			}
+			advertisement.Prefixes = append(advertisement.Prefixes, prefixes...)
+		}
+	}
+	if len(advertisement.Prefixes) == 0 {
+		return nil, nil
+	}
+	sort.Strings(advertisement.Prefixes)
+	return advertisement, nil
+}
+
+func (c *Controller) getNodeEgressIPPrefixes(nodeName string) ([]string, error) {
+	eips, err := c.eipLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	prefixes := []string{}
+	for _, eip := range eips {
+		for _, item := range eip.Status.Items {
+			if item.Node != nodeName {
+				continue
+			}
+			prefix, err := util.GetIPNetFullMask(item.EgressIP)
+			if err != nil {
+				klog.Warningf("Ignoring invalid egress IP %q of EgressIP %s: %v", item.EgressIP, eip.Name, err)
+				continue
+			}
+			prefixes = append(prefixes, prefix.String())
+		}
+	}
+	return prefixes, nil
+}
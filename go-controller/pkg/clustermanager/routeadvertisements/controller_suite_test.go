@@ -0,0 +1,13 @@
+package routeadvertisements
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestRouteAdvertisementsController(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Cluster Manager Route Advertisements Controller Suite")
+}
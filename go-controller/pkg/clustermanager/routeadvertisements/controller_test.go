@@ -0,0 +1,198 @@
+package routeadvertisements
+
+import (
+	"context"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	egressipv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1"
+	ratypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
+	ovntest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ = ginkgo.Describe("Cluster manager Route Advertisements Controller operations", func() {
+	var (
+		raController *Controller
+		wf           *factory.WatchFactory
+		fakeClient   *util.OVNClusterManagerClientset
+	)
+
+	start := func(objects ...runtime.Object) {
+		fakeClient = util.GetOVNClientset(objects...).GetClusterManagerClientset()
+		var err error
+		wf, err = factory.NewClusterManagerWatchFactory(fakeClient)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		raController = NewController(fakeClient, wf)
+
+		err = wf.Start()
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		err = raController.Start()
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	}
+
+	buildNode := func(name string, labels map[string]string, hostSubnets ...string) *corev1.Node {
+		annotations, err := util.UpdateNodeHostSubnetAnnotation(map[string]string{}, ovntest.MustParseIPNets(hostSubnets...), types.DefaultNetworkName)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Labels:      labels,
+				Annotations: annotations,
+			},
+		}
+	}
+
+	buildRouteAdvertisements := func(name string, nodeSelector metav1.LabelSelector, advertisements ...ratypes.AdvertisementType) *ratypes.RouteAdvertisements {
+		return &ratypes.RouteAdvertisements{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Spec: ratypes.RouteAdvertisementsSpec{
+				NodeSelector:   nodeSelector,
+				Advertisements: advertisements,
+			},
+		}
+	}
+
+	getNodeRouteAdvertisements := func(name string) func() map[string]util.NodeRouteAdvertisements {
+		return func() map[string]util.NodeRouteAdvertisements {
+			node, err := fakeClient.KubeClient.CoreV1().Nodes().Get(context.TODO(), name, metav1.GetOptions{})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			advertisements, err := util.ParseNodeRouteAdvertisementsAnnotation(node)
+			if util.IsAnnotationNotSetError(err) {
+				return nil
+			}
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			return advertisements
+		}
+	}
+
+	getAcceptedCondition := func(name string) func() *metav1.Condition {
+		return func() *metav1.Condition {
+			ra, err := fakeClient.RouteAdvertisementsClient.K8sV1().RouteAdvertisements().Get(context.TODO(), name, metav1.GetOptions{})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			return meta.FindStatusCondition(ra.Status.Conditions, conditionTypeAccepted)
+		}
+	}
+
+	ginkgo.BeforeEach(func() {
+		gomega.Expect(config.PrepareTestConfig()).To(gomega.Succeed())
+		config.OVNKubernetesFeature.EnableRouteAdvertisements = true
+		config.Default.ClusterSubnets = []config.CIDRNetworkEntry{{CIDR: ovntest.MustParseIPNet("10.128.0.0/14"), HostSubnetLength: 24}}
+		wf = nil
+		raController = nil
+	})
+
+	ginkgo.AfterEach(func() {
+		if wf != nil {
+			wf.Shutdown()
+		}
+		if raController != nil {
+			raController.Stop()
+		}
+	})
+
+	ginkgo.It("advertises the pod network of the selected nodes", func() {
+		start(
+			buildNode("node1", map[string]string{"bgp": "true"}, "10.128.1.0/24"),
+			buildNode("node2", nil, "10.128.2.0/24"),
+			buildRouteAdvertisements("default", metav1.LabelSelector{MatchLabels: map[string]string{"bgp": "true"}}, ratypes.PodNetwork),
+		)
+
+		gomega.Eventually(getNodeRouteAdvertisements("node1")).Should(gomega.Equal(map[string]util.NodeRouteAdvertisements{
+			types.DefaultNetworkName: {
+				Prefixes: []string{"10.128.1.0/24"},
+				Subnets:  []string{"10.128.0.0/14"},
+			},
+		}))
+		gomega.Consistently(getNodeRouteAdvertisements("node2")).Should(gomega.BeNil())
+
+		condition := getAcceptedCondition("default")()
+		gomega.Expect(condition).NotTo(gomega.BeNil())
+		gomega.Expect(condition.Status).To(gomega.Equal(metav1.ConditionTrue))
+
+		ginkgo.By("deleting the RouteAdvertisements")
+		err := fakeClient.RouteAdvertisementsClient.K8sV1().RouteAdvertisements().Delete(context.TODO(), "default", metav1.DeleteOptions{})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Eventually(getNodeRouteAdvertisements("node1")).Should(gomega.BeNil())
+	})
+
+	ginkgo.It("advertises the egress IPs assigned to the node", func() {
+		config.OVNKubernetesFeature.EnableEgressIP = true
+		eip := &egressipv1.EgressIP{
+			ObjectMeta: metav1.ObjectMeta{Name: "eip"},
+			Spec: egressipv1.EgressIPSpec{
+				EgressIPs: []string{"172.18.0.100", "172.18.0.101"},
+			},
+			Status: egressipv1.EgressIPStatus{
+				Items: []egressipv1.EgressIPStatusItem{
+					{Node: "node1", EgressIP: "172.18.0.100"},
+					{Node: "node2", EgressIP: "172.18.0.101"},
+				},
+			},
+		}
+		start(
+			buildNode("node1", nil, "10.128.1.0/24"),
+			eip,
+			buildRouteAdvertisements("default", metav1.LabelSelector{}, ratypes.PodNetwork, ratypes.EgressIP),
+		)
+
+		gomega.Eventually(getNodeRouteAdvertisements("node1")).Should(gomega.Equal(map[string]util.NodeRouteAdvertisements{
+			types.DefaultNetworkName: {
+				Prefixes: []string{"10.128.1.0/24", "172.18.0.100/32"},
+				Subnets:  []string{"10.128.0.0/14"},
+			},
+		}))
+	})
+
+	ginkgo.It("advertises the subnets of a primary layer2 user defined network in its VRF", func() {
+		config.OVNKubernetesFeature.EnableMultiNetwork = true
+		config.OVNKubernetesFeature.EnableNetworkSegmentation = true
+		nad := ovntest.GenerateNAD("blue", "blue", "ns1", types.Layer2Topology, "10.10.0.0/16", types.NetworkRolePrimary)
+		nad.Labels = map[string]string{"advertise": "true"}
+		node := buildNode("node1", nil, "10.128.1.0/24")
+		var err error
+		node.Annotations, err = util.UpdateNetworkIDAnnotation(node.Annotations, "blue", 3)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		ra := buildRouteAdvertisements("blue", metav1.LabelSelector{}, ratypes.PodNetwork)
+		ra.Spec.TargetVRF = ratypes.TargetVRFAuto
+		ra.Spec.NetworkSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"advertise": "true"}}
+		start(node, nad, ra)
+
+		gomega.Eventually(getNodeRouteAdvertisements("node1")).Should(gomega.Equal(map[string]util.NodeRouteAdvertisements{
+			"blue": {
+				TargetVRF:  util.GetVRFDeviceNameForUDN(3),
+				NetworkVRF: util.GetVRFDeviceNameForUDN(3),
+				Prefixes:   []string{"10.10.0.0/16"},
+				Subnets:    []string{"10.10.0.0/16"},
+			},
+		}))
+	})
+
+	ginkgo.It("does not accept an invalid configuration", func() {
+		ra := buildRouteAdvertisements("auto", metav1.LabelSelector{}, ratypes.PodNetwork)
+		ra.Spec.TargetVRF = ratypes.TargetVRFAuto
+		start(
+			buildNode("node1", nil, "10.128.1.0/24"),
+			ra,
+		)
+
+		gomega.Eventually(getAcceptedCondition("auto")).Should(gomega.And(
+			gomega.Not(gomega.BeNil()),
+			gomega.HaveField("Status", metav1.ConditionFalse),
+			gomega.HaveField("Reason", reasonConfigError),
+		))
+		gomega.Consistently(getNodeRouteAdvertisements("node1")).Should(gomega.BeNil())
+	})
+})
@@ -0,0 +1,125 @@
+package status_manager
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	egressipapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1"
+	egressipapply "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1/apis/applyconfiguration/egressip/v1"
+	egressipclientset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1/apis/clientset/versioned"
+	egressiplisters "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1/apis/listers/egressip/v1"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metaapplyv1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+const (
+	egressIPProgrammedReason    = "ProgrammingSucceeded"
+	egressIPNotProgrammedReason = "ProgrammingFailed"
+)
+
+type egressIPManager struct {
+	lister egressiplisters.EgressIPLister
+	client egressipclientset.Interface
+}
+
+func newEgressIPManager(lister egressiplisters.EgressIPLister, client egressipclientset.Interface) *egressIPManager {
+	return &egressIPManager{
+		lister: lister,
+		client: client,
+	}
+}
+
+//lint:ignore U1000 generic interfaces throw false-positives https://github.com/dominikh/go-tools/issues/1440
+func (m *egressIPManager) get(namespace, name string) (*egressipapi.EgressIP, error) {
+	return m.lister.Get(name)
+}
+
+// getMessages only considers the conditions reported by the zones, the other
+// conditions are owned by cluster-manager.
+//
+//lint:ignore U1000 generic interfaces throw false-positives
+func (m *egressIPManager) getMessages(egressIP *egressipapi.EgressIP) []string {
+	var messages []string
+	for _, condition := range egressIP.Status.Conditions {
+		if strings.HasPrefix(condition.Type, egressipapi.EgressIPConditionProgrammedInZonePrefix) {
+			messages = append(messages, condition.Message)
+		}
+	}
+	return messages
+}
+
+// updateStatus sets the Programmed condition, which is only true when the
+// EgressIP is programmed in all the zones.
+//
+//lint:ignore U1000 generic interfaces throw false-positives
+func (m *egressIPManager) updateStatus(egressIP *egressipapi.EgressIP, applyOpts *metav1.ApplyOptions,
+	applyEmptyOrFailed bool) error {
+	if egressIP == nil {
+		return nil
+	}
+	newCondition := metav1.Condition{
+		Type:    egressipapi.EgressIPConditionProgrammed,
+		Status:  metav1.ConditionTrue,
+		Reason:  egressIPProgrammedReason,
+		Message: "EgressIP programmed in all zones",
+	}
+	var failedZones []string
+	for _, condition := range egressIP.Status.Conditions {
+		if strings.HasPrefix(condition.Type, egressipapi.EgressIPConditionProgrammedInZonePrefix) &&
+			strings.Contains(condition.Message, types.EgressIPErrorMsg) {
+			failedZones = append(failedZones, strings.TrimPrefix(condition.Type, egressipapi.EgressIPConditionProgrammedInZonePrefix))
+		}
+	}
+	if len(failedZones) > 0 {
+		newCondition.Status = metav1.ConditionFalse
+		newCondition.Reason = egressIPNotProgrammedReason
+		newCondition.Message = types.EgressIPErrorMsg + " in zones: " + strings.Join(failedZones, ", ")
+	} else if applyEmptyOrFailed {
+		newCondition.Status = ""
+	}
+
+	existingCondition := meta.FindStatusCondition(egressIP.Status.Conditions, egressipapi.EgressIPConditionProgrammed)
+	if newCondition.Status == "" && existingCondition == nil {
+		// already unset
+		return nil
+	}
+	if existingCondition != nil && existingCondition.Status == newCondition.Status &&
+		existingCondition.Reason == newCondition.Reason && existingCondition.Message == newCondition.Message {
+		// already set to the same value
+		return nil
+	}
+
+	applyStatus := egressipapply.EgressIPStatus()
+	if newCondition.Status != "" {
+		newCondition.LastTransitionTime = metav1.NewTime(time.Now())
+		if existingCondition != nil && existingCondition.Status == newCondition.Status {
+			newCondition.LastTransitionTime = existingCondition.LastTransitionTime
+		}
+		applyStatus.WithConditions(&metaapplyv1.ConditionApplyConfiguration{
+			Type:               &newCondition.Type,
+			Status:             &newCondition.Status,
+			LastTransitionTime: &newCondition.LastTransitionTime,
+			Reason:             &newCondition.Reason,
+			Message:            &newCondition.Message,
+		})
+	}
+
+	applyObj := egressipapply.EgressIP(egressIP.Name).
+		WithStatus(applyStatus)
+
+	_, err := m.client.K8sV1().EgressIPs().ApplyStatus(context.TODO(), applyObj, *applyOpts)
+	return err
+}
+
+//lint:ignore U1000 generic interfaces throw false-positives
+func (m *egressIPManager) cleanupStatus(egressIP *egressipapi.EgressIP, applyOpts *metav1.ApplyOptions) error {
+	applyObj := egressipapply.EgressIP(egressIP.Name).
+		WithStatus(egressipapply.EgressIPStatus())
+
+	_, err := m.client.K8sV1().EgressIPs().ApplyStatus(context.TODO(), applyObj, *applyOpts)
+	return err
+}
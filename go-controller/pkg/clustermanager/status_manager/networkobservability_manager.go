@@ -0,0 +1,94 @@
+package status_manager
+
+import (
+	"context"
+	"strings"
+
+	networkobservabilityapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkobservability/v1"
+	networkobservabilityapply "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkobservability/v1/apis/applyconfiguration/networkobservability/v1"
+	networkobservabilityclientset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkobservability/v1/apis/clientset/versioned"
+	networkobservabilitylisters "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkobservability/v1/apis/listers/networkobservability/v1"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const networkObservabilityAppliedStatus = "Applied"
+
+type networkObservabilityManager struct {
+	lister networkobservabilitylisters.NetworkObservabilityLister
+	client networkobservabilityclientset.Interface
+}
+
+func newNetworkObservabilityManager(lister networkobservabilitylisters.NetworkObservabilityLister,
+	client networkobservabilityclientset.Interface) *networkObservabilityManager {
+	return &networkObservabilityManager{
+		lister: lister,
+		client: client,
+	}
+}
+
+//lint:ignore U1000 generic interfaces throw false-positives https://github.com/dominikh/go-tools/issues/1440
+func (m *networkObservabilityManager) get(namespace, name string) (*networkobservabilityapi.NetworkObservability, error) {
+	return m.lister.Get(name)
+}
+
+//lint:ignore U1000 generic interfaces throw false-positives
+func (m *networkObservabilityManager) getMessages(observ *networkobservabilityapi.NetworkObservability) []string {
+	var messages []string
+	for _, condition := range observ.Status.Conditions {
+		if strings.HasPrefix(condition.Type, networkobservabilityapi.NetworkObservabilityConditionAppliedInZonePrefix) {
+			messages = append(messages, condition.Message)
+		}
+	}
+	return messages
+}
+
+// updateStatus sets the Status, which is only Applied when the configuration is applied in all the zones.
+//
+//lint:ignore U1000 generic interfaces throw false-positives
+func (m *networkObservabilityManager) updateStatus(observ *networkobservabilityapi.NetworkObservability,
+	applyOpts *metav1.ApplyOptions, applyEmptyOrFailed bool) error {
+	if observ == nil {
+		return nil
+	}
+	newStatus := networkObservabilityAppliedStatus
+	var failedZones []string
+	for _, condition := range observ.Status.Conditions {
+		if strings.HasPrefix(condition.Type, networkobservabilityapi.NetworkObservabilityConditionAppliedInZonePrefix) &&
+			strings.Contains(condition.Message, types.NetworkObservabilityErrorMsg) {
+			failedZones = append(failedZones, strings.TrimPrefix(condition.Type, networkobservabilityapi.NetworkObservabilityConditionAppliedInZonePrefix))
+		}
+	}
+	if len(failedZones) > 0 {
+		newStatus = types.NetworkObservabilityErrorMsg + " in zones: " + strings.Join(failedZones, ", ")
+	} else if applyEmptyOrFailed {
+		newStatus = ""
+	}
+
+	if observ.Status.Status == newStatus {
+		// already set to the same value
+		return nil
+	}
+
+	applyStatus := networkobservabilityapply.NetworkObservabilityStatus()
+	if newStatus != "" {
+		applyStatus.WithStatus(newStatus)
+	}
+
+	applyObj := networkobservabilityapply.NetworkObservability(observ.Name).
+		WithStatus(applyStatus)
+
+	_, err := m.client.K8sV1().NetworkObservabilities().ApplyStatus(context.TODO(), applyObj, *applyOpts)
+	return err
+}
+
+//lint:ignore U1000 generic interfaces throw false-positives
+func (m *networkObservabilityManager) cleanupStatus(observ *networkobservabilityapi.NetworkObservability,
+	applyOpts *metav1.ApplyOptions) error {
+	applyObj := networkobservabilityapply.NetworkObservability(observ.Name).
+		WithStatus(networkobservabilityapply.NetworkObservabilityStatus())
+
+	_, err := m.client.K8sV1().NetworkObservabilities().ApplyStatus(context.TODO(), applyObj, *applyOpts)
+	return err
+}
@@ -11,7 +11,9 @@ import (
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/controller"
 	adminpolicybasedrouteapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/adminpolicybasedroute/v1"
 	egressfirewallapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressfirewall/v1"
+	egressipapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1"
 	egressqosapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressqos/v1"
+	networkobservabilityapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkobservability/v1"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
@@ -203,6 +205,26 @@ func NewStatusManager(wf *factory.WatchFactory, ovnClient *util.OVNClusterManage
 		)
 		sm.typedManagers["egressqoses"] = egressQoSManager
 	}
+	if config.OVNKubernetesFeature.EnableEgressIP {
+		egressIPManager := newStatusManager[egressipapi.EgressIP](
+			"egressips_statusmanager",
+			wf.EgressIPInformer().Informer(),
+			wf.EgressIPInformer().Lister().List,
+			newEgressIPManager(wf.EgressIPInformer().Lister(), ovnClient.EgressIPClient),
+			sm.withZonesRLock,
+		)
+		sm.typedManagers["egressips"] = egressIPManager
+	}
+	if config.OVNKubernetesFeature.EnableObservability {
+		networkObservabilityManager := newStatusManager[networkobservabilityapi.NetworkObservability](
+			"networkobservabilities_statusmanager",
+			wf.NetworkObservabilityInformer().Informer(),
+			wf.NetworkObservabilityInformer().Lister().List,
+			newNetworkObservabilityManager(wf.NetworkObservabilityInformer().Lister(), ovnClient.ObservabilityClient),
+			sm.withZonesRLock,
+		)
+		sm.typedManagers["networkobservabilities"] = networkObservabilityManager
+	}
 	return sm
 }
 
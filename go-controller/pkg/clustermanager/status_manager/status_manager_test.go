@@ -18,16 +18,21 @@ import (
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 	adminpolicybasedrouteapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/adminpolicybasedroute/v1"
 	egressfirewallapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressfirewall/v1"
+	egressipapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1"
 	egressqosapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressqos/v1"
+	networkobservabilityapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkobservability/v1"
+	networkobservabilityfake "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkobservability/v1/apis/clientset/versioned/fake"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
 
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/pointer"
+	"k8s.io/utils/ptr"
 )
 
 func getNodeWithZone(nodeName, zoneName string) *v1.Node {
@@ -164,7 +169,7 @@ func newEgressQoS(namespace string) *egressqosapi.EgressQoS {
 		Spec: egressqosapi.EgressQoSSpec{
 			Egress: []egressqosapi.EgressQoSRule{
 				{
-					DSCP:    60,
+					DSCP:    ptr.To(60),
 					DstCIDR: pointer.String("1.2.3.4/32"),
 				},
 			},
@@ -204,6 +209,127 @@ func checkEmptyEQStatusConsistently(egressQoS *egressqosapi.EgressQoS, fakeClien
 	}).Should(BeTrue(), "expected Status to be consistently empty")
 }
 
+func newEgressIP(name string) *egressipapi.EgressIP {
+	return &egressipapi.EgressIP{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: egressipapi.EgressIPSpec{
+			EgressIPs: []string{"192.168.126.101"},
+		},
+	}
+}
+
+func newEgressIPZoneCondition(zone string, failed bool) metav1.Condition {
+	if failed {
+		return metav1.Condition{
+			Type:    egressipapi.EgressIPConditionProgrammedInZonePrefix + zone,
+			Status:  metav1.ConditionFalse,
+			Reason:  "ProgrammingFailed",
+			Message: types.GetZoneStatus(zone, types.EgressIPErrorMsg+": error"),
+		}
+	}
+	return metav1.Condition{
+		Type:    egressipapi.EgressIPConditionProgrammedInZonePrefix + zone,
+		Status:  metav1.ConditionTrue,
+		Reason:  "ProgrammingSucceeded",
+		Message: types.GetZoneStatus(zone, "EgressIP programmed"),
+	}
+}
+
+func updateEgressIPStatus(egressIP *egressipapi.EgressIP, status *egressipapi.EgressIPStatus,
+	fakeClient *util.OVNClusterManagerClientset) {
+	egressIP.Status = *status
+	_, err := fakeClient.EgressIPClient.K8sV1().EgressIPs().
+		Update(context.TODO(), egressIP, metav1.UpdateOptions{})
+	Expect(err).ToNot(HaveOccurred())
+}
+
+func checkEIPStatusEventually(egressIP *egressipapi.EgressIP, expectFailure bool, fakeClient *util.OVNClusterManagerClientset) {
+	Eventually(func() bool {
+		eIP, err := fakeClient.EgressIPClient.K8sV1().EgressIPs().
+			Get(context.TODO(), egressIP.Name, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		condition := meta.FindStatusCondition(eIP.Status.Conditions, egressipapi.EgressIPConditionProgrammed)
+		if condition == nil {
+			return false
+		}
+		if expectFailure {
+			return condition.Status == metav1.ConditionFalse && strings.Contains(condition.Message, types.EgressIPErrorMsg)
+		}
+		return condition.Status == metav1.ConditionTrue
+	}).Should(BeTrue(), fmt.Sprintf("expected egress IP Programmed condition with expectFailure=%v", expectFailure))
+}
+
+func checkEmptyEIPStatusConsistently(egressIP *egressipapi.EgressIP, fakeClient *util.OVNClusterManagerClientset) {
+	Consistently(func() *metav1.Condition {
+		eIP, err := fakeClient.EgressIPClient.K8sV1().EgressIPs().
+			Get(context.TODO(), egressIP.Name, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		return meta.FindStatusCondition(eIP.Status.Conditions, egressipapi.EgressIPConditionProgrammed)
+	}).Should(BeNil(), "expected Programmed condition to be consistently unset")
+}
+
+func newNetworkObservability() *networkobservabilityapi.NetworkObservability {
+	return &networkobservabilityapi.NetworkObservability{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+		Spec: networkobservabilityapi.NetworkObservabilitySpec{
+			Collectors: []networkobservabilityapi.NetworkObservabilityCollector{{
+				CollectorSetID: 1,
+				Features: []networkobservabilityapi.FeatureSampling{
+					{Feature: networkobservabilityapi.NetworkPolicy, Probability: 100},
+				},
+			}},
+		},
+	}
+}
+
+func newNetworkObservabilityZoneCondition(zone string, failed bool) metav1.Condition {
+	if failed {
+		return metav1.Condition{
+			Type:    networkobservabilityapi.NetworkObservabilityConditionAppliedInZonePrefix + zone,
+			Status:  metav1.ConditionFalse,
+			Reason:  "ConfigError",
+			Message: types.GetZoneStatus(zone, types.NetworkObservabilityErrorMsg+": error"),
+		}
+	}
+	return metav1.Condition{
+		Type:    networkobservabilityapi.NetworkObservabilityConditionAppliedInZonePrefix + zone,
+		Status:  metav1.ConditionTrue,
+		Reason:  "Applied",
+		Message: types.GetZoneStatus(zone, "Observability configuration applied"),
+	}
+}
+
+func updateNetworkObservabilityStatus(observ *networkobservabilityapi.NetworkObservability,
+	status *networkobservabilityapi.NetworkObservabilityStatus, fakeClient *util.OVNClusterManagerClientset) {
+	observ.Status = *status
+	_, err := fakeClient.ObservabilityClient.K8sV1().NetworkObservabilities().
+		Update(context.TODO(), observ, metav1.UpdateOptions{})
+	Expect(err).ToNot(HaveOccurred())
+}
+
+func checkNetworkObservabilityStatusEventually(observ *networkobservabilityapi.NetworkObservability, expectFailure bool,
+	fakeClient *util.OVNClusterManagerClientset) {
+	Eventually(func() bool {
+		updated, err := fakeClient.ObservabilityClient.K8sV1().NetworkObservabilities().
+			Get(context.TODO(), observ.Name, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		if expectFailure {
+			return strings.Contains(updated.Status.Status, types.NetworkObservabilityErrorMsg)
+		}
+		return updated.Status.Status == networkObservabilityAppliedStatus
+	}).Should(BeTrue(), fmt.Sprintf("expected network observability status with expectFailure=%v", expectFailure))
+}
+
+func checkEmptyNetworkObservabilityStatusConsistently(observ *networkobservabilityapi.NetworkObservability,
+	fakeClient *util.OVNClusterManagerClientset) {
+	Consistently(func() string {
+		updated, err := fakeClient.ObservabilityClient.K8sV1().NetworkObservabilities().
+			Get(context.TODO(), observ.Name, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		return updated.Status.Status
+	}).Should(BeEmpty(), "expected Status to be consistently empty")
+}
+
 var _ = Describe("Cluster Manager Status Manager", func() {
 	var (
 		statusManager *StatusManager
@@ -447,6 +573,104 @@ var _ = Describe("Cluster Manager Status Manager", func() {
 		}, fakeClient)
 		checkEQStatusEventually(egressQoS, false, false, fakeClient)
 	})
+	It("updates EgressIP status with 1 zone", func() {
+		config.OVNKubernetesFeature.EnableEgressIP = true
+		zones := sets.New[string]("zone1")
+		egressIP := newEgressIP("egressip")
+		start(zones, egressIP)
+
+		updateEgressIPStatus(egressIP, &egressipapi.EgressIPStatus{
+			Conditions: []metav1.Condition{newEgressIPZoneCondition("zone1", false)},
+		}, fakeClient)
+		checkEIPStatusEventually(egressIP, false, fakeClient)
+	})
+
+	It("updates EgressIP status with 2 zones", func() {
+		config.OVNKubernetesFeature.EnableEgressIP = true
+		zones := sets.New[string]("zone1", "zone2")
+		egressIP := newEgressIP("egressip")
+		start(zones, egressIP)
+
+		updateEgressIPStatus(egressIP, &egressipapi.EgressIPStatus{
+			Conditions: []metav1.Condition{newEgressIPZoneCondition("zone1", false)},
+		}, fakeClient)
+		checkEmptyEIPStatusConsistently(egressIP, fakeClient)
+
+		updateEgressIPStatus(egressIP, &egressipapi.EgressIPStatus{
+			Conditions: []metav1.Condition{
+				newEgressIPZoneCondition("zone1", false),
+				newEgressIPZoneCondition("zone2", true),
+			},
+		}, fakeClient)
+		checkEIPStatusEventually(egressIP, true, fakeClient)
+	})
+
+	It("updates EgressIP status with a failed zone before all zones report", func() {
+		config.OVNKubernetesFeature.EnableEgressIP = true
+		zones := sets.New[string]("zone1", "zone2")
+		egressIP := newEgressIP("egressip")
+		start(zones, egressIP)
+
+		updateEgressIPStatus(egressIP, &egressipapi.EgressIPStatus{
+			Conditions: []metav1.Condition{newEgressIPZoneCondition("zone1", true)},
+		}, fakeClient)
+		checkEIPStatusEventually(egressIP, true, fakeClient)
+	})
+
+	It("updates NetworkObservability status with 2 zones", func() {
+		config.OVNKubernetesFeature.EnableObservability = true
+		zones := sets.New[string]("zone1", "zone2")
+		observ := newNetworkObservability()
+		start(zones, observ)
+
+		updateNetworkObservabilityStatus(observ, &networkobservabilityapi.NetworkObservabilityStatus{
+			Conditions: []metav1.Condition{newNetworkObservabilityZoneCondition("zone1", false)},
+		}, fakeClient)
+		checkEmptyNetworkObservabilityStatusConsistently(observ, fakeClient)
+
+		updateNetworkObservabilityStatus(observ, &networkobservabilityapi.NetworkObservabilityStatus{
+			Conditions: []metav1.Condition{
+				newNetworkObservabilityZoneCondition("zone1", false),
+				newNetworkObservabilityZoneCondition("zone2", false),
+			},
+		}, fakeClient)
+		checkNetworkObservabilityStatusEventually(observ, false, fakeClient)
+
+		updateNetworkObservabilityStatus(observ, &networkobservabilityapi.NetworkObservabilityStatus{
+			Conditions: []metav1.Condition{
+				newNetworkObservabilityZoneCondition("zone1", false),
+				newNetworkObservabilityZoneCondition("zone2", true),
+			},
+		}, fakeClient)
+		checkNetworkObservabilityStatusEventually(observ, true, fakeClient)
+	})
+
+	// cleanup can't be tested by unit test apiserver, since it relies on SSA logic with FieldManagers
+	It("cleans up the NetworkObservability status of a deleted zone with the zone as field manager", func() {
+		config.OVNKubernetesFeature.EnableObservability = true
+		zones := sets.New[string]("zone1")
+		observ := newNetworkObservability()
+		observ.Status = networkobservabilityapi.NetworkObservabilityStatus{
+			Conditions: []metav1.Condition{
+				newNetworkObservabilityZoneCondition("zone1", false),
+				newNetworkObservabilityZoneCondition("zone2", false),
+			},
+		}
+		var cleanedUpZone atomic.Value
+		start(zones)
+		fakeClient.ObservabilityClient.(*networkobservabilityfake.Clientset).PrependReactor("patch", "networkobservabilities",
+			func(action clienttesting.Action) (handled bool, ret runtime.Object, err error) {
+				patch := action.(clienttesting.PatchActionImpl)
+				if patch.PatchOptions.FieldManager != clusterManagerName {
+					cleanedUpZone.Store(patch.PatchOptions.FieldManager)
+				}
+				return false, nil, nil
+			})
+		_, err := fakeClient.ObservabilityClient.K8sV1().NetworkObservabilities().Create(context.TODO(), observ, metav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(cleanedUpZone.Load).Should(Equal("zone2"))
+	})
+
 	// cleanup can't be tested by unit test apiserver, since it relies on SSA logic with FieldManagers
 	It("test if APIServer lister/patcher is called for AdminNetworkPolicy when the zone is deleted", func() {
 		config.OVNKubernetesFeature.EnableAdminNetworkPolicy = true
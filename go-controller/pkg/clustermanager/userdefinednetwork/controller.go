@@ -51,6 +51,14 @@ func (n *networkInUseError) Error() string {
 	return n.err.Error()
 }
 
+type physicalNetworkMappingError struct {
+	err error
+}
+
+func (p *physicalNetworkMappingError) Error() string {
+	return p.err.Error()
+}
+
 type Controller struct {
 	// cudnController manage ClusterUserDefinedNetwork CRs.
 	cudnController controller.Controller
@@ -60,6 +68,10 @@ type Controller struct {
 	nadNotifier *notifier.NetAttachDefNotifier
 	// namespaceInformer notifies subscribing controllers about Namespace events.
 	namespaceNotifier *notifier.NamespaceNotifier
+	// nodeNotifier notifies subscribing controllers about Node events.
+	nodeNotifier *notifier.NodeNotifier
+	// podNotifier notifies subscribing controllers about Pod events.
+	podNotifier *notifier.PodNotifier
 	// namespaceTracker tracks each CUDN CRs affected namespaces, enable finding stale NADs.
 	// Keys are CR name, value is affected namespace names slice.
 	namespaceTracker     map[string]sets.Set[string]
@@ -77,6 +89,7 @@ type Controller struct {
 	nadLister         netv1lister.NetworkAttachmentDefinitionLister
 	podInformer       corev1informer.PodInformer
 	namespaceInformer corev1informer.NamespaceInformer
+	nodeInformer      corev1informer.NodeInformer
 
 	networkInUseRequeueInterval time.Duration
 	eventRecorder               record.EventRecorder
@@ -93,6 +106,7 @@ func New(
 	renderNadFn RenderNetAttachDefManifest,
 	podInformer corev1informer.PodInformer,
 	namespaceInformer corev1informer.NamespaceInformer,
+	nodeInformer corev1informer.NodeInformer,
 	eventRecorder record.EventRecorder,
 ) *Controller {
 	udnLister := udnInformer.Lister()
@@ -106,6 +120,7 @@ func New(
 		renderNadFn:                 renderNadFn,
 		podInformer:                 podInformer,
 		namespaceInformer:           namespaceInformer,
+		nodeInformer:                nodeInformer,
 		networkInUseRequeueInterval: defaultNetworkInUseCheckInterval,
 		namespaceTracker:            map[string]sets.Set[string]{},
 		eventRecorder:               eventRecorder,
@@ -132,6 +147,8 @@ func New(
 
 	c.nadNotifier = notifier.NewNetAttachDefNotifier(nadInfomer, c)
 	c.namespaceNotifier = notifier.NewNamespaceNotifier(namespaceInformer, c)
+	c.nodeNotifier = notifier.NewNodeNotifier(nodeInformer, c)
+	c.podNotifier = notifier.NewPodNotifier(podInformer, c)
 
 	return c
 }
@@ -144,6 +161,8 @@ func (c *Controller) Run() error {
 		c.udnController,
 		c.nadNotifier.Controller,
 		c.namespaceNotifier.Controller,
+		c.nodeNotifier.Controller,
+		c.podNotifier.Controller,
 	); err != nil {
 		return fmt.Errorf("unable to start user-defined network controller: %v", err)
 	}
@@ -201,6 +220,8 @@ func (c *Controller) Shutdown() {
 		c.udnController,
 		c.nadNotifier.Controller,
 		c.namespaceNotifier.Controller,
+		c.nodeNotifier.Controller,
+		c.podNotifier.Controller,
 	)
 }
 
@@ -286,6 +307,52 @@ func (c *Controller) ReconcileNamespace(key string) error {
 	return nil
 }
 
+// ReconcileNode enqueue Localnet Cluster UDN CR requests following node events,
+// the physical network mapping of the nodes is reflected in the CR status.
+func (c *Controller) ReconcileNode(key string) error {
+	cudns, err := c.cudnLister.List(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("failed to list CUDNs: %w", err)
+	}
+	for _, cudn := range cudns {
+		if cudn.Spec.Network.Topology == userdefinednetworkv1.NetworkTopologyLocalnet {
+			klog.V(5).Infof("Enqueue ClusterUDN %q following node %q event", cudn.Name, key)
+			c.cudnController.Reconcile(cudn.Name)
+		}
+	}
+	return nil
+}
+
+// ReconcilePod enqueue Localnet Cluster UDN CR requests following pod events in the namespaces the CRs
+// are affecting, the physical network mapping is validated on the nodes running pods attached to the network.
+func (c *Controller) ReconcilePod(key string) error {
+	namespace, _, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return fmt.Errorf("failed to split meta namespace key %q: %v", key, err)
+	}
+
+	c.namespaceTrackerLock.RLock()
+	defer c.namespaceTrackerLock.RUnlock()
+
+	for cudnName, affectedNamespaces := range c.namespaceTracker {
+		if !affectedNamespaces.Has(namespace) {
+			continue
+		}
+		cudn, err := c.cudnLister.Get(cudnName)
+		if err != nil {
+			if kerrors.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("failed to get CUDN %q from cache: %w", cudnName, err)
+		}
+		if cudn.Spec.Network.Topology == userdefinednetworkv1.NetworkTopologyLocalnet {
+			klog.V(5).Infof("Enqueue ClusterUDN %q following pod %q event", cudnName, key)
+			c.cudnController.Reconcile(cudnName)
+		}
+	}
+	return nil
+}
+
 // UpdateSubsystemCondition may be used by other controllers handling UDN/NAD/network setup to report conditions that
 // may affect UDN functionality.
 // FieldManager should be unique for every subsystem.
@@ -409,7 +476,7 @@ func (c *Controller) syncUserDefinedNetwork(udn *userdefinednetworkv1.UserDefine
 		klog.Infof("Added Finalizer to UserDefinedNetwork [%s/%s]", udn.Namespace, udn.Name)
 	}
 
-	return c.updateNAD(udn, udn.Namespace)
+	return c.updateNAD(udn, udn.Namespace, "")
 }
 
 func (c *Controller) updateUserDefinedNetworkStatus(udn *userdefinednetworkv1.UserDefinedNetwork, nad *netv1.NetworkAttachmentDefinition, syncError error) error {
@@ -419,9 +486,15 @@ func (c *Controller) updateUserDefinedNetworkStatus(udn *userdefinednetworkv1.Us
 
 	networkReadyCondition := newNetworkReadyCondition(nad, syncError)
 
-	conditions, updated := updateCondition(udn.Status.Conditions, networkReadyCondition)
+	conditions, networkReadyUpdated := updateCondition(udn.Status.Conditions, networkReadyCondition)
+
+	var rejectedSpecChanges []string
+	if nad != nil {
+		rejectedSpecChanges = c.rejectedSpecChanges(udn, *nad)
+	}
+	conditions, specAppliedUpdated := updateSpecAppliedCondition(conditions, rejectedSpecChanges)
 
-	if updated {
+	if networkReadyUpdated || specAppliedUpdated {
 		var err error
 		conditionsApply := make([]*metaapplyv1.ConditionApplyConfiguration, len(conditions))
 		for i := range conditions {
@@ -475,18 +548,57 @@ func newNetworkReadyCondition(nad *netv1.NetworkAttachmentDefinition, syncError
 }
 
 func updateCondition(conditions []metav1.Condition, cond *metav1.Condition) ([]metav1.Condition, bool) {
-	if len(conditions) == 0 {
+	idx := slices.IndexFunc(conditions, func(c metav1.Condition) bool {
+		return c.Type == cond.Type
+	})
+	if idx == -1 {
 		return append(conditions, *cond), true
 	}
+	if c := conditions[idx]; c.Status != cond.Status || c.Reason != cond.Reason || c.Message != cond.Message {
+		return slices.Replace(conditions, idx, idx+1, *cond), true
+	}
+	return conditions, false
+}
 
+func removeCondition(conditions []metav1.Condition, conditionType string) ([]metav1.Condition, bool) {
 	idx := slices.IndexFunc(conditions, func(c metav1.Condition) bool {
-		return (c.Type == cond.Type) &&
-			(c.Status != cond.Status || c.Reason != cond.Reason || c.Message != cond.Message)
+		return c.Type == conditionType
 	})
-	if idx != -1 {
-		return slices.Replace(conditions, idx, idx+1, *cond), true
+	if idx == -1 {
+		return conditions, false
 	}
-	return conditions, false
+	return slices.Delete(conditions, idx, idx+1), true
+}
+
+// rejectedSpecChanges returns the network spec changes that can't be applied to the given NADs,
+// see NetAttachDefConfigUpdate.
+func (c *Controller) rejectedSpecChanges(obj client.Object, nads ...netv1.NetworkAttachmentDefinition) []string {
+	rejectedChanges := sets.New[string]()
+	for _, nad := range nads {
+		desiredNAD, err := c.renderNadFn(obj, nad.Namespace)
+		if err != nil || desiredNAD == nil {
+			// render errors are reported by the sync
+			continue
+		}
+		_, nadRejectedChanges := NetAttachDefConfigUpdate(nad.Spec.Config, desiredNAD.Spec.Config)
+		rejectedChanges.Insert(nadRejectedChanges...)
+	}
+	return sets.List(rejectedChanges)
+}
+
+// updateSpecAppliedCondition sets the SpecApplied condition reporting the rejected network spec changes.
+// The condition is removed once the spec is fully applied.
+func updateSpecAppliedCondition(conditions []metav1.Condition, rejectedChanges []string) ([]metav1.Condition, bool) {
+	if len(rejectedChanges) == 0 {
+		return removeCondition(conditions, "SpecApplied")
+	}
+	return updateCondition(conditions, &metav1.Condition{
+		Type:               "SpecApplied",
+		Status:             metav1.ConditionFalse,
+		Reason:             "UnsupportedSpecChange",
+		Message:            fmt.Sprintf("The following spec changes are not supported and have not been applied: [%s]", strings.Join(rejectedChanges, "; ")),
+		LastTransitionTime: metav1.Now(),
+	})
 }
 
 func (c *Controller) cudnNeedUpdate(_ *userdefinednetworkv1.ClusterUserDefinedNetwork, _ *userdefinednetworkv1.ClusterUserDefinedNetwork) bool {
@@ -512,7 +624,11 @@ func (c *Controller) reconcileCUDN(key string) error {
 
 	nads, syncErr := c.syncClusterUDN(cudnCopy)
 
-	updateStatusErr := c.updateClusterUDNStatus(cudnCopy, nads, syncErr)
+	statusErr := syncErr
+	if statusErr == nil {
+		statusErr = c.validatePhysicalNetworkMapping(cudnCopy, nads)
+	}
+	updateStatusErr := c.updateClusterUDNStatus(cudnCopy, nads, statusErr)
 
 	var networkInUse *networkInUseError
 	if errors.As(syncErr, &networkInUse) {
@@ -593,9 +709,10 @@ func (c *Controller) syncClusterUDN(cudn *userdefinednetworkv1.ClusterUserDefine
 		}
 	}
 
+	networkConfig := c.networkConfig(cudn, affectedNamespaces.Intersection(selectedNamespaces))
 	var nads []netv1.NetworkAttachmentDefinition
 	for nsToUpdate := range selectedNamespaces {
-		nad, err := c.updateNAD(cudn, nsToUpdate)
+		nad, err := c.updateNAD(cudn, nsToUpdate, networkConfig)
 		if err != nil {
 			errs = append(errs, err)
 		} else {
@@ -607,6 +724,89 @@ func (c *Controller) syncClusterUDN(cudn *userdefinednetworkv1.ClusterUserDefine
 	return nads, errors.Join(errs...)
 }
 
+// networkConfig returns the config of the NAD the given ClusterUserDefinedNetwork owns in the first of the given
+// namespaces, or an empty string when it owns none.
+func (c *Controller) networkConfig(cudn *userdefinednetworkv1.ClusterUserDefinedNetwork, namespaces sets.Set[string]) string {
+	for _, namespace := range sets.List(namespaces) {
+		nad, err := c.nadLister.NetworkAttachmentDefinitions(namespace).Get(cudn.Name)
+		if err != nil || !metav1.IsControlledBy(nad, cudn) {
+			continue
+		}
+		return nad.Spec.Config
+	}
+	return ""
+}
+
+// validatePhysicalNetworkMapping verifies the physical network of a Localnet network is mapped to an OVS bridge
+// on the nodes running pods attached to the network, as reported by the nodes physical networks annotation.
+// Nodes that don't report their physical networks are not validated.
+func (c *Controller) validatePhysicalNetworkMapping(cudn *userdefinednetworkv1.ClusterUserDefinedNetwork, nads []netv1.NetworkAttachmentDefinition) error {
+	if cudn == nil || !cudn.DeletionTimestamp.IsZero() ||
+		cudn.Spec.Network.Topology != userdefinednetworkv1.NetworkTopologyLocalnet || cudn.Spec.Network.Localnet == nil {
+		return nil
+	}
+	physicalNetworkName := cudn.Spec.Network.Localnet.PhysicalNetworkName
+
+	nodeNames, err := c.getNetworkNodes(nads)
+	if err != nil {
+		return err
+	}
+	var unmappedNodes []string
+	for nodeName := range nodeNames {
+		node, err := c.nodeInformer.Lister().Get(nodeName)
+		if err != nil {
+			if kerrors.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("failed to get node %q from cache: %w", nodeName, err)
+		}
+		physicalNetworks, err := util.ParseNodePhysicalNetworks(node)
+		if err != nil {
+			if !util.IsAnnotationNotSetError(err) {
+				klog.Warningf("Failed to get physical networks of node %s: %v", node.Name, err)
+			}
+			continue
+		}
+		if !physicalNetworks.Has(physicalNetworkName) {
+			unmappedNodes = append(unmappedNodes, node.Name)
+		}
+	}
+	if len(unmappedNodes) > 0 {
+		slices.Sort(unmappedNodes)
+		return &physicalNetworkMappingError{err: fmt.Errorf("physical network %q is not mapped to an OVS bridge on the following nodes: [%s]",
+			physicalNetworkName, strings.Join(unmappedNodes, ", "))}
+	}
+	return nil
+}
+
+// getNetworkNodes returns the names of the nodes running pods requesting one of the given NADs.
+func (c *Controller) getNetworkNodes(nads []netv1.NetworkAttachmentDefinition) (sets.Set[string], error) {
+	nodeNames := sets.New[string]()
+	for _, nad := range nads {
+		pods, err := c.podInformer.Lister().Pods(nad.Namespace).List(labels.Everything())
+		if err != nil {
+			return nil, fmt.Errorf("failed to list pods at namespace %q: %w", nad.Namespace, err)
+		}
+		for _, pod := range pods {
+			if !util.PodScheduled(pod) || util.PodCompleted(pod) || nodeNames.Has(pod.Spec.NodeName) {
+				continue
+			}
+			networks, err := util.GetK8sPodAllNetworkSelections(pod)
+			if err != nil {
+				klog.Warningf("Failed to get the networks of pod %s/%s: %v", pod.Namespace, pod.Name, err)
+				continue
+			}
+			for _, network := range networks {
+				if network.Namespace == nad.Namespace && network.Name == nad.Name {
+					nodeNames.Insert(pod.Spec.NodeName)
+					break
+				}
+			}
+		}
+	}
+	return nodeNames, nil
+}
+
 // getSelectedNamespaces list all selected namespaces according to given selector and create
 // a set of the selected namespaces keys.
 func (c *Controller) getSelectedNamespaces(sel metav1.LabelSelector) (sets.Set[string], error) {
@@ -637,8 +837,9 @@ func (c *Controller) updateClusterUDNStatus(cudn *userdefinednetworkv1.ClusterUs
 
 	networkReadyCondition := newClusterNetworkReadyCondition(nads, syncError)
 
-	conditions, updated := updateCondition(cudn.Status.Conditions, networkReadyCondition)
-	if !updated {
+	conditions, networkReadyUpdated := updateCondition(cudn.Status.Conditions, networkReadyCondition)
+	conditions, specAppliedUpdated := updateSpecAppliedCondition(conditions, c.rejectedSpecChanges(cudn, nads...))
+	if !networkReadyUpdated && !specAppliedUpdated {
 		return nil
 	}
 	conditionsApply := make([]*metaapplyv1.ConditionApplyConfiguration, len(conditions))
@@ -697,7 +898,12 @@ func newClusterNetworkReadyCondition(nads []netv1.NetworkAttachmentDefinition, s
 		condition.Message = fmt.Sprintf("NetworkAttachmentDefinition are being deleted: %v", deletedNadKeys)
 	}
 
-	if syncError != nil {
+	var mappingErr *physicalNetworkMappingError
+	if errors.As(syncError, &mappingErr) {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "PhysicalNetworkMappingMissing"
+		condition.Message = mappingErr.Error()
+	} else if syncError != nil {
 		condition.Status = metav1.ConditionFalse
 		condition.Reason = "NetworkAttachmentDefinitionSyncError"
 		condition.Message = syncError.Error()
@@ -18,7 +18,10 @@ import (
 	utiludn "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util/udn"
 )
 
-func (c *Controller) updateNAD(obj client.Object, namespace string) (*netv1.NetworkAttachmentDefinition, error) {
+// updateNAD creates or updates the NAD of the given network in the given namespace.
+// The networkConfig is the config of the network NADs in other namespaces, if any: the NAD created in the
+// given namespace gets the same network config, see NetAttachDefConfigFrom.
+func (c *Controller) updateNAD(obj client.Object, namespace, networkConfig string) (*netv1.NetworkAttachmentDefinition, error) {
 	desiredNAD, err := c.renderNadFn(obj, namespace)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate NetworkAttachmentDefinition: %w", err)
@@ -48,6 +51,11 @@ func (c *Controller) updateNAD(obj client.Object, namespace string) (*netv1.Netw
 			}
 		}
 
+		if networkConfig != "" {
+			// The network is in use, don't apply the rejected changes to the newly selected namespaces.
+			desiredNAD.Spec.Config, _ = NetAttachDefConfigUpdate(NetAttachDefConfigFrom(networkConfig, desiredNAD.Spec.Config), desiredNAD.Spec.Config)
+		}
+
 		newNAD, err := c.nadClient.K8sCniCncfIoV1().NetworkAttachmentDefinitions(namespace).Create(context.Background(), desiredNAD, metav1.CreateOptions{})
 		if err != nil {
 			return nil, fmt.Errorf("failed to create NetworkAttachmentDefinition: %w", err)
@@ -61,11 +69,14 @@ func (c *Controller) updateNAD(obj client.Object, namespace string) (*netv1.Netw
 		return nil, fmt.Errorf("foreign NetworkAttachmentDefinition with the desired name already exist [%s/%s]", nadCopy.Namespace, nadCopy.Name)
 	}
 
-	if reflect.DeepEqual(nadCopy.Spec.Config, desiredNAD.Spec.Config) {
+	// The network may be in use, apply the safe changes only; the rejected changes are reflected in the
+	// CR status.
+	desiredConfig, _ := NetAttachDefConfigUpdate(nadCopy.Spec.Config, desiredNAD.Spec.Config)
+	if reflect.DeepEqual(nadCopy.Spec.Config, desiredConfig) {
 		return nadCopy, nil
 	}
 
-	nadCopy.Spec.Config = desiredNAD.Spec.Config
+	nadCopy.Spec.Config = desiredConfig
 	updatedNAD, err := c.nadClient.K8sCniCncfIoV1().NetworkAttachmentDefinitions(nadCopy.Namespace).Update(context.Background(), nadCopy, metav1.UpdateOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to update NetworkAttachmentDefinition: %w", err)
@@ -61,7 +61,7 @@ var _ = Describe("User Defined Network Controller", func() {
 
 		return New(cs.NetworkAttchDefClient, f.NADInformer(),
 			cs.UserDefinedNetworkClient, f.UserDefinedNetworkInformer(), f.ClusterUserDefinedNetworkInformer(),
-			renderNADStub, f.PodCoreInformer(), f.NamespaceInformer(), nil,
+			renderNADStub, f.PodCoreInformer(), f.NamespaceInformer(), f.NodeCoreInformer(), nil,
 		)
 	}
 
@@ -242,6 +242,75 @@ var _ = Describe("User Defined Network Controller", func() {
 				}).Should(Equal(mutatedNAD))
 			})
 
+			It("should apply safe spec changes and reflect rejected changes in status", func() {
+				udn := testUDN()
+				udn.Spec = udnv1.UserDefinedNetworkSpec{
+					Topology: udnv1.NetworkTopologyLayer3,
+					Layer3: &udnv1.Layer3Config{
+						Role:    udnv1.NetworkRoleSecondary,
+						Subnets: []udnv1.Layer3Subnet{{CIDR: "10.10.0.0/16", HostSubnet: 24}},
+					},
+				}
+				c = newTestController(template.RenderNetAttachDefManifest, udn)
+				Expect(c.Run()).To(Succeed())
+
+				getConditions := func() []metav1.Condition {
+					udn, err := cs.UserDefinedNetworkClient.K8sV1().UserDefinedNetworks(udn.Namespace).Get(context.Background(), udn.Name, metav1.GetOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					return normalizeConditions(udn.Status.Conditions)
+				}
+				getNADConfig := func() string {
+					nad, err := cs.NetworkAttchDefClient.K8sCniCncfIoV1().NetworkAttachmentDefinitions(udn.Namespace).Get(context.Background(), udn.Name, metav1.GetOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					return nad.Spec.Config
+				}
+				networkReadyCondition := metav1.Condition{
+					Type:    "NetworkReady",
+					Status:  "True",
+					Reason:  "NetworkAttachmentDefinitionReady",
+					Message: "NetworkAttachmentDefinition has been created",
+				}
+				Eventually(getConditions).Should(Equal([]metav1.Condition{networkReadyCondition}))
+
+				By("appending a subnet and raising the MTU")
+				udn, err := cs.UserDefinedNetworkClient.K8sV1().UserDefinedNetworks(udn.Namespace).Get(context.Background(), udn.Name, metav1.GetOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				udn.Spec.Layer3.Subnets = append(udn.Spec.Layer3.Subnets, udnv1.Layer3Subnet{CIDR: "10.20.0.0/16", HostSubnet: 24})
+				udn.Spec.Layer3.MTU = 9000
+				udn, err = cs.UserDefinedNetworkClient.K8sV1().UserDefinedNetworks(udn.Namespace).Update(context.Background(), udn, metav1.UpdateOptions{})
+				Expect(err).NotTo(HaveOccurred())
+
+				expectedNADConfig := `{"cniVersion":"1.0.0","type":"ovn-k8s-cni-overlay","name":"test.test","netAttachDefName":"test/test",` +
+					`"topology":"layer3","role":"secondary","mtu":9000,"subnets":"10.10.0.0/16/24,10.20.0.0/16/24"}`
+				Eventually(getNADConfig).Should(MatchJSON(expectedNADConfig))
+				Consistently(getConditions).Should(Equal([]metav1.Condition{networkReadyCondition}))
+
+				By("modifying a subnet and decreasing the MTU")
+				udn.Spec.Layer3.Subnets[0].CIDR = "10.30.0.0/16"
+				udn.Spec.Layer3.MTU = 1300
+				udn, err = cs.UserDefinedNetworkClient.K8sV1().UserDefinedNetworks(udn.Namespace).Update(context.Background(), udn, metav1.UpdateOptions{})
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(getConditions).Should(Equal([]metav1.Condition{networkReadyCondition, {
+					Type:   "SpecApplied",
+					Status: "False",
+					Reason: "UnsupportedSpecChange",
+					Message: "The following spec changes are not supported and have not been applied: [" +
+						"mtu: can't be decreased from 9000 to 1300; " +
+						"subnets: existing subnets [10.10.0.0/16/24 10.20.0.0/16/24] can't be removed or modified, only new subnets can be appended]",
+				}}))
+				Expect(getNADConfig()).To(MatchJSON(expectedNADConfig))
+
+				By("reverting the rejected changes")
+				udn.Spec.Layer3.Subnets[0].CIDR = "10.10.0.0/16"
+				udn.Spec.Layer3.MTU = 9000
+				_, err = cs.UserDefinedNetworkClient.K8sV1().UserDefinedNetworks(udn.Namespace).Update(context.Background(), udn, metav1.UpdateOptions{})
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(getConditions).Should(Equal([]metav1.Condition{networkReadyCondition}))
+				Expect(getNADConfig()).To(MatchJSON(expectedNADConfig))
+			})
+
 			It("given primary UDN, should fail when primary NAD already exist", func() {
 				primaryUDN := testUDN()
 				primaryUDN.Spec.Topology = udnv1.NetworkTopologyLayer2
@@ -405,6 +474,112 @@ var _ = Describe("User Defined Network Controller", func() {
 				}
 			})
 
+			It("when CR selector is extended while spec changes are rejected, should create NAD with the applied network config in the new namespaces", func() {
+				cudn := testClusterUDN("test", "red")
+				cudn.Spec.Network = udnv1.NetworkSpec{Topology: udnv1.NetworkTopologyLayer2, Layer2: &udnv1.Layer2Config{
+					Role: udnv1.NetworkRoleSecondary, MTU: 9000, Subnets: udnv1.DualStackCIDRs{"10.10.0.0/16"}}}
+				c = newTestController(template.RenderNetAttachDefManifest, testNamespace("red"), testNamespace("blue"), cudn)
+				Expect(c.Run()).To(Succeed())
+
+				getNADConfig := func(namespace string) func() (string, error) {
+					return func() (string, error) {
+						nad, err := cs.NetworkAttchDefClient.K8sCniCncfIoV1().NetworkAttachmentDefinitions(namespace).Get(context.Background(), cudn.Name, metav1.GetOptions{})
+						if err != nil {
+							return "", err
+						}
+						return nad.Spec.Config, nil
+					}
+				}
+				expectedNADConfig := func(namespace string) string {
+					return `{"cniVersion":"1.0.0","type":"ovn-k8s-cni-overlay","name":"cluster.udn.test","netAttachDefName":"` + namespace + `/test",` +
+						`"topology":"layer2","role":"secondary","mtu":9000,"subnets":"10.10.0.0/16"}`
+				}
+				Eventually(getNADConfig("red")).Should(MatchJSON(expectedNADConfig("red")))
+
+				By("decreasing the MTU and selecting a new namespace")
+				cudn, err := cs.UserDefinedNetworkClient.K8sV1().ClusterUserDefinedNetworks().Get(context.Background(), cudn.Name, metav1.GetOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				cudn.Spec.Network.Layer2.MTU = 1300
+				cudn.Spec.NamespaceSelector.MatchExpressions[0].Values = []string{"red", "blue"}
+				_, err = cs.UserDefinedNetworkClient.K8sV1().ClusterUserDefinedNetworks().Update(context.Background(), cudn, metav1.UpdateOptions{})
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(getNADConfig("blue")).Should(MatchJSON(expectedNADConfig("blue")),
+					"NAD in the new namespace should not get the rejected changes")
+				Expect(getNADConfig("red")()).To(MatchJSON(expectedNADConfig("red")))
+				Eventually(func() []metav1.Condition {
+					cudn, err := cs.UserDefinedNetworkClient.K8sV1().ClusterUserDefinedNetworks().Get(context.Background(), cudn.Name, metav1.GetOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					return normalizeConditions(cudn.Status.Conditions)
+				}).Should(ContainElement(metav1.Condition{
+					Type:    "SpecApplied",
+					Status:  "False",
+					Reason:  "UnsupportedSpecChange",
+					Message: "The following spec changes are not supported and have not been applied: [mtu: can't be decreased from 9000 to 1300]",
+				}))
+			})
+
+			It("given Localnet topology, should reflect nodes the physical network is not mapped on in status", func() {
+				testNs := testNamespace("blue")
+				cudn := testClusterUDN("test", testNs.Name)
+				cudn.Spec.Network = udnv1.NetworkSpec{Topology: udnv1.NetworkTopologyLocalnet, Localnet: &udnv1.LocalnetConfig{
+					Role: udnv1.NetworkRoleSecondary, PhysicalNetworkName: "physnet1", VLAN: 200}}
+				mappedNode := testNode("node1", `["physnet","physnet1"]`)
+				unmappedNode := testNode("node2", `["physnet"]`)
+				// nodes not reporting their physical networks are not validated
+				unknownNode := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node3"}}
+				// nodes not running pods attached to the network are not validated
+				idleNode := testNode("node4", `["physnet"]`)
+				objs := []runtime.Object{testNs, cudn, mappedNode, unmappedNode, unknownNode, idleNode}
+				for _, node := range []string{mappedNode.Name, unmappedNode.Name, unknownNode.Name} {
+					objs = append(objs, testNetworkPod("pod-"+node, testNs.Name, node, cudn.Name))
+				}
+
+				c = newTestController(template.RenderNetAttachDefManifest, objs...)
+				Expect(c.Run()).To(Succeed())
+
+				getConditions := func() []metav1.Condition {
+					cudn, err := cs.UserDefinedNetworkClient.K8sV1().ClusterUserDefinedNetworks().Get(context.Background(), cudn.Name, metav1.GetOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					return normalizeConditions(cudn.Status.Conditions)
+				}
+				Eventually(getConditions).Should(Equal([]metav1.Condition{{
+					Type:    "NetworkReady",
+					Status:  "False",
+					Reason:  "PhysicalNetworkMappingMissing",
+					Message: `physical network "physnet1" is not mapped to an OVS bridge on the following nodes: [node2]`,
+				}}), "status should reflect the node missing the physical network mapping")
+				nad, err := cs.NetworkAttchDefClient.K8sCniCncfIoV1().NetworkAttachmentDefinitions(testNs.Name).Get(context.Background(), cudn.Name, metav1.GetOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(nad.Spec.Config).To(MatchJSON(`{"cniVersion":"1.0.0","name":"cluster.udn.test","netAttachDefName":"blue/test",`+
+					`"role":"secondary","topology":"localnet","type":"ovn-k8s-cni-overlay","physicalNetworkName":"physnet1","vlanID":200}`),
+					"NAD should be created regardless of the physical network mapping")
+
+				By("map the physical network on the node")
+				unmappedNode.Annotations[util.OVNNodePhysicalNetworks] = `["physnet","physnet1"]`
+				_, err = cs.KubeClient.CoreV1().Nodes().Update(context.Background(), unmappedNode, metav1.UpdateOptions{})
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(getConditions).Should(Equal([]metav1.Condition{{
+					Type:    "NetworkReady",
+					Status:  "True",
+					Reason:  "NetworkAttachmentDefinitionReady",
+					Message: "NetworkAttachmentDefinition has been created in following namespaces: [blue]",
+				}}), "status should reflect the physical network is mapped on all nodes")
+
+				By("schedule a pod attached to the network on a node the physical network is not mapped on")
+				_, err = cs.KubeClient.CoreV1().Pods(testNs.Name).Create(context.Background(),
+					testNetworkPod("pod-"+idleNode.Name, testNs.Name, idleNode.Name, cudn.Name), metav1.CreateOptions{})
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(getConditions).Should(Equal([]metav1.Condition{{
+					Type:    "NetworkReady",
+					Status:  "False",
+					Reason:  "PhysicalNetworkMappingMissing",
+					Message: `physical network "physnet1" is not mapped to an OVS bridge on the following nodes: [node4]`,
+				}}), "status should reflect the node the pod is scheduled on is missing the physical network mapping")
+			})
+
 			When("CR exist, and few connected & disconnected namespaces", func() {
 				const (
 					cudnName       = "global-network"
@@ -1366,6 +1541,26 @@ func testNamespace(name string) *corev1.Namespace {
 	}
 }
 
+func testNode(name, physicalNetworks string) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Annotations: map[string]string{util.OVNNodePhysicalNetworks: physicalNetworks},
+		},
+	}
+}
+
+func testNetworkPod(name, namespace, nodeName string, networks ...string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   namespace,
+			Annotations: map[string]string{"k8s.v1.cni.cncf.io/networks": strings.Join(networks, ",")},
+		},
+		Spec: corev1.PodSpec{NodeName: nodeName},
+	}
+}
+
 func testClusterUDN(name string, targetNamespaces ...string) *udnv1.ClusterUserDefinedNetwork {
 	return &udnv1.ClusterUserDefinedNetwork{
 		ObjectMeta: metav1.ObjectMeta{
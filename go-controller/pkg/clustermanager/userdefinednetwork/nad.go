@@ -3,13 +3,17 @@ package userdefinednetwork
 import (
 	"encoding/json"
 	"fmt"
+	"reflect"
+	"strings"
 
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	netv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
 
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/clustermanager/userdefinednetwork/template"
 	cnitypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/cni/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 	ovntypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
 )
@@ -50,3 +54,131 @@ func PrimaryNetAttachDefNotExist(nads []*netv1.NetworkAttachmentDefinition) erro
 	}
 	return nil
 }
+
+// netConfFieldNames maps the NAD config fields to the network spec fields rendering them.
+var netConfFieldNames = map[string]string{
+	"allowPersistentIPs": "ipamLifecycle",
+	"vlanID":             "vlan",
+}
+
+// NetAttachDefConfigUpdate returns the NAD config to apply given the current NAD config and the config
+// rendered from the desired network spec.
+// Only the changes that are safe for a network in use are applied: appending subnets and raising the MTU.
+// The returned config keeps the current value of any other changed field, the rejected changes are
+// described in the returned slice.
+// In case either config can't be parsed (e.g.: NAD mutated by a third party) the desired config is returned.
+func NetAttachDefConfigUpdate(currentConfig, desiredConfig string) (string, []string) {
+	var current, desired map[string]interface{}
+	if err := json.Unmarshal([]byte(currentConfig), &current); err != nil {
+		return desiredConfig, nil
+	}
+	if err := json.Unmarshal([]byte(desiredConfig), &desired); err != nil {
+		return desiredConfig, nil
+	}
+
+	fields := sets.New[string]()
+	for field := range current {
+		fields.Insert(field)
+	}
+	for field := range desired {
+		fields.Insert(field)
+	}
+
+	var rejectedChanges []string
+	for _, field := range sets.List(fields) {
+		currentValue, desiredValue := current[field], desired[field]
+		if reflect.DeepEqual(currentValue, desiredValue) {
+			continue
+		}
+
+		var rejectReason string
+		switch field {
+		case "subnets":
+			rejectReason = validateSubnetsUpdate(currentValue, desiredValue)
+		case "mtu":
+			rejectReason = validateMTUUpdate(currentValue, desiredValue)
+		default:
+			rejectReason = "can't be changed"
+		}
+		if rejectReason == "" {
+			current[field] = desiredValue
+			continue
+		}
+
+		specField := field
+		if name, ok := netConfFieldNames[field]; ok {
+			specField = name
+		}
+		rejectedChanges = append(rejectedChanges, fmt.Sprintf("%s: %s", specField, rejectReason))
+	}
+	if len(rejectedChanges) == 0 {
+		return desiredConfig, nil
+	}
+
+	config, err := json.Marshal(current)
+	if err != nil {
+		return currentConfig, rejectedChanges
+	}
+	return string(config), rejectedChanges
+}
+
+// NetAttachDefConfigFrom returns the given network NAD config rendered for the NAD of the desired config,
+// i.e.: the network fields are taken from the given config and the NAD name from the desired config.
+// In case either config can't be parsed the desired config is returned.
+func NetAttachDefConfigFrom(networkConfig, desiredConfig string) string {
+	var network, desired map[string]interface{}
+	if err := json.Unmarshal([]byte(networkConfig), &network); err != nil {
+		return desiredConfig
+	}
+	if err := json.Unmarshal([]byte(desiredConfig), &desired); err != nil {
+		return desiredConfig
+	}
+	network["netAttachDefName"] = desired["netAttachDefName"]
+	config, err := json.Marshal(network)
+	if err != nil {
+		return desiredConfig
+	}
+	return string(config)
+}
+
+// validateSubnetsUpdate returns the reason the subnets update is rejected, or an empty string when
+// the existing subnets are kept as-is and new subnets are only appended.
+func validateSubnetsUpdate(currentValue, desiredValue interface{}) string {
+	currentSubnets := configSubnets(currentValue)
+	if len(currentSubnets) == 0 {
+		return "can't be added to a network without subnets"
+	}
+	desiredSubnets := configSubnets(desiredValue)
+	if !sets.New(desiredSubnets...).HasAll(currentSubnets...) {
+		return fmt.Sprintf("existing subnets %v can't be removed or modified, only new subnets can be appended", currentSubnets)
+	}
+	return ""
+}
+
+// validateMTUUpdate returns the reason the MTU update is rejected, or an empty string when the MTU is raised.
+func validateMTUUpdate(currentValue, desiredValue interface{}) string {
+	currentMTU, desiredMTU := configMTU(currentValue), configMTU(desiredValue)
+	if desiredMTU < currentMTU {
+		return fmt.Sprintf("can't be decreased from %d to %d", currentMTU, desiredMTU)
+	}
+	return ""
+}
+
+func configSubnets(value interface{}) []string {
+	subnetsStr, _ := value.(string)
+	var subnets []string
+	for _, subnet := range strings.Split(subnetsStr, ",") {
+		if subnet = strings.TrimSpace(subnet); subnet != "" {
+			subnets = append(subnets, subnet)
+		}
+	}
+	return subnets
+}
+
+// configMTU returns the MTU set in the NAD config, or the default MTU used by the network when not set.
+func configMTU(value interface{}) int {
+	if mtu, ok := value.(float64); ok && mtu > 0 {
+		return int(mtu)
+	}
+	return config.Default.MTU
+}
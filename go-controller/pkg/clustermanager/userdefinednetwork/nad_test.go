@@ -122,3 +122,56 @@ var _ = Describe("PrimaryNetAttachDefNotExist", func() {
 		Expect(PrimaryNetAttachDefNotExist(nads)).ToNot(Succeed())
 	})
 })
+
+var _ = Describe("NetAttachDefConfigUpdate", func() {
+	const currentConfig = `{"cniVersion":"1.0.0","type":"ovn-k8s-cni-overlay","name":"blue.test","netAttachDefName":"blue/test",` +
+		`"topology":"layer3","role":"primary","subnets":"10.10.0.0/16/24","joinSubnets":"100.65.0.0/16,fd99::/64"}`
+
+	DescribeTable("should apply safe changes",
+		func(desiredConfig string) {
+			config, rejectedChanges := NetAttachDefConfigUpdate(currentConfig, desiredConfig)
+			Expect(rejectedChanges).To(BeEmpty())
+			Expect(config).To(MatchJSON(desiredConfig))
+		},
+		Entry("no change", currentConfig),
+		Entry("subnet appended",
+			`{"cniVersion":"1.0.0","type":"ovn-k8s-cni-overlay","name":"blue.test","netAttachDefName":"blue/test",`+
+				`"topology":"layer3","role":"primary","subnets":"10.10.0.0/16/24,2001:db8::/60/64","joinSubnets":"100.65.0.0/16,fd99::/64"}`),
+		Entry("MTU raised",
+			`{"cniVersion":"1.0.0","type":"ovn-k8s-cni-overlay","name":"blue.test","netAttachDefName":"blue/test",`+
+				`"topology":"layer3","role":"primary","subnets":"10.10.0.0/16/24","joinSubnets":"100.65.0.0/16,fd99::/64","mtu":9000}`),
+	)
+
+	DescribeTable("should reject unsafe changes, keeping the current values",
+		func(desiredConfig, expectedConfig string, expectedRejectedChanges []string) {
+			config, rejectedChanges := NetAttachDefConfigUpdate(currentConfig, desiredConfig)
+			Expect(rejectedChanges).To(Equal(expectedRejectedChanges))
+			Expect(config).To(MatchJSON(expectedConfig))
+		},
+		Entry("subnet modified",
+			`{"cniVersion":"1.0.0","type":"ovn-k8s-cni-overlay","name":"blue.test","netAttachDefName":"blue/test",`+
+				`"topology":"layer3","role":"primary","subnets":"10.20.0.0/16/24","joinSubnets":"100.65.0.0/16,fd99::/64"}`,
+			currentConfig,
+			[]string{"subnets: existing subnets [10.10.0.0/16/24] can't be removed or modified, only new subnets can be appended"},
+		),
+		Entry("MTU decreased",
+			`{"cniVersion":"1.0.0","type":"ovn-k8s-cni-overlay","name":"blue.test","netAttachDefName":"blue/test",`+
+				`"topology":"layer3","role":"primary","subnets":"10.10.0.0/16/24","joinSubnets":"100.65.0.0/16,fd99::/64","mtu":1300}`,
+			currentConfig,
+			[]string{"mtu: can't be decreased from 1400 to 1300"},
+		),
+		Entry("role changed and MTU raised, only MTU is applied",
+			`{"cniVersion":"1.0.0","type":"ovn-k8s-cni-overlay","name":"blue.test","netAttachDefName":"blue/test",`+
+				`"topology":"layer3","role":"secondary","subnets":"10.10.0.0/16/24","mtu":9000}`,
+			`{"cniVersion":"1.0.0","type":"ovn-k8s-cni-overlay","name":"blue.test","netAttachDefName":"blue/test",`+
+				`"topology":"layer3","role":"primary","subnets":"10.10.0.0/16/24","joinSubnets":"100.65.0.0/16,fd99::/64","mtu":9000}`,
+			[]string{"joinSubnets: can't be changed", "role: can't be changed"},
+		),
+	)
+
+	It("should return the desired config given current config is invalid", func() {
+		config, rejectedChanges := NetAttachDefConfigUpdate("MUTATED", currentConfig)
+		Expect(rejectedChanges).To(BeEmpty())
+		Expect(config).To(Equal(currentConfig))
+	})
+})
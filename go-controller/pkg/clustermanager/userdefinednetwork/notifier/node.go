@@ -0,0 +1,67 @@
+package notifier
+
+import (
+	"errors"
+
+	corev1 "k8s.io/api/core/v1"
+	corev1informer "k8s.io/client-go/informers/core/v1"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/controller"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+type NodeReconciler interface {
+	ReconcileNode(key string) error
+}
+
+// NodeNotifier watches Node objects and notify subscribers upon change.
+// It enqueues the reconciled object keys in the subscribing controllers workqueue.
+type NodeNotifier struct {
+	Controller controller.Controller
+
+	subscribers []NodeReconciler
+}
+
+func NewNodeNotifier(nodeInformer corev1informer.NodeInformer, subscribers ...NodeReconciler) *NodeNotifier {
+	c := &NodeNotifier{
+		subscribers: subscribers,
+	}
+
+	nodeLister := nodeInformer.Lister()
+	cfg := &controller.ControllerConfig[corev1.Node]{
+		RateLimiter:    workqueue.DefaultTypedControllerRateLimiter[string](),
+		Reconcile:      c.reconcile,
+		ObjNeedsUpdate: c.needUpdate,
+		Threadiness:    1,
+		Informer:       nodeInformer.Informer(),
+		Lister:         nodeLister.List,
+	}
+	c.Controller = controller.NewController[corev1.Node]("udn-node-controller", cfg)
+
+	return c
+}
+
+// needUpdate return true when the node has been deleted or created, or its physical networks have changed.
+func (c *NodeNotifier) needUpdate(old, new *corev1.Node) bool {
+	nodeCreated := old == nil && new != nil
+	nodeDeleted := old != nil && new == nil
+	physicalNetworksChanged := old != nil && new != nil &&
+		util.NodePhysicalNetworksAnnotationChanged(old, new)
+
+	return nodeCreated || nodeDeleted || physicalNetworksChanged
+}
+
+// reconcile notify subscribers with the request node key following node events.
+func (c *NodeNotifier) reconcile(key string) error {
+	var errs []error
+	for _, subscriber := range c.subscribers {
+		if subscriber != nil {
+			if err := subscriber.ReconcileNode(key); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,80 @@
+package notifier
+
+import (
+	"errors"
+
+	netv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	corev1informer "k8s.io/client-go/informers/core/v1"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/controller"
+)
+
+type PodReconciler interface {
+	ReconcilePod(key string) error
+}
+
+// PodNotifier watches Pod objects and notify subscribers upon change.
+// It enqueues the reconciled object keys in the subscribing controllers workqueue.
+type PodNotifier struct {
+	Controller controller.Controller
+
+	subscribers []PodReconciler
+}
+
+func NewPodNotifier(podInformer corev1informer.PodInformer, subscribers ...PodReconciler) *PodNotifier {
+	c := &PodNotifier{
+		subscribers: subscribers,
+	}
+
+	podLister := podInformer.Lister()
+	cfg := &controller.ControllerConfig[corev1.Pod]{
+		RateLimiter:    workqueue.DefaultTypedControllerRateLimiter[string](),
+		Reconcile:      c.reconcile,
+		ObjNeedsUpdate: c.needUpdate,
+		Threadiness:    1,
+		Informer:       podInformer.Informer(),
+		Lister:         podLister.List,
+	}
+	c.Controller = controller.NewController[corev1.Pod]("udn-pod-controller", cfg)
+
+	return c
+}
+
+// needUpdate return true when a pod requesting secondary networks has been created or deleted,
+// scheduled to a node or completed, or when its requested networks have changed.
+func (c *PodNotifier) needUpdate(old, new *corev1.Pod) bool {
+	if old == nil || new == nil {
+		pod := old
+		if pod == nil {
+			pod = new
+		}
+		return requestsNetworks(pod)
+	}
+	if !requestsNetworks(old) && !requestsNetworks(new) {
+		return false
+	}
+	return old.Spec.NodeName != new.Spec.NodeName ||
+		old.Status.Phase != new.Status.Phase ||
+		old.Annotations[netv1.NetworkAttachmentAnnot] != new.Annotations[netv1.NetworkAttachmentAnnot]
+}
+
+func requestsNetworks(pod *corev1.Pod) bool {
+	return pod != nil && pod.Annotations[netv1.NetworkAttachmentAnnot] != ""
+}
+
+// reconcile notify subscribers with the request pod key following pod events.
+func (c *PodNotifier) reconcile(key string) error {
+	var errs []error
+	for _, subscriber := range c.subscribers {
+		if subscriber != nil {
+			if err := subscriber.ReconcilePod(key); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
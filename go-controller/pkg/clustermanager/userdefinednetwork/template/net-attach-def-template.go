@@ -32,6 +32,7 @@ type SpecGetter interface {
 	GetTopology() userdefinednetworkv1.NetworkTopology
 	GetLayer3() *userdefinednetworkv1.Layer3Config
 	GetLayer2() *userdefinednetworkv1.Layer2Config
+	GetLocalnet() *userdefinednetworkv1.LocalnetConfig
 }
 
 func ParseNetworkName(networkName string) (udnNamespace, udnName string) {
@@ -42,6 +43,16 @@ func ParseNetworkName(networkName string) (udnNamespace, udnName string) {
 	return "", ""
 }
 
+// UserDefinedNetworkName returns the name of the network rendered for the UserDefinedNetwork.
+func UserDefinedNetworkName(namespace, name string) string {
+	return namespace + "." + name
+}
+
+// ClusterUserDefinedNetworkName returns the name of the network rendered for the ClusterUserDefinedNetwork.
+func ClusterUserDefinedNetworkName(name string) string {
+	return "cluster.udn." + name
+}
+
 func RenderNetAttachDefManifest(obj client.Object, targetNamespace string) (*netv1.NetworkAttachmentDefinition, error) {
 	if obj == nil {
 		return nil, nil
@@ -58,11 +69,11 @@ func RenderNetAttachDefManifest(obj client.Object, targetNamespace string) (*net
 	case *userdefinednetworkv1.UserDefinedNetwork:
 		ownerRef = *metav1.NewControllerRef(obj, userdefinednetworkv1.SchemeGroupVersion.WithKind("UserDefinedNetwork"))
 		spec = &o.Spec
-		networkName = targetNamespace + "." + obj.GetName()
+		networkName = UserDefinedNetworkName(targetNamespace, obj.GetName())
 	case *userdefinednetworkv1.ClusterUserDefinedNetwork:
 		ownerRef = *metav1.NewControllerRef(obj, userdefinednetworkv1.SchemeGroupVersion.WithKind("ClusterUserDefinedNetwork"))
 		spec = &o.Spec.Network
-		networkName = "cluster.udn." + obj.GetName()
+		networkName = ClusterUserDefinedNetworkName(obj.GetName())
 	default:
 		return nil, fmt.Errorf("unknown type %T", obj)
 	}
@@ -106,7 +117,8 @@ func RenderNADSpec(networkName, nadName string, spec SpecGetter) (*netv1.Network
 
 func validateTopology(spec SpecGetter) error {
 	if spec.GetTopology() == userdefinednetworkv1.NetworkTopologyLayer3 && spec.GetLayer3() == nil ||
-		spec.GetTopology() == userdefinednetworkv1.NetworkTopologyLayer2 && spec.GetLayer2() == nil {
+		spec.GetTopology() == userdefinednetworkv1.NetworkTopologyLayer2 && spec.GetLayer2() == nil ||
+		spec.GetTopology() == userdefinednetworkv1.NetworkTopologyLocalnet && spec.GetLocalnet() == nil {
 		return fmt.Errorf("topology %[1]s is specified but %[1]s config is nil", spec.GetTopology())
 	}
 	return nil
@@ -137,6 +149,15 @@ func renderCNINetworkConfig(networkName, nadName string, spec SpecGetter) (map[s
 		netConfSpec.AllowPersistentIPs = cfg.IPAMLifecycle == userdefinednetworkv1.IPAMLifecyclePersistent
 		netConfSpec.Subnets = cidrString(cfg.Subnets)
 		netConfSpec.JoinSubnet = cidrString(renderJoinSubnets(cfg.Role, cfg.JoinSubnets))
+	case userdefinednetworkv1.NetworkTopologyLocalnet:
+		cfg := spec.GetLocalnet()
+		netConfSpec.Role = strings.ToLower(string(cfg.Role))
+		netConfSpec.MTU = int(cfg.MTU)
+		netConfSpec.PhysicalNetworkName = cfg.PhysicalNetworkName
+		netConfSpec.VLANID = int(cfg.VLAN)
+		netConfSpec.AllowPersistentIPs = cfg.IPAMLifecycle == userdefinednetworkv1.IPAMLifecyclePersistent
+		netConfSpec.Subnets = cidrString(cfg.Subnets)
+		netConfSpec.ExcludeSubnets = cidrString(cfg.ExcludeSubnets)
 	}
 
 	if err := util.ValidateNetConf(nadName, netConfSpec); err != nil {
@@ -172,6 +193,15 @@ func renderCNINetworkConfig(networkName, nadName string, spec SpecGetter) (map[s
 	if netConfSpec.AllowPersistentIPs {
 		cniNetConf["allowPersistentIPs"] = netConfSpec.AllowPersistentIPs
 	}
+	if len(netConfSpec.ExcludeSubnets) > 0 {
+		cniNetConf["excludeSubnets"] = netConfSpec.ExcludeSubnets
+	}
+	if len(netConfSpec.PhysicalNetworkName) > 0 {
+		cniNetConf["physicalNetworkName"] = netConfSpec.PhysicalNetworkName
+	}
+	if netConfSpec.VLANID > 0 {
+		cniNetConf["vlanID"] = netConfSpec.VLANID
+	}
 
 	return cniNetConf, nil
 }
@@ -289,6 +289,26 @@ func (pr *PodRequest) cmdCheck() error {
 	return nil
 }
 
+// cmdGC deletes the stale pod interfaces of the network, i.e. the interfaces of
+// the sandboxes the runtime did not pass as valid attachments
+func cmdGC(conf *ovncnitypes.NetConf) (*Response, error) {
+	response := &Response{}
+	if config.OvnKubeNode.Mode == types.NodeModeDPUHost {
+		// the pod interfaces are plugged into OVS on the DPU, nothing to do
+		response.Result = &current.Result{}
+		return response, nil
+	}
+	if config.UnprivilegedMode {
+		// a nil Result tells the cniShim to delete the stale interfaces
+		return response, nil
+	}
+	if err := gcStaleInterfaces(conf); err != nil {
+		return nil, err
+	}
+	response.Result = &current.Result{}
+	return response, nil
+}
+
 // HandlePodRequest is the callback for all the requests
 // coming to the cniserver after being processed into PodRequest objects
 // Argument '*PodRequest' encapsulates all the necessary information
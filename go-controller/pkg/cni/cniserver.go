@@ -215,6 +215,10 @@ func (s *Server) handleCNIRequest(r *http.Request) ([]byte, error) {
 	if err := json.Unmarshal(b, &cr); err != nil {
 		return nil, err
 	}
+	switch command(cr.Env["CNI_COMMAND"]) {
+	case CNIGC, CNIStatus:
+		return s.handleNetworkRequest(&cr)
+	}
 	req, err := cniRequestToPodRequest(&cr)
 	if err != nil {
 		return nil, err
@@ -229,6 +233,59 @@ func (s *Server) handleCNIRequest(r *http.Request) ([]byte, error) {
 	return result, nil
 }
 
+// Dispatch a request about a whole network, rather than a pod, and return the
+// result to the CNI server client
+func (s *Server) handleNetworkRequest(cr *Request) ([]byte, error) {
+	cmd := command(cr.Env["CNI_COMMAND"])
+	conf, err := config.ReadCNIConfig(cr.Config)
+	if err != nil {
+		return nil, fmt.Errorf("broken stdin args")
+	}
+
+	var response *Response
+	klog.Infof("[%s] %s starting CNI request", conf.Name, cmd)
+	switch cmd {
+	case CNIGC:
+		response, err = cmdGC(conf)
+	case CNIStatus:
+		err = s.cmdStatus()
+	}
+	klog.Infof("[%s] %s finished CNI request, err %v", conf.Name, cmd, err)
+	if err != nil {
+		// Prefix errors with request info for easier failure debugging
+		return nil, fmt.Errorf("[%s] %s %v", conf.Name, cmd, err)
+	}
+
+	if response == nil {
+		return nil, nil
+	}
+	return response.Marshal()
+}
+
+// SetReady marks the node networking as initialized: STATUS requests succeed
+// from then on, as long as the given OVS bridges exist
+func (s *Server) SetReady(bridges ...string) {
+	s.readyLock.Lock()
+	defer s.readyLock.Unlock()
+	s.ready = true
+	s.bridges = bridges
+}
+
+// cmdStatus returns an error if the node networking is not ready to service ADD requests
+func (s *Server) cmdStatus() error {
+	s.readyLock.RLock()
+	defer s.readyLock.RUnlock()
+	if !s.ready {
+		return fmt.Errorf("node networking is not initialized yet")
+	}
+	for _, bridge := range s.bridges {
+		if _, err := ovsExec("br-exists", bridge); err != nil {
+			return fmt.Errorf("OVS bridge %s is missing: %v", bridge, err)
+		}
+	}
+	return nil
+}
+
 func (s *Server) handleCNIMetrics(w http.ResponseWriter, r *http.Request) {
 	var cm CNIRequestMetrics
 
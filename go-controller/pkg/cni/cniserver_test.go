@@ -274,4 +274,23 @@ func TestCNIServer(t *testing.T) {
 			}
 		}
 	}
+
+	// STATUS requests fail until the node networking is ready
+	statusRequest := &Request{
+		Env: map[string]string{
+			"CNI_COMMAND": string(CNIStatus),
+		},
+		Config: []byte(cniConfig),
+	}
+	body, code := clientDoCNI(t, client, statusRequest)
+	if code != http.StatusBadRequest {
+		t.Fatalf("[STATUS] expected status %v but got %v", http.StatusBadRequest, code)
+	}
+	if !strings.Contains(string(body), "node networking is not initialized yet") {
+		t.Fatalf("[STATUS] unexpected error message '%v'", string(body))
+	}
+	s.SetReady()
+	if _, code := clientDoCNI(t, client, statusRequest); code != http.StatusOK {
+		t.Fatalf("[STATUS] expected status %v but got %v", http.StatusOK, code)
+	}
 }
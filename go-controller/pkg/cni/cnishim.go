@@ -332,3 +332,66 @@ func (p *Plugin) CmdCheck(args *skel.CmdArgs) error {
 	// after CNI ADD before it finishes bringing the container up
 	return nil
 }
+
+// CmdGC is the callback for 'garbage collection' cni calls, deleting the stale
+// pod interfaces of the network
+func (p *Plugin) CmdGC(args *skel.CmdArgs) error {
+	var err error
+	var body []byte
+	var conf *ovntypes.NetConf
+
+	startTime := time.Now()
+	defer func() {
+		p.postMetrics(startTime, CNIGC, err)
+		if err != nil {
+			klog.Errorf(err.Error())
+		}
+	}()
+
+	// read the config stdin args
+	conf, err = config.ReadCNIConfig(args.StdinData)
+	if err != nil {
+		return err
+	}
+	setupLogging(conf)
+
+	req := newCNIRequest(args, nadapi.DeviceInfo{})
+	body, err = p.doCNI("http://dummy/", req)
+	if err != nil {
+		return err
+	}
+
+	response := &Response{}
+	err = json.Unmarshal(body, response)
+	if err != nil {
+		err = fmt.Errorf("cmdGC: failed to unmarshal response '%s': %v", string(body), err)
+		return err
+	}
+
+	// if Result is nil, then ovnkube-node is running in unprivileged mode so delete the stale interfaces from here.
+	if response.Result == nil {
+		// Initialize OVS exec runner; find OVS binaries that the CNI code uses.
+		if err = SetExec(kexec.New()); err != nil {
+			err = fmt.Errorf("failed to initialize OVS exec runner: %v", err)
+			return err
+		}
+		err = gcStaleInterfaces(conf)
+	}
+	return err
+}
+
+// CmdStatus is the callback for 'status' cni calls, reporting whether ovnkube-node
+// can service ADD requests
+func (p *Plugin) CmdStatus(args *skel.CmdArgs) error {
+	conf, err := config.ReadCNIConfig(args.StdinData)
+	if err != nil {
+		return err
+	}
+	setupLogging(conf)
+
+	req := newCNIRequest(args, nadapi.DeviceInfo{})
+	if _, err := p.doCNI("http://dummy/", req); err != nil {
+		return types.NewError(errPluginNotAvailable, "ovnkube-node is not ready", err.Error())
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ package cni
 
 import (
 	"context"
+	"encoding/csv"
 	"fmt"
 	"net"
 	"os"
@@ -14,10 +15,13 @@ import (
 	"sync"
 	"time"
 
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
 
+	ovncnitypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/cni/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+	utilerrors "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util/errors"
 
 	current "github.com/containernetworking/cni/pkg/types/100"
 	"github.com/containernetworking/plugins/pkg/ip"
@@ -531,6 +535,10 @@ func ConfigureOVS(ctx context.Context, namespace, podName, hostIfaceName string,
 	if ifInfo.NetName != types.DefaultNetworkName {
 		ovsArgs = append(ovsArgs, fmt.Sprintf("external_ids:%s=%s", types.NetworkExternalID, ifInfo.NetName))
 		ovsArgs = append(ovsArgs, fmt.Sprintf("external_ids:%s=%s", types.NADExternalID, ifInfo.NADName))
+		if ifInfo.Role == types.NetworkRolePrimary {
+			// the primary UDN interface is plugged by the default network ADD, see gcStaleInterfaces
+			ovsArgs = append(ovsArgs, fmt.Sprintf("external_ids:%s=%s", types.NetworkRoleExternalID, ifInfo.Role))
+		}
 	} else {
 		ovsArgs = append(ovsArgs, []string{"--", "--if-exists", "remove", "interface", hostIfaceName, "external_ids", types.NetworkExternalID}...)
 		ovsArgs = append(ovsArgs, []string{"--", "--if-exists", "remove", "interface", hostIfaceName, "external_ids", types.NADExternalID}...)
@@ -835,3 +843,72 @@ func (pr *PodRequest) deletePorts(ifaces []string, podNamespace, podName string)
 		pr.deletePort(iface, podNamespace, podName)
 	}
 }
+
+// gcStaleInterfaces deletes the OVS interfaces of the network whose sandbox is
+// not in the valid attachments passed by the runtime, along with their host
+// interfaces, conntrack entries and bandwidth settings.
+// The primary UDN interfaces are plugged by the default network ADD, hence are
+// part of the default network attachments.
+func gcStaleInterfaces(conf *ovncnitypes.NetConf) error {
+	isNetworkInterface := func(externalIDs string) bool {
+		nadName := util.GetExternalIDValByKey(externalIDs, types.NADExternalID)
+		if conf.Name != types.DefaultNetworkName {
+			return nadName == conf.NADName
+		}
+		return nadName == "" || util.GetExternalIDValByKey(externalIDs, types.NetworkRoleExternalID) == types.NetworkRolePrimary
+	}
+	validSandboxes := sets.New[string]()
+	for _, attachment := range conf.ValidAttachments {
+		validSandboxes.Insert(attachment.ContainerID)
+	}
+
+	ifaces, err := ovsFind("Interface", "name,external_ids")
+	if err != nil {
+		return fmt.Errorf("failed to list OVS interfaces: %v", err)
+	}
+
+	var errs []error
+	for _, iface := range ifaces {
+		record, err := csv.NewReader(strings.NewReader(iface)).Read()
+		if err != nil || len(record) != 2 {
+			klog.Warningf("Failed to parse OVS interface %q: %v", iface, err)
+			continue
+		}
+		name, externalIDs := record[0], record[1]
+		sandboxID := util.GetExternalIDValByKey(externalIDs, "sandbox")
+		if sandboxID == "" || util.GetExternalIDValByKey(externalIDs, "iface-id") == "" ||
+			!isNetworkInterface(externalIDs) || validSandboxes.Has(sandboxID) {
+			continue
+		}
+
+		klog.Infof("Deleting stale OVS interface %s of sandbox %s for network %s", name, sandboxID, conf.Name)
+		if err := clearPodBandwidthForPorts([]string{name}, sandboxID); err != nil {
+			errs = append(errs, fmt.Errorf("failed to clear the bandwidth of sandbox %s: %v", sandboxID, err))
+		}
+		if out, err := ovsExec("--if-exists", "--with-iface", "del-port", "br-int", name); err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete OVS port %s: %v\n  %q", name, err, out))
+			continue
+		}
+		// skip deleting representor ports
+		if util.GetExternalIDValByKey(externalIDs, "vf-netdev-name") == "" {
+			link, err := util.GetNetLinkOps().LinkByName(name)
+			if err == nil {
+				err = util.GetNetLinkOps().LinkDelete(link)
+			}
+			if err != nil && !util.GetNetLinkOps().IsLinkNotFoundError(err) {
+				klog.Warningf("Failed to delete stale interface %s: %v", name, err)
+			}
+		}
+		ipAddresses := strings.Trim(util.GetExternalIDValByKey(externalIDs, "ip_addresses"), "\"")
+		for _, ipAddress := range strings.Split(ipAddresses, ",") {
+			ip, _, err := net.ParseCIDR(ipAddress)
+			if err != nil {
+				continue
+			}
+			if err := util.DeleteConntrack(ip.String(), 0, "", netlink.ConntrackReplyAnyIP, nil); err != nil {
+				klog.Warningf("Failed to delete conntrack entries of stale interface %s for %s: %v", name, ip, err)
+			}
+		}
+	}
+	return utilerrors.Join(errs...)
+}
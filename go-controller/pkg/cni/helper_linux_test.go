@@ -1676,6 +1676,61 @@ func TestConfigureOVS_getPfEncapIpWithError(t *testing.T) {
 
 // TODO(leih): Below functions are copied from pkg/node/base_node_network_controller_dpu_test.go.
 // Move them to a common place to elimate duplications.
+func TestGCStaleInterfaces(t *testing.T) {
+	mockLink := new(netlink_mocks.Link)
+	mockNetLinkOps := new(util_mocks.NetLinkOps)
+	util.SetNetLinkOpMockInst(mockNetLinkOps)
+
+	interfaces := strings.Join([]string{
+		// stale interface of the default network
+		"stale-veth,attached_mac=0a:58:0a:f4:00:05 iface-id=ns-foo_pod-bar ip_addresses=10.244.0.5/24 sandbox=stale-sandbox",
+		// interface of a valid attachment
+		"valid-veth,attached_mac=0a:58:0a:f4:00:06 iface-id=ns-foo_pod-baz ip_addresses=10.244.0.6/24 sandbox=valid-sandbox",
+		// stale representor of the default network
+		"stale-rep,iface-id=ns-foo_pod-vf sandbox=vf-sandbox vf-netdev-name=enp1s0f0v1",
+		// stale interface of a secondary network
+		"nad-veth,iface-id=ns-foo_pod-bar k8s.ovn.org/nad=ns-foo/nad sandbox=stale-sandbox",
+		// stale interface of a primary UDN, plugged by the default network ADD
+		"udn-veth,iface-id=ns-foo.udn_ns-foo_pod-bar ip_addresses=10.100.0.5/24 k8s.ovn.org/nad=ns-foo/udn k8s.ovn.org/role=primary sandbox=stale-sandbox",
+		// interface of a primary UDN of a valid attachment
+		"valid-udn-veth,iface-id=ns-foo.udn_ns-foo_pod-baz k8s.ovn.org/nad=ns-foo/udn k8s.ovn.org/role=primary sandbox=valid-sandbox",
+		// management port
+		"ovn-k8s-mp0,iface-id=k8s-node1",
+	}, "\n")
+
+	execMock := ovntest.NewFakeExec()
+	assert.Nil(t, SetExec(execMock))
+	execMock.AddFakeCmd(&ovntest.ExpectedCmd{
+		Cmd:    "ovs-vsctl --timeout=30 --no-heading --format=csv --data=bare --columns=name,external_ids find Interface",
+		Output: interfaces,
+	})
+	for _, iface := range []struct{ name, sandbox string }{{"stale-veth", "stale-sandbox"}, {"stale-rep", "vf-sandbox"}, {"udn-veth", "stale-sandbox"}} {
+		execMock.AddFakeCmdsNoOutputNoError([]string{
+			"ovs-vsctl --timeout=30 --if-exists clear port " + iface.name + " qos",
+			genOVSFindCmd("30", "qos", "_uuid", "external-ids:sandbox="+iface.sandbox),
+			"ovs-vsctl --timeout=30 --if-exists --with-iface del-port br-int " + iface.name,
+		})
+	}
+	ovntest.ProcessMockFnList(&mockNetLinkOps.Mock, []ovntest.TestifyMockHelper{
+		{OnCallMethodName: "LinkByName", OnCallMethodArgs: []interface{}{"stale-veth"}, RetArgList: []interface{}{mockLink, nil}},
+		{OnCallMethodName: "LinkDelete", OnCallMethodArgs: []interface{}{mockLink}, RetArgList: []interface{}{nil}},
+		{OnCallMethodName: "ConntrackDeleteFilter", OnCallMethodArgType: []string{"netlink.ConntrackTableType", "netlink.InetFamily", "*netlink.ConntrackFilter"}, RetArgList: []interface{}{uint(1), nil}},
+		{OnCallMethodName: "LinkByName", OnCallMethodArgs: []interface{}{"udn-veth"}, RetArgList: []interface{}{mockLink, nil}},
+		{OnCallMethodName: "LinkDelete", OnCallMethodArgs: []interface{}{mockLink}, RetArgList: []interface{}{nil}},
+		{OnCallMethodName: "ConntrackDeleteFilter", OnCallMethodArgType: []string{"netlink.ConntrackTableType", "netlink.InetFamily", "*netlink.ConntrackFilter"}, RetArgList: []interface{}{uint(1), nil}},
+	})
+
+	conf := &types.NetConf{
+		NetConf: cnitypes.NetConf{
+			Name:             ovntypes.DefaultNetworkName,
+			ValidAttachments: []cnitypes.GCAttachment{{ContainerID: "valid-sandbox", IfName: "eth0"}},
+		},
+	}
+	assert.Nil(t, gcStaleInterfaces(conf))
+	assert.True(t, execMock.CalledMatchesExpected(), execMock.ErrorDesc)
+	mockNetLinkOps.AssertExpectations(t)
+}
+
 func genOVSFindCmd(timeout, table, column, condition string) string {
 	return fmt.Sprintf("ovs-vsctl --timeout=%s --no-heading --format=csv --data=bare --columns=%s find %s %s",
 		timeout, column, table, condition)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"sync"
 	"time"
 
 	current "github.com/containernetworking/cni/pkg/types/100"
@@ -75,6 +76,16 @@ const CNIDel command = "DEL"
 // CNICheck is the command representing check operation on a pod
 const CNICheck command = "CHECK"
 
+// CNIGC is the command representing garbage collection of the stale attachments of a network
+const CNIGC command = "GC"
+
+// CNIStatus is the command representing the check of the readiness of a network
+const CNIStatus command = "STATUS"
+
+// errPluginNotAvailable is the CNI error code returned by STATUS when the plugin
+// can't service ADD requests
+const errPluginNotAvailable uint = 50
+
 // Request sent to the Server by the OVN CNI plugin
 type Request struct {
 	// CNI environment variables, like CNI_COMMAND and CNI_NETNS
@@ -196,4 +207,11 @@ type Server struct {
 	clientSet            *ClientSet
 	kubeAuth             *KubeAPIAuth
 	nadController        *nad.NetAttachDefinitionController
+
+	// readyLock protects ready and bridges
+	readyLock sync.RWMutex
+	// ready is set once the node networking is initialized
+	ready bool
+	// bridges are the OVS bridges that must exist for the node networking to be ready
+	bridges []string
 }
@@ -25,7 +25,7 @@ var ErrorChainingNotSupported = errors.New("CNI plugin chaining is not supported
 func WriteCNIConfig() error {
 	netConf := &ovncnitypes.NetConf{
 		NetConf: types.NetConf{
-			CNIVersion: "0.4.0",
+			CNIVersion: CNI.Version,
 			Name:       "ovn-kubernetes",
 			Type:       CNI.Plugin,
 		},
@@ -171,3 +171,14 @@ func ReadCNIConfig(bytes []byte) (*ovncnitypes.NetConf, error) {
 	}
 	return conf, nil
 }
+
+// isSupportedCNIVersion returns whether the CNI plugin supports the given CNI
+// spec version
+func isSupportedCNIVersion(cniVersion string) bool {
+	for _, v := range version.All.SupportedVersions() {
+		if v == cniVersion {
+			return true
+		}
+	}
+	return false
+}
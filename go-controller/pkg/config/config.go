@@ -3,6 +3,7 @@ package config
 import (
 	"flag"
 	"fmt"
+	"math"
 	"net"
 	"net/url"
 	"os"
@@ -72,6 +73,7 @@ var (
 		OVSDBTxnTimeout:              DefaultDBTxnTimeout,
 		LFlowCacheEnable:             true,
 		RawClusterSubnets:            "10.128.0.0/14/23",
+		MaxHostSubnets:               1,
 		Zone:                         types.OvnDefaultZone,
 		RawUDNAllowedDefaultServices: "default/kubernetes,kube-system/kube-dns",
 	}
@@ -107,6 +109,7 @@ var (
 	CNI = CNIConfig{
 		ConfDir: "/etc/cni/net.d",
 		Plugin:  "ovn-k8s-cni-overlay",
+		Version: "0.4.0",
 	}
 
 	// Kubernetes holds Kubernetes-related parsed config file parameters and command-line overrides
@@ -129,7 +132,10 @@ var (
 
 	// OVNKubernetesFeatureConfig holds OVN-Kubernetes feature enhancement config file parameters and command-line overrides
 	OVNKubernetesFeature = OVNKubernetesFeatureConfig{
-		EgressIPReachabiltyTotalTimeout: 1,
+		EgressIPReachabiltyTotalTimeout:  1,
+		RouteAdvertisementsASN:           64512,
+		RouteAdvertisementsFRRConfigFile: "/etc/frr/ovnkube-frr.conf",
+		RouteAdvertisementsFRRReload:     "/usr/lib/frr/frr-reload.py --reload",
 	}
 
 	// OvnNorth holds northbound OVN database client and server authentication and location details
@@ -144,6 +150,7 @@ var (
 		V6JoinSubnet:       "fd98::/64",
 		V4MasqueradeSubnet: "169.254.169.0/29",
 		V6MasqueradeSubnet: "fd69::/125",
+		FirewallBackend:    FirewallBackendIPTables,
 		MasqueradeIPs: MasqueradeIPsConfig{
 			V4OVNMasqueradeIP:               net.ParseIP("169.254.169.1"),
 			V6OVNMasqueradeIP:               net.ParseIP("fd69::1"),
@@ -180,6 +187,12 @@ var (
 		VXLANPort: DefaultVXLANPort,
 	}
 
+	// IPsec holds IPsec encryption config options.
+	IPsec = IPsecConfig{
+		CertDir:      "/etc/ovn/ipsec",
+		CertDuration: 24 * time.Hour,
+	}
+
 	// UnprivilegedMode allows ovnkube-node to run without SYS_ADMIN capability, by performing interface setup in the CNI plugin
 	UnprivilegedMode bool
 
@@ -198,8 +211,10 @@ var (
 	}
 
 	ClusterManager = ClusterManagerConfig{
-		V4TransitSwitchSubnet: "100.88.0.0/16",
-		V6TransitSwitchSubnet: "fd97::/64",
+		V4TransitSwitchSubnet:  "100.88.0.0/16",
+		V6TransitSwitchSubnet:  "fd97::/64",
+		V4NetworkPeeringSubnet: "100.91.0.0/16",
+		V6NetworkPeeringSubnet: "fd95::/64",
 	}
 )
 
@@ -274,6 +289,10 @@ type DefaultConfig struct {
 	// ClusterSubnets holds parsed cluster subnet entries and may be used
 	// outside the config module.
 	ClusterSubnets []CIDRNetworkEntry
+	// MaxHostSubnets is the maximum number of host subnets of each IP family
+	// a node can be allocated. Above 1, additional host subnets are allocated
+	// to a node when it runs out of pod IPs.
+	MaxHostSubnets int `gcfg:"max-host-subnets"`
 	// EnableUDPAggregation is true if ovn-kubernetes should use UDP Generic Receive
 	// Offload forwarding to improve the performance of containers that transmit lots
 	// of small UDP packets by allowing them to be aggregated before passing through
@@ -352,6 +371,9 @@ type CNIConfig struct {
 	ConfDir string `gcfg:"conf-dir"`
 	// Plugin specifies the name of the CNI plugin
 	Plugin string `gcfg:"plugin"`
+	// Version specifies the CNI spec version of the overlay CNI config file.
+	// The runtime only sends GC and STATUS requests from version 1.1.0.
+	Version string `gcfg:"version"`
 }
 
 // KubernetesConfig holds Kubernetes-related parsed config file parameters and command-line overrides
@@ -425,6 +447,25 @@ type OVNKubernetesFeatureConfig struct {
 	EnableDNSNameResolver           bool `gcfg:"enable-dns-name-resolver"`
 	EnableServiceTemplateSupport    bool `gcfg:"enable-svc-template-support"`
 	EnableObservability             bool `gcfg:"enable-observability"`
+	EnableRouteAdvertisements       bool `gcfg:"enable-route-advertisements"`
+	// RouteAdvertisementsASN is the autonomous system number of the nodes when
+	// advertising routes to their BGP peers
+	RouteAdvertisementsASN uint `gcfg:"route-advertisements-asn"`
+	// RouteAdvertisementsFRRConfigFile is the path of the FRR configuration file
+	// ovnkube-node renders with the routes the node advertises
+	RouteAdvertisementsFRRConfigFile string `gcfg:"route-advertisements-frr-config-file"`
+	// RouteAdvertisementsFRRReload is the command, followed by the path of the
+	// FRR configuration file, ovnkube-node runs to apply it
+	RouteAdvertisementsFRRReload string `gcfg:"route-advertisements-frr-reload"`
+	// RouteAdvertisementsNeighbors is the comma separated list of the addresses
+	// of the BGP neighbors of the nodes
+	RouteAdvertisementsNeighbors string `gcfg:"route-advertisements-neighbors"`
+	// RouteAdvertisementsNeighborASN is the autonomous system number of the BGP
+	// neighbors, the ASN of the nodes if not set
+	RouteAdvertisementsNeighborASN uint `gcfg:"route-advertisements-neighbor-asn"`
+	// EnableLBHealthChecks allows services to opt in to OVN health checks of
+	// their backends
+	EnableLBHealthChecks bool `gcfg:"enable-lb-health-checks"`
 }
 
 // GatewayMode holds the node gateway mode
@@ -439,6 +480,13 @@ const (
 	GatewayModeLocal GatewayMode = "local"
 )
 
+const (
+	// FirewallBackendIPTables programs gateway service rules as iptables chains
+	FirewallBackendIPTables = "iptables"
+	// FirewallBackendNFTables programs gateway service rules in a native nftables table
+	FirewallBackendNFTables = "nftables"
+)
+
 // GatewayConfig holds node gateway-related parsed config file parameters and command-line overrides
 type GatewayConfig struct {
 	// Mode is the gateway mode; if may be either empty (disabled), "shared", or "local"
@@ -480,6 +528,9 @@ type GatewayConfig struct {
 	DisableForwarding bool `gcfg:"disable-forwarding"`
 	// AllowNoUplink (disabled by default) controls if the external gateway bridge without an uplink port is allowed in local gateway mode.
 	AllowNoUplink bool `gcfg:"allow-no-uplink"`
+	// FirewallBackend selects whether the node gateway service rules (nodeport, external IP,
+	// ETP/ITP and management port SNAT) are programmed with iptables (default) or nftables.
+	FirewallBackend string `gcfg:"firewall-backend"`
 }
 
 // OvnAuthConfig holds client authentication and location details for
@@ -521,6 +572,22 @@ type HybridOverlayConfig struct {
 	VXLANPort uint `gcfg:"hybrid-overlay-vxlan-port"`
 }
 
+// IPsecConfig holds configuration for the IPsec encryption of the
+// traffic between the nodes.
+type IPsecConfig struct {
+	// Enabled indicates whether IPsec is managed by ovn-kubernetes and enabled
+	// for the whole cluster.
+	Enabled bool `gcfg:"enabled"`
+	// CertDir is the directory where the per node IPsec certificates are stored.
+	CertDir string `gcfg:"cert-dir"`
+	// CertDuration is the requested lifetime of the per node IPsec certificates.
+	// Certificates are rotated before they expire.
+	CertDuration time.Duration `gcfg:"cert-duration"`
+	// CACert is the path of the CA certificate that signs the per node IPsec
+	// certificates, used to authenticate the remote nodes.
+	CACert string `gcfg:"ca-cert"`
+}
+
 // OvnKubeNodeConfig holds ovnkube-node configurations
 type OvnKubeNodeConfig struct {
 	Mode                   string `gcfg:"mode"`
@@ -535,6 +602,10 @@ type ClusterManagerConfig struct {
 	V4TransitSwitchSubnet string `gcfg:"v4-transit-switch-subnet"`
 	// V6TransitSwitchSubnet to be used in the cluster for interconnecting multiple zones
 	V6TransitSwitchSubnet string `gcfg:"v6-transit-switch-subnet"`
+	// V4NetworkPeeringSubnet to be used in the cluster for connecting the routers of peered user defined networks
+	V4NetworkPeeringSubnet string `gcfg:"v4-network-peering-subnet"`
+	// V6NetworkPeeringSubnet to be used in the cluster for connecting the routers of peered user defined networks
+	V6NetworkPeeringSubnet string `gcfg:"v6-network-peering-subnet"`
 }
 
 // OvnDBScheme describes the OVN database connection transport method
@@ -565,6 +636,7 @@ type config struct {
 	MasterHA             HAConfig
 	ClusterMgrHA         HAConfig
 	HybridOverlay        HybridOverlayConfig
+	IPsec                IPsecConfig
 	OvnKubeNode          OvnKubeNodeConfig
 	ClusterManager       ClusterManagerConfig
 }
@@ -584,6 +656,7 @@ var (
 	savedMasterHA             HAConfig
 	savedClusterMgrHA         HAConfig
 	savedHybridOverlay        HybridOverlayConfig
+	savedIPsec                IPsecConfig
 	savedOvnKubeNode          OvnKubeNodeConfig
 	savedClusterManager       ClusterManagerConfig
 
@@ -615,6 +688,7 @@ func init() {
 	savedMasterHA = MasterHA
 	savedClusterMgrHA = ClusterMgrHA
 	savedHybridOverlay = HybridOverlay
+	savedIPsec = IPsec
 	savedOvnKubeNode = OvnKubeNode
 	savedClusterManager = ClusterManager
 	cli.VersionPrinter = func(c *cli.Context) {
@@ -645,6 +719,7 @@ func PrepareTestConfig() error {
 	Gateway = savedGateway
 	MasterHA = savedMasterHA
 	HybridOverlay = savedHybridOverlay
+	IPsec = savedIPsec
 	OvnKubeNode = savedOvnKubeNode
 	ClusterManager = savedClusterManager
 	Kubernetes.DisableRequestedChassis = false
@@ -869,6 +944,14 @@ var CommonFlags = []cli.Flag{
 			"it defaults to 24 if unspecified.",
 		Destination: &cliConfig.Default.RawClusterSubnets,
 	},
+	&cli.IntFlag{
+		Name: "max-host-subnets",
+		Usage: "Maximum number of host subnets of each IP family allocated to a node. " +
+			"Above 1, additional host subnets are allocated to a node on demand when " +
+			"it runs out of pod IPs (default: 1).",
+		Destination: &cliConfig.Default.MaxHostSubnets,
+		Value:       Default.MaxHostSubnets,
+	},
 	&cli.BoolFlag{
 		Name:        "unprivileged-mode",
 		Usage:       "Run ovnkube-node container in unprivileged mode. Valid only with --init-node option.",
@@ -1005,6 +1088,12 @@ var CNIFlags = []cli.Flag{
 		Destination: &cliConfig.CNI.Plugin,
 		Value:       CNI.Plugin,
 	},
+	&cli.StringFlag{
+		Name:        "cni-version",
+		Usage:       "the CNI spec version of the overlay CNI config file; 1.1.0 enables the GC and STATUS commands (default: 0.4.0)",
+		Destination: &cliConfig.CNI.Version,
+		Value:       CNI.Version,
+	},
 }
 
 // OVNK8sFeatureFlags capture OVN-Kubernetes feature related options
@@ -1110,6 +1199,48 @@ var OVNK8sFeatureFlags = []cli.Flag{
 		Destination: &cliConfig.OVNKubernetesFeature.EnableObservability,
 		Value:       OVNKubernetesFeature.EnableObservability,
 	},
+	&cli.BoolFlag{
+		Name:        "enable-route-advertisements",
+		Usage:       "Configure to use BGP route advertisements of the cluster networks with ovn-kubernetes.",
+		Destination: &cliConfig.OVNKubernetesFeature.EnableRouteAdvertisements,
+		Value:       OVNKubernetesFeature.EnableRouteAdvertisements,
+	},
+	&cli.UintFlag{
+		Name:        "route-advertisements-asn",
+		Usage:       "The BGP autonomous system number the nodes advertise routes from.",
+		Destination: &cliConfig.OVNKubernetesFeature.RouteAdvertisementsASN,
+		Value:       OVNKubernetesFeature.RouteAdvertisementsASN,
+	},
+	&cli.StringFlag{
+		Name:        "route-advertisements-frr-config-file",
+		Usage:       "The path of the FRR configuration file rendered with the routes the node advertises.",
+		Destination: &cliConfig.OVNKubernetesFeature.RouteAdvertisementsFRRConfigFile,
+		Value:       OVNKubernetesFeature.RouteAdvertisementsFRRConfigFile,
+	},
+	&cli.StringFlag{
+		Name:        "route-advertisements-frr-reload",
+		Usage:       "The command, followed by the path of the FRR configuration file, run to apply the configuration.",
+		Destination: &cliConfig.OVNKubernetesFeature.RouteAdvertisementsFRRReload,
+		Value:       OVNKubernetesFeature.RouteAdvertisementsFRRReload,
+	},
+	&cli.StringFlag{
+		Name:        "route-advertisements-neighbors",
+		Usage:       "A comma separated list of the addresses of the BGP neighbors of the nodes.",
+		Destination: &cliConfig.OVNKubernetesFeature.RouteAdvertisementsNeighbors,
+		Value:       OVNKubernetesFeature.RouteAdvertisementsNeighbors,
+	},
+	&cli.UintFlag{
+		Name:        "route-advertisements-neighbor-asn",
+		Usage:       "The autonomous system number of the BGP neighbors of the nodes, the ASN of the nodes if not set.",
+		Destination: &cliConfig.OVNKubernetesFeature.RouteAdvertisementsNeighborASN,
+		Value:       OVNKubernetesFeature.RouteAdvertisementsNeighborASN,
+	},
+	&cli.BoolFlag{
+		Name:        "enable-lb-health-checks",
+		Usage:       "Configure to allow services to opt in to OVN load balancer health checks of their backends.",
+		Destination: &cliConfig.OVNKubernetesFeature.EnableLBHealthChecks,
+		Value:       OVNKubernetesFeature.EnableLBHealthChecks,
+	},
 }
 
 // K8sFlags capture Kubernetes-related options
@@ -1464,6 +1595,13 @@ var OVNGatewayFlags = []cli.Flag{
 		Usage:       "Allow the external gateway bridge without an uplink port in local gateway mode",
 		Destination: &cliConfig.Gateway.AllowNoUplink,
 	},
+	&cli.StringFlag{
+		Name: "gateway-firewall-backend",
+		Usage: "The host firewall backend used to program gateway service rules, " +
+			"either \"iptables\" (default) or \"nftables\"",
+		Destination: &cliConfig.Gateway.FirewallBackend,
+		Value:       Gateway.FirewallBackend,
+	},
 	// Deprecated CLI options
 	&cli.BoolFlag{
 		Name:        "init-gateways",
@@ -1547,6 +1685,32 @@ var HybridOverlayFlags = []cli.Flag{
 	},
 }
 
+// IPsecFlags capture IPsec encryption options
+var IPsecFlags = []cli.Flag{
+	&cli.BoolFlag{
+		Name:        "enable-ipsec",
+		Usage:       "Enables the IPsec encryption of the traffic between the nodes, managed by ovn-kubernetes",
+		Destination: &cliConfig.IPsec.Enabled,
+	},
+	&cli.StringFlag{
+		Name:        "ipsec-cert-dir",
+		Usage:       "The directory where the per node IPsec certificates are stored",
+		Value:       IPsec.CertDir,
+		Destination: &cliConfig.IPsec.CertDir,
+	},
+	&cli.DurationFlag{
+		Name:        "ipsec-cert-duration",
+		Usage:       "The requested lifetime of the per node IPsec certificates",
+		Value:       IPsec.CertDuration,
+		Destination: &cliConfig.IPsec.CertDuration,
+	},
+	&cli.StringFlag{
+		Name:        "ipsec-ca-cert",
+		Usage:       "The CA certificate that signs the per node IPsec certificates",
+		Destination: &cliConfig.IPsec.CACert,
+	},
+}
+
 // OvnKubeNodeFlags captures ovnkube-node specific configurations
 var OvnKubeNodeFlags = []cli.Flag{
 	&cli.StringFlag{
@@ -1591,6 +1755,18 @@ var ClusterManagerFlags = []cli.Flag{
 		Destination: &cliConfig.ClusterManager.V6TransitSwitchSubnet,
 		Value:       ClusterManager.V6TransitSwitchSubnet,
 	},
+	&cli.StringFlag{
+		Name:        "cluster-manager-v4-network-peering-subnet",
+		Usage:       "The v4 subnet used for assigning IPv4 addresses to the links between the routers of peered user defined networks",
+		Destination: &cliConfig.ClusterManager.V4NetworkPeeringSubnet,
+		Value:       ClusterManager.V4NetworkPeeringSubnet,
+	},
+	&cli.StringFlag{
+		Name:        "cluster-manager-v6-network-peering-subnet",
+		Usage:       "The v6 subnet used for assigning IPv6 addresses to the links between the routers of peered user defined networks",
+		Destination: &cliConfig.ClusterManager.V6NetworkPeeringSubnet,
+		Value:       ClusterManager.V6NetworkPeeringSubnet,
+	},
 }
 
 // Flags are general command-line flags. Apps should add these flags to their
@@ -1611,6 +1787,7 @@ func GetFlags(customFlags []cli.Flag) []cli.Flag {
 	flags = append(flags, MasterHAFlags...)
 	flags = append(flags, ClusterMgrHAFlags...)
 	flags = append(flags, HybridOverlayFlags...)
+	flags = append(flags, IPsecFlags...)
 	flags = append(flags, MonitoringFlags...)
 	flags = append(flags, IPFIXFlags...)
 	flags = append(flags, OvnKubeNodeFlags...)
@@ -1888,6 +2065,13 @@ func buildGatewayConfig(ctx *cli.Context, cli, file *config) error {
 		return fmt.Errorf("gateway VLAN ID option: %d is supported only in shared gateway mode", Gateway.VLANID)
 	}
 
+	switch Gateway.FirewallBackend {
+	case FirewallBackendIPTables, FirewallBackendNFTables:
+	default:
+		return fmt.Errorf("invalid gateway firewall backend %q: expect one of %s,%s", Gateway.FirewallBackend,
+			FirewallBackendIPTables, FirewallBackendNFTables)
+	}
+
 	return nil
 }
 
@@ -1937,6 +2121,21 @@ func buildOVNKubernetesFeatureConfig(ctx *cli.Context, cli, file *config) error
 	if err := overrideFields(&OVNKubernetesFeature, &cli.OVNKubernetesFeature, &savedOVNKubernetesFeature); err != nil {
 		return err
 	}
+	if OVNKubernetesFeature.EnableRouteAdvertisements {
+		if OVNKubernetesFeature.RouteAdvertisementsASN == 0 || OVNKubernetesFeature.RouteAdvertisementsASN > math.MaxUint32 {
+			return fmt.Errorf("invalid route advertisements ASN %d: expect a value between 1 and %d",
+				OVNKubernetesFeature.RouteAdvertisementsASN, uint32(math.MaxUint32))
+		}
+		if OVNKubernetesFeature.RouteAdvertisementsNeighborASN > math.MaxUint32 {
+			return fmt.Errorf("invalid route advertisements neighbor ASN %d: expect a value between 1 and %d",
+				OVNKubernetesFeature.RouteAdvertisementsNeighborASN, uint32(math.MaxUint32))
+		}
+		for _, neighbor := range RouteAdvertisementsNeighbors() {
+			if net.ParseIP(neighbor) == nil {
+				return fmt.Errorf("invalid route advertisements neighbor %q: expect an IP address", neighbor)
+			}
+		}
+	}
 	return nil
 }
 
@@ -2070,6 +2269,29 @@ func completeHybridOverlayConfig(allSubnets *ConfigSubnets) error {
 	return nil
 }
 
+func buildIPsecConfig(cli, file *config) error {
+	// Copy config file values over default values
+	if err := overrideFields(&IPsec, &file.IPsec, &savedIPsec); err != nil {
+		return err
+	}
+
+	// And CLI overrides over config file and default values
+	if err := overrideFields(&IPsec, &cli.IPsec, &savedIPsec); err != nil {
+		return err
+	}
+
+	if IPsec.Enabled {
+		if IPsec.CACert == "" {
+			return fmt.Errorf("ipsec CA certificate must be provided when IPsec is enabled")
+		}
+		if IPsec.CertDuration < 10*time.Minute {
+			return fmt.Errorf("ipsec certificate duration %s is invalid, it must be at least 10m", IPsec.CertDuration)
+		}
+	}
+
+	return nil
+}
+
 func buildClusterManagerConfig(ctx *cli.Context, cli, file *config) error {
 	// Copy config file values over default values
 	if err := overrideFields(&ClusterManager, &file.ClusterManager, &savedClusterManager); err != nil {
@@ -2099,6 +2321,19 @@ func completeClusterManagerConfig(allSubnets *ConfigSubnets) error {
 	}
 	allSubnets.Append(ConfigSubnetTransit, v4TransitCIDR)
 	allSubnets.Append(ConfigSubnetTransit, v6TransitCIDR)
+
+	// Validate v4 and v6 network peering subnets
+	v4IP, v4PeeringCIDR, err := net.ParseCIDR(ClusterManager.V4NetworkPeeringSubnet)
+	if err != nil || utilnet.IsIPv6(v4IP) {
+		return fmt.Errorf("invalid network peering v4 subnet specified, subnet: %s: error: %v", ClusterManager.V4NetworkPeeringSubnet, err)
+	}
+
+	v6IP, v6PeeringCIDR, err := net.ParseCIDR(ClusterManager.V6NetworkPeeringSubnet)
+	if err != nil || !utilnet.IsIPv6(v6IP) {
+		return fmt.Errorf("invalid network peering v6 subnet specified, subnet: %s: error: %v", ClusterManager.V6NetworkPeeringSubnet, err)
+	}
+	allSubnets.Append(ConfigSubnetNetworkPeering, v4PeeringCIDR)
+	allSubnets.Append(ConfigSubnetNetworkPeering, v6PeeringCIDR)
 	return nil
 }
 
@@ -2118,6 +2353,9 @@ func buildDefaultConfig(cli, file *config) error {
 	if Default.RawClusterSubnets == "" {
 		return fmt.Errorf("cluster subnet is required")
 	}
+	if Default.MaxHostSubnets < 1 {
+		return fmt.Errorf("invalid max-host-subnets %d: must be at least 1", Default.MaxHostSubnets)
+	}
 
 	if Default.Zone == "" {
 		Default.Zone = types.OvnDefaultZone
@@ -2149,6 +2387,19 @@ func completeDefaultConfig(allSubnets *ConfigSubnets) error {
 	return nil
 }
 
+// RouteAdvertisementsNeighbors returns the addresses of the BGP neighbors of the
+// nodes. It ignores spaces and empty elements.
+func RouteAdvertisementsNeighbors() []string {
+	var neighbors []string
+	for _, neighbor := range strings.Split(OVNKubernetesFeature.RouteAdvertisementsNeighbors, ",") {
+		neighbor = strings.TrimSpace(neighbor)
+		if neighbor != "" {
+			neighbors = append(neighbors, neighbor)
+		}
+	}
+	return neighbors
+}
+
 // parseServicesNamespacedNames splits the input string by `,` and returns a slice
 // of keys that were verified to be a valid namespaced service name. It ignores spaces between the elements.
 func parseServicesNamespacedNames(servicesRaw string) ([]string, error) {
@@ -2229,6 +2480,7 @@ func initConfigWithPath(ctx *cli.Context, exec kexec.Interface, saPath string, d
 		MasterHA:             savedMasterHA,
 		ClusterMgrHA:         savedClusterMgrHA,
 		HybridOverlay:        savedHybridOverlay,
+		IPsec:                savedIPsec,
 		OvnKubeNode:          savedOvnKubeNode,
 		ClusterManager:       savedClusterManager,
 	}
@@ -2271,6 +2523,9 @@ func initConfigWithPath(ctx *cli.Context, exec kexec.Interface, saPath string, d
 	if err = overrideFields(&CNI, &cliConfig.CNI, &savedCNI); err != nil {
 		return "", err
 	}
+	if !isSupportedCNIVersion(CNI.Version) {
+		return "", fmt.Errorf("unsupported CNI version %q", CNI.Version)
+	}
 
 	// Logging setup
 	if err = overrideFields(&Logging, &cfg.Logging, &savedLogging); err != nil {
@@ -2344,6 +2599,10 @@ func initConfigWithPath(ctx *cli.Context, exec kexec.Interface, saPath string, d
 		return "", err
 	}
 
+	if err = buildIPsecConfig(&cliConfig, &cfg); err != nil {
+		return "", err
+	}
+
 	if err = buildOvnKubeNodeConfig(ctx, &cliConfig, &cfg); err != nil {
 		return "", err
 	}
@@ -2378,6 +2637,7 @@ func initConfigWithPath(ctx *cli.Context, exec kexec.Interface, saPath string, d
 	klog.V(5).Infof("OVN North config: %+v", OvnNorth)
 	klog.V(5).Infof("OVN South config: %+v", OvnSouth)
 	klog.V(5).Infof("Hybrid Overlay config: %+v", HybridOverlay)
+	klog.V(5).Infof("IPsec config: %+v", IPsec)
 	klog.V(5).Infof("Ovnkube Node config: %+v", OvnKubeNode)
 	klog.V(5).Infof("Ovnkube Cluster Manager config: %+v", ClusterManager)
 
@@ -184,6 +184,7 @@ cache-active-timeout=789
 [cni]
 conf-dir=/etc/cni/net.d22
 plugin=ovn-k8s-cni-overlay22
+version=1.1.0
 
 [ovnnorth]
 address=ssl:1.2.3.4:6641
@@ -213,6 +214,7 @@ router-subnet=10.50.0.0/16
 single-node=false
 disable-forwarding=true
 allow-no-uplink=false
+firewall-backend=nftables
 
 [hybridoverlay]
 enabled=true
@@ -231,6 +233,8 @@ enable-interconnect=false
 enable-multi-external-gateway=false
 enable-admin-network-policy=false
 enable-persistent-ips=false
+enable-route-advertisements=false
+route-advertisements-asn=64513
 
 [clustermanager]
 v4-transit-switch-subnet=100.89.0.0/16
@@ -292,6 +296,7 @@ var _ = Describe("Config Operations", func() {
 			gomega.Expect(Default.LFlowCacheEnable).To(gomega.BeTrue())
 			gomega.Expect(Default.LFlowCacheLimit).To(gomega.Equal(uint(0)))
 			gomega.Expect(Default.LFlowCacheLimitKb).To(gomega.Equal(uint(0)))
+			gomega.Expect(Default.MaxHostSubnets).To(gomega.Equal(1))
 			gomega.Expect(Default.EnableUDPAggregation).To(gomega.BeFalse())
 			gomega.Expect(Logging.File).To(gomega.Equal(""))
 			gomega.Expect(Logging.Level).To(gomega.Equal(5))
@@ -303,6 +308,7 @@ var _ = Describe("Config Operations", func() {
 			gomega.Expect(IPFIX.CacheActiveTimeout).To(gomega.Equal(uint(60)))
 			gomega.Expect(CNI.ConfDir).To(gomega.Equal("/etc/cni/net.d"))
 			gomega.Expect(CNI.Plugin).To(gomega.Equal("ovn-k8s-cni-overlay"))
+			gomega.Expect(CNI.Version).To(gomega.Equal("0.4.0"))
 			gomega.Expect(Kubernetes.Kubeconfig).To(gomega.Equal(""))
 			gomega.Expect(Kubernetes.BootstrapKubeconfig).To(gomega.Equal(""))
 			gomega.Expect(Kubernetes.CertDir).To(gomega.Equal(""))
@@ -333,6 +339,7 @@ var _ = Describe("Config Operations", func() {
 			gomega.Expect(Gateway.SingleNode).To(gomega.BeFalse())
 			gomega.Expect(Gateway.DisableForwarding).To(gomega.BeFalse())
 			gomega.Expect(Gateway.AllowNoUplink).To(gomega.BeFalse())
+			gomega.Expect(Gateway.FirewallBackend).To(gomega.Equal(FirewallBackendIPTables))
 			gomega.Expect(OVNKubernetesFeature.EgressIPReachabiltyTotalTimeout).To(gomega.Equal(1))
 			gomega.Expect(OVNKubernetesFeature.EgressIPNodeHealthCheckPort).To(gomega.Equal(0))
 			gomega.Expect(OVNKubernetesFeature.EnableMultiNetwork).To(gomega.BeFalse())
@@ -342,6 +349,12 @@ var _ = Describe("Config Operations", func() {
 			gomega.Expect(OVNKubernetesFeature.EnableMultiExternalGateway).To(gomega.BeFalse())
 			gomega.Expect(OVNKubernetesFeature.EnableAdminNetworkPolicy).To(gomega.BeFalse())
 			gomega.Expect(OVNKubernetesFeature.EnablePersistentIPs).To(gomega.BeFalse())
+			gomega.Expect(OVNKubernetesFeature.EnableRouteAdvertisements).To(gomega.BeFalse())
+			gomega.Expect(OVNKubernetesFeature.RouteAdvertisementsASN).To(gomega.Equal(uint(64512)))
+			gomega.Expect(OVNKubernetesFeature.RouteAdvertisementsFRRConfigFile).To(gomega.Equal("/etc/frr/ovnkube-frr.conf"))
+			gomega.Expect(OVNKubernetesFeature.RouteAdvertisementsFRRReload).To(gomega.Equal("/usr/lib/frr/frr-reload.py --reload"))
+			gomega.Expect(RouteAdvertisementsNeighbors()).To(gomega.BeEmpty())
+			gomega.Expect(OVNKubernetesFeature.RouteAdvertisementsNeighborASN).To(gomega.Equal(uint(0)))
 
 			for _, a := range []OvnAuthConfig{OvnNorth, OvnSouth} {
 				gomega.Expect(a.Scheme).To(gomega.Equal(OvnDBSchemeUnix))
@@ -599,6 +612,7 @@ var _ = Describe("Config Operations", func() {
 			"enable-multi-external-gateway=true",
 			"enable-admin-network-policy=true",
 			"enable-persistent-ips=true",
+			"enable-route-advertisements=true",
 			"zone=foo",
 		)
 		gomega.Expect(err).NotTo(gomega.HaveOccurred())
@@ -625,6 +639,7 @@ var _ = Describe("Config Operations", func() {
 			gomega.Expect(IPFIX.CacheActiveTimeout).To(gomega.Equal(uint(789)))
 			gomega.Expect(CNI.ConfDir).To(gomega.Equal("/etc/cni/net.d22"))
 			gomega.Expect(CNI.Plugin).To(gomega.Equal("ovn-k8s-cni-overlay22"))
+			gomega.Expect(CNI.Version).To(gomega.Equal("1.1.0"))
 			gomega.Expect(Kubernetes.Kubeconfig).To(gomega.Equal(kubeconfigFile))
 			gomega.Expect(Kubernetes.BootstrapKubeconfig).To(gomega.Equal(bootstrapKubeconfigFile))
 			gomega.Expect(Kubernetes.CertDir).To(gomega.Equal(certDir))
@@ -678,6 +693,7 @@ var _ = Describe("Config Operations", func() {
 			gomega.Expect(Gateway.SingleNode).To(gomega.BeFalse())
 			gomega.Expect(Gateway.DisableForwarding).To(gomega.BeTrue())
 			gomega.Expect(Gateway.AllowNoUplink).To(gomega.BeFalse())
+			gomega.Expect(Gateway.FirewallBackend).To(gomega.Equal(FirewallBackendNFTables))
 
 			gomega.Expect(HybridOverlay.Enabled).To(gomega.BeTrue())
 			gomega.Expect(OVNKubernetesFeature.EgressIPReachabiltyTotalTimeout).To(gomega.Equal(3))
@@ -688,6 +704,8 @@ var _ = Describe("Config Operations", func() {
 			gomega.Expect(OVNKubernetesFeature.EnableMultiExternalGateway).To(gomega.BeTrue())
 			gomega.Expect(OVNKubernetesFeature.EnableAdminNetworkPolicy).To(gomega.BeTrue())
 			gomega.Expect(OVNKubernetesFeature.EnablePersistentIPs).To(gomega.BeTrue())
+			gomega.Expect(OVNKubernetesFeature.EnableRouteAdvertisements).To(gomega.BeTrue())
+			gomega.Expect(OVNKubernetesFeature.RouteAdvertisementsASN).To(gomega.Equal(uint(64513)))
 			gomega.Expect(HybridOverlay.ClusterSubnets).To(gomega.Equal([]CIDRNetworkEntry{
 				{ovntest.MustParseIPNet("11.132.0.0/14"), 23},
 			}))
@@ -731,6 +749,7 @@ var _ = Describe("Config Operations", func() {
 			gomega.Expect(Logging.ACLLoggingRateLimit).To(gomega.Equal(30))
 			gomega.Expect(CNI.ConfDir).To(gomega.Equal("/some/cni/dir"))
 			gomega.Expect(CNI.Plugin).To(gomega.Equal("a-plugin"))
+			gomega.Expect(CNI.Version).To(gomega.Equal("1.0.0"))
 			gomega.Expect(Kubernetes.Kubeconfig).To(gomega.Equal(kubeconfigFile))
 			gomega.Expect(Kubernetes.BootstrapKubeconfig).To(gomega.Equal(bootstrapKubeconfigFile))
 			gomega.Expect(Kubernetes.CertDir).To(gomega.Equal(certDir))
@@ -817,6 +836,7 @@ var _ = Describe("Config Operations", func() {
 			"-acl-logging-rate-limit=30",
 			"-cni-conf-dir=/some/cni/dir",
 			"-cni-plugin=a-plugin",
+			"-cni-version=1.0.0",
 			"-cluster-subnets=10.130.0.0/15/24",
 			"-k8s-kubeconfig=" + kubeconfigFile,
 			"-bootstrap-kubeconfig=" + bootstrapKubeconfigFile,
@@ -980,6 +1000,20 @@ cluster-subnets=172.18.0.0/23
 		gomega.Expect(err).NotTo(gomega.HaveOccurred())
 	})
 
+	It("returns an error when the max-host-subnets is invalid", func() {
+		app.Action = func(ctx *cli.Context) error {
+			_, err := InitConfig(ctx, kexec.New(), nil)
+			gomega.Expect(err).To(gomega.MatchError("invalid max-host-subnets 0: must be at least 1"))
+			return nil
+		}
+		cliArgs := []string{
+			app.Name,
+			"-max-host-subnets=0",
+		}
+		err := app.Run(cliArgs)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	})
+
 	It("returns an error when the hybrid overlay cluster-subnets is invalid", func() {
 		app.Action = func(ctx *cli.Context) error {
 			_, err := InitConfig(ctx, kexec.New(), nil)
@@ -995,6 +1029,45 @@ cluster-subnets=172.18.0.0/23
 		gomega.Expect(err).NotTo(gomega.HaveOccurred())
 	})
 
+	It("returns an error when IPsec is enabled without a CA certificate", func() {
+		app.Action = func(ctx *cli.Context) error {
+			_, err := InitConfig(ctx, kexec.New(), nil)
+			gomega.Expect(err).To(gomega.MatchError("ipsec CA certificate must be provided when IPsec is enabled"))
+			return nil
+		}
+		cliArgs := []string{
+			app.Name,
+			"-enable-ipsec",
+		}
+		err := app.Run(cliArgs)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	})
+
+	It("overrides IPsec config file and defaults with CLI options", func() {
+		err := ioutil.WriteFile(cfgFile.Name(), []byte(`[ipsec]
+enabled=true
+ca-cert=/etc/ovn/ipsec-ca.pem
+`), 0o644)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		app.Action = func(ctx *cli.Context) error {
+			_, err := InitConfig(ctx, kexec.New(), nil)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(IPsec.Enabled).To(gomega.BeTrue())
+			gomega.Expect(IPsec.CACert).To(gomega.Equal("/etc/ovn/ipsec-ca.pem"))
+			gomega.Expect(IPsec.CertDuration).To(gomega.Equal(3 * time.Hour))
+			gomega.Expect(IPsec.CertDir).To(gomega.Equal("/etc/ovn/ipsec"))
+			return nil
+		}
+		cliArgs := []string{
+			app.Name,
+			"-config-file=" + cfgFile.Name(),
+			"-ipsec-cert-duration=3h",
+		}
+		err = app.Run(cliArgs)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	})
+
 	It("overrides config file and defaults with CLI legacy --init-gateways option", func() {
 		err := ioutil.WriteFile(cfgFile.Name(), []byte(`[gateway]
 mode=local
@@ -1113,6 +1186,83 @@ enable-pprof=true
 		gomega.Expect(err).NotTo(gomega.HaveOccurred())
 	})
 
+	It("returns an error when the CNI version is unsupported", func() {
+		app.Action = func(ctx *cli.Context) error {
+			_, err := InitConfig(ctx, kexec.New(), nil)
+			gomega.Expect(err).To(gomega.MatchError("unsupported CNI version \"1.2.0\""))
+			return nil
+		}
+		cliArgs := []string{
+			app.Name,
+			"-cni-version=1.2.0",
+		}
+		err := app.Run(cliArgs)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	})
+
+	It("returns an error when the gateway firewall backend is invalid", func() {
+		app.Action = func(ctx *cli.Context) error {
+			_, err := InitConfig(ctx, kexec.New(), nil)
+			gomega.Expect(err).To(gomega.MatchError("invalid gateway firewall backend \"ebtables\": expect one of iptables,nftables"))
+			return nil
+		}
+		cliArgs := []string{
+			app.Name,
+			"-gateway-mode=shared",
+			"-gateway-firewall-backend=ebtables",
+		}
+		err := app.Run(cliArgs)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	})
+
+	It("returns an error when the route advertisements ASN is invalid", func() {
+		app.Action = func(ctx *cli.Context) error {
+			_, err := InitConfig(ctx, kexec.New(), nil)
+			gomega.Expect(err).To(gomega.MatchError("invalid route advertisements ASN 4294967296: expect a value between 1 and 4294967295"))
+			return nil
+		}
+		cliArgs := []string{
+			app.Name,
+			"-enable-route-advertisements=true",
+			"-route-advertisements-asn=4294967296",
+		}
+		err := app.Run(cliArgs)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	})
+
+	It("parses the route advertisements neighbors", func() {
+		app.Action = func(ctx *cli.Context) error {
+			_, err := InitConfig(ctx, kexec.New(), nil)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(RouteAdvertisementsNeighbors()).To(gomega.Equal([]string{"172.18.0.5", "fd00::5"}))
+			gomega.Expect(OVNKubernetesFeature.RouteAdvertisementsNeighborASN).To(gomega.Equal(uint(64000)))
+			return nil
+		}
+		cliArgs := []string{
+			app.Name,
+			"-enable-route-advertisements=true",
+			"-route-advertisements-neighbors=172.18.0.5, fd00::5",
+			"-route-advertisements-neighbor-asn=64000",
+		}
+		err := app.Run(cliArgs)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	})
+
+	It("returns an error when a route advertisements neighbor is invalid", func() {
+		app.Action = func(ctx *cli.Context) error {
+			_, err := InitConfig(ctx, kexec.New(), nil)
+			gomega.Expect(err).To(gomega.MatchError("invalid route advertisements neighbor \"tor1\": expect an IP address"))
+			return nil
+		}
+		cliArgs := []string{
+			app.Name,
+			"-enable-route-advertisements=true",
+			"-route-advertisements-neighbors=172.18.0.5,tor1",
+		}
+		err := app.Run(cliArgs)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	})
+
 	It("returns an error when the vlan-id is specified for mode other than shared gateway mode", func() {
 		app.Action = func(ctx *cli.Context) error {
 			_, err := InitConfig(ctx, kexec.New(), nil)
@@ -1240,6 +1390,49 @@ enable-pprof=true
 		gomega.Expect(ClusterManager.V4TransitSwitchSubnet).To(gomega.Equal("100.89.0.0/16"))
 		gomega.Expect(ClusterManager.V6TransitSwitchSubnet).To(gomega.Equal("fd99::/64"))
 	})
+	It("returns an error when the v4 network peering subnet specified is invalid", func() {
+		app.Action = func(ctx *cli.Context) error {
+			_, err := InitConfig(ctx, kexec.New(), nil)
+			gomega.Expect(err).To(gomega.MatchError("invalid network peering v4 subnet specified, subnet: fd95::/64: error: <nil>"))
+			return nil
+		}
+		cliArgs := []string{
+			app.Name,
+			"-cluster-manager-v4-network-peering-subnet=fd95::/64",
+		}
+		err := app.Run(cliArgs)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	})
+	It("returns an error when the network peering subnet overlaps the transit switch subnet", func() {
+		app.Action = func(ctx *cli.Context) error {
+			_, err := InitConfig(ctx, kexec.New(), nil)
+			gomega.Expect(err).To(gomega.MatchError(
+				"illegal network configuration: network peering subnet \"100.88.0.0/24\" overlaps transit switch subnet \"100.88.0.0/16\""))
+			return nil
+		}
+		cliArgs := []string{
+			app.Name,
+			"-cluster-manager-v4-network-peering-subnet=100.88.0.0/24",
+		}
+		err := app.Run(cliArgs)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	})
+	It("successfully overrides the default network peering subnets", func() {
+		app.Action = func(ctx *cli.Context) error {
+			_, err := InitConfig(ctx, kexec.New(), nil)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			return nil
+		}
+		cliArgs := []string{
+			app.Name,
+			"-cluster-manager-v4-network-peering-subnet=100.92.0.0/24",
+			"-cluster-manager-v6-network-peering-subnet=fd94::/64",
+		}
+		err := app.Run(cliArgs)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(ClusterManager.V4NetworkPeeringSubnet).To(gomega.Equal("100.92.0.0/24"))
+		gomega.Expect(ClusterManager.V6NetworkPeeringSubnet).To(gomega.Equal("fd94::/64"))
+	})
 	It("overrides config file and defaults with CLI options (multi-master)", func() {
 		kubeconfigFile, _, err := createTempFile("kubeconfig")
 		gomega.Expect(err).NotTo(gomega.HaveOccurred())
@@ -1276,6 +1469,7 @@ enable-pprof=true
 			gomega.Expect(IPFIX.CacheActiveTimeout).To(gomega.Equal(uint(1789)))
 			gomega.Expect(CNI.ConfDir).To(gomega.Equal("/some/cni/dir"))
 			gomega.Expect(CNI.Plugin).To(gomega.Equal("a-plugin"))
+			gomega.Expect(CNI.Version).To(gomega.Equal("1.1.0"))
 			gomega.Expect(Kubernetes.Kubeconfig).To(gomega.Equal(kubeconfigFile))
 			gomega.Expect(Kubernetes.BootstrapKubeconfig).To(gomega.Equal(bootstrapKubeconfigFile))
 			gomega.Expect(Kubernetes.CertDir).To(gomega.Equal(certDir))
@@ -1384,6 +1578,7 @@ enable-pprof=true
 			gomega.Expect(Logging.Level).To(gomega.Equal(5))
 			gomega.Expect(CNI.ConfDir).To(gomega.Equal("/etc/cni/net.d22"))
 			gomega.Expect(CNI.Plugin).To(gomega.Equal("ovn-k8s-cni-overlay22"))
+			gomega.Expect(CNI.Version).To(gomega.Equal("1.1.0"))
 			gomega.Expect(Kubernetes.Kubeconfig).To(gomega.Equal(kubeconfigFile))
 			gomega.Expect(Kubernetes.BootstrapKubeconfig).To(gomega.Equal(bootstrapKubeconfigFile))
 			gomega.Expect(Kubernetes.CertDir).To(gomega.Equal(certDir))
@@ -165,14 +165,15 @@ func ParseFlowCollectors(flowCollectors string) ([]HostPort, error) {
 type ConfigSubnetType string
 
 const (
-	ConfigSubnetJoin       ConfigSubnetType = "built-in join subnet"
-	ConfigSubnetCluster    ConfigSubnetType = "cluster subnet"
-	ConfigSubnetService    ConfigSubnetType = "service subnet"
-	ConfigSubnetHybrid     ConfigSubnetType = "hybrid overlay subnet"
-	ConfigSubnetMasquerade ConfigSubnetType = "masquerade subnet"
-	ConfigSubnetTransit    ConfigSubnetType = "transit switch subnet"
-	UserDefinedSubnets     ConfigSubnetType = "user defined subnet"
-	UserDefinedJoinSubnet  ConfigSubnetType = "user defined join subnet"
+	ConfigSubnetJoin           ConfigSubnetType = "built-in join subnet"
+	ConfigSubnetCluster        ConfigSubnetType = "cluster subnet"
+	ConfigSubnetService        ConfigSubnetType = "service subnet"
+	ConfigSubnetHybrid         ConfigSubnetType = "hybrid overlay subnet"
+	ConfigSubnetMasquerade     ConfigSubnetType = "masquerade subnet"
+	ConfigSubnetTransit        ConfigSubnetType = "transit switch subnet"
+	ConfigSubnetNetworkPeering ConfigSubnetType = "network peering subnet"
+	UserDefinedSubnets         ConfigSubnetType = "user defined subnet"
+	UserDefinedJoinSubnet      ConfigSubnetType = "user defined join subnet"
 )
 
 type ConfigSubnet struct {
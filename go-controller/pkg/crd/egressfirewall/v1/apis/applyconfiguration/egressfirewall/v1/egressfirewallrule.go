@@ -26,6 +26,7 @@ import (
 type EgressFirewallRuleApplyConfiguration struct {
 	Type  *v1.EgressFirewallRuleType                   `json:"type,omitempty"`
 	Ports []EgressFirewallPortApplyConfiguration       `json:"ports,omitempty"`
+	ICMP  []EgressFirewallICMPApplyConfiguration       `json:"icmp,omitempty"`
 	To    *EgressFirewallDestinationApplyConfiguration `json:"to,omitempty"`
 }
 
@@ -56,6 +57,19 @@ func (b *EgressFirewallRuleApplyConfiguration) WithPorts(values ...*EgressFirewa
 	return b
 }
 
+// WithICMP adds the given value to the ICMP field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ICMP field.
+func (b *EgressFirewallRuleApplyConfiguration) WithICMP(values ...*EgressFirewallICMPApplyConfiguration) *EgressFirewallRuleApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithICMP")
+		}
+		b.ICMP = append(b.ICMP, *values[i])
+	}
+	return b
+}
+
 // WithTo sets the To field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the To field is set to the value of the last call.
@@ -35,6 +35,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &egressfirewallv1.EgressFirewallApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("EgressFirewallDestination"):
 		return &egressfirewallv1.EgressFirewallDestinationApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("EgressFirewallICMP"):
+		return &egressfirewallv1.EgressFirewallICMPApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("EgressFirewallPort"):
 		return &egressfirewallv1.EgressFirewallPortApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("EgressFirewallRule"):
@@ -56,11 +56,16 @@ type EgressFirewallRule struct {
 	// ports specify what ports and protocols the rule applies to
 	// +optional
 	Ports []EgressFirewallPort `json:"ports,omitempty"`
+	// icmp specify what ICMP and ICMPv6 messages the rule applies to. If both
+	// ports and icmp are set, the rule applies to the traffic matching any of them.
+	// +optional
+	ICMP []EgressFirewallICMP `json:"icmp,omitempty"`
 	// to is the target that traffic is allowed/denied to
 	To EgressFirewallDestination `json:"to"`
 }
 
 // EgressFirewallPort specifies the port to allow or deny traffic to
+// +kubebuilder:validation:XValidation:rule="!has(self.endPort) || self.endPort >= self.port", message="endPort must be greater than or equal to port"
 type EgressFirewallPort struct {
 	// protocol (tcp, udp, sctp) that the traffic must match.
 	// +kubebuilder:validation:Pattern=^TCP|UDP|SCTP$
@@ -69,6 +74,32 @@ type EgressFirewallPort struct {
 	// +kubebuilder:validation:Minimum:=1
 	// +kubebuilder:validation:Maximum:=65535
 	Port int32 `json:"port"`
+	// endPort, if set, indicates that the traffic must match the range of ports
+	// between port and endPort, inclusive.
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=65535
+	// +optional
+	EndPort int32 `json:"endPort,omitempty"`
+}
+
+// EgressFirewallICMP specifies the ICMP or ICMPv6 messages to allow or deny traffic to
+// +kubebuilder:validation:XValidation:rule="!has(self.code) || has(self.type)", message="code requires type to be set"
+type EgressFirewallICMP struct {
+	// protocol (ICMP, ICMPv6) that the traffic must match.
+	// +kubebuilder:validation:Pattern=^(ICMP|ICMPv6)$
+	Protocol string `json:"protocol"`
+	// type of the ICMP messages that the traffic must match. All the types are
+	// matched if unset.
+	// +kubebuilder:validation:Minimum:=0
+	// +kubebuilder:validation:Maximum:=255
+	// +optional
+	Type *int32 `json:"type,omitempty"`
+	// code of the ICMP messages that the traffic must match. All the codes of
+	// the type are matched if unset.
+	// +kubebuilder:validation:Minimum:=0
+	// +kubebuilder:validation:Maximum:=255
+	// +optional
+	Code *int32 `json:"code,omitempty"`
 }
 
 // +kubebuilder:validation:MinProperties:=1
@@ -74,6 +74,32 @@ func (in *EgressFirewallDestination) DeepCopy() *EgressFirewallDestination {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressFirewallICMP) DeepCopyInto(out *EgressFirewallICMP) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Code != nil {
+		in, out := &in.Code, &out.Code
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressFirewallICMP.
+func (in *EgressFirewallICMP) DeepCopy() *EgressFirewallICMP {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressFirewallICMP)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EgressFirewallList) DeepCopyInto(out *EgressFirewallList) {
 	*out = *in
@@ -131,6 +157,13 @@ func (in *EgressFirewallRule) DeepCopyInto(out *EgressFirewallRule) {
 		*out = make([]EgressFirewallPort, len(*in))
 		copy(*out, *in)
 	}
+	if in.ICMP != nil {
+		in, out := &in.ICMP, &out.ICMP
+		*out = make([]EgressFirewallICMP, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.To.DeepCopyInto(&out.To)
 	return
 }
@@ -18,15 +18,18 @@ limitations under the License.
 package v1
 
 import (
-	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+	metav1 "k8s.io/client-go/applyconfigurations/meta/v1"
 )
 
 // EgressIPSpecApplyConfiguration represents a declarative configuration of the EgressIPSpec type for use
 // with apply.
 type EgressIPSpecApplyConfiguration struct {
-	EgressIPs         []string                            `json:"egressIPs,omitempty"`
-	NamespaceSelector *v1.LabelSelectorApplyConfiguration `json:"namespaceSelector,omitempty"`
-	PodSelector       *v1.LabelSelectorApplyConfiguration `json:"podSelector,omitempty"`
+	EgressIPs                 []string                                             `json:"egressIPs,omitempty"`
+	PoolRequest               *EgressIPPoolRequestApplyConfiguration               `json:"poolRequest,omitempty"`
+	NamespaceSelector         *metav1.LabelSelectorApplyConfiguration              `json:"namespaceSelector,omitempty"`
+	PodSelector               *metav1.LabelSelectorApplyConfiguration              `json:"podSelector,omitempty"`
+	TopologySpreadConstraints []EgressIPTopologySpreadConstraintApplyConfiguration `json:"topologySpreadConstraints,omitempty"`
+	NodePreferences           []EgressIPNodePreferenceApplyConfiguration           `json:"nodePreferences,omitempty"`
 }
 
 // EgressIPSpecApplyConfiguration constructs a declarative configuration of the EgressIPSpec type for use with
@@ -45,10 +48,18 @@ func (b *EgressIPSpecApplyConfiguration) WithEgressIPs(values ...string) *Egress
 	return b
 }
 
+// WithPoolRequest sets the PoolRequest field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PoolRequest field is set to the value of the last call.
+func (b *EgressIPSpecApplyConfiguration) WithPoolRequest(value *EgressIPPoolRequestApplyConfiguration) *EgressIPSpecApplyConfiguration {
+	b.PoolRequest = value
+	return b
+}
+
 // WithNamespaceSelector sets the NamespaceSelector field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the NamespaceSelector field is set to the value of the last call.
-func (b *EgressIPSpecApplyConfiguration) WithNamespaceSelector(value *v1.LabelSelectorApplyConfiguration) *EgressIPSpecApplyConfiguration {
+func (b *EgressIPSpecApplyConfiguration) WithNamespaceSelector(value *metav1.LabelSelectorApplyConfiguration) *EgressIPSpecApplyConfiguration {
 	b.NamespaceSelector = value
 	return b
 }
@@ -56,7 +67,33 @@ func (b *EgressIPSpecApplyConfiguration) WithNamespaceSelector(value *v1.LabelSe
 // WithPodSelector sets the PodSelector field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the PodSelector field is set to the value of the last call.
-func (b *EgressIPSpecApplyConfiguration) WithPodSelector(value *v1.LabelSelectorApplyConfiguration) *EgressIPSpecApplyConfiguration {
+func (b *EgressIPSpecApplyConfiguration) WithPodSelector(value *metav1.LabelSelectorApplyConfiguration) *EgressIPSpecApplyConfiguration {
 	b.PodSelector = value
 	return b
 }
+
+// WithTopologySpreadConstraints adds the given value to the TopologySpreadConstraints field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the TopologySpreadConstraints field.
+func (b *EgressIPSpecApplyConfiguration) WithTopologySpreadConstraints(values ...*EgressIPTopologySpreadConstraintApplyConfiguration) *EgressIPSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithTopologySpreadConstraints")
+		}
+		b.TopologySpreadConstraints = append(b.TopologySpreadConstraints, *values[i])
+	}
+	return b
+}
+
+// WithNodePreferences adds the given value to the NodePreferences field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the NodePreferences field.
+func (b *EgressIPSpecApplyConfiguration) WithNodePreferences(values ...*EgressIPNodePreferenceApplyConfiguration) *EgressIPSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithNodePreferences")
+		}
+		b.NodePreferences = append(b.NodePreferences, *values[i])
+	}
+	return b
+}
@@ -33,12 +33,26 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 	// Group=k8s.ovn.org, Version=v1
 	case v1.SchemeGroupVersion.WithKind("EgressIP"):
 		return &egressipv1.EgressIPApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("EgressIPNodePreference"):
+		return &egressipv1.EgressIPNodePreferenceApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("EgressIPPool"):
+		return &egressipv1.EgressIPPoolApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("EgressIPPoolAllocation"):
+		return &egressipv1.EgressIPPoolAllocationApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("EgressIPPoolRequest"):
+		return &egressipv1.EgressIPPoolRequestApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("EgressIPPoolSpec"):
+		return &egressipv1.EgressIPPoolSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("EgressIPPoolStatus"):
+		return &egressipv1.EgressIPPoolStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("EgressIPSpec"):
 		return &egressipv1.EgressIPSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("EgressIPStatus"):
 		return &egressipv1.EgressIPStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("EgressIPStatusItem"):
 		return &egressipv1.EgressIPStatusItemApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("EgressIPTopologySpreadConstraint"):
+		return &egressipv1.EgressIPTopologySpreadConstraintApplyConfiguration{}
 
 	}
 	return nil
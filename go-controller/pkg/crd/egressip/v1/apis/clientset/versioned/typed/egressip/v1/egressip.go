@@ -39,6 +39,8 @@ type EgressIPsGetter interface {
 type EgressIPInterface interface {
 	Create(ctx context.Context, egressIP *v1.EgressIP, opts metav1.CreateOptions) (*v1.EgressIP, error)
 	Update(ctx context.Context, egressIP *v1.EgressIP, opts metav1.UpdateOptions) (*v1.EgressIP, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, egressIP *v1.EgressIP, opts metav1.UpdateOptions) (*v1.EgressIP, error)
 	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
 	DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error
 	Get(ctx context.Context, name string, opts metav1.GetOptions) (*v1.EgressIP, error)
@@ -46,6 +48,8 @@ type EgressIPInterface interface {
 	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *v1.EgressIP, err error)
 	Apply(ctx context.Context, egressIP *egressipv1.EgressIPApplyConfiguration, opts metav1.ApplyOptions) (result *v1.EgressIP, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, egressIP *egressipv1.EgressIPApplyConfiguration, opts metav1.ApplyOptions) (result *v1.EgressIP, err error)
 	EgressIPExpansion
 }
 
@@ -101,6 +101,18 @@ func (c *FakeEgressIPs) Update(ctx context.Context, egressIP *v1.EgressIP, opts
 	return obj.(*v1.EgressIP), err
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeEgressIPs) UpdateStatus(ctx context.Context, egressIP *v1.EgressIP, opts metav1.UpdateOptions) (result *v1.EgressIP, err error) {
+	emptyResult := &v1.EgressIP{}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateSubresourceActionWithOptions(egressipsResource, "status", egressIP, opts), emptyResult)
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1.EgressIP), err
+}
+
 // Delete takes name of the egressIP and deletes it. Returns an error if one occurs.
 func (c *FakeEgressIPs) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
 	_, err := c.Fake.
@@ -148,3 +160,26 @@ func (c *FakeEgressIPs) Apply(ctx context.Context, egressIP *egressipv1.EgressIP
 	}
 	return obj.(*v1.EgressIP), err
 }
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeEgressIPs) ApplyStatus(ctx context.Context, egressIP *egressipv1.EgressIPApplyConfiguration, opts metav1.ApplyOptions) (result *v1.EgressIP, err error) {
+	if egressIP == nil {
+		return nil, fmt.Errorf("egressIP provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(egressIP)
+	if err != nil {
+		return nil, err
+	}
+	name := egressIP.Name
+	if name == nil {
+		return nil, fmt.Errorf("egressIP.Name must be provided to Apply")
+	}
+	emptyResult := &v1.EgressIP{}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceActionWithOptions(egressipsResource, *name, types.ApplyPatchType, data, opts.ToPatchOptions(), "status"), emptyResult)
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1.EgressIP), err
+}
@@ -9,9 +9,9 @@ import (
 
 // +genclient
 // +genclient:nonNamespaced
-// +genclient:noStatus
 // +resource:path=egressip
 // +kubebuilder:resource:shortName=eip,scope=Cluster
+// +kubebuilder:subresource:status
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +kubebuilder:printcolumn:name="EgressIPs",type=string,JSONPath=".spec.egressIPs[*]"
 // +kubebuilder:printcolumn:name="Assigned Node",type=string,JSONPath=".status.items[*].node"
@@ -33,8 +33,30 @@ type EgressIP struct {
 type EgressIPStatus struct {
 	// The list of assigned egress IPs and their corresponding node assignment.
 	Items []EgressIPStatusItem `json:"items"`
+	// Conditions slice of condition objects indicating details about EgressIP status.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
+// Types of the EgressIP status conditions.
+const (
+	// EgressIPConditionAssigned reports whether all the requested egress IPs
+	// are assigned to a node.
+	EgressIPConditionAssigned = "Assigned"
+	// EgressIPConditionCloudAssigned reports, on public clouds, whether the
+	// cloud provider attached all the assigned egress IPs to their node.
+	EgressIPConditionCloudAssigned = "CloudAssigned"
+	// EgressIPConditionProgrammed reports whether the assigned egress IPs are
+	// programmed in all the zones.
+	EgressIPConditionProgrammed = "Programmed"
+	// EgressIPConditionProgrammedInZonePrefix prefixes the type of the
+	// condition each zone reports whether it programmed the assigned egress
+	// IPs with, followed by the zone name.
+	EgressIPConditionProgrammedInZonePrefix = "Programmed-In-Zone-"
+)
+
 // The per node status, for those egress IPs who have been assigned.
 type EgressIPStatusItem struct {
 	// Assigned node name
@@ -44,10 +66,18 @@ type EgressIPStatusItem struct {
 }
 
 // EgressIPSpec is a desired state description of EgressIP.
+// +kubebuilder:validation:XValidation:rule="!has(self.poolRequest) || !has(self.egressIPs) || size(self.egressIPs) == 0", message="egressIPs and poolRequest are mutually exclusive"
 type EgressIPSpec struct {
 	// EgressIPs is the list of egress IP addresses requested. Can be IPv4 and/or IPv6.
-	// This field is mandatory.
-	EgressIPs []string `json:"egressIPs"`
+	// This field is mandatory unless PoolRequest is set.
+	// +optional
+	EgressIPs []string `json:"egressIPs,omitempty"`
+	// PoolRequest requests egress IP addresses to be allocated from an
+	// EgressIPPool instead of listing them in EgressIPs. The allocated addresses
+	// are reported in the status of the EgressIPPool and, once assigned to a
+	// node, in the status of this EgressIP. Mutually exclusive with EgressIPs.
+	// +optional
+	PoolRequest *EgressIPPoolRequest `json:"poolRequest,omitempty"`
 	// NamespaceSelector applies the egress IP only to the namespace(s) whose label
 	// matches this definition. This field is mandatory.
 	NamespaceSelector metav1.LabelSelector `json:"namespaceSelector"`
@@ -60,6 +90,79 @@ type EgressIPSpec struct {
 	// match this pod selector.
 	// +optional
 	PodSelector metav1.LabelSelector `json:"podSelector,omitempty"`
+	// TopologySpreadConstraints describes how the egress IPs are spread across
+	// the failure domains of the egress assignable nodes. The constraints are
+	// honored when egress IPs are assigned, including when they are reassigned
+	// because the node they were assigned to became unusable.
+	// +listType=map
+	// +listMapKey=topologyKey
+	// +optional
+	TopologySpreadConstraints []EgressIPTopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+	// NodePreferences gives precedence to some egress assignable nodes when
+	// assigning the egress IPs. The nodes with the greatest sum of the weights
+	// of the preferences they match are preferred, falling back to the other
+	// nodes when they can't host the egress IPs.
+	// +optional
+	NodePreferences []EgressIPNodePreference `json:"nodePreferences,omitempty"`
+}
+
+// EgressIPUnsatisfiableConstraintAction is the action to take when an egress
+// IP can't be assigned without violating a topology spread constraint.
+// +kubebuilder:validation:Enum=DoNotSchedule;ScheduleAnyway
+type EgressIPUnsatisfiableConstraintAction string
+
+const (
+	// DoNotSchedule leaves the egress IP unassigned instead of violating the
+	// constraint.
+	DoNotSchedule EgressIPUnsatisfiableConstraintAction = "DoNotSchedule"
+	// ScheduleAnyway assigns the egress IP regardless, preferring the nodes
+	// that satisfy the constraint.
+	ScheduleAnyway EgressIPUnsatisfiableConstraintAction = "ScheduleAnyway"
+)
+
+// EgressIPTopologySpreadConstraint describes how the egress IPs of an EgressIP
+// are spread across the failure domains defined by a node label.
+type EgressIPTopologySpreadConstraint struct {
+	// TopologyKey is the key of the node label whose values define the
+	// failure domains, e.g. topology.kubernetes.io/zone. Nodes without the
+	// label are only considered when WhenUnsatisfiable is ScheduleAnyway.
+	// +kubebuilder:validation:MinLength=1
+	TopologyKey string `json:"topologyKey"`
+	// MaxSkew is the maximum permitted difference between the number of
+	// egress IPs assigned to the nodes of a failure domain and the minimum
+	// number of egress IPs assigned to the nodes of any failure domain.
+	// Defaults to 1.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=1
+	// +optional
+	MaxSkew int `json:"maxSkew,omitempty"`
+	// WhenUnsatisfiable is the action to take when an egress IP can't be
+	// assigned without violating the constraint. Defaults to DoNotSchedule.
+	// +kubebuilder:default=DoNotSchedule
+	// +optional
+	WhenUnsatisfiable EgressIPUnsatisfiableConstraintAction `json:"whenUnsatisfiable,omitempty"`
+}
+
+// EgressIPNodePreference is a weighted selector of the egress assignable
+// nodes to prefer when assigning egress IPs.
+type EgressIPNodePreference struct {
+	// Weight associated with matching NodeSelector, in the range 1-100.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	Weight int32 `json:"weight"`
+	// NodeSelector selects the preferred nodes.
+	NodeSelector metav1.LabelSelector `json:"nodeSelector"`
+}
+
+// EgressIPPoolRequest describes the egress IP addresses requested from an
+// EgressIPPool.
+type EgressIPPoolRequest struct {
+	// PoolName is the name of the EgressIPPool to allocate the egress IPs from.
+	// +kubebuilder:validation:MinLength=1
+	PoolName string `json:"poolName"`
+	// Count is the number of egress IP addresses to allocate from the pool.
+	// +kubebuilder:validation:Minimum=1
+	Count int `json:"count"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -72,3 +175,67 @@ type EgressIPList struct {
 	// List of EgressIP.
 	Items []EgressIP `json:"items"`
 }
+
+// +genclient
+// +genclient:nonNamespaced
+// +resource:path=egressippool
+// +kubebuilder:resource:shortName=eippool,scope=Cluster
+// +kubebuilder:subresource:status
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:printcolumn:name="Ranges",type=string,JSONPath=".spec.ranges[*]"
+// +kubebuilder:printcolumn:name="Allocated EgressIPs",type=string,JSONPath=".status.allocations[*].egressIP"
+// EgressIPPool is a CRD defining a set of addresses from which egress IPs are
+// allocated to the EgressIP objects requesting them through their PoolRequest.
+type EgressIPPool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Specification of the desired behavior of EgressIPPool.
+	Spec EgressIPPoolSpec `json:"spec"`
+	// Observed status of EgressIPPool. Read-only.
+	// +optional
+	Status EgressIPPoolStatus `json:"status,omitempty"`
+}
+
+// EgressIPPoolSpec is a desired state description of EgressIPPool.
+type EgressIPPoolSpec struct {
+	// Ranges is the list of addresses egress IPs are allocated from. Each entry
+	// is either a CIDR, e.g. "172.18.0.0/28", or an inclusive range of addresses
+	// of the same IP family, e.g. "172.18.0.10-172.18.0.20". The network and
+	// broadcast addresses of IPv4 CIDRs are never allocated.
+	// +kubebuilder:validation:MinItems=1
+	Ranges []string `json:"ranges"`
+	// NodeSelector restricts the assignment of the egress IPs allocated from
+	// this pool to the egress assignable nodes whose labels match. This field is
+	// optional, and in case it is not set: egress IPs may be assigned to any
+	// egress assignable node.
+	// +optional
+	NodeSelector metav1.LabelSelector `json:"nodeSelector,omitempty"`
+}
+
+// EgressIPPoolStatus is the observed state of EgressIPPool.
+type EgressIPPoolStatus struct {
+	// Allocations is the list of addresses allocated from the pool and the
+	// EgressIP objects they are allocated to.
+	// +optional
+	Allocations []EgressIPPoolAllocation `json:"allocations,omitempty"`
+}
+
+// EgressIPPoolAllocation is an address allocated from an EgressIPPool.
+type EgressIPPoolAllocation struct {
+	// Allocated egress IP
+	EgressIP string `json:"egressIP"`
+	// Name of the EgressIP object the address is allocated to
+	EgressIPName string `json:"egressIPName"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +resource:path=egressippool
+// EgressIPPoolList is the list of EgressIPPool.
+type EgressIPPoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// List of EgressIPPool.
+	Items []EgressIPPool `json:"items"`
+}
@@ -24,9 +24,11 @@ import (
 // EgressQoSRuleApplyConfiguration represents a declarative configuration of the EgressQoSRule type for use
 // with apply.
 type EgressQoSRuleApplyConfiguration struct {
-	DSCP        *int                                `json:"dscp,omitempty"`
-	DstCIDR     *string                             `json:"dstCIDR,omitempty"`
-	PodSelector *v1.LabelSelectorApplyConfiguration `json:"podSelector,omitempty"`
+	DSCP        *int                                  `json:"dscp,omitempty"`
+	Bandwidth   *EgressQoSBandwidthApplyConfiguration `json:"bandwidth,omitempty"`
+	DstCIDR     *string                               `json:"dstCIDR,omitempty"`
+	PodSelector *v1.LabelSelectorApplyConfiguration   `json:"podSelector,omitempty"`
+	Ports       []EgressQoSPortApplyConfiguration     `json:"ports,omitempty"`
 }
 
 // EgressQoSRuleApplyConfiguration constructs a declarative configuration of the EgressQoSRule type for use with
@@ -43,6 +45,14 @@ func (b *EgressQoSRuleApplyConfiguration) WithDSCP(value int) *EgressQoSRuleAppl
 	return b
 }
 
+// WithBandwidth sets the Bandwidth field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Bandwidth field is set to the value of the last call.
+func (b *EgressQoSRuleApplyConfiguration) WithBandwidth(value *EgressQoSBandwidthApplyConfiguration) *EgressQoSRuleApplyConfiguration {
+	b.Bandwidth = value
+	return b
+}
+
 // WithDstCIDR sets the DstCIDR field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the DstCIDR field is set to the value of the last call.
@@ -58,3 +68,16 @@ func (b *EgressQoSRuleApplyConfiguration) WithPodSelector(value *v1.LabelSelecto
 	b.PodSelector = value
 	return b
 }
+
+// WithPorts adds the given value to the Ports field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Ports field.
+func (b *EgressQoSRuleApplyConfiguration) WithPorts(values ...*EgressQoSPortApplyConfiguration) *EgressQoSRuleApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithPorts")
+		}
+		b.Ports = append(b.Ports, *values[i])
+	}
+	return b
+}
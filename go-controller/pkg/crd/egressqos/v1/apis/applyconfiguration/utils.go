@@ -33,6 +33,10 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 	// Group=k8s.ovn.org, Version=v1
 	case v1.SchemeGroupVersion.WithKind("EgressQoS"):
 		return &egressqosv1.EgressQoSApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("EgressQoSBandwidth"):
+		return &egressqosv1.EgressQoSBandwidthApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("EgressQoSPort"):
+		return &egressqosv1.EgressQoSPortApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("EgressQoSRule"):
 		return &egressqosv1.EgressQoSRuleApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("EgressQoSSpec"):
@@ -49,11 +49,19 @@ type EgressQoSSpec struct {
 	Egress []EgressQoSRule `json:"egress"`
 }
 
+// +kubebuilder:validation:XValidation:rule="has(self.dscp) || has(self.bandwidth)", message="at least one of dscp or bandwidth must be set"
 type EgressQoSRule struct {
 	// DSCP marking value for matching pods' traffic.
+	// This field is optional if bandwidth is set.
+	// +optional
 	// +kubebuilder:validation:Maximum:=63
 	// +kubebuilder:validation:Minimum:=0
-	DSCP int `json:"dscp"`
+	DSCP *int `json:"dscp,omitempty"`
+
+	// Bandwidth limits the rate of the matching pods' traffic.
+	// This field is optional if dscp is set.
+	// +optional
+	Bandwidth *EgressQoSBandwidth `json:"bandwidth,omitempty"`
 
 	// DstCIDR specifies the destination's CIDR. Only traffic heading
 	// to this CIDR will be marked with the DSCP value.
@@ -68,6 +76,47 @@ type EgressQoSRule struct {
 	// results in the rule being applied to all pods in the namespace.
 	// +optional
 	PodSelector metav1.LabelSelector `json:"podSelector,omitempty"`
+
+	// Ports specify the destination ports and protocols of the traffic the
+	// rule applies to. This field is optional, and in case it is not set
+	// the rule is applied to all egress traffic regardless of the protocol.
+	// +optional
+	Ports []EgressQoSPort `json:"ports,omitempty"`
+}
+
+// EgressQoSPort specifies the destination port and protocol of the traffic
+// +kubebuilder:validation:XValidation:rule="!has(self.endPort) || has(self.port) && self.endPort >= self.port", message="endPort requires port and must be greater than or equal to it"
+type EgressQoSPort struct {
+	// Protocol (TCP, UDP, SCTP) that the traffic must match.
+	// +kubebuilder:validation:Enum=TCP;UDP;SCTP
+	Protocol string `json:"protocol"`
+
+	// Port that the traffic must match. This field is optional, and in case
+	// it is not set the rule is applied to all the ports of the protocol.
+	// +optional
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=65535
+	Port int32 `json:"port,omitempty"`
+
+	// EndPort, if set, indicates that the traffic must match the range of
+	// ports between port and endPort, inclusive.
+	// +optional
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=65535
+	EndPort int32 `json:"endPort,omitempty"`
+}
+
+// EgressQoSBandwidth specifies the rate limit of the traffic
+type EgressQoSBandwidth struct {
+	// Rate of the traffic, in kbps.
+	// +kubebuilder:validation:Minimum:=1
+	Rate int32 `json:"rate"`
+
+	// Burst of the traffic above the rate, in kilobits. This field is
+	// optional, and in case it is not set OVN's default burst is used.
+	// +optional
+	// +kubebuilder:validation:Minimum:=1
+	Burst int32 `json:"burst,omitempty"`
 }
 
 // EgressQoSStatus defines the observed state of EgressQoS
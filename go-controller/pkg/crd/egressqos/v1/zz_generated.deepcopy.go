@@ -53,6 +53,22 @@ func (in *EgressQoS) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressQoSBandwidth) DeepCopyInto(out *EgressQoSBandwidth) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressQoSBandwidth.
+func (in *EgressQoSBandwidth) DeepCopy() *EgressQoSBandwidth {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressQoSBandwidth)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EgressQoSList) DeepCopyInto(out *EgressQoSList) {
 	*out = *in
@@ -86,15 +102,46 @@ func (in *EgressQoSList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressQoSPort) DeepCopyInto(out *EgressQoSPort) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressQoSPort.
+func (in *EgressQoSPort) DeepCopy() *EgressQoSPort {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressQoSPort)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EgressQoSRule) DeepCopyInto(out *EgressQoSRule) {
 	*out = *in
+	if in.DSCP != nil {
+		in, out := &in.DSCP, &out.DSCP
+		*out = new(int)
+		**out = **in
+	}
+	if in.Bandwidth != nil {
+		in, out := &in.Bandwidth, &out.Bandwidth
+		*out = new(EgressQoSBandwidth)
+		**out = **in
+	}
 	if in.DstCIDR != nil {
 		in, out := &in.DstCIDR, &out.DstCIDR
 		*out = new(string)
 		**out = **in
 	}
 	in.PodSelector.DeepCopyInto(&out.PodSelector)
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]EgressQoSPort, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -0,0 +1,157 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NetworkObservability configures the sampling of the traffic matched by the
+// OVN ACLs of the cluster and the collectors the samples are sent to.
+// Only one NetworkObservability named "default" is allowed in the cluster.
+//
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +resource:path=networkobservabilities
+// +kubebuilder:resource:path=networkobservabilities,scope=Cluster,shortName=netobserv
+// +kubebuilder:singular=networkobservability
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=".status.status"
+type NetworkObservability struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// +kubebuilder:validation:Required
+	// +required
+	Spec NetworkObservabilitySpec `json:"spec"`
+	// +optional
+	Status NetworkObservabilityStatus `json:"status,omitempty"`
+}
+
+// NetworkObservabilitySpec defines the desired state of NetworkObservability.
+type NetworkObservabilitySpec struct {
+	// Collectors is the list of collectors the samples are sent to.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +listType=map
+	// +listMapKey=collectorSetID
+	// +required
+	Collectors []NetworkObservabilityCollector `json:"collectors"`
+}
+
+// NetworkObservabilityCollector defines which features are sampled, and with
+// which probability, for a collector.
+type NetworkObservabilityCollector struct {
+	// CollectorSetID is the ID of the OVS Flow_Sample_Collector_Set the
+	// samples are sent to, as configured on the nodes.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=4294967295
+	// +required
+	CollectorSetID int64 `json:"collectorSetID"`
+
+	// Features determines the features whose ACLs are sampled, and the
+	// sampling probability of each of them.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// +listType=map
+	// +listMapKey=feature
+	// +required
+	Features []FeatureSampling `json:"features"`
+
+	// NamespaceSelector limits the sampling of the namespaced features
+	// (NetworkPolicy, EgressFirewall and Multicast) to the ACLs of the selected
+	// namespaces. The ACLs of cluster-scoped features are not affected. If not
+	// set, all the namespaces are selected.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// PodSelector limits the sampling of the NetworkPolicy feature to the
+	// ACLs of the network policies that apply to at least one of the selected
+	// pods in the selected namespaces. If not set, all the pods are selected.
+	// +optional
+	PodSelector *metav1.LabelSelector `json:"podSelector,omitempty"`
+}
+
+// FeatureSampling defines the sampling probability of a feature.
+type FeatureSampling struct {
+	// Feature is the feature whose ACLs are sampled.
+	// +kubebuilder:validation:Required
+	// +required
+	Feature Feature `json:"feature"`
+
+	// Probability is the percentage of the packets that are sampled.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:default=100
+	// +optional
+	Probability int32 `json:"probability"`
+}
+
+// Feature is an OVN-Kubernetes feature that supports sampling.
+// +kubebuilder:validation:Enum=EgressFirewall;NetworkPolicy;AdminNetworkPolicy;Multicast;UDNIsolation
+type Feature string
+
+const (
+	EgressFirewall     Feature = "EgressFirewall"
+	NetworkPolicy      Feature = "NetworkPolicy"
+	AdminNetworkPolicy Feature = "AdminNetworkPolicy"
+	Multicast          Feature = "Multicast"
+	UDNIsolation       Feature = "UDNIsolation"
+)
+
+const (
+	// NetworkObservabilityConditionAppliedInZonePrefix prefixes the type of
+	// the condition each zone reports whether it applied the configuration
+	// with, followed by the zone name.
+	NetworkObservabilityConditionAppliedInZonePrefix = "Applied-In-Zone-"
+)
+
+// NetworkObservabilityStatus contains the observed status of the NetworkObservability.
+type NetworkObservabilityStatus struct {
+	// Status is a concise indication of whether the NetworkObservability
+	// resource is applied with success in all the zones.
+	// +optional
+	Status string `json:"status,omitempty"`
+
+	// Collectors reports the OVN sample collectors created for each collector set
+	// in every zone.
+	// +listType=map
+	// +listMapKey=zone
+	// +listMapKey=collectorSetID
+	// +optional
+	Collectors []CollectorStatus `json:"collectors,omitempty"`
+
+	// Conditions slice of condition objects indicating details about NetworkObservability status,
+	// every zone reports whether the configuration is applied in an Applied-In-Zone-<zone> condition.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// CollectorStatus contains the OVN sample collectors created for a collector set.
+type CollectorStatus struct {
+	// Zone is the zone the OVN sample collectors are created in.
+	// +required
+	Zone string `json:"zone"`
+
+	// CollectorSetID is the ID of the OVS collector set.
+	// +required
+	CollectorSetID int64 `json:"collectorSetID"`
+
+	// CollectorIDs are the IDs of the OVN sample collectors created for the
+	// collector set, one for every distinct sampling probability.
+	// +listType=set
+	// +optional
+	CollectorIDs []int32 `json:"collectorIDs,omitempty"`
+}
+
+// NetworkObservabilityList contains a list of NetworkObservability.
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type NetworkObservabilityList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NetworkObservability `json:"items"`
+}
@@ -0,0 +1,96 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RouteAdvertisements describes which routes of the cluster networks are
+// advertised by the nodes to their routing peers and in which VRF.
+//
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +resource:path=routeadvertisements
+// +kubebuilder:resource:path=routeadvertisements,scope=Cluster,shortName=ra
+// +kubebuilder:singular=routeadvertisements
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=".status.status"
+type RouteAdvertisements struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// +kubebuilder:validation:Required
+	// +required
+	Spec RouteAdvertisementsSpec `json:"spec"`
+	// +optional
+	Status RouteAdvertisementsStatus `json:"status,omitempty"`
+}
+
+// RouteAdvertisementsSpec defines the desired state of RouteAdvertisements.
+type RouteAdvertisementsSpec struct {
+	// TargetVRF determines the VRF the selected networks are advertised in
+	// and the VRF routes are learned from. If not set, the default VRF is
+	// used. The value "auto" advertises each network in its own VRF, which is
+	// only supported for user defined networks.
+	// +kubebuilder:validation:MaxLength=15
+	// +optional
+	TargetVRF string `json:"targetVRF,omitempty"`
+
+	// NetworkSelector selects the primary layer3 and layer2 user defined networks to
+	// advertise by the labels of their NetworkAttachmentDefinitions. If not
+	// set, the default cluster network is advertised.
+	// +optional
+	NetworkSelector *metav1.LabelSelector `json:"networkSelector,omitempty"`
+
+	// NodeSelector limits the advertisements to the selected nodes. An empty
+	// selector selects all the nodes.
+	// +optional
+	NodeSelector metav1.LabelSelector `json:"nodeSelector,omitempty"`
+
+	// Advertisements determines what is advertised for the selected networks.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// +listType=set
+	// +required
+	Advertisements []AdvertisementType `json:"advertisements"`
+}
+
+// AdvertisementType is a type of route that can be advertised.
+// +kubebuilder:validation:Enum=PodNetwork;EgressIP
+type AdvertisementType string
+
+const (
+	// PodNetwork advertises the pod subnet each node is assigned on the
+	// selected networks.
+	PodNetwork AdvertisementType = "PodNetwork"
+	// EgressIP advertises the egress IPs assigned to each node. Only
+	// supported for the default cluster network.
+	EgressIP AdvertisementType = "EgressIP"
+)
+
+// TargetVRFAuto is the TargetVRF value that selects the own VRF of each
+// advertised network.
+const TargetVRFAuto = "auto"
+
+// RouteAdvertisementsStatus contains the observed status of the RouteAdvertisements.
+type RouteAdvertisementsStatus struct {
+	// Status is a concise indication of whether the RouteAdvertisements
+	// resource is applied with success.
+	// +optional
+	Status string `json:"status,omitempty"`
+
+	// Conditions slice of condition objects indicating details about RouteAdvertisements status.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// RouteAdvertisementsList contains a list of RouteAdvertisements.
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type RouteAdvertisementsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RouteAdvertisements `json:"items"`
+}
@@ -43,8 +43,18 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &userdefinednetworkv1.Layer3ConfigApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("Layer3Subnet"):
 		return &userdefinednetworkv1.Layer3SubnetApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("LocalnetConfig"):
+		return &userdefinednetworkv1.LocalnetConfigApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("NetworkPeering"):
+		return &userdefinednetworkv1.NetworkPeeringApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("NetworkPeeringSpec"):
+		return &userdefinednetworkv1.NetworkPeeringSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("NetworkPeeringStatus"):
+		return &userdefinednetworkv1.NetworkPeeringStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("NetworkSpec"):
 		return &userdefinednetworkv1.NetworkSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("PeeredNetwork"):
+		return &userdefinednetworkv1.PeeredNetworkApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("UserDefinedNetwork"):
 		return &userdefinednetworkv1.UserDefinedNetworkApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("UserDefinedNetworkSpec"):
@@ -31,6 +31,10 @@ func (c *FakeK8sV1) ClusterUserDefinedNetworks() v1.ClusterUserDefinedNetworkInt
 	return &FakeClusterUserDefinedNetworks{c}
 }
 
+func (c *FakeK8sV1) NetworkPeerings() v1.NetworkPeeringInterface {
+	return &FakeNetworkPeerings{c}
+}
+
 func (c *FakeK8sV1) UserDefinedNetworks(namespace string) v1.UserDefinedNetworkInterface {
 	return &FakeUserDefinedNetworks{c, namespace}
 }
@@ -19,4 +19,6 @@ package v1
 
 type ClusterUserDefinedNetworkExpansion interface{}
 
+type NetworkPeeringExpansion interface{}
+
 type UserDefinedNetworkExpansion interface{}
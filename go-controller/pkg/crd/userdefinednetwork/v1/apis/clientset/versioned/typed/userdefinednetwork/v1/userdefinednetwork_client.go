@@ -28,6 +28,7 @@ import (
 type K8sV1Interface interface {
 	RESTClient() rest.Interface
 	ClusterUserDefinedNetworksGetter
+	NetworkPeeringsGetter
 	UserDefinedNetworksGetter
 }
 
@@ -40,6 +41,10 @@ func (c *K8sV1Client) ClusterUserDefinedNetworks() ClusterUserDefinedNetworkInte
 	return newClusterUserDefinedNetworks(c)
 }
 
+func (c *K8sV1Client) NetworkPeerings() NetworkPeeringInterface {
+	return newNetworkPeerings(c)
+}
+
 func (c *K8sV1Client) UserDefinedNetworks(namespace string) UserDefinedNetworkInterface {
 	return newUserDefinedNetworks(c, namespace)
 }
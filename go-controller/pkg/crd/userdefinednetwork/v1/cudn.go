@@ -30,7 +30,8 @@ type ClusterUserDefinedNetworkSpec struct {
 
 	// Network is the user-defined-network spec
 	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf", message="Network spec is immutable"
+	// +kubebuilder:validation:XValidation:rule="self.topology == oldSelf.topology", message="Topology is immutable"
+	// +kubebuilder:validation:XValidation:rule="has(self.topology) && self.topology == 'Localnet' ? has(self.localnet): !has(self.localnet)", message="spec.localnet is required when topology is Localnet and forbidden otherwise"
 	// +required
 	Network NetworkSpec `json:"network"`
 }
@@ -40,11 +41,14 @@ type ClusterUserDefinedNetworkSpec struct {
 type NetworkSpec struct {
 	// Topology describes network configuration.
 	//
-	// Allowed values are "Layer3", "Layer2".
+	// Allowed values are "Layer3", "Layer2" and "Localnet".
 	// Layer3 topology creates a layer 2 segment per node, each with a different subnet. Layer 3 routing is used to interconnect node subnets.
 	// Layer2 topology creates one logical switch shared by all nodes.
+	// Localnet topology creates one logical switch shared by all nodes, connected to a physical network through the
+	// OVS bridge the physical network is mapped to on every node.
 	//
 	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=Layer2;Layer3;Localnet
 	// +required
 	// +unionDiscriminator
 	Topology NetworkTopology `json:"topology"`
@@ -56,6 +60,10 @@ type NetworkSpec struct {
 	// Layer2 is the Layer2 topology configuration.
 	// +optional
 	Layer2 *Layer2Config `json:"layer2,omitempty"`
+
+	// Localnet is the Localnet topology configuration.
+	// +optional
+	Localnet *LocalnetConfig `json:"localnet,omitempty"`
 }
 
 // ClusterUserDefinedNetworkStatus contains the observed status of the ClusterUserDefinedNetwork.
@@ -0,0 +1,101 @@
+package v1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// NetworkPeering connects two primary user-defined networks, routing the traffic between their subnets.
+//
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:path=networkpeerings,scope=Cluster
+// +kubebuilder:singular=networkpeering
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+type NetworkPeering struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf", message="Spec is immutable"
+	// +required
+	Spec NetworkPeeringSpec `json:"spec"`
+	// +optional
+	Status NetworkPeeringStatus `json:"status,omitempty"`
+}
+
+// NetworkPeeringSpec defines the desired state of NetworkPeering.
+type NetworkPeeringSpec struct {
+	// Networks are the two peered networks.
+	//
+	// The networks must be primary networks with Layer3 or Layer2 topology, and their subnets must not overlap.
+	//
+	// +kubebuilder:validation:MinItems=2
+	// +kubebuilder:validation:MaxItems=2
+	// +kubebuilder:validation:XValidation:rule="self[0].kind != self[1].kind || self[0].name != self[1].name || (has(self[0].__namespace__) ? self[0].__namespace__ : '') != (has(self[1].__namespace__) ? self[1].__namespace__ : '')", message="A network cannot be peered with itself"
+	// +listType=atomic
+	// +required
+	Networks []PeeredNetwork `json:"networks"`
+}
+
+type PeeredNetworkKind string
+
+const (
+	PeeredNetworkKindUserDefinedNetwork        PeeredNetworkKind = "UserDefinedNetwork"
+	PeeredNetworkKindClusterUserDefinedNetwork PeeredNetworkKind = "ClusterUserDefinedNetwork"
+)
+
+// PeeredNetwork references one of the peered networks.
+//
+// +kubebuilder:validation:XValidation:rule="self.kind == 'UserDefinedNetwork' ? has(self.__namespace__) : !has(self.__namespace__)", message="namespace is required for UserDefinedNetwork and forbidden for ClusterUserDefinedNetwork"
+type PeeredNetwork struct {
+	// Kind is the kind of the network.
+	//
+	// Allowed values are "UserDefinedNetwork" and "ClusterUserDefinedNetwork".
+	//
+	// +kubebuilder:validation:Enum=UserDefinedNetwork;ClusterUserDefinedNetwork
+	// +required
+	Kind PeeredNetworkKind `json:"kind"`
+
+	// Name is the name of the network.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the UserDefinedNetwork.
+	// It must not be set for a ClusterUserDefinedNetwork.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// ExposedServices restricts the traffic the peer network can send to this network to the endpoints of the
+	// selected services.
+	//
+	// When omitted, the peer network can reach all the pods of this network.
+	// The services are selected in the namespaces of this network, and their endpoints are reached through their
+	// pod IPs and target ports, not through the service IPs. Headless services are not supported.
+	// An empty selector selects all the services of this network.
+	// The restriction is stateless: when set, this network can't initiate connections to the pods of the peer
+	// network, as their replies are dropped as well.
+	//
+	// +optional
+	ExposedServices *metav1.LabelSelector `json:"exposedServices,omitempty"`
+}
+
+// NetworkPeeringStatus contains the observed status of the NetworkPeering.
+type NetworkPeeringStatus struct {
+	// Conditions slice of condition objects indicating details about NetworkPeering status.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// NetworkPeeringList contains a list of NetworkPeering.
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type NetworkPeeringList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NetworkPeering `json:"items"`
+}
@@ -30,6 +30,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&UserDefinedNetworkList{},
 		&ClusterUserDefinedNetwork{},
 		&ClusterUserDefinedNetworkList{},
+		&NetworkPeering{},
+		&NetworkPeeringList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
@@ -16,14 +16,18 @@ limitations under the License.
 
 package v1
 
-// +kubebuilder:validation:Enum=Layer2;Layer3
 type NetworkTopology string
 
 const (
-	NetworkTopologyLayer2 NetworkTopology = "Layer2"
-	NetworkTopologyLayer3 NetworkTopology = "Layer3"
+	NetworkTopologyLayer2   NetworkTopology = "Layer2"
+	NetworkTopologyLayer3   NetworkTopology = "Layer3"
+	NetworkTopologyLocalnet NetworkTopology = "Localnet"
 )
 
+// The topology configs of an existing network can only be updated in a way that is safe for the pods
+// attached to it: new subnets can be appended, the existing subnets can't be removed or modified, and
+// the MTU can be raised but not decreased. Any other change is rejected by the admission webhook.
+
 // +kubebuilder:validation:XValidation:rule="has(self.subnets) && size(self.subnets) > 0", message="Subnets is required for Layer3 topology"
 // +kubebuilder:validation:XValidation:rule="!has(self.joinSubnets) || has(self.role) && self.role == 'Primary'", message="JoinSubnets is only supported for Primary network"
 type Layer3Config struct {
@@ -141,6 +145,76 @@ type Layer2Config struct {
 	IPAMLifecycle NetworkIPAMLifecycle `json:"ipamLifecycle,omitempty"`
 }
 
+// +kubebuilder:validation:XValidation:rule="self.role == 'Secondary'", message="Localnet topology is only supported for Secondary network"
+// +kubebuilder:validation:XValidation:rule="!has(self.excludeSubnets) || has(self.subnets) && size(self.subnets) > 0", message="ExcludeSubnets is only supported when subnets are set"
+// +kubebuilder:validation:XValidation:rule="!has(self.ipamLifecycle) || has(self.subnets) && size(self.subnets) > 0", message="IPAMLifecycle is only supported when subnets are set"
+type LocalnetConfig struct {
+	// Role describes the network role in the pod.
+	//
+	// Allowed value is "Secondary".
+	// Secondary network is only assigned to pods that use `k8s.v1.cni.cncf.io/networks` annotation to select given network.
+	//
+	// +kubebuilder:validation:Required
+	// +required
+	Role NetworkRole `json:"role"`
+
+	// PhysicalNetworkName is the name of the physical network the network is connected to, as mapped to an OVS bridge
+	// by the `ovn-bridge-mappings` of the nodes.
+	// It cannot contain `,` or `:` characters.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:XValidation:rule="self.matches('^[^,:]+$')", message="PhysicalNetworkName cannot contain `,` or `:` characters"
+	// +kubebuilder:validation:Required
+	// +required
+	PhysicalNetworkName string `json:"physicalNetworkName"`
+
+	// MTU is the maximum transmission unit for a network.
+	// MTU is optional, if not provided, the globally configured value in OVN-Kubernetes (defaults to 1400) is used for the network.
+	// It should match the MTU of the physical network.
+	//
+	// +kubebuilder:validation:Minimum=576
+	// +kubebuilder:validation:Maximum=65536
+	// +optional
+	MTU int32 `json:"mtu,omitempty"`
+
+	// VLAN is the VLAN ID the network traffic is tagged with on the physical network.
+	// When omitted, the traffic is not tagged.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=4094
+	// +optional
+	VLAN int32 `json:"vlan,omitempty"`
+
+	// Subnets are used for the pod network across the cluster.
+	// Dual-stack clusters may set 2 subnets (one for each IP family), otherwise only 1 subnet is allowed.
+	//
+	// The format should match standard CIDR notation (for example, "10.128.0.0/16").
+	// This field may be omitted. In that case users must configure IP addresses for the pods,
+	// or rely on an IPAM on the physical network (e.g. DHCP).
+	//
+	// +optional
+	Subnets DualStackCIDRs `json:"subnets,omitempty"`
+
+	// ExcludeSubnets is a list of CIDRs that are not assigned to the pods, e.g. addresses already used by the
+	// physical network gateway or other hosts.
+	// Only supported when "subnets" are set.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=25
+	// +optional
+	ExcludeSubnets []CIDR `json:"excludeSubnets,omitempty"`
+
+	// IPAMLifecycle controls IP addresses management lifecycle.
+	//
+	// The only allowed value is Persistent. When set, OVN Kubernetes assigned IP addresses will be persisted in an
+	// `ipamclaims.k8s.cni.cncf.io` object. These IP addresses will be reused by other pods if requested.
+	// Only supported when "subnets" are set.
+	//
+	// +optional
+	IPAMLifecycle NetworkIPAMLifecycle `json:"ipamLifecycle,omitempty"`
+}
+
 // +kubebuilder:validation:Enum=Primary;Secondary
 type NetworkRole string
 
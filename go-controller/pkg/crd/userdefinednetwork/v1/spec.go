@@ -12,6 +12,10 @@ func (s *UserDefinedNetworkSpec) GetLayer2() *Layer2Config {
 	return s.Layer2
 }
 
+func (s *UserDefinedNetworkSpec) GetLocalnet() *LocalnetConfig {
+	return nil
+}
+
 func (s *NetworkSpec) GetTopology() NetworkTopology {
 	return s.Topology
 }
@@ -23,3 +27,7 @@ func (s *NetworkSpec) GetLayer3() *Layer3Config {
 func (s *NetworkSpec) GetLayer2() *Layer2Config {
 	return s.Layer2
 }
+
+func (s *NetworkSpec) GetLocalnet() *LocalnetConfig {
+	return s.Localnet
+}
@@ -23,12 +23,19 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
 )
 
 const (
 	ControllerName = "ovnkube-csr-approver-controller"
 	NamePrefix     = "system:ovn-node"
 	MaxDuration    = time.Hour * 24 * 365
+
+	// IPsecSignerName is the signer of the per node IPsec certificates. The
+	// CommonName of these certificates is the OVN chassis ID of the node, as
+	// expected by ovs-monitor-ipsec.
+	IPsecSignerName = "k8s.ovn.org/ipsec"
 )
 
 // CSRAcceptanceCondition specifies conditions which CSRs are approved by csrapprover.
@@ -95,6 +102,12 @@ var (
 	Usages = sets.New[certificatesv1.KeyUsage](
 		certificatesv1.UsageDigitalSignature,
 		certificatesv1.UsageClientAuth)
+	// IPsecUsages are the usages of the per node IPsec certificates
+	IPsecUsages = sets.New[certificatesv1.KeyUsage](
+		certificatesv1.UsageDigitalSignature,
+		certificatesv1.UsageKeyEncipherment,
+		certificatesv1.UsageServerAuth,
+		certificatesv1.UsageClientAuth)
 )
 
 // OVNKubeCSRController approves certificate signing requests (CSRs) by applying the conditions, which is defined
@@ -149,6 +162,9 @@ func NewController(client crclient.Client,
 }
 
 func (c *OVNKubeCSRController) filterCSR(csr *certificatesv1.CertificateSigningRequest, x509CSR *x509.CertificateRequest) bool {
+	if csr.Spec.SignerName == IPsecSignerName {
+		return true
+	}
 	for _, v := range c.commonNamePrefixes {
 		if strings.HasPrefix(x509CSR.Subject.CommonName, v) {
 			return csr.Spec.SignerName == certificatesv1.KubeAPIServerClientSignerName
@@ -240,6 +256,14 @@ func (c *OVNKubeCSRController) Reconcile(ctx context.Context, request reconcile.
 		return reconcile.Result{}, nil
 	}
 
+	if req.Spec.SignerName == IPsecSignerName {
+		nodeName, err = c.validateIPsecCSR(ctx, req, x509CSR)
+		if err != nil {
+			return reconcile.Result{}, c.denyCSR(ctx, req, err)
+		}
+		return reconcile.Result{}, c.approveCSR(ctx, req)
+	}
+
 	// expected common name format: userPrefix:nodeName
 	// example: system:ovn-node:ovn-worker2
 	i := strings.LastIndex(x509CSR.Subject.CommonName, ":")
@@ -274,6 +298,49 @@ func (c *OVNKubeCSRController) Reconcile(ctx context.Context, request reconcile.
 	return reconcile.Result{}, c.approveCSR(ctx, req)
 }
 
+// validateIPsecCSR validates a CSR for the IPsec certificate of a node and returns the node name. The CSR must be
+// created by the node's ovnkube identity, its CommonName must be the OVN chassis ID of the node and it must not
+// carry any subject alternative name.
+func (c *OVNKubeCSRController) validateIPsecCSR(ctx context.Context, req *certificatesv1.CertificateSigningRequest, x509CSR *x509.CertificateRequest) (string, error) {
+	// expected username format: system:ovn-node:nodeName
+	i := strings.LastIndex(req.Spec.Username, ":")
+	if i == -1 || i == len(req.Spec.Username)-1 || req.Spec.Username[:i] != NamePrefix {
+		return "", fmt.Errorf("CSR %q was created by an unexpected user: %q", req.Name, req.Spec.Username)
+	}
+	nodeName := req.Spec.Username[i+1:]
+	if errs := validation.IsDNS1123Subdomain(nodeName); len(errs) != 0 {
+		return "", fmt.Errorf("extracted node name %q is not a valid DNS subdomain %v", nodeName, errs)
+	}
+
+	if usages := sets.New[certificatesv1.KeyUsage](req.Spec.Usages...); !usages.Equal(IPsecUsages) {
+		return nodeName, fmt.Errorf("CSR %q was created with unexpected usages: %v", req.Name, usages.UnsortedList())
+	}
+
+	node := &corev1.Node{}
+	if err := c.client.Get(ctx, crclient.ObjectKey{Name: nodeName}, node); err != nil {
+		return nodeName, fmt.Errorf("failed to get node %s for CSR %q: %v", nodeName, req.Name, err)
+	}
+	chassisID, err := util.ParseNodeChassisIDAnnotation(node)
+	if err != nil {
+		return nodeName, fmt.Errorf("failed to get the chassis ID of node %s for CSR %q: %v", nodeName, req.Name, err)
+	}
+	if x509CSR.Subject.CommonName != chassisID {
+		return nodeName, fmt.Errorf("expected the CSR's commonName to be %q, but it is %q", chassisID, x509CSR.Subject.CommonName)
+	}
+	// the certificate only authenticates the chassis ID, the node must not choose any other name
+	if len(x509CSR.DNSNames) > 0 || len(x509CSR.IPAddresses) > 0 || len(x509CSR.URIs) > 0 || len(x509CSR.EmailAddresses) > 0 {
+		return nodeName, fmt.Errorf("CSR %q was created with unexpected subject alternative names", req.Name)
+	}
+
+	if req.Spec.ExpirationSeconds == nil {
+		return nodeName, fmt.Errorf("CSR %q was created without specyfying the expirationSeconds", req.Name)
+	}
+	if csr.ExpirationSecondsToDuration(*req.Spec.ExpirationSeconds) > c.maxDuration {
+		return nodeName, fmt.Errorf("CSR %q was created with invalid expirationSeconds value: %d", req.Name, *req.Spec.ExpirationSeconds)
+	}
+	return nodeName, nil
+}
+
 func (c *CSRAcceptanceCondition) validateCSR(req *certificatesv1.CertificateSigningRequest, x509CSR *x509.CertificateRequest, acceptUsages sets.Set[certificatesv1.KeyUsage]) error {
 
 	// expected username format: userPrefix:nodeName
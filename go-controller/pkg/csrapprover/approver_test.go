@@ -7,6 +7,7 @@ import (
 	"crypto/rand"
 	"crypto/x509/pkix"
 	"fmt"
+	"net"
 	"testing"
 	"time"
 
@@ -391,3 +392,148 @@ func TestOVNKubeCSRController(t *testing.T) {
 		})
 	}
 }
+
+func TestOVNKubeCSRControllerIPsec(t *testing.T) {
+	const chassisID = "3c8bc9b1-3a5a-4bca-8ac4-3f1e4a3c3c6e"
+	tests := []struct {
+		name              string
+		csrUserName       string
+		commonName        string
+		dnsNames          []string
+		ipAddresses       []net.IP
+		usages            sets.Set[certificatesv1.KeyUsage]
+		expectedCondition certificatesv1.CertificateSigningRequestCondition
+	}{
+		{
+			name:        "IPsec CSR for the chassis ID of the node is approved",
+			csrUserName: "system:ovn-node:test.node",
+			commonName:  chassisID,
+			usages:      IPsecUsages,
+			expectedCondition: certificatesv1.CertificateSigningRequestCondition{
+				Type:    certificatesv1.CertificateApproved,
+				Status:  corev1.ConditionTrue,
+				Reason:  "AutoApproved",
+				Message: fmt.Sprintf("Auto-approved CSR %q", csrName),
+			},
+		},
+		{
+			name:        "IPsec CSR created by an unexpected user is denied",
+			csrUserName: "system:node:test.node",
+			commonName:  chassisID,
+			usages:      IPsecUsages,
+			expectedCondition: certificatesv1.CertificateSigningRequestCondition{
+				Type:    certificatesv1.CertificateDenied,
+				Status:  corev1.ConditionTrue,
+				Reason:  "CSRDenied",
+				Message: fmt.Sprintf("CSR %q was created by an unexpected user: %q", csrName, "system:node:test.node"),
+			},
+		},
+		{
+			name:        "IPsec CSR with a CommonName different from the chassis ID of the node is denied",
+			csrUserName: "system:ovn-node:test.node",
+			commonName:  "other-chassis",
+			usages:      IPsecUsages,
+			expectedCondition: certificatesv1.CertificateSigningRequestCondition{
+				Type:    certificatesv1.CertificateDenied,
+				Status:  corev1.ConditionTrue,
+				Reason:  "CSRDenied",
+				Message: fmt.Sprintf("expected the CSR's commonName to be %q, but it is %q", chassisID, "other-chassis"),
+			},
+		},
+		{
+			name:        "IPsec CSR with unexpected usages is denied",
+			csrUserName: "system:ovn-node:test.node",
+			commonName:  chassisID,
+			usages:      sets.New[certificatesv1.KeyUsage](certificatesv1.UsageClientAuth),
+			expectedCondition: certificatesv1.CertificateSigningRequestCondition{
+				Type:    certificatesv1.CertificateDenied,
+				Status:  corev1.ConditionTrue,
+				Reason:  "CSRDenied",
+				Message: fmt.Sprintf("CSR %q was created with unexpected usages: %v", csrName, []certificatesv1.KeyUsage{certificatesv1.UsageClientAuth}),
+			},
+		},
+		{
+			name:        "IPsec CSR with DNS subject alternative names is denied",
+			csrUserName: "system:ovn-node:test.node",
+			commonName:  chassisID,
+			dnsNames:    []string{"kubernetes.default.svc"},
+			usages:      IPsecUsages,
+			expectedCondition: certificatesv1.CertificateSigningRequestCondition{
+				Type:    certificatesv1.CertificateDenied,
+				Status:  corev1.ConditionTrue,
+				Reason:  "CSRDenied",
+				Message: fmt.Sprintf("CSR %q was created with unexpected subject alternative names", csrName),
+			},
+		},
+		{
+			name:        "IPsec CSR with IP subject alternative names is denied",
+			csrUserName: "system:ovn-node:test.node",
+			commonName:  chassisID,
+			ipAddresses: []net.IP{net.ParseIP("10.0.0.1")},
+			usages:      IPsecUsages,
+			expectedCondition: certificatesv1.CertificateSigningRequestCondition{
+				Type:    certificatesv1.CertificateDenied,
+				Status:  corev1.ConditionTrue,
+				Reason:  "CSRDenied",
+				Message: fmt.Sprintf("CSR %q was created with unexpected subject alternative names", csrName),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			if err != nil {
+				t.Fatal(err)
+			}
+			csrPEM, err := cert.MakeCSR(privateKey, &pkix.Name{CommonName: tt.commonName}, tt.dnsNames, tt.ipAddresses)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			csrObj := &certificatesv1.CertificateSigningRequest{
+				TypeMeta: metav1.TypeMeta{Kind: "CertificateSigningRequest"},
+				ObjectMeta: metav1.ObjectMeta{
+					Name: csrName,
+				},
+				Spec: certificatesv1.CertificateSigningRequestSpec{
+					Request:           csrPEM,
+					Usages:            tt.usages.UnsortedList(),
+					SignerName:        IPsecSignerName,
+					Username:          tt.csrUserName,
+					ExpirationSeconds: csr.DurationToExpirationSeconds(time.Hour),
+				},
+			}
+			node := &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "test.node",
+					Annotations: map[string]string{"k8s.ovn.org/node-chassis-id": chassisID},
+				},
+			}
+
+			client := fake.NewClientBuilder().WithRuntimeObjects(csrObj, node).Build()
+			recorder := record.NewFakeRecorder(10)
+			csrCtrl := NewController(client, nil, Usages, MaxDuration, recorder)
+
+			req := reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name: csrName,
+				},
+			}
+			if _, err = csrCtrl.Reconcile(context.Background(), req); err != nil {
+				t.Fatal(err)
+			}
+
+			csrObj = &certificatesv1.CertificateSigningRequest{}
+			if err = client.Get(context.TODO(), req.NamespacedName, csrObj); err != nil {
+				t.Fatal(err)
+			}
+			if len(csrObj.Status.Conditions) != 1 {
+				t.Fatal(fmt.Errorf("invalid conditions: %v", csrObj.Status.Conditions))
+			}
+			if csrObj.Status.Conditions[0] != tt.expectedCondition {
+				t.Fatal(fmt.Errorf("expected:\n%v\ngot:\n%v", tt.expectedCondition, csrObj.Status.Conditions[0]))
+			}
+		})
+	}
+}
@@ -0,0 +1,171 @@
+package csrapprover
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"math"
+	"math/big"
+	"os"
+	"time"
+
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/cert"
+	"k8s.io/client-go/util/certificate/csr"
+	"k8s.io/client-go/util/keyutil"
+	"k8s.io/klog/v2"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	SignerControllerName = "ovnkube-ipsec-signer-controller"
+	// certificates are backdated to tolerate clock skew between the nodes
+	signerBackdate = 5 * time.Minute
+)
+
+// IPsecSigner signs the approved CSRs of the IPsecSignerName signer with the IPsec CA.
+type IPsecSigner struct {
+	caCert      *x509.Certificate
+	caKey       crypto.Signer
+	maxDuration time.Duration
+
+	client   crclient.Client
+	recorder record.EventRecorder
+}
+
+// NewIPsecSigner creates a new IPsecSigner with the CA certificate and private key from the provided files
+func NewIPsecSigner(client crclient.Client, caCertFile, caKeyFile string, maxDuration time.Duration,
+	recorder record.EventRecorder) (*IPsecSigner, error) {
+	certPEM, err := os.ReadFile(caCertFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the IPsec CA certificate: %w", err)
+	}
+	certs, err := cert.ParseCertsPEM(certPEM)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the IPsec CA certificate: %w", err)
+	}
+	keyPEM, err := os.ReadFile(caKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the IPsec CA private key: %w", err)
+	}
+	key, err := keyutil.ParsePrivateKeyPEM(keyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the IPsec CA private key: %w", err)
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("the IPsec CA private key is not a signer")
+	}
+	return &IPsecSigner{
+		caCert:      certs[0],
+		caKey:       signer,
+		maxDuration: maxDuration,
+		client:      client,
+		recorder:    recorder,
+	}, nil
+}
+
+func isApproved(status *certificatesv1.CertificateSigningRequestStatus) bool {
+	approved := false
+	for _, c := range status.Conditions {
+		switch c.Type {
+		case certificatesv1.CertificateApproved:
+			approved = true
+		case certificatesv1.CertificateDenied, certificatesv1.CertificateFailed:
+			return false
+		}
+	}
+	return approved
+}
+
+func (s *IPsecSigner) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	req := &certificatesv1.CertificateSigningRequest{}
+	err := s.client.Get(ctx, request.NamespacedName, req)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	if req.Spec.SignerName != IPsecSignerName || len(req.Status.Certificate) > 0 || !isApproved(&req.Status) {
+		return reconcile.Result{}, nil
+	}
+
+	certPEM, err := s.sign(req)
+	if err != nil {
+		klog.Errorf("Failed to sign CSR %s: %v", req.Name, err)
+		req.Status.Conditions = append(req.Status.Conditions,
+			certificatesv1.CertificateSigningRequestCondition{
+				Type:    certificatesv1.CertificateFailed,
+				Status:  corev1.ConditionTrue,
+				Reason:  "SigningFailed",
+				Message: err.Error(),
+			})
+		return reconcile.Result{}, s.client.Status().Update(ctx, req)
+	}
+
+	req.Status.Certificate = certPEM
+	if err := s.client.Status().Update(ctx, req); err != nil {
+		return reconcile.Result{}, err
+	}
+	s.recorder.Eventf(&corev1.ObjectReference{
+		Kind: "CertificateSigningRequest",
+		Name: req.Name,
+	}, corev1.EventTypeNormal, "CSRSigned", "CSR %q has been signed", req.Name)
+	klog.Infof("Signed CSR %s", req.Name)
+	return reconcile.Result{}, nil
+}
+
+// sign returns the PEM encoded certificate for the CSR, signed by the IPsec CA. Only the subject of the CSR is
+// copied to the certificate, any requested subject alternative name is ignored.
+func (s *IPsecSigner) sign(req *certificatesv1.CertificateSigningRequest) ([]byte, error) {
+	csrPEM, _ := pem.Decode(req.Spec.Request)
+	if csrPEM == nil {
+		return nil, fmt.Errorf("failed to decode PEM block in .spec.request: no CSRs were found")
+	}
+	x509CSR, err := x509.ParseCertificateRequest(csrPEM.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse CSR: %v", err)
+	}
+	if err := x509CSR.CheckSignature(); err != nil {
+		return nil, fmt.Errorf("invalid CSR signature: %v", err)
+	}
+
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).SetInt64(math.MaxInt64))
+	if err != nil {
+		return nil, err
+	}
+
+	duration := s.maxDuration
+	if req.Spec.ExpirationSeconds != nil && csr.ExpirationSecondsToDuration(*req.Spec.ExpirationSeconds) < duration {
+		duration = csr.ExpirationSecondsToDuration(*req.Spec.ExpirationSeconds)
+	}
+	now := time.Now()
+	notAfter := now.Add(duration)
+	if notAfter.After(s.caCert.NotAfter) {
+		notAfter = s.caCert.NotAfter
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          serialNumber,
+		Subject:               x509CSR.Subject,
+		NotBefore:             now.Add(-signerBackdate),
+		NotAfter:              notAfter,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, s.caCert, x509CSR.PublicKey, s.caKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create certificate: %v", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: cert.CertificateBlockType, Bytes: der}), nil
+}
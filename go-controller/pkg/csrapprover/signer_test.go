@@ -0,0 +1,169 @@
+package csrapprover
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/cert"
+	"k8s.io/client-go/util/certificate/csr"
+	"k8s.io/client-go/util/keyutil"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func newTestIPsecCA(t *testing.T) (string, string) {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	caCert, err := cert.NewSelfSignedCACert(cert.Config{CommonName: "ipsec-ca"}, caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyPEM, err := keyutil.MarshalPrivateKeyToPEM(caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "ca.crt")
+	keyFile := filepath.Join(dir, "ca.key")
+	if err := os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: cert.CertificateBlockType, Bytes: caCert.Raw}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(keyFile, keyPEM, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return certFile, keyFile
+}
+
+func TestIPsecSigner(t *testing.T) {
+	tests := []struct {
+		name       string
+		signerName string
+		conditions []certificatesv1.CertificateSigningRequestCondition
+		shouldSign bool
+	}{
+		{
+			name:       "approved IPsec CSR is signed",
+			signerName: IPsecSignerName,
+			conditions: []certificatesv1.CertificateSigningRequestCondition{
+				{Type: certificatesv1.CertificateApproved, Status: corev1.ConditionTrue},
+			},
+			shouldSign: true,
+		},
+		{
+			name:       "IPsec CSR that is not approved is ignored",
+			signerName: IPsecSignerName,
+		},
+		{
+			name:       "denied IPsec CSR is ignored",
+			signerName: IPsecSignerName,
+			conditions: []certificatesv1.CertificateSigningRequestCondition{
+				{Type: certificatesv1.CertificateDenied, Status: corev1.ConditionTrue},
+			},
+		},
+		{
+			name:       "approved CSR of another signer is ignored",
+			signerName: certificatesv1.KubeAPIServerClientSignerName,
+			conditions: []certificatesv1.CertificateSigningRequestCondition{
+				{Type: certificatesv1.CertificateApproved, Status: corev1.ConditionTrue},
+			},
+		},
+	}
+
+	caCertFile, caKeyFile := newTestIPsecCA(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// the subject alternative names requested by the CSR must not be signed
+			csrPEM, err := cert.MakeCSR(privateKey, &pkix.Name{CommonName: "chassis"}, []string{"other.example.com"}, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			csrObj := &certificatesv1.CertificateSigningRequest{
+				TypeMeta: metav1.TypeMeta{Kind: "CertificateSigningRequest"},
+				ObjectMeta: metav1.ObjectMeta{
+					Name: csrName,
+				},
+				Spec: certificatesv1.CertificateSigningRequestSpec{
+					Request:           csrPEM,
+					Usages:            IPsecUsages.UnsortedList(),
+					SignerName:        tt.signerName,
+					ExpirationSeconds: csr.DurationToExpirationSeconds(time.Hour),
+				},
+				Status: certificatesv1.CertificateSigningRequestStatus{
+					Conditions: tt.conditions,
+				},
+			}
+
+			client := fake.NewClientBuilder().
+				WithRuntimeObjects(csrObj).
+				WithStatusSubresource(&certificatesv1.CertificateSigningRequest{}).
+				Build()
+			signer, err := NewIPsecSigner(client, caCertFile, caKeyFile, MaxDuration, record.NewFakeRecorder(10))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			req := reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name: csrName,
+				},
+			}
+			if _, err = signer.Reconcile(context.Background(), req); err != nil {
+				t.Fatal(err)
+			}
+
+			csrObj = &certificatesv1.CertificateSigningRequest{}
+			if err = client.Get(context.TODO(), req.NamespacedName, csrObj); err != nil {
+				t.Fatal(err)
+			}
+			if !tt.shouldSign {
+				if len(csrObj.Status.Certificate) != 0 {
+					t.Fatalf("unexpected certificate for CSR: %s", csrObj.Status.Certificate)
+				}
+				return
+			}
+
+			certs, err := cert.ParseCertsPEM(csrObj.Status.Certificate)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if certs[0].Subject.CommonName != "chassis" {
+				t.Fatalf("unexpected certificate CommonName %q", certs[0].Subject.CommonName)
+			}
+			if len(certs[0].DNSNames) != 0 || len(certs[0].IPAddresses) != 0 {
+				t.Fatalf("unexpected certificate subject alternative names: %v %v", certs[0].DNSNames, certs[0].IPAddresses)
+			}
+			if certs[0].NotAfter.After(time.Now().Add(time.Hour)) {
+				t.Fatalf("certificate expires after the requested duration: %v", certs[0].NotAfter)
+			}
+			caCerts, err := cert.CertsFromFile(caCertFile)
+			if err != nil {
+				t.Fatal(err)
+			}
+			roots := x509.NewCertPool()
+			roots.AddCert(caCerts[0])
+			if _, err := certs[0].Verify(x509.VerifyOptions{Roots: roots, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageAny}}); err != nil {
+				t.Fatalf("certificate is not signed by the IPsec CA: %v", err)
+			}
+		})
+	}
+}
@@ -13,6 +13,7 @@ import (
 	anpinformer "sigs.k8s.io/network-policy-api/pkg/client/informers/externalversions/apis/v1alpha1"
 
 	certificatesinformers "k8s.io/client-go/informers/certificates/v1"
+	networkinginformers "k8s.io/client-go/informers/networking/v1"
 
 	ocpnetworkapiv1alpha1 "github.com/openshift/api/network/v1alpha1"
 	ocpnetworkscheme "github.com/openshift/client-go/network/clientset/versioned/scheme"
@@ -70,6 +71,14 @@ import (
 	ipamclaimsinformer "github.com/k8snetworkplumbingwg/ipamclaims/pkg/crd/ipamclaims/v1alpha1/apis/informers/externalversions/ipamclaims/v1alpha1"
 	ipamclaimslister "github.com/k8snetworkplumbingwg/ipamclaims/pkg/crd/ipamclaims/v1alpha1/apis/listers/ipamclaims/v1alpha1"
 
+	networkobservabilityapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkobservability/v1"
+	networkobservabilityscheme "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkobservability/v1/apis/clientset/versioned/scheme"
+	networkobservabilityinformerfactory "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkobservability/v1/apis/informers/externalversions"
+	networkobservabilityinformer "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkobservability/v1/apis/informers/externalversions/networkobservability/v1"
+	routeadvertisementsapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1"
+	routeadvertisementsscheme "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1/apis/clientset/versioned/scheme"
+	routeadvertisementsinformerfactory "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1/apis/informers/externalversions"
+	routeadvertisementsinformer "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1/apis/informers/externalversions/routeadvertisements/v1"
 	userdefinednetworkapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1"
 	userdefinednetworkscheme "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1/apis/clientset/versioned/scheme"
 	userdefinednetworkapiinformerfactory "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1/apis/informers/externalversions"
@@ -114,6 +123,8 @@ type WatchFactory struct {
 	ipamClaimsFactory    ipamclaimsfactory.SharedInformerFactory
 	nadFactory           nadinformerfactory.SharedInformerFactory
 	udnFactory           userdefinednetworkapiinformerfactory.SharedInformerFactory
+	raFactory            routeadvertisementsinformerfactory.SharedInformerFactory
+	netObservFactory     networkobservabilityinformerfactory.SharedInformerFactory
 	informers            map[reflect.Type]*informer
 
 	stopChan chan struct{}
@@ -207,6 +218,10 @@ func NewMasterWatchFactory(ovnClientset *util.OVNMasterClientset) (*WatchFactory
 		return nil, err
 	}
 	wf.cpipcFactory = ocpcloudnetworkinformerfactory.NewSharedInformerFactory(ovnClientset.CloudNetworkClient, resyncInterval)
+	if config.OVNKubernetesFeature.EnableEgressIP {
+		// make sure shared informer is created for a factory, so on wf.eipFactory.Start() it is initialized and caches are synced.
+		wf.eipFactory.K8s().V1().EgressIPPools().Informer()
+	}
 	if util.PlatformTypeIsEgressIPCloudProvider() {
 		wf.informers[CloudPrivateIPConfigType], err = newInformer(CloudPrivateIPConfigType, wf.cpipcFactory.Cloud().V1().CloudPrivateIPConfigs().Informer())
 		if err != nil {
@@ -293,6 +308,9 @@ func NewOVNKubeControllerWatchFactory(ovnClientset *util.OVNKubeControllerClient
 	if err := userdefinednetworkapi.AddToScheme(userdefinednetworkscheme.Scheme); err != nil {
 		return nil, err
 	}
+	if err := networkobservabilityapi.AddToScheme(networkobservabilityscheme.Scheme); err != nil {
+		return nil, err
+	}
 
 	// For Services and Endpoints, pre-populate the shared Informer with one that
 	// has a label selector excluding headless services.
@@ -410,6 +428,15 @@ func NewOVNKubeControllerWatchFactory(ovnClientset *util.OVNKubeControllerClient
 		if err != nil {
 			return nil, err
 		}
+
+		// make sure shared informer is created for a factory, so on wf.udnFactory.Start() it is initialized and caches are synced.
+		wf.udnFactory.K8s().V1().NetworkPeerings().Informer()
+	}
+
+	if config.OVNKubernetesFeature.EnableObservability {
+		wf.netObservFactory = networkobservabilityinformerfactory.NewSharedInformerFactory(ovnClientset.ObservabilityClient, resyncInterval)
+		// make sure shared informer is created for a factory, so on wf.netObservFactory.Start() it is initialized and caches are synced.
+		wf.netObservFactory.K8s().V1().NetworkObservabilities().Informer()
 	}
 
 	if util.IsMultiNetworkPoliciesSupportEnabled() {
@@ -540,6 +567,24 @@ func (wf *WatchFactory) Start() error {
 		}
 	}
 
+	if config.OVNKubernetesFeature.EnableRouteAdvertisements && wf.raFactory != nil {
+		wf.raFactory.Start(wf.stopChan)
+		for oType, synced := range waitForCacheSyncWithTimeout(wf.raFactory, wf.stopChan) {
+			if !synced {
+				return fmt.Errorf("error in syncing cache for %v informer", oType)
+			}
+		}
+	}
+
+	if config.OVNKubernetesFeature.EnableObservability && wf.netObservFactory != nil {
+		wf.netObservFactory.Start(wf.stopChan)
+		for oType, synced := range waitForCacheSyncWithTimeout(wf.netObservFactory, wf.stopChan) {
+			if !synced {
+				return fmt.Errorf("error in syncing cache for %v informer", oType)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -581,6 +626,14 @@ func (wf *WatchFactory) Stop() {
 	if wf.udnFactory != nil {
 		wf.udnFactory.Shutdown()
 	}
+
+	if wf.raFactory != nil {
+		wf.raFactory.Shutdown()
+	}
+
+	if wf.netObservFactory != nil {
+		wf.netObservFactory.Shutdown()
+	}
 }
 
 // NewNodeWatchFactory initializes a watch factory with significantly fewer
@@ -770,6 +823,12 @@ func NewClusterManagerWatchFactory(ovnClientset *util.OVNClusterManagerClientset
 	if err := userdefinednetworkapi.AddToScheme(userdefinednetworkscheme.Scheme); err != nil {
 		return nil, err
 	}
+	if err := routeadvertisementsapi.AddToScheme(routeadvertisementsscheme.Scheme); err != nil {
+		return nil, err
+	}
+	if err := networkobservabilityapi.AddToScheme(networkobservabilityscheme.Scheme); err != nil {
+		return nil, err
+	}
 
 	// For Services and Endpoints, pre-populate the shared Informer with one that
 	// has a label selector excluding headless services.
@@ -814,6 +873,8 @@ func NewClusterManagerWatchFactory(ovnClientset *util.OVNClusterManagerClientset
 		if err != nil {
 			return nil, err
 		}
+		// make sure shared informer is created for a factory, so on wf.eipFactory.Start() it is initialized and caches are synced.
+		wf.eipFactory.K8s().V1().EgressIPPools().Informer()
 	}
 	if util.PlatformTypeIsEgressIPCloudProvider() {
 		wf.informers[CloudPrivateIPConfigType], err = newInformer(CloudPrivateIPConfigType, wf.cpipcFactory.Cloud().V1().CloudPrivateIPConfigs().Informer())
@@ -877,6 +938,8 @@ func NewClusterManagerWatchFactory(ovnClientset *util.OVNClusterManagerClientset
 		if err != nil {
 			return nil, err
 		}
+		// make sure shared informer is created for a factory, so on wf.udnFactory.Start() it is initialized and caches are synced.
+		wf.udnFactory.K8s().V1().NetworkPeerings().Informer()
 
 		// make sure namespace informer cache is initialized and synced on Start().
 		wf.iFactory.Core().V1().Namespaces().Informer()
@@ -885,6 +948,18 @@ func NewClusterManagerWatchFactory(ovnClientset *util.OVNClusterManagerClientset
 		wf.iFactory.Core().V1().Pods().Informer()
 	}
 
+	if config.OVNKubernetesFeature.EnableRouteAdvertisements {
+		wf.raFactory = routeadvertisementsinformerfactory.NewSharedInformerFactory(ovnClientset.RouteAdvertisementsClient, resyncInterval)
+		// make sure shared informer is created for a factory, so on wf.raFactory.Start() it is initialized and caches are synced.
+		wf.raFactory.K8s().V1().RouteAdvertisements().Informer()
+	}
+
+	if config.OVNKubernetesFeature.EnableObservability {
+		wf.netObservFactory = networkobservabilityinformerfactory.NewSharedInformerFactory(ovnClientset.ObservabilityClient, resyncInterval)
+		// make sure shared informer is created for a factory, so on wf.netObservFactory.Start() it is initialized and caches are synced.
+		wf.netObservFactory.K8s().V1().NetworkObservabilities().Informer()
+	}
+
 	return wf, nil
 }
 
@@ -1498,6 +1573,10 @@ func (wf *WatchFactory) NamespaceCoreInformer() v1coreinformers.NamespaceInforme
 	return wf.iFactory.Core().V1().Namespaces()
 }
 
+func (wf *WatchFactory) NetworkPolicyCoreInformer() networkinginformers.NetworkPolicyInformer {
+	return wf.iFactory.Networking().V1().NetworkPolicies()
+}
+
 func (wf *WatchFactory) ServiceInformer() cache.SharedIndexInformer {
 	return wf.informers[ServiceType].inf
 }
@@ -1538,6 +1617,10 @@ func (wf *WatchFactory) EgressIPInformer() egressipinformer.EgressIPInformer {
 	return wf.eipFactory.K8s().V1().EgressIPs()
 }
 
+func (wf *WatchFactory) EgressIPPoolInformer() egressipinformer.EgressIPPoolInformer {
+	return wf.eipFactory.K8s().V1().EgressIPPools()
+}
+
 func (wf *WatchFactory) EgressFirewallInformer() egressfirewallinformer.EgressFirewallInformer {
 	return wf.efFactory.K8s().V1().EgressFirewalls()
 }
@@ -1558,6 +1641,18 @@ func (wf *WatchFactory) ClusterUserDefinedNetworkInformer() userdefinednetworkin
 	return wf.udnFactory.K8s().V1().ClusterUserDefinedNetworks()
 }
 
+func (wf *WatchFactory) NetworkPeeringInformer() userdefinednetworkinformer.NetworkPeeringInformer {
+	return wf.udnFactory.K8s().V1().NetworkPeerings()
+}
+
+func (wf *WatchFactory) RouteAdvertisementsInformer() routeadvertisementsinformer.RouteAdvertisementsInformer {
+	return wf.raFactory.K8s().V1().RouteAdvertisements()
+}
+
+func (wf *WatchFactory) NetworkObservabilityInformer() networkobservabilityinformer.NetworkObservabilityInformer {
+	return wf.netObservFactory.K8s().V1().NetworkObservabilities()
+}
+
 func (wf *WatchFactory) DNSNameResolverInformer() ocpnetworkinformerv1alpha1.DNSNameResolverInformer {
 	return wf.dnsFactory.Network().V1alpha1().DNSNameResolvers()
 }
@@ -19,6 +19,7 @@ import (
 	core "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/utils/pointer"
+	"k8s.io/utils/ptr"
 
 	anpapi "sigs.k8s.io/network-policy-api/apis/v1alpha1"
 	anpapifake "sigs.k8s.io/network-policy-api/pkg/client/clientset/versioned/fake"
@@ -176,7 +177,7 @@ func newEgressQoS(name, namespace string) *egressqos.EgressQoS {
 		Spec: egressqos.EgressQoSSpec{
 			Egress: []egressqos.EgressQoSRule{
 				{
-					DSCP:    50,
+					DSCP:    ptr.To(50),
 					DstCIDR: pointer.String("1.2.3.4/32"),
 				},
 			},
@@ -1888,7 +1889,7 @@ var _ = Describe("Watch Factory Operations", func() {
 			UpdateFunc: func(old, new interface{}) {
 				newEgressQoS := new.(*egressqos.EgressQoS)
 				Expect(reflect.DeepEqual(newEgressQoS, added)).To(BeTrue())
-				Expect(newEgressQoS.Spec.Egress[0].DSCP).To(Equal(40))
+				Expect(*newEgressQoS.Spec.Egress[0].DSCP).To(Equal(40))
 			},
 			DeleteFunc: func(obj interface{}) {
 				egressQoS := obj.(*egressqos.EgressQoS)
@@ -1899,7 +1900,7 @@ var _ = Describe("Watch Factory Operations", func() {
 		egressQoSes = append(egressQoSes, added)
 		egressQoSWatch.Add(added)
 		Eventually(c.getAdded, 2).Should(Equal(1))
-		added.Spec.Egress[0].DSCP = 40
+		added.Spec.Egress[0].DSCP = ptr.To(40)
 		egressQoSWatch.Modify(added)
 		Eventually(c.getUpdated, 2).Should(Equal(1))
 		egressQoSes = egressQoSes[:0]
@@ -15,6 +15,7 @@ import (
 	egressqosclientset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressqos/v1/apis/clientset/versioned"
 	egressserviceclientset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressservice/v1/apis/clientset/versioned"
 	kapi "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -32,10 +33,13 @@ import (
 type InterfaceOVN interface {
 	Interface
 	UpdateEgressFirewall(egressfirewall *egressfirewall.EgressFirewall) error
-	UpdateEgressIP(eIP *egressipv1.EgressIP) error
-	PatchEgressIP(name string, patchData []byte) error
+	UpdateEgressIPStatus(eIP *egressipv1.EgressIP) error
+	PatchEgressIPStatus(name string, patchData []byte) error
+	MergePatchEgressIPStatus(name string, patchData []byte) error
 	GetEgressIP(name string) (*egressipv1.EgressIP, error)
 	GetEgressIPs() ([]*egressipv1.EgressIP, error)
+	GetEgressIPPool(name string) (*egressipv1.EgressIPPool, error)
+	UpdateEgressIPPoolStatus(pool *egressipv1.EgressIPPool) error
 	GetEgressFirewalls() ([]*egressfirewall.EgressFirewall, error)
 	CreateCloudPrivateIPConfig(cloudPrivateIPConfig *ocpcloudnetworkapi.CloudPrivateIPConfig) (*ocpcloudnetworkapi.CloudPrivateIPConfig, error)
 	UpdateCloudPrivateIPConfig(cloudPrivateIPConfig *ocpcloudnetworkapi.CloudPrivateIPConfig) (*ocpcloudnetworkapi.CloudPrivateIPConfig, error)
@@ -390,15 +394,27 @@ func (k *KubeOVN) UpdateEgressFirewall(egressfirewall *egressfirewall.EgressFire
 	return err
 }
 
-// UpdateEgressIP updates the EgressIP with the provided EgressIP data
-func (k *KubeOVN) UpdateEgressIP(eIP *egressipv1.EgressIP) error {
+// UpdateEgressIPStatus updates the status of the EgressIP with the provided EgressIP data
+func (k *KubeOVN) UpdateEgressIPStatus(eIP *egressipv1.EgressIP) error {
 	klog.Infof("Updating status on EgressIP %s status %v", eIP.Name, eIP.Status)
-	_, err := k.EIPClient.K8sV1().EgressIPs().Update(context.TODO(), eIP, metav1.UpdateOptions{})
+	_, err := k.EIPClient.K8sV1().EgressIPs().UpdateStatus(context.TODO(), eIP, metav1.UpdateOptions{})
 	return err
 }
 
-func (k *KubeOVN) PatchEgressIP(name string, patchData []byte) error {
-	_, err := k.EIPClient.K8sV1().EgressIPs().Patch(context.TODO(), name, types.JSONPatchType, patchData, metav1.PatchOptions{})
+// PatchEgressIPStatus applies the provided JSON patch to the status of the EgressIP
+func (k *KubeOVN) PatchEgressIPStatus(name string, patchData []byte) error {
+	_, err := k.EIPClient.K8sV1().EgressIPs().Patch(context.TODO(), name, types.JSONPatchType, patchData, metav1.PatchOptions{}, "status")
+	return err
+}
+
+// MergePatchEgressIPStatus applies the provided JSON merge patch to the status of the EgressIP. The status
+// subresource is not found until the EgressIP CRD is upgraded to enable it, the patch is then applied to the
+// EgressIP itself so that the EgressIPs keep being assigned while ovnkube is upgraded before the CRD.
+func (k *KubeOVN) MergePatchEgressIPStatus(name string, patchData []byte) error {
+	_, err := k.EIPClient.K8sV1().EgressIPs().Patch(context.TODO(), name, types.MergePatchType, patchData, metav1.PatchOptions{}, "status")
+	if apierrors.IsNotFound(err) {
+		_, err = k.EIPClient.K8sV1().EgressIPs().Patch(context.TODO(), name, types.MergePatchType, patchData, metav1.PatchOptions{})
+	}
 	return err
 }
 
@@ -407,6 +423,18 @@ func (k *KubeOVN) GetEgressIP(name string) (*egressipv1.EgressIP, error) {
 	return k.EIPClient.K8sV1().EgressIPs().Get(context.TODO(), name, metav1.GetOptions{})
 }
 
+// GetEgressIPPool returns the EgressIPPool object from kubernetes
+func (k *KubeOVN) GetEgressIPPool(name string) (*egressipv1.EgressIPPool, error) {
+	return k.EIPClient.K8sV1().EgressIPPools().Get(context.TODO(), name, metav1.GetOptions{})
+}
+
+// UpdateEgressIPPoolStatus updates the status of the EgressIPPool with the provided EgressIPPool data
+func (k *KubeOVN) UpdateEgressIPPoolStatus(pool *egressipv1.EgressIPPool) error {
+	klog.Infof("Updating status on EgressIPPool %s status %v", pool.Name, pool.Status)
+	_, err := k.EIPClient.K8sV1().EgressIPPools().UpdateStatus(context.TODO(), pool, metav1.UpdateOptions{})
+	return err
+}
+
 // GetEgressIPs returns the list of all EgressIP objects from kubernetes
 func (k *KubeOVN) GetEgressIPs() ([]*egressipv1.EgressIP, error) {
 	list := []*egressipv1.EgressIP{}
@@ -5,9 +5,14 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	egressipv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1"
+	egressipfake "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1/apis/clientset/versioned/fake"
 	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
 )
 
 var _ = Describe("Kube", func() {
@@ -194,4 +199,67 @@ var _ = Describe("Kube", func() {
 			})
 		})
 	})
+
+	Describe("MergePatchEgressIPStatus", func() {
+		const egressIPName = "my-egressip"
+		var kube KubeOVN
+		var fakeEIPClient *egressipfake.Clientset
+		statusPatch := []byte(`{"status":{"items":[{"node":"my-node","egressIP":"192.168.126.10"}]}}`)
+
+		BeforeEach(func() {
+			fakeEIPClient = egressipfake.NewSimpleClientset(&egressipv1.EgressIP{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: egressIPName,
+				},
+				Spec: egressipv1.EgressIPSpec{
+					EgressIPs: []string{"192.168.126.10"},
+				},
+			})
+			kube = KubeOVN{
+				EIPClient: fakeEIPClient,
+			}
+		})
+
+		getPatchedSubresources := func() []string {
+			var subresources []string
+			for _, action := range fakeEIPClient.Actions() {
+				if action.GetVerb() == "patch" {
+					subresources = append(subresources, action.GetSubresource())
+				}
+			}
+			return subresources
+		}
+
+		It("should patch the status subresource", func() {
+			err := kube.MergePatchEgressIPStatus(egressIPName, statusPatch)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(getPatchedSubresources()).To(Equal([]string{"status"}))
+
+			eIP, err := fakeEIPClient.K8sV1().EgressIPs().Get(context.TODO(), egressIPName, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(eIP.Status.Items).To(Equal([]egressipv1.EgressIPStatusItem{{Node: "my-node", EgressIP: "192.168.126.10"}}))
+		})
+
+		It("should patch the EgressIP when the status subresource is not enabled in the CRD", func() {
+			fakeEIPClient.PrependReactor("patch", "egressips", func(action clienttesting.Action) (bool, runtime.Object, error) {
+				if action.GetSubresource() == "status" {
+					return true, nil, apierrors.NewNotFound(egressipv1.Resource("egressips"), egressIPName)
+				}
+				return false, nil, nil
+			})
+
+			err := kube.MergePatchEgressIPStatus(egressIPName, statusPatch)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(getPatchedSubresources()).To(Equal([]string{"status", ""}))
+
+			eIP, err := fakeEIPClient.K8sV1().EgressIPs().Get(context.TODO(), egressIPName, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(eIP.Status.Items).To(Equal([]egressipv1.EgressIPStatusItem{{Node: "my-node", EgressIP: "192.168.126.10"}}))
+		})
+
+		It("should return an error, if the EgressIP doesn't exist", func() {
+			err := kube.MergePatchEgressIPStatus("unknown", statusPatch)
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+	})
 })
@@ -181,6 +181,36 @@ func (_m *InterfaceOVN) GetEgressIP(name string) (*egressipv1.EgressIP, error) {
 	return r0, r1
 }
 
+// GetEgressIPPool provides a mock function with given fields: name
+func (_m *InterfaceOVN) GetEgressIPPool(name string) (*egressipv1.EgressIPPool, error) {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEgressIPPool")
+	}
+
+	var r0 *egressipv1.EgressIPPool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*egressipv1.EgressIPPool, error)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) *egressipv1.EgressIPPool); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*egressipv1.EgressIPPool)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetEgressIPs provides a mock function with given fields:
 func (_m *InterfaceOVN) GetEgressIPs() ([]*egressipv1.EgressIP, error) {
 	ret := _m.Called()
@@ -361,12 +391,30 @@ func (_m *InterfaceOVN) GetPods(namespace string, opts metav1.ListOptions) ([]*a
 	return r0, r1
 }
 
-// PatchEgressIP provides a mock function with given fields: name, patchData
-func (_m *InterfaceOVN) PatchEgressIP(name string, patchData []byte) error {
+// MergePatchEgressIPStatus provides a mock function with given fields: name, patchData
+func (_m *InterfaceOVN) MergePatchEgressIPStatus(name string, patchData []byte) error {
+	ret := _m.Called(name, patchData)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergePatchEgressIPStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, []byte) error); ok {
+		r0 = rf(name, patchData)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PatchEgressIPStatus provides a mock function with given fields: name, patchData
+func (_m *InterfaceOVN) PatchEgressIPStatus(name string, patchData []byte) error {
 	ret := _m.Called(name, patchData)
 
 	if len(ret) == 0 {
-		panic("no return value specified for PatchEgressIP")
+		panic("no return value specified for PatchEgressIPStatus")
 	}
 
 	var r0 error
@@ -571,12 +619,30 @@ func (_m *InterfaceOVN) UpdateEgressFirewall(egressfirewall *egressfirewallv1.Eg
 	return r0
 }
 
-// UpdateEgressIP provides a mock function with given fields: eIP
-func (_m *InterfaceOVN) UpdateEgressIP(eIP *egressipv1.EgressIP) error {
+// UpdateEgressIPPoolStatus provides a mock function with given fields: pool
+func (_m *InterfaceOVN) UpdateEgressIPPoolStatus(pool *egressipv1.EgressIPPool) error {
+	ret := _m.Called(pool)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateEgressIPPoolStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*egressipv1.EgressIPPool) error); ok {
+		r0 = rf(pool)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateEgressIPStatus provides a mock function with given fields: eIP
+func (_m *InterfaceOVN) UpdateEgressIPStatus(eIP *egressipv1.EgressIP) error {
 	ret := _m.Called(eIP)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateEgressIP")
+		panic("no return value specified for UpdateEgressIPStatus")
 	}
 
 	var r0 error
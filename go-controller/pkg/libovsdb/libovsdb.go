@@ -171,6 +171,8 @@ func NewSBClientWithConfig(cfg config.OvnAuthConfig, promRegistry prometheus.Reg
 			client.WithTable(&sbdb.SBGlobal{}),
 			// used for metrics
 			client.WithTable(&sbdb.PortBinding{}),
+			// used by services controller for load balancer health checks
+			client.WithTable(&sbdb.ServiceMonitor{}),
 		),
 	)
 	if err != nil {
@@ -39,6 +39,8 @@ const (
 	ClusterOwnerType ownerType = "Cluster"
 	// UDNIsolationOwnerType means the object is needed to implement UserDefinedNetwork isolation
 	UDNIsolationOwnerType ownerType = "UDNIsolation"
+	// NetworkPeeringOwnerType means the object is needed to implement a NetworkPeering
+	NetworkPeeringOwnerType ownerType = "NetworkPeering"
 
 	// owner extra IDs, make sure to define only 1 ExternalIDKey for every string value
 	PriorityKey           ExternalIDKey = "priority"
@@ -310,6 +312,15 @@ var LogicalRouterPolicyEgressIP = newObjectIDsType(logicalRouterPolicy, EgressIP
 	IPFamilyKey,
 })
 
+var LogicalRouterPolicyNetworkPeering = newObjectIDsType(logicalRouterPolicy, NetworkPeeringOwnerType, []ExternalIDKey{
+	// the priority of the LRP
+	PriorityKey,
+	// NetworkPeering name
+	ObjectNameKey,
+	// the IP Family for this policy, ip4 or ip6
+	IPFamilyKey,
+})
+
 var QoSEgressQoS = newObjectIDsType(qos, EgressQoSOwnerType, []ExternalIDKey{
 	// the priority of the QoSRule (OVN priority is the same as the rule index priority for this feature)
 	// this value will be unique in a given namespace
@@ -2,12 +2,15 @@ package ops
 
 import (
 	"context"
+	"errors"
 
 	libovsdbclient "github.com/ovn-org/libovsdb/client"
 	libovsdb "github.com/ovn-org/libovsdb/ovsdb"
 
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+
+	"k8s.io/apimachinery/pkg/util/sets"
 )
 
 // getNonZeroLoadBalancerMutableFields builds a list of load balancer
@@ -78,6 +81,45 @@ func CreateOrUpdateLoadBalancersOps(nbClient libovsdbclient.Client, ops []libovs
 	return modelClient.CreateOrUpdateOps(ops, opModels...)
 }
 
+// CreateOrUpdateLoadBalancerHealthChecksOps creates or updates the provided
+// health checks of the provided load balancer and returns the corresponding
+// ops. Existing health checks are looked up by VIP among the ones referenced
+// by the load balancer, which must then have its UUID set. The UUIDs of the
+// health checks are set so that the load balancer can reference them.
+func CreateOrUpdateLoadBalancerHealthChecksOps(nbClient libovsdbclient.Client, ops []libovsdb.Operation, lb *nbdb.LoadBalancer,
+	hcs ...*nbdb.LoadBalancerHealthCheck) ([]libovsdb.Operation, error) {
+	existingHCs := sets.New[string]()
+	if lb.UUID != "" {
+		existingLB := &nbdb.LoadBalancer{UUID: lb.UUID}
+		ctx, cancel := context.WithTimeout(context.Background(), config.Default.OVSDBTxnTimeout)
+		defer cancel()
+		err := nbClient.Get(ctx, existingLB)
+		if err != nil && !errors.Is(err, libovsdbclient.ErrNotFound) {
+			return nil, err
+		}
+		existingHCs.Insert(existingLB.HealthCheck...)
+	}
+
+	opModels := make([]operationModel, 0, len(hcs))
+	for i := range hcs {
+		// can't use i in the predicate, for loop replaces it in-memory
+		hc := hcs[i]
+		opModel := operationModel{
+			Model: hc,
+			ModelPredicate: func(item *nbdb.LoadBalancerHealthCheck) bool {
+				return existingHCs.Has(item.UUID) && item.Vip == hc.Vip
+			},
+			OnModelUpdates: []interface{}{}, // update all fields
+			ErrNotFound:    false,
+			BulkOp:         false,
+		}
+		opModels = append(opModels, opModel)
+	}
+
+	modelClient := newModelClient(nbClient)
+	return modelClient.CreateOrUpdateOps(ops, opModels...)
+}
+
 // RemoveLoadBalancerVipsOps removes the provided VIPs from the provided load
 // balancer set and returns the corresponding ops
 func RemoveLoadBalancerVipsOps(nbClient libovsdbclient.Client, ops []libovsdb.Operation, lb *nbdb.LoadBalancer, vips ...string) ([]libovsdb.Operation, error) {
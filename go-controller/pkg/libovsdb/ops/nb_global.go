@@ -4,6 +4,7 @@ import (
 	libovsdbclient "github.com/ovn-org/libovsdb/client"
 
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
 )
 
 // GetNBGlobal looks up the NB Global entry from the cache
@@ -62,3 +63,44 @@ func UpdateNBGlobalSetOptions(nbClient libovsdbclient.Client, nbGlobal *nbdb.NBG
 	_, err = m.CreateOrUpdate(opModel)
 	return err
 }
+
+// UpdateNBGlobalIPsec sets the ipsec column of the NB Global entry. IPsec is
+// only disabled if it was enabled by ovn-kubernetes, so that a deployment that
+// enables IPsec by other means is left untouched.
+func UpdateNBGlobalIPsec(nbClient libovsdbclient.Client, ipsec bool) error {
+	nbGlobal, err := GetNBGlobal(nbClient, &nbdb.NBGlobal{})
+	if err != nil {
+		return err
+	}
+
+	_, managed := nbGlobal.ExternalIDs[types.NBGlobalIPsecManagedExternalID]
+	if nbGlobal.Ipsec == ipsec && managed == ipsec {
+		return nil
+	}
+	if !ipsec && !managed {
+		return nil
+	}
+
+	if nbGlobal.ExternalIDs == nil {
+		nbGlobal.ExternalIDs = map[string]string{}
+	}
+	if ipsec {
+		nbGlobal.ExternalIDs[types.NBGlobalIPsecManagedExternalID] = "true"
+	} else {
+		delete(nbGlobal.ExternalIDs, types.NBGlobalIPsecManagedExternalID)
+	}
+	nbGlobal.Ipsec = ipsec
+	opModel := operationModel{
+		Model: nbGlobal,
+		OnModelUpdates: []interface{}{
+			&nbGlobal.Ipsec,
+			&nbGlobal.ExternalIDs,
+		},
+		ErrNotFound: true,
+		BulkOp:      false,
+	}
+
+	m := newModelClient(nbClient)
+	_, err = m.CreateOrUpdate(opModel)
+	return err
+}
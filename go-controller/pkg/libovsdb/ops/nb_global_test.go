@@ -0,0 +1,64 @@
+package ops
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	libovsdbtest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+)
+
+func TestUpdateNBGlobalIPsec(t *testing.T) {
+	managed := map[string]string{types.NBGlobalIPsecManagedExternalID: "true"}
+
+	tests := []struct {
+		desc     string
+		initial  *nbdb.NBGlobal
+		ipsec    bool
+		expected *nbdb.NBGlobal
+	}{
+		{
+			desc:     "enables IPsec and marks it as managed",
+			initial:  &nbdb.NBGlobal{UUID: "nb-global-UUID", Name: "global"},
+			ipsec:    true,
+			expected: &nbdb.NBGlobal{UUID: "nb-global-UUID", Name: "global", Ipsec: true, ExternalIDs: managed},
+		},
+		{
+			desc:     "disables managed IPsec",
+			initial:  &nbdb.NBGlobal{UUID: "nb-global-UUID", Name: "global", Ipsec: true, ExternalIDs: managed},
+			ipsec:    false,
+			expected: &nbdb.NBGlobal{UUID: "nb-global-UUID", Name: "global"},
+		},
+		{
+			desc:     "does not disable IPsec that is not managed",
+			initial:  &nbdb.NBGlobal{UUID: "nb-global-UUID", Name: "global", Ipsec: true},
+			ipsec:    false,
+			expected: &nbdb.NBGlobal{UUID: "nb-global-UUID", Name: "global", Ipsec: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			initialNbdb := libovsdbtest.TestSetup{NBData: []libovsdbtest.TestData{tt.initial}}
+			nbClient, cleanup, err := libovsdbtest.NewNBTestHarness(initialNbdb, nil)
+			if err != nil {
+				t.Fatalf("test: \"%s\" failed to set up test harness: %v", tt.desc, err)
+			}
+			t.Cleanup(cleanup.Cleanup)
+
+			if err = UpdateNBGlobalIPsec(nbClient, tt.ipsec); err != nil {
+				t.Fatal(fmt.Errorf("UpdateNBGlobalIPsec() error = %v", err))
+			}
+
+			matcher := libovsdbtest.HaveData([]libovsdbtest.TestData{tt.expected})
+			success, err := matcher.Match(nbClient)
+			if !success {
+				t.Fatal(fmt.Errorf("test: \"%s\" didn't match expected with actual, err: %v", tt.desc, matcher.FailureMessage(nbClient)))
+			}
+			if err != nil {
+				t.Fatal(fmt.Errorf("test: \"%s\" encountered error: %v", tt.desc, err))
+			}
+		})
+	}
+}
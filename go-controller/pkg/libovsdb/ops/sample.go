@@ -139,6 +139,9 @@ const (
 // SamplingConfig is used to configure sampling for different db objects.
 type SamplingConfig struct {
 	featureCollectors map[SampleFeature][]string
+	// collectorFilters optionally limits the ACLs sampled by a collector, keyed by collector UUID.
+	// Collectors without a filter sample all the ACLs of their features.
+	collectorFilters map[string]func(acl *nbdb.ACL) bool
 }
 
 func NewSamplingConfig(featureCollectors map[SampleFeature][]string) *SamplingConfig {
@@ -147,6 +150,31 @@ func NewSamplingConfig(featureCollectors map[SampleFeature][]string) *SamplingCo
 	}
 }
 
+// NewSamplingConfigWithFilters returns a SamplingConfig where the collectors that have a filter
+// only sample the ACLs the filter returns true for.
+func NewSamplingConfigWithFilters(featureCollectors map[SampleFeature][]string,
+	collectorFilters map[string]func(acl *nbdb.ACL) bool) *SamplingConfig {
+	return &SamplingConfig{
+		featureCollectors: featureCollectors,
+		collectorFilters:  collectorFilters,
+	}
+}
+
+// getACLCollectors returns the UUIDs of the collectors that should sample given ACL.
+func (c *SamplingConfig) getACLCollectors(acl *nbdb.ACL) []string {
+	collectors := c.featureCollectors[getACLSampleFeature(acl)]
+	if len(c.collectorFilters) == 0 {
+		return collectors
+	}
+	filtered := make([]string, 0, len(collectors))
+	for _, collector := range collectors {
+		if filter := c.collectorFilters[collector]; filter == nil || filter(acl) {
+			filtered = append(filtered, collector)
+		}
+	}
+	return filtered
+}
+
 func addSample(c *SamplingConfig, opModels []operationModel, model model.Model) []operationModel {
 	switch t := model.(type) {
 	case *nbdb.ACL:
@@ -162,7 +190,7 @@ func createOrUpdateSampleForACL(opModels []operationModel, c *SamplingConfig, ac
 		acl.SampleNew = nil
 		return opModels
 	}
-	collectors := c.featureCollectors[getACLSampleFeature(acl)]
+	collectors := c.getACLCollectors(acl)
 	if len(collectors) == 0 {
 		acl.SampleEst = nil
 		acl.SampleNew = nil
@@ -396,6 +396,16 @@ func DeleteLogicalSwitchPorts(nbClient libovsdbclient.Client, sw *nbdb.LogicalSw
 
 type logicalSwitchPortPredicate func(*nbdb.LogicalSwitchPort) bool
 
+// FindLogicalSwitchPortsWithPredicate looks up logical switch ports from the
+// cache based on a given predicate
+func FindLogicalSwitchPortsWithPredicate(nbClient libovsdbclient.Client, p logicalSwitchPortPredicate) ([]*nbdb.LogicalSwitchPort, error) {
+	found := []*nbdb.LogicalSwitchPort{}
+	ctx, cancel := context.WithTimeout(context.Background(), config.Default.OVSDBTxnTimeout)
+	defer cancel()
+	err := nbClient.WhereCache(p).List(ctx, &found)
+	return found, err
+}
+
 // DeleteLogicalSwitchPortsWithPredicateOps looks up logical switch ports from
 // the cache based on a given predicate and removes from them the provided
 // logical switch
@@ -45,7 +45,38 @@ var metricOvnKubeNodeLogFileSize = prometheus.NewGaugeVec(prometheus.GaugeOpts{
 	},
 )
 
+// MetricIPsecTunnels is the number of IPsec tunnels towards the other nodes by the state of their security associations
+var MetricIPsecTunnels = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: MetricOvnkubeNamespace,
+	Subsystem: MetricOvnkubeSubsystemNode,
+	Name:      "ipsec_tunnels",
+	Help:      "The number of IPsec tunnels towards the other nodes, by whether their security associations are established."},
+	[]string{
+		"state",
+	},
+)
+
+// MetricIPsecTunnelEstablished reports, per tunnel, whether its IPsec security associations are established
+var MetricIPsecTunnelEstablished = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: MetricOvnkubeNamespace,
+	Subsystem: MetricOvnkubeSubsystemNode,
+	Name:      "ipsec_tunnel_established",
+	Help:      "Whether the IPsec security associations of the tunnel towards the remote node IP are established (1) or not (0)."},
+	[]string{
+		"remote_ip",
+	},
+)
+
+// MetricIPsecCertificateExpiry is the expiration time of the IPsec certificate of the node
+var MetricIPsecCertificateExpiry = prometheus.NewGauge(prometheus.GaugeOpts{
+	Namespace: MetricOvnkubeNamespace,
+	Subsystem: MetricOvnkubeSubsystemNode,
+	Name:      "ipsec_certificate_expiration_timestamp_seconds",
+	Help:      "The expiration time of the IPsec certificate of the node, in unix seconds.",
+})
+
 var registerNodeMetricsOnce sync.Once
+var registerIPsecMetricsOnce sync.Once
 
 func RegisterNodeMetrics(stopChan <-chan struct{}) {
 	registerNodeMetricsOnce.Do(func() {
@@ -81,3 +112,12 @@ func RegisterNodeMetrics(stopChan <-chan struct{}) {
 		go ovnKubeLogFileSizeMetricsUpdater(metricOvnKubeNodeLogFileSize, stopChan)
 	})
 }
+
+// RegisterIPsecMetrics registers the metrics of the IPsec manager of ovnkube-node
+func RegisterIPsecMetrics() {
+	registerIPsecMetricsOnce.Do(func() {
+		prometheus.MustRegister(MetricIPsecTunnels)
+		prometheus.MustRegister(MetricIPsecTunnelEstablished)
+		prometheus.MustRegister(MetricIPsecCertificateExpiry)
+	})
+}
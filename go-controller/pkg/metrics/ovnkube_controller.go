@@ -269,6 +269,18 @@ var metricEgressFirewallCount = prometheus.NewGauge(prometheus.GaugeOpts{
 	Help:      "The number of egress firewall policies",
 })
 
+var metricServiceBackendHealthy = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: MetricOvnkubeNamespace,
+	Subsystem: MetricOvnkubeSubsystemController,
+	Name:      "service_backend_healthy",
+	Help:      "Specifies whether a service backend health checked by OVN is healthy(1) or not(0)"},
+	[]string{
+		"namespace",
+		"service",
+		"backend",
+	},
+)
+
 /** AdminNetworkPolicyMetrics Begin**/
 var metricANPCount = prometheus.NewGauge(prometheus.GaugeOpts{
 	Namespace: MetricOvnkubeNamespace,
@@ -442,6 +454,7 @@ func RegisterOVNKubeControllerFunctional(stopChan <-chan struct{}) {
 	prometheus.MustRegister(metricEgressRoutingViaHost)
 	prometheus.MustRegister(metricANPCount)
 	prometheus.MustRegister(metricBANPCount)
+	prometheus.MustRegister(metricServiceBackendHealthy)
 	if err := prometheus.Register(MetricResourceRetryFailuresCount); err != nil {
 		if _, ok := err.(prometheus.AlreadyRegisteredError); !ok {
 			panic(err)
@@ -657,6 +670,22 @@ func DecrementEgressFirewallCount() {
 	metricEgressFirewallCount.Dec()
 }
 
+// SetServiceBackendHealthy records whether the given backend of the given
+// service is healthy according to the OVN health checks.
+func SetServiceBackendHealthy(namespace, name, backend string, healthy bool) {
+	value := 0.0
+	if healthy {
+		value = 1
+	}
+	metricServiceBackendHealthy.WithLabelValues(namespace, name, backend).Set(value)
+}
+
+// DeleteServiceBackendHealthy stops recording the health of the given backend
+// of the given service.
+func DeleteServiceBackendHealthy(namespace, name, backend string) {
+	metricServiceBackendHealthy.DeleteLabelValues(namespace, name, backend)
+}
+
 // IncrementANPCount increments the number of Admin Network Policies
 func IncrementANPCount() {
 	metricANPCount.Inc()
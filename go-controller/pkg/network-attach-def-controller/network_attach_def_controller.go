@@ -250,6 +250,16 @@ func (nadController *NetAttachDefinitionController) syncNAD(key string, nad *net
 		// the NAD refers to an existing compatible network, ensure that
 		// existing network holds a reference to this NAD
 		ensureNetwork = currentNetwork
+	case util.IsSafeNetworkUpdate(currentNetwork, nadNetwork):
+		// the NAD updates an existing network in place, the other NADs
+		// referring to the network are expected to be updated the same way
+		nadNetwork.SetNADs(currentNetwork.GetNADs()...)
+		ensureNetwork = nadNetwork
+	case util.IsSafeNetworkUpdate(nadNetwork, currentNetwork) && !sets.New(key).HasAll(currentNetwork.GetNADs()...):
+		// the NAD has not been updated yet like the other NADs referring to
+		// the existing network, ensure that existing network holds a reference
+		// to this NAD
+		ensureNetwork = currentNetwork
 	case sets.New(key).HasAll(currentNetwork.GetNADs()...):
 		// the NAD is the only NAD referring to an existing incompatible
 		// network, remove the reference from the old network and ensure that
@@ -502,6 +502,161 @@ func TestNetAttachDefinitionController(t *testing.T) {
 	}
 }
 
+func TestNetworkControllerKeptOnNADsUpdate(t *testing.T) {
+	g := gomega.NewWithT(t)
+	g.Expect(config.PrepareTestConfig()).To(gomega.Succeed())
+	config.OVNKubernetesFeature.EnableNetworkSegmentation = true
+	config.OVNKubernetesFeature.EnableMultiNetwork = true
+	tncm := &testNetworkControllerManager{
+		controllers: map[string]NetworkController{},
+	}
+	nadController := &NetAttachDefinitionController{
+		nads:           map[string]string{},
+		networkManager: newNetworkManager("", tncm),
+		primaryNADs:    map[string]string{},
+	}
+	g.Expect(nadController.networkManager.Start()).To(gomega.Succeed())
+	defer nadController.networkManager.Stop()
+
+	// the NADs rendered in the namespaces selected by a ClusterUserDefinedNetwork
+	network := &ovncnitypes.NetConf{
+		Topology: types.Layer2Topology,
+		NetConf: cnitypes.NetConf{
+			Name: "cluster.udn.shared",
+			Type: "ovn-k8s-cni-overlay",
+		},
+		Subnets: "10.1.130.0/24",
+		Role:    types.NetworkRolePrimary,
+		MTU:     1400,
+	}
+	netInfo, err := util.NewNetInfo(network)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	key := testNetworkKey(netInfo)
+	syncNAD := func(nadKey string) {
+		namespace, name, err := cache.SplitMetaNamespaceKey(nadKey)
+		g.Expect(err).ToNot(gomega.HaveOccurred())
+		network.NADName = nadKey
+		nad, err := buildNAD(name, namespace, network)
+		g.Expect(err).ToNot(gomega.HaveOccurred())
+		g.Expect(nadController.syncNAD(nadKey, nad)).To(gomega.Succeed())
+	}
+	getController := func() NetworkController {
+		tncm.Lock()
+		defer tncm.Unlock()
+		return tncm.controllers[key]
+	}
+	getNADs := func() []string {
+		if controller := getController(); controller != nil {
+			return controller.GetNADs()
+		}
+		return nil
+	}
+
+	syncNAD("blue/shared")
+	g.Eventually(getNADs).Should(gomega.ConsistOf("blue/shared"))
+	controller := getController()
+
+	// the namespace selector is extended
+	syncNAD("red/shared")
+	g.Eventually(getNADs).Should(gomega.ConsistOf("blue/shared", "red/shared"))
+
+	g.Consistently(func(g gomega.Gomega) {
+		g.Expect(getController()).To(gomega.BeIdenticalTo(controller))
+		tncm.Lock()
+		defer tncm.Unlock()
+		g.Expect(tncm.started).To(gomega.Equal([]string{key}), "started network controllers")
+		g.Expect(tncm.stopped).To(gomega.BeEmpty(), "stopped network controllers")
+		g.Expect(tncm.cleaned).To(gomega.BeEmpty(), "cleaned up network controllers")
+	}).Should(gomega.Succeed())
+}
+
+func TestNetworkControllerRestartedOnSafeNetworkUpdate(t *testing.T) {
+	g := gomega.NewWithT(t)
+	g.Expect(config.PrepareTestConfig()).To(gomega.Succeed())
+	config.OVNKubernetesFeature.EnableNetworkSegmentation = true
+	config.OVNKubernetesFeature.EnableMultiNetwork = true
+	config.IPv4Mode = true
+	config.IPv6Mode = true
+	tncm := &testNetworkControllerManager{
+		controllers: map[string]NetworkController{},
+	}
+	nadController := &NetAttachDefinitionController{
+		nads:           map[string]string{},
+		networkManager: newNetworkManager("", tncm),
+		primaryNADs:    map[string]string{},
+	}
+	g.Expect(nadController.networkManager.Start()).To(gomega.Succeed())
+	defer nadController.networkManager.Stop()
+
+	network := &ovncnitypes.NetConf{
+		Topology: types.Layer2Topology,
+		NetConf: cnitypes.NetConf{
+			Name: "cluster.udn.shared",
+			Type: "ovn-k8s-cni-overlay",
+		},
+		Subnets: "10.1.130.0/24",
+		Role:    types.NetworkRolePrimary,
+		MTU:     1400,
+	}
+	updatedNetwork := *network
+	updatedNetwork.Subnets = "10.1.130.0/24,fd00:10:1:130::/64"
+	updatedNetwork.MTU = 9000
+
+	netInfo, err := util.NewNetInfo(network)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	key := testNetworkKey(netInfo)
+	syncNAD := func(nadKey string, network *ovncnitypes.NetConf) {
+		namespace, name, err := cache.SplitMetaNamespaceKey(nadKey)
+		g.Expect(err).ToNot(gomega.HaveOccurred())
+		network.NADName = nadKey
+		nad, err := buildNAD(name, namespace, network)
+		g.Expect(err).ToNot(gomega.HaveOccurred())
+		g.Expect(nadController.syncNAD(nadKey, nad)).To(gomega.Succeed())
+	}
+	getController := func() NetworkController {
+		tncm.Lock()
+		defer tncm.Unlock()
+		return tncm.controllers[key]
+	}
+	getMTU := func() int {
+		if controller := getController(); controller != nil {
+			return controller.MTU()
+		}
+		return 0
+	}
+	getNADs := func() []string {
+		if controller := getController(); controller != nil {
+			return controller.GetNADs()
+		}
+		return nil
+	}
+	getStarted := func() []string {
+		tncm.Lock()
+		defer tncm.Unlock()
+		return tncm.started
+	}
+
+	syncNAD("blue/shared", network)
+	syncNAD("red/shared", network)
+	g.Eventually(getNADs).Should(gomega.ConsistOf("blue/shared", "red/shared"))
+
+	// the NADs are rolled one at a time with the updated network
+	syncNAD("blue/shared", &updatedNetwork)
+	g.Eventually(getMTU).Should(gomega.Equal(9000))
+	g.Expect(getNADs()).To(gomega.ConsistOf("blue/shared", "red/shared"))
+	syncNAD("red/shared", &updatedNetwork)
+	g.Eventually(getStarted).Should(gomega.Equal([]string{key, key}))
+
+	g.Consistently(func(g gomega.Gomega) {
+		g.Expect(getNADs()).To(gomega.ConsistOf("blue/shared", "red/shared"))
+		tncm.Lock()
+		defer tncm.Unlock()
+		g.Expect(tncm.started).To(gomega.Equal([]string{key, key}), "started network controllers")
+		g.Expect(tncm.stopped).To(gomega.Equal([]string{key}), "stopped network controllers")
+		g.Expect(tncm.cleaned).To(gomega.BeEmpty(), "cleaned up network controllers")
+	}).Should(gomega.Succeed())
+}
+
 func TestSyncAll(t *testing.T) {
 	network_A := &ovncnitypes.NetConf{
 		Topology: types.Layer3Topology,
@@ -20,9 +20,11 @@ type networkManager interface {
 	// EnsureNetwork will add the network controller for the provided network
 	// configuration. If a controller already exists for the same network with a
 	// different configuration, the existing controller is stopped and cleaned
-	// up before creating the new one. If a controller already exists for the
-	// same network configuration, it synchronizes the list of NADs to the
-	// controller.
+	// up before creating the new one, unless the configuration is a safe
+	// update of the existing one (see util.IsSafeNetworkUpdate) in which case
+	// the existing controller is only stopped. If a controller already exists
+	// for the same network configuration, it synchronizes the list of NADs to
+	// the controller.
 	EnsureNetwork(util.NetInfo)
 
 	// DeleteNetwork removes the network controller for the provided network
@@ -61,6 +63,9 @@ func newNetworkManager(name string, ncm NetworkControllerManager) networkManager
 type networkControllerState struct {
 	controller         NetworkController
 	stoppedAndDeleting bool
+	// stoppedForUpdate is set when the controller has been stopped to be
+	// replaced by a controller with an updated configuration
+	stoppedForUpdate bool
 }
 
 type networkManagerImpl struct {
@@ -81,6 +86,9 @@ func (nm *networkManagerImpl) Start() error {
 func (nm *networkManagerImpl) Stop() {
 	controller.Stop(nm.controller)
 	for _, networkControllerState := range nm.getAllNetworkStates() {
+		if networkControllerState.stoppedForUpdate {
+			continue
+		}
 		networkControllerState.controller.Stop()
 	}
 }
@@ -157,12 +165,16 @@ func (nm *networkManagerImpl) sync(network string) error {
 	want := nm.getNetwork(network)
 	have := nm.getNetworkState(network)
 
+	// the old network is kept if the configuration changed in a safe way, its
+	// controller is replaced by a controller with the new configuration
+	update := have != nil && !have.stoppedAndDeleting && want != nil && util.IsSafeNetworkUpdate(have.controller, want)
+
 	// we will dispose of the old network if deletion is in progress or if
 	// configuration changed
-	dispose := have != nil && (have.stoppedAndDeleting || !have.controller.Equals(want))
+	dispose := have != nil && !update && (have.stoppedAndDeleting || !have.controller.Equals(want))
 
 	if dispose {
-		if !have.stoppedAndDeleting {
+		if !have.stoppedAndDeleting && !have.stoppedForUpdate {
 			have.controller.Stop()
 		}
 		have.stoppedAndDeleting = true
@@ -179,11 +191,17 @@ func (nm *networkManagerImpl) sync(network string) error {
 	}
 
 	// we didn't dispose of current controller, so this might just be an update of the network NADs
-	if have != nil && !dispose {
+	if have != nil && !dispose && !update && !have.stoppedForUpdate {
 		have.controller.SetNADs(want.GetNADs()...)
 		return nil
 	}
 
+	if update && !have.stoppedForUpdate {
+		klog.Infof("%s: restarting network %s controller with an updated configuration", nm.name, network)
+		have.controller.Stop()
+		have.stoppedForUpdate = true
+	}
+
 	// setup & start the new network controller
 	nc, err := nm.ncm.NewNetworkController(util.CopyNetInfo(want))
 	if err != nil {
@@ -11,6 +11,7 @@ import (
 
 	ovncnitypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/cni/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	networkobservabilityclientset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkobservability/v1/apis/clientset/versioned"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/kube"
 	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
@@ -55,6 +56,10 @@ type NetworkControllerManager struct {
 
 	// net-attach-def controller handle net-attach-def and create/delete network controllers
 	nadController *nad.NetAttachDefinitionController
+
+	// observability client and manager, the manager is nil if observability is disabled
+	observabilityClient  networkobservabilityclientset.Interface
+	observabilityManager *observability.Manager
 }
 
 func (cm *NetworkControllerManager) NewNetworkController(nInfo util.NetInfo) (nad.NetworkController, error) {
@@ -203,6 +208,8 @@ func NewNetworkControllerManager(ovnClient *util.OVNClientset, wf *factory.Watch
 
 		wg:               wg,
 		multicastSupport: config.EnableMulticast,
+
+		observabilityClient: ovnClient.ObservabilityClient,
 	}
 
 	var err error
@@ -394,10 +401,9 @@ func (cm *NetworkControllerManager) Start(ctx context.Context) error {
 		}
 	}
 
-	var observabilityManager *observability.Manager
 	if config.OVNKubernetesFeature.EnableObservability {
-		observabilityManager = observability.NewManager(cm.nbClient)
-		if err = observabilityManager.Init(); err != nil {
+		cm.observabilityManager = observability.NewManager(cm.nbClient, cm.watchFactory, cm.observabilityClient, config.Default.Zone)
+		if err = cm.observabilityManager.Init(); err != nil {
 			return fmt.Errorf("failed to init observability manager: %w", err)
 		}
 	} else {
@@ -406,7 +412,7 @@ func (cm *NetworkControllerManager) Start(ctx context.Context) error {
 			klog.Warningf("Observability cleanup failed, expected if not all Samples ware deleted yet: %v", err)
 		}
 	}
-	err = cm.initDefaultNetworkController(cm.nadController, observabilityManager)
+	err = cm.initDefaultNetworkController(cm.nadController, cm.observabilityManager)
 	if err != nil {
 		return fmt.Errorf("failed to init default network controller: %v", err)
 	}
@@ -432,4 +438,9 @@ func (cm *NetworkControllerManager) Stop() {
 	if cm.nadController != nil {
 		cm.nadController.Stop()
 	}
+
+	// stop the observability manager
+	if cm.observabilityManager != nil {
+		cm.observabilityManager.Stop()
+	}
 }
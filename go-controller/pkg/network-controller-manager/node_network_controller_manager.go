@@ -14,6 +14,7 @@ import (
 	nad "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/network-attach-def-controller"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/node"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/node/iprulemanager"
+	noderoutead "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/node/routeadvertisements"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/node/routemanager"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/node/vrfmanager"
 	ovntypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
@@ -47,6 +48,9 @@ type nodeNetworkControllerManager struct {
 	routeManager *routemanager.Controller
 	// iprule manager that creates and manages iprules for all UDNs
 	ruleManager *iprulemanager.Controller
+	// route advertisements controller that configures the routing daemon
+	// nil if route advertisements are not enabled
+	routeAdvertisementsController *noderoutead.Controller
 }
 
 // NewNetworkController create secondary node network controllers for the given NetInfo
@@ -132,6 +136,9 @@ func NewNodeNetworkControllerManager(ovnClient *util.OVNClientset, wf factory.No
 		ncm.vrfManager = vrfmanager.NewController(ncm.routeManager)
 		ncm.ruleManager = iprulemanager.NewController(config.IPv4Mode, config.IPv6Mode)
 	}
+	if config.OVNKubernetesFeature.EnableRouteAdvertisements {
+		ncm.routeAdvertisementsController = noderoutead.NewController(name, wf, ncm.vrfManager)
+	}
 	return ncm, nil
 }
 
@@ -231,6 +238,13 @@ func (ncm *nodeNetworkControllerManager) Start(ctx context.Context) (err error)
 			return fmt.Errorf("failed to own priority %d for IP rules: %v", node.UDNMasqueradeIPRulePriority, err)
 		}
 	}
+
+	if ncm.routeAdvertisementsController != nil {
+		err = ncm.routeAdvertisementsController.Start()
+		if err != nil {
+			return fmt.Errorf("failed to start route advertisements controller: %w", err)
+		}
+	}
 	return nil
 }
 
@@ -247,6 +261,10 @@ func (ncm *nodeNetworkControllerManager) Stop() {
 	if ncm.nadController != nil {
 		ncm.nadController.Stop()
 	}
+
+	if ncm.routeAdvertisementsController != nil {
+		ncm.routeAdvertisementsController.Stop()
+	}
 }
 
 // checkForStaleOVSRepresentorInterfaces checks for stale OVS ports backed by Repreresentor interfaces,
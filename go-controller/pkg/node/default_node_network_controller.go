@@ -16,6 +16,7 @@ import (
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	clientset "k8s.io/client-go/kubernetes"
 	listers "k8s.io/client-go/listers/core/v1"
@@ -35,6 +36,7 @@ import (
 	nad "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/network-attach-def-controller"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/node/controllers/egressip"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/node/controllers/egressservice"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/node/ipsec"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/node/linkmanager"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/node/ovspinning"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/node/routemanager"
@@ -1072,12 +1074,16 @@ func (nc *DefaultNodeNetworkController) Start(ctx context.Context) error {
 	nc.Gateway.Start()
 	klog.Infof("Gateway and management port readiness took %v", time.Since(start))
 
+	// OVS bridges the pod networking relies on, checked on CNI STATUS requests
+	var ovsBridges []string
 	// Note(adrianc): DPU deployments are expected to support the new shared gateway changes, upgrade flow
 	// is not needed. Future upgrade flows will need to take DPUs into account.
 	if config.OvnKubeNode.Mode != types.NodeModeDPUHost {
+		ovsBridges = append(ovsBridges, "br-int")
 		bridgeName := ""
 		if config.OvnKubeNode.Mode == types.NodeModeFull {
 			bridgeName = nc.Gateway.GetGatewayBridgeIface()
+			ovsBridges = append(ovsBridges, bridgeName)
 			// Configure route for svc towards shared gw bridge
 			// Have to have the route to bridge for multi-NIC mode, where the default gateway may go to a non-OVS interface
 			if err := configureSvcRouteViaBridge(nc.routeManager, bridgeName); err != nil {
@@ -1085,6 +1091,9 @@ func (nc *DefaultNodeNetworkController) Start(ctx context.Context) error {
 			}
 		}
 	}
+	if nc.cniServer != nil {
+		nc.cniServer.SetReady(ovsBridges...)
+	}
 
 	if config.HybridOverlay.Enabled {
 		// Not supported with DPUs, enforced in config
@@ -1133,6 +1142,18 @@ func (nc *DefaultNodeNetworkController) Start(ctx context.Context) error {
 	}
 
 	if config.OvnKubeNode.Mode != types.NodeModeDPUHost {
+		// every minute publish the physical networks mapped on the node, ovn-bridge-mappings may be changed
+		// at any time by the administrator
+		nc.wg.Add(1)
+		go func() {
+			defer nc.wg.Done()
+			wait.Until(func() {
+				if err := nc.updatePhysicalNetworksAnnotation(); err != nil {
+					klog.Errorf("Failed to update physical networks of node %s: %v", nc.name, err)
+				}
+			}, time.Minute*1, nc.stopChan)
+		}()
+
 		// If interconnect is disabled OR interconnect is running in single-zone-mode,
 		// the ovnkube-master is responsible for patching ICNI managed namespaces with
 		// "k8s.ovn.org/external-gw-pod-ips". In that case, we need ovnkube-node to flush
@@ -1212,6 +1233,15 @@ func (nc *DefaultNodeNetworkController) Start(ctx context.Context) error {
 
 	linkManager.Run(nc.stopChan, nc.wg)
 
+	ipsecManager := ipsec.NewManager(nc.name, nc.client, nc.Kube, nc.watchFactory.NodeCoreInformer().Lister())
+	if config.IPsec.Enabled {
+		if err = ipsecManager.Run(nc.stopChan, nc.wg); err != nil {
+			return fmt.Errorf("failed to run IPsec manager: %w", err)
+		}
+	} else if err = ipsecManager.Cleanup(); err != nil {
+		return fmt.Errorf("failed to clean up IPsec: %w", err)
+	}
+
 	nc.wg.Add(1)
 	go func() {
 		defer nc.wg.Done()
@@ -1222,6 +1252,36 @@ func (nc *DefaultNodeNetworkController) Start(ctx context.Context) error {
 	return nil
 }
 
+// updatePhysicalNetworksAnnotation annotates the node with the physical networks mapped to an OVS bridge by the
+// ovn-bridge-mappings, so that the cluster manager can validate the localnet networks.
+func (nc *DefaultNodeNetworkController) updatePhysicalNetworksAnnotation() error {
+	stdout, stderr, err := util.RunOVSVsctl("--if-exists", "get", "Open_vSwitch", ".",
+		"external_ids:ovn-bridge-mappings")
+	if err != nil {
+		return fmt.Errorf("failed to get ovn-bridge-mappings stderr:%s (%v)", stderr, err)
+	}
+	physicalNetworks := sets.New[string]()
+	// ovn-bridge-mappings is in the form of physnet1:br1,physnet2:br2
+	for _, bridgeMapping := range strings.Split(strings.Trim(stdout, "\""), ",") {
+		if network, _, found := strings.Cut(bridgeMapping, ":"); found && network != "" {
+			physicalNetworks.Insert(network)
+		}
+	}
+
+	node, err := nc.watchFactory.GetNode(nc.name)
+	if err != nil {
+		return fmt.Errorf("failed to get node: %w", err)
+	}
+	if current, err := util.ParseNodePhysicalNetworks(node); err == nil && current.Equal(physicalNetworks) {
+		return nil
+	}
+	nodeAnnotator := kube.NewNodeAnnotator(nc.Kube, nc.name)
+	if err := util.SetNodePhysicalNetworks(nodeAnnotator, physicalNetworks); err != nil {
+		return err
+	}
+	return nodeAnnotator.Run()
+}
+
 // Stop gracefully stops the controller
 // deleteLogicalEntities will never be true for default network
 func (nc *DefaultNodeNetworkController) Stop() {
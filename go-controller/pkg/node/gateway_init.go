@@ -1,6 +1,7 @@
 package node
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net"
@@ -14,6 +15,7 @@ import (
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/kube"
+	nodenft "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/node/nftables"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/node/routemanager"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
@@ -529,6 +531,9 @@ func (nc *DefaultNodeNetworkController) initGatewayDPUHost(kubeNodeIP net.IP) er
 		if err := initSharedGatewayIPTables(); err != nil {
 			return err
 		}
+		if err := initGatewayNFTables(); err != nil {
+			return err
+		}
 		gw.nodePortWatcherIptables = newNodePortWatcherIptables(nc.nadController)
 		gw.loadBalancerHealthChecker = newLoadBalancerHealthChecker(nc.name, nc.watchFactory)
 		portClaimWatcher, err := newPortClaimWatcher(nc.recorder)
@@ -573,6 +578,13 @@ func CleanupClusterNode(name string) error {
 	// Delete iptable rules for management port
 	DelMgtPortIptRules()
 
+	// Delete the nftables gateway and management port rules
+	if useNFTablesBackend() {
+		if err := nodenft.DeleteTable(context.TODO()); err != nil {
+			klog.Errorf("Failed to delete nftables gateway rules, error: %v", err)
+		}
+	}
+
 	return nil
 }
 
@@ -575,10 +575,19 @@ func addChaintoTable(ipt util.IPTablesHelper, tableName, chain string) {
 	}
 }
 
+// gatewayIPTChains returns the iptables chains the gateway jumps to for service traffic.
+// With the nftables backend only the egress service chain is still programmed with iptables.
+func gatewayIPTChains() []string {
+	if useNFTablesBackend() {
+		return []string{egressservice.Chain}
+	}
+	// (NOTE: Order is important, add jump to iptableETPChain before jump to NP/EIP chains)
+	return []string{iptableITPChain, egressservice.Chain, iptableNodePortChain, iptableExternalIPChain, iptableETPChain}
+}
+
 func handleGatewayIPTables(iptCallback func(rules []nodeipt.Rule) error, genGatewayChainRules func(chain string, proto iptables.Protocol) []nodeipt.Rule) error {
 	rules := make([]nodeipt.Rule, 0)
-	// (NOTE: Order is important, add jump to iptableETPChain before jump to NP/EIP chains)
-	for _, chain := range []string{iptableITPChain, egressservice.Chain, iptableNodePortChain, iptableExternalIPChain, iptableETPChain} {
+	for _, chain := range gatewayIPTChains() {
 		for _, proto := range clusterIPTablesProtocols() {
 			ipt, err := util.GetIPTablesHelper(proto)
 			if err != nil {
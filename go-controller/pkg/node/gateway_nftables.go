@@ -0,0 +1,566 @@
+//go:build linux
+// +build linux
+
+package node
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/coreos/go-iptables/iptables"
+	kapi "k8s.io/api/core/v1"
+	ktypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	utilnet "k8s.io/utils/net"
+	"sigs.k8s.io/knftables"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	nodenft "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/node/nftables"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+// The nftables backend programs the same service rules as the iptables chains in
+// gateway_iptables.go, but keeps the per-service data in maps and sets of the
+// ovn-kubernetes inet table so that lookups don't scale with the number of services:
+//
+//	nat-prerouting  (nat, prerouting, dstnat)  -> etp, external-ip, nodeport
+//	nat-output      (nat, output, dstnat)      -> external-ip, nodeport, itp
+//	mangle-output   (route, output, mangle)    -> marks ITP=local traffic towards ovn-k8s-mp0
+//	nat-postrouting (nat, postrouting, srcnat) -> mgmtport-snat for traffic leaving via ovn-k8s-mp0
+const (
+	nftablesNATPreroutingChain  = "nat-prerouting"
+	nftablesNATOutputChain      = "nat-output"
+	nftablesMangleOutputChain   = "mangle-output"
+	nftablesNATPostroutingChain = "nat-postrouting"
+
+	nftablesNodePortChain       = "nodeport"
+	nftablesExternalIPChain     = "external-ip"
+	nftablesETPChain            = "etp"
+	nftablesETPNoNodePortChain  = "etp-no-nodeport"
+	nftablesITPChain            = "itp"
+	nftablesMgmtPortSNATChain   = "mgmtport-snat"
+	nftablesMgmtPortNoSNATPorts = "mgmtport-no-snat-nodeports"
+
+	// per IP family maps and sets, the family suffix is appended by nftablesFamilyName
+	nftablesNodePortMap          = "nodeport"
+	nftablesETPNodePortMap       = "etp-nodeport"
+	nftablesExternalIPMap        = "external-ip"
+	nftablesETPExternalIPMap     = "etp-external-ip"
+	nftablesITPRedirectMap       = "itp-redirect"
+	nftablesITPMarkSet           = "itp-mark"
+	nftablesMgmtPortNoSNATSvcSet = "mgmtport-no-snat-services"
+)
+
+// useNFTablesBackend returns true if gateway service rules are programmed with nftables
+func useNFTablesBackend() bool {
+	return config.Gateway.FirewallBackend == config.FirewallBackendNFTables
+}
+
+// nftablesFamilyName returns the name of the per IP family set or map
+func nftablesFamilyName(name string, isIPv6 bool) string {
+	if isIPv6 {
+		return name + "-v6"
+	}
+	return name + "-v4"
+}
+
+func nftablesIPFamilies() []bool {
+	var families []bool
+	if config.IPv4Mode {
+		families = append(families, false)
+	}
+	if config.IPv6Mode {
+		families = append(families, true)
+	}
+	return families
+}
+
+// nftablesAddrFamily returns the nftables address family keyword and address type
+// used in rules, sets and maps for the given IP family
+func nftablesAddrFamily(isIPv6 bool) (string, string) {
+	if isIPv6 {
+		return "ip6", "ipv6_addr"
+	}
+	return "ip", "ipv4_addr"
+}
+
+// gatewayNFTablesServiceSets returns the names of all the sets holding per-service data
+func gatewayNFTablesServiceSets() []string {
+	sets := []string{nftablesMgmtPortNoSNATPorts}
+	for _, isIPv6 := range nftablesIPFamilies() {
+		sets = append(sets,
+			nftablesFamilyName(nftablesITPMarkSet, isIPv6),
+			nftablesFamilyName(nftablesMgmtPortNoSNATSvcSet, isIPv6),
+		)
+	}
+	return sets
+}
+
+// gatewayNFTablesServiceMaps returns the names of all the maps holding per-service data
+func gatewayNFTablesServiceMaps() []string {
+	var maps []string
+	for _, isIPv6 := range nftablesIPFamilies() {
+		for _, name := range []string{nftablesNodePortMap, nftablesETPNodePortMap, nftablesExternalIPMap,
+			nftablesETPExternalIPMap, nftablesITPRedirectMap} {
+			maps = append(maps, nftablesFamilyName(name, isIPv6))
+		}
+	}
+	return maps
+}
+
+// gatewayNFTablesServiceChains returns the names of the chains holding per-service rules
+func gatewayNFTablesServiceChains() []string {
+	return []string{nftablesETPNoNodePortChain}
+}
+
+// ensureGatewayNFTablesObjects adds the table, chains, sets and maps used by the gateway
+// to the transaction. Adding existing objects is a no-op, so this is safe to call on
+// every (re)initialization.
+func ensureGatewayNFTablesObjects(tx *knftables.Transaction) {
+	tx.Add(&knftables.Table{
+		Comment: knftables.PtrTo("rules for ovn-kubernetes node gateway services"),
+	})
+
+	tx.Add(&knftables.Chain{
+		Name:     nftablesNATPreroutingChain,
+		Type:     knftables.PtrTo(knftables.NATType),
+		Hook:     knftables.PtrTo(knftables.PreroutingHook),
+		Priority: knftables.PtrTo(knftables.DNATPriority),
+	})
+	tx.Add(&knftables.Chain{
+		Name:     nftablesNATOutputChain,
+		Type:     knftables.PtrTo(knftables.NATType),
+		Hook:     knftables.PtrTo(knftables.OutputHook),
+		Priority: knftables.PtrTo(knftables.DNATPriority),
+	})
+	tx.Add(&knftables.Chain{
+		Name:     nftablesMangleOutputChain,
+		Type:     knftables.PtrTo(knftables.RouteType),
+		Hook:     knftables.PtrTo(knftables.OutputHook),
+		Priority: knftables.PtrTo(knftables.ManglePriority),
+	})
+	tx.Add(&knftables.Chain{
+		Name:     nftablesNATPostroutingChain,
+		Type:     knftables.PtrTo(knftables.NATType),
+		Hook:     knftables.PtrTo(knftables.PostroutingHook),
+		Priority: knftables.PtrTo(knftables.SNATPriority),
+	})
+	for _, chain := range []string{nftablesNodePortChain, nftablesExternalIPChain, nftablesETPChain,
+		nftablesETPNoNodePortChain, nftablesITPChain, nftablesMgmtPortSNATChain} {
+		tx.Add(&knftables.Chain{Name: chain})
+	}
+
+	tx.Add(&knftables.Set{
+		Name:    nftablesMgmtPortNoSNATPorts,
+		Type:    "inet_proto . inet_service",
+		Comment: knftables.PtrTo("nodeports of ETP=local services that must not be SNATed to the management port"),
+	})
+	for _, isIPv6 := range nftablesIPFamilies() {
+		_, addrType := nftablesAddrFamily(isIPv6)
+		tx.Add(&knftables.Set{
+			Name:    nftablesFamilyName(nftablesITPMarkSet, isIPv6),
+			Type:    addrType + " . inet_proto . inet_service",
+			Comment: knftables.PtrTo("ITP=local cluster IPs without local host-network endpoints"),
+		})
+		tx.Add(&knftables.Set{
+			Name:    nftablesFamilyName(nftablesMgmtPortNoSNATSvcSet, isIPv6),
+			Type:    addrType + " . inet_proto . inet_service",
+			Comment: knftables.PtrTo("local endpoints of ETP=local load balancers that must not be SNATed to the management port"),
+		})
+		tx.Add(&knftables.Map{
+			Name:    nftablesFamilyName(nftablesNodePortMap, isIPv6),
+			Type:    "inet_proto . inet_service : " + addrType + " . inet_service",
+			Comment: knftables.PtrTo("nodeport DNAT to cluster IP"),
+		})
+		tx.Add(&knftables.Map{
+			Name:    nftablesFamilyName(nftablesETPNodePortMap, isIPv6),
+			Type:    "inet_proto . inet_service : " + addrType + " . inet_service",
+			Comment: knftables.PtrTo("ETP=local nodeport DNAT to the host masquerade IP"),
+		})
+		tx.Add(&knftables.Map{
+			Name:    nftablesFamilyName(nftablesExternalIPMap, isIPv6),
+			Type:    addrType + " . inet_proto . inet_service : " + addrType + " . inet_service",
+			Comment: knftables.PtrTo("external and load balancer IP DNAT to cluster IP"),
+		})
+		tx.Add(&knftables.Map{
+			Name:    nftablesFamilyName(nftablesETPExternalIPMap, isIPv6),
+			Type:    addrType + " . inet_proto . inet_service : " + addrType + " . inet_service",
+			Comment: knftables.PtrTo("ETP=local external and load balancer IP DNAT to the host masquerade IP"),
+		})
+		tx.Add(&knftables.Map{
+			Name:    nftablesFamilyName(nftablesITPRedirectMap, isIPv6),
+			Type:    addrType + " . inet_proto . inet_service : inet_service",
+			Comment: knftables.PtrTo("ITP=local cluster IP redirect to local host-network endpoints"),
+		})
+	}
+}
+
+// getGatewayNFTablesInitRules returns the static rules of the gateway chains that do the
+// map and set lookups for service traffic. The nat-postrouting and mgmtport-snat chains
+// are owned by the management port, see setupManagementPortNFTables.
+func getGatewayNFTablesInitRules() []*knftables.Rule {
+	// (NOTE: Order is important, jump to the ETP chain before jumping to the NP/EIP chains)
+	rules := []*knftables.Rule{
+		{Chain: nftablesNATPreroutingChain, Rule: "jump " + nftablesETPChain},
+		{Chain: nftablesNATPreroutingChain, Rule: "jump " + nftablesExternalIPChain},
+		{Chain: nftablesNATPreroutingChain, Rule: "jump " + nftablesNodePortChain},
+		{Chain: nftablesNATOutputChain, Rule: "jump " + nftablesExternalIPChain},
+		{Chain: nftablesNATOutputChain, Rule: "jump " + nftablesNodePortChain},
+		{Chain: nftablesNATOutputChain, Rule: "jump " + nftablesITPChain},
+		{Chain: nftablesETPChain, Rule: "jump " + nftablesETPNoNodePortChain},
+	}
+	for _, isIPv6 := range nftablesIPFamilies() {
+		family, _ := nftablesAddrFamily(isIPv6)
+		nfproto := "ipv4"
+		if isIPv6 {
+			nfproto = "ipv6"
+		}
+		rules = append(rules,
+			&knftables.Rule{
+				Chain: nftablesETPChain,
+				Rule: knftables.Concat(
+					"meta nfproto", nfproto, "fib daddr type local",
+					"dnat", family, "addr . port to meta l4proto . th dport map",
+					"@"+nftablesFamilyName(nftablesETPNodePortMap, isIPv6),
+				),
+			},
+			&knftables.Rule{
+				Chain: nftablesETPChain,
+				Rule: knftables.Concat(
+					"dnat", family, "addr . port to", family, "daddr . meta l4proto . th dport map",
+					"@"+nftablesFamilyName(nftablesETPExternalIPMap, isIPv6),
+				),
+			},
+			&knftables.Rule{
+				Chain: nftablesNodePortChain,
+				Rule: knftables.Concat(
+					"meta nfproto", nfproto, "fib daddr type local",
+					"dnat", family, "addr . port to meta l4proto . th dport map",
+					"@"+nftablesFamilyName(nftablesNodePortMap, isIPv6),
+				),
+			},
+			&knftables.Rule{
+				Chain: nftablesExternalIPChain,
+				Rule: knftables.Concat(
+					"dnat", family, "addr . port to", family, "daddr . meta l4proto . th dport map",
+					"@"+nftablesFamilyName(nftablesExternalIPMap, isIPv6),
+				),
+			},
+			&knftables.Rule{
+				Chain: nftablesITPChain,
+				Rule: knftables.Concat(
+					"redirect to :", family, "daddr . meta l4proto . th dport map",
+					"@"+nftablesFamilyName(nftablesITPRedirectMap, isIPv6),
+				),
+			},
+			&knftables.Rule{
+				Chain: nftablesMangleOutputChain,
+				Rule: knftables.Concat(
+					family, "daddr . meta l4proto . th dport",
+					"@"+nftablesFamilyName(nftablesITPMarkSet, isIPv6),
+					"meta mark set", ovnkubeITPMark,
+				),
+			},
+		)
+	}
+	return rules
+}
+
+// initGatewayNFTables prepares the host firewall for the configured gateway backend.
+// With the nftables backend it (re)creates the ovn-kubernetes table base objects and
+// migrates away from the iptables backend by removing its service chains. With the
+// iptables backend it removes any ovn-kubernetes table left behind by a previous run
+// with the nftables backend.
+func initGatewayNFTables() error {
+	ctx := context.TODO()
+	if !useNFTablesBackend() {
+		if _, err := nodenft.GetNFTablesHelper(); err != nil {
+			// nft is not available on this host, so there is nothing to clean up
+			klog.V(5).Infof("Skipping nftables gateway cleanup: %v", err)
+			return nil
+		}
+		return nodenft.DeleteTable(ctx)
+	}
+
+	nft, err := nodenft.GetNFTablesHelper()
+	if err != nil {
+		return err
+	}
+	tx := nft.NewTransaction()
+	ensureGatewayNFTablesObjects(tx)
+	for _, chain := range []string{nftablesNATPreroutingChain, nftablesNATOutputChain, nftablesMangleOutputChain,
+		nftablesNodePortChain, nftablesExternalIPChain, nftablesETPChain, nftablesITPChain} {
+		tx.Flush(&knftables.Chain{Name: chain})
+	}
+	for _, rule := range getGatewayNFTablesInitRules() {
+		tx.Add(rule)
+	}
+	if err := nft.Run(ctx, tx); err != nil {
+		return fmt.Errorf("failed to initialize nftables gateway rules: %w", err)
+	}
+
+	cleanupGatewayIPTChains()
+	return nil
+}
+
+// cleanupGatewayIPTChains removes the iptables service chains, and the rules jumping to
+// them, that are replaced by the nftables backend.
+func cleanupGatewayIPTChains() {
+	klog.Info("Removing iptables gateway service chains replaced by nftables")
+	// We clean up both IPv4 and IPv6, regardless of what is currently in use
+	for _, proto := range []iptables.Protocol{iptables.ProtocolIPv4, iptables.ProtocolIPv6} {
+		ipt, err := util.GetIPTablesHelper(proto)
+		if err != nil {
+			klog.V(5).Infof("Skipping iptables gateway cleanup for %v: %v", proto, err)
+			continue
+		}
+		for _, chain := range []string{iptableITPChain, iptableNodePortChain, iptableExternalIPChain, iptableETPChain} {
+			if err := deleteIptRules(getGatewayInitRules(chain, proto)); err != nil {
+				klog.Warningf("Failed to delete iptables jump rules to chain %s: %v", chain, err)
+			}
+			tables := []string{"nat"}
+			if chain == iptableITPChain {
+				tables = append(tables, "mangle")
+			}
+			for _, table := range tables {
+				_ = ipt.ClearChain(table, chain)
+				_ = ipt.DeleteChain(table, chain)
+			}
+		}
+	}
+	DelMgtPortIptRules()
+}
+
+// nftablesServiceOwner returns the comment used to tag the nftables objects of a service.
+// nft rejects comments longer than knftables.CommentLengthMax, longer owners are truncated
+// and suffixed with a hash of the full owner to keep them unique.
+func nftablesServiceOwner(service *kapi.Service) string {
+	owner := ktypes.NamespacedName{Namespace: service.Namespace, Name: service.Name}.String()
+	if len(owner) <= knftables.CommentLengthMax {
+		return owner
+	}
+	hash := util.HashForOVN(owner)
+	return owner[:knftables.CommentLengthMax-len(hash)-1] + "-" + hash
+}
+
+func nftablesProto(protocol kapi.Protocol) string {
+	return strings.ToLower(string(protocol))
+}
+
+// getGatewayNFTElements returns the nftables set/map elements and rules for a service.
+// It follows the same cases as getGatewayIPTRules:
+// case1: If !svcHasLocalHostNetEndPnt and svcTypeIsETPLocal, elements that redirect
+// traffic to ovn-k8s-mp0 preserving sourceIP are added.
+//
+// case2: (default) A DNAT towards clusterIP svc is added ALWAYS.
+//
+// case3: if svcHasLocalHostNetEndPnt and svcTypeIsITPLocal, clusterIP traffic is redirected to host targetPort.
+//
+//	if !svcHasLocalHostNetEndPnt and svcTypeIsITPLocal, clusterIP traffic is marked to steer it to ovn-k8s-mp0.
+func getGatewayNFTElements(service *kapi.Service, localEndpoints []string, svcHasLocalHostNetEndPnt bool) ([]*knftables.Element, []*knftables.Rule) {
+	var elements []*knftables.Element
+	var rules []*knftables.Rule
+	clusterIPs := util.GetClusterIPs(service)
+	svcTypeIsETPLocal := util.ServiceExternalTrafficPolicyLocal(service)
+	svcTypeIsITPLocal := util.ServiceInternalTrafficPolicyLocal(service)
+	for _, svcPort := range service.Spec.Ports {
+		proto := nftablesProto(svcPort.Protocol)
+		if util.ServiceTypeHasNodePort(service) {
+			err := util.ValidatePort(svcPort.Protocol, svcPort.NodePort)
+			if err != nil {
+				klog.Errorf("Skipping service: %s, invalid service NodePort: %v", svcPort.Name, err)
+				continue
+			}
+			err = util.ValidatePort(svcPort.Protocol, svcPort.Port)
+			if err != nil {
+				klog.Errorf("Skipping service: %s, invalid service port %v", svcPort.Name, err)
+				continue
+			}
+			nodePort := fmt.Sprintf("%d", svcPort.NodePort)
+			for _, clusterIP := range clusterIPs {
+				isIPv6 := utilnet.IsIPv6String(clusterIP)
+				if svcTypeIsETPLocal && !svcHasLocalHostNetEndPnt {
+					// case1: DNAT to masqueradeIP:nodePort takes priority over DNAT to clusterIP.
+					if config.Gateway.Mode == config.GatewayModeLocal {
+						elements = append(elements, &knftables.Element{
+							Map:   nftablesFamilyName(nftablesETPNodePortMap, isIPv6),
+							Key:   []string{proto, nodePort},
+							Value: []string{getMasqueradeVIP(clusterIP), nodePort},
+						})
+					}
+					// skip SNAT to the management port to preserve sourceIP for etp=local traffic.
+					elements = append(elements, &knftables.Element{
+						Set: nftablesMgmtPortNoSNATPorts,
+						Key: []string{proto, nodePort},
+					})
+				}
+				// case2
+				elements = append(elements, &knftables.Element{
+					Map:   nftablesFamilyName(nftablesNodePortMap, isIPv6),
+					Key:   []string{proto, nodePort},
+					Value: []string{clusterIP, fmt.Sprintf("%d", svcPort.Port)},
+				})
+			}
+		}
+
+		for _, externalIP := range util.GetExternalAndLBIPs(service) {
+			err := util.ValidatePort(svcPort.Protocol, svcPort.Port)
+			if err != nil {
+				klog.Errorf("Skipping service: %s, invalid service port %v", svcPort.Name, err)
+				continue
+			}
+			isIPv6 := utilnet.IsIPv6String(externalIP)
+			clusterIP, err := util.MatchIPStringFamily(isIPv6, clusterIPs)
+			if err != nil {
+				continue
+			}
+			key := []string{externalIP, proto, fmt.Sprintf("%d", svcPort.Port)}
+			if svcTypeIsETPLocal && !svcHasLocalHostNetEndPnt {
+				// case1
+				if !util.ServiceTypeHasNodePort(service) {
+					rules = append(rules, getNFTRulesForLoadBalancersWithoutNodePorts(svcPort, externalIP, localEndpoints)...)
+					elements = append(elements, getNFTElementsSkipMgmtForLocalEndpoints(svcPort, localEndpoints)...)
+				} else {
+					elements = append(elements, &knftables.Element{
+						Map:   nftablesFamilyName(nftablesETPExternalIPMap, isIPv6),
+						Key:   key,
+						Value: []string{getMasqueradeVIP(externalIP), fmt.Sprintf("%d", svcPort.NodePort)},
+					})
+				}
+			}
+			// case2
+			elements = append(elements, &knftables.Element{
+				Map:   nftablesFamilyName(nftablesExternalIPMap, isIPv6),
+				Key:   key,
+				Value: []string{clusterIP, fmt.Sprintf("%d", svcPort.Port)},
+			})
+		}
+
+		if svcTypeIsITPLocal {
+			// case3
+			for _, clusterIP := range clusterIPs {
+				isIPv6 := utilnet.IsIPv6String(clusterIP)
+				key := []string{clusterIP, proto, fmt.Sprintf("%d", svcPort.Port)}
+				if svcHasLocalHostNetEndPnt {
+					elements = append(elements, &knftables.Element{
+						Map:   nftablesFamilyName(nftablesITPRedirectMap, isIPv6),
+						Key:   key,
+						Value: []string{fmt.Sprintf("%d", int32(svcPort.TargetPort.IntValue()))},
+					})
+				} else {
+					elements = append(elements, &knftables.Element{
+						Set: nftablesFamilyName(nftablesITPMarkSet, isIPv6),
+						Key: key,
+					})
+				}
+			}
+		}
+	}
+	return dedupNFTElements(elements), rules
+}
+
+// getNFTRulesForLoadBalancersWithoutNodePorts returns the rule that load balances ETP=local
+// traffic for a load balancer without nodeports randomly across the local endpoints.
+func getNFTRulesForLoadBalancersWithoutNodePorts(svcPort kapi.ServicePort, externalIP string, localEndpoints []string) []*knftables.Rule {
+	isIPv6 := utilnet.IsIPv6String(externalIP)
+	family, _ := nftablesAddrFamily(isIPv6)
+	targetPort := fmt.Sprintf("%d", int32(svcPort.TargetPort.IntValue()))
+	var backends []string
+	for _, ip := range localEndpoints {
+		if len(ip) == 0 || utilnet.IsIPv6String(ip) != isIPv6 {
+			continue
+		}
+		backends = append(backends, fmt.Sprintf("%d : %s . %s", len(backends), ip, targetPort))
+	}
+	if len(backends) == 0 {
+		// either its smart nic mode; etp&itp not implemented, OR
+		// fetching endpointSlices error-ed out prior to reaching here so nothing to do
+		return nil
+	}
+	return []*knftables.Rule{
+		{
+			Chain: nftablesETPNoNodePortChain,
+			Rule: knftables.Concat(
+				family, "daddr", externalIP,
+				"meta l4proto", nftablesProto(svcPort.Protocol),
+				"th dport", svcPort.Port,
+				"dnat", family, "addr . port to numgen random mod", len(backends),
+				"map {", strings.Join(backends, ", "), "}",
+			),
+		},
+	}
+}
+
+// getNFTElementsSkipMgmtForLocalEndpoints returns the elements that prevent SNAT to the
+// management port of ETP=local traffic towards local endpoints.
+func getNFTElementsSkipMgmtForLocalEndpoints(svcPort kapi.ServicePort, localEndpoints []string) []*knftables.Element {
+	elements := make([]*knftables.Element, 0, len(localEndpoints))
+	for _, localEndpoint := range localEndpoints {
+		if len(localEndpoint) == 0 {
+			continue
+		}
+		elements = append(elements, &knftables.Element{
+			Set: nftablesFamilyName(nftablesMgmtPortNoSNATSvcSet, utilnet.IsIPv6String(localEndpoint)),
+			Key: []string{localEndpoint, nftablesProto(svcPort.Protocol), fmt.Sprintf("%d", int32(svcPort.TargetPort.IntValue()))},
+		})
+	}
+	return elements
+}
+
+// dedupNFTElements removes duplicate keys; the same element can be generated once per
+// cluster IP or external IP of a service, which nft rejects within a transaction.
+func dedupNFTElements(elements []*knftables.Element) []*knftables.Element {
+	seen := make(map[string]bool, len(elements))
+	deduped := make([]*knftables.Element, 0, len(elements))
+	for _, element := range elements {
+		key := nodenft.ElementKey(element)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		deduped = append(deduped, element)
+	}
+	return deduped
+}
+
+// addGatewayNFTRules ensures the nftables elements and rules of a service match its
+// current configuration
+func addGatewayNFTRules(service *kapi.Service, localEndpoints []string, svcHasLocalHostNetEndPnt bool) error {
+	elements, rules := getGatewayNFTElements(service, localEndpoints, svcHasLocalHostNetEndPnt)
+	return nodenft.ReplaceOwnedObjects(context.TODO(), nftablesServiceOwner(service), gatewayNFTablesServiceSets(),
+		gatewayNFTablesServiceMaps(), gatewayNFTablesServiceChains(), elements, rules)
+}
+
+// delGatewayNFTRules removes all the nftables elements and rules of a service
+func delGatewayNFTRules(service *kapi.Service) error {
+	return nodenft.ReplaceOwnedObjects(context.TODO(), nftablesServiceOwner(service), gatewayNFTablesServiceSets(),
+		gatewayNFTablesServiceMaps(), gatewayNFTablesServiceChains(), nil, nil)
+}
+
+// gatewayNFTSyncer accumulates the nftables elements and rules of all services so they
+// can be programmed in a single transaction
+type gatewayNFTSyncer struct {
+	elements []*knftables.Element
+	rules    []*knftables.Rule
+}
+
+func (s *gatewayNFTSyncer) addService(service *kapi.Service, localEndpoints []string, svcHasLocalHostNetEndPnt bool) {
+	owner := knftables.PtrTo(nftablesServiceOwner(service))
+	elements, rules := getGatewayNFTElements(service, localEndpoints, svcHasLocalHostNetEndPnt)
+	for _, element := range elements {
+		element.Comment = owner
+	}
+	for _, rule := range rules {
+		rule.Comment = owner
+	}
+	s.elements = append(s.elements, elements...)
+	s.rules = append(s.rules, rules...)
+}
+
+// sync replaces the contents of all the per-service sets, maps and chains with the
+// accumulated elements and rules
+func (s *gatewayNFTSyncer) sync() error {
+	return nodenft.SyncObjects(context.TODO(), gatewayNFTablesServiceSets(), gatewayNFTablesServiceMaps(),
+		gatewayNFTablesServiceChains(), s.elements, s.rules)
+}
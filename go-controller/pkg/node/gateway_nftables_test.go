@@ -0,0 +1,228 @@
+package node
+
+import (
+	"context"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	nodenft "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/node/nftables"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/knftables"
+)
+
+var _ = Describe("Gateway nftables backend", func() {
+	var nft *knftables.Fake
+
+	BeforeEach(func() {
+		Expect(config.PrepareTestConfig()).To(Succeed())
+		config.IPv4Mode = true
+		config.IPv6Mode = false
+		config.Gateway.Mode = config.GatewayModeLocal
+		config.Gateway.FirewallBackend = config.FirewallBackendNFTables
+		util.SetFakeIPTablesHelpers()
+		nft = nodenft.SetFakeNFTablesHelper()
+		Expect(initGatewayNFTables()).To(Succeed())
+	})
+
+	AfterEach(func() {
+		nodenft.SetNFTablesHelper(nil)
+	})
+
+	nodePortService := func(name string, isETPLocal bool) *v1.Service {
+		return newService(name, "namespace1", "10.129.0.2",
+			[]v1.ServicePort{{
+				NodePort:   31111,
+				Protocol:   v1.ProtocolTCP,
+				Port:       8080,
+				TargetPort: intstr.FromInt(8080),
+			}},
+			v1.ServiceTypeNodePort, []string{"8.8.8.8"}, v1.ServiceStatus{}, isETPLocal, false)
+	}
+
+	It("creates the gateway table and removes the iptables service chains", func() {
+		ipt, err := util.GetIPTablesHelper(getIPTablesProtocol("10.0.0.1"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ipt.NewChain("nat", iptableNodePortChain)).To(Succeed())
+		Expect(insertIptRules(getGatewayInitRules(iptableNodePortChain, getIPTablesProtocol("10.0.0.1")))).To(Succeed())
+
+		Expect(initGatewayNFTables()).To(Succeed())
+
+		_, err = ipt.List("nat", iptableNodePortChain)
+		Expect(err).To(HaveOccurred())
+		Expect(nft.Table).NotTo(BeNil())
+		for _, name := range gatewayNFTablesServiceMaps() {
+			Expect(nft.Table.Maps).To(HaveKey(name))
+		}
+		for _, name := range gatewayNFTablesServiceSets() {
+			Expect(nft.Table.Sets).To(HaveKey(name))
+		}
+		Expect(nft.Table.Chains[nftablesNATPreroutingChain].Rules).To(HaveLen(3))
+	})
+
+	It("adds and deletes the nodeport and external IP elements of a service", func() {
+		service := nodePortService("service1", false)
+		Expect(addGatewayNFTRules(service, nil, false)).To(Succeed())
+
+		nodePorts := nft.Table.Maps[nftablesFamilyName(nftablesNodePortMap, false)]
+		element := nodePorts.FindElement("tcp", "31111")
+		Expect(element).NotTo(BeNil())
+		Expect(element.Value).To(Equal([]string{"10.129.0.2", "8080"}))
+		Expect(*element.Comment).To(Equal("namespace1/service1"))
+		externalIPs := nft.Table.Maps[nftablesFamilyName(nftablesExternalIPMap, false)]
+		Expect(externalIPs.FindElement("8.8.8.8", "tcp", "8080")).NotTo(BeNil())
+
+		// adding again is idempotent
+		Expect(addGatewayNFTRules(service, nil, false)).To(Succeed())
+		Expect(nft.Table.Maps[nftablesFamilyName(nftablesNodePortMap, false)].Elements).To(HaveLen(1))
+
+		Expect(delGatewayNFTRules(service)).To(Succeed())
+		Expect(nft.Table.Maps[nftablesFamilyName(nftablesNodePortMap, false)].Elements).To(BeEmpty())
+		Expect(nft.Table.Maps[nftablesFamilyName(nftablesExternalIPMap, false)].Elements).To(BeEmpty())
+	})
+
+	It("tags the objects of services with maximum length names with valid comments", func() {
+		service := nodePortService(strings.Repeat("s", validation.DNS1035LabelMaxLength), false)
+		service.Namespace = strings.Repeat("n", validation.DNS1123LabelMaxLength)
+		Expect(addGatewayNFTRules(service, nil, false)).To(Succeed())
+
+		element := nft.Table.Maps[nftablesFamilyName(nftablesNodePortMap, false)].FindElement("tcp", "31111")
+		Expect(element).NotTo(BeNil())
+		Expect(*element.Comment).To(Equal(service.Namespace + "/" + service.Name))
+		Expect(len(*element.Comment)).To(BeNumerically("<=", knftables.CommentLengthMax))
+
+		// owners longer than the nft limit are truncated but remain unique
+		longService1 := nodePortService(strings.Repeat("s", 100)+"1", false)
+		longService1.Namespace = service.Namespace
+		longService2 := nodePortService(strings.Repeat("s", 100)+"2", false)
+		longService2.Namespace = service.Namespace
+		owner1, owner2 := nftablesServiceOwner(longService1), nftablesServiceOwner(longService2)
+		Expect(len(owner1)).To(BeNumerically("<=", knftables.CommentLengthMax))
+		Expect(len(owner2)).To(BeNumerically("<=", knftables.CommentLengthMax))
+		Expect(owner1).NotTo(Equal(owner2))
+
+		Expect(delGatewayNFTRules(service)).To(Succeed())
+		Expect(nft.Table.Maps[nftablesFamilyName(nftablesNodePortMap, false)].Elements).To(BeEmpty())
+	})
+
+	It("keeps the external IP element of a service sharing it with a deleted service", func() {
+		service1 := nodePortService("service1", false)
+		service2 := nodePortService("service2", false)
+		service2.Spec.ClusterIP = "10.129.0.3"
+		service2.Spec.ClusterIPs = []string{"10.129.0.3"}
+		service2.Spec.Ports[0].NodePort = 32222
+		Expect(addGatewayNFTRules(service1, nil, false)).To(Succeed())
+		Expect(addGatewayNFTRules(service2, nil, false)).To(Succeed())
+
+		externalIPs := nft.Table.Maps[nftablesFamilyName(nftablesExternalIPMap, false)]
+		element := externalIPs.FindElement("8.8.8.8", "tcp", "8080")
+		Expect(element).NotTo(BeNil())
+		Expect(element.Value).To(Equal([]string{"10.129.0.2", "8080"}))
+		Expect(*element.Comment).To(Equal("namespace1/service1"))
+
+		// updating the service that doesn't own the element doesn't touch it
+		Expect(addGatewayNFTRules(service2, nil, false)).To(Succeed())
+		element = nft.Table.Maps[nftablesFamilyName(nftablesExternalIPMap, false)].FindElement("8.8.8.8", "tcp", "8080")
+		Expect(*element.Comment).To(Equal("namespace1/service1"))
+
+		// the element of the remaining service replaces the one of the deleted service
+		Expect(delGatewayNFTRules(service1)).To(Succeed())
+		element = nft.Table.Maps[nftablesFamilyName(nftablesExternalIPMap, false)].FindElement("8.8.8.8", "tcp", "8080")
+		Expect(element).NotTo(BeNil())
+		Expect(element.Value).To(Equal([]string{"10.129.0.3", "8080"}))
+		Expect(*element.Comment).To(Equal("namespace1/service2"))
+
+		Expect(delGatewayNFTRules(service2)).To(Succeed())
+		Expect(nft.Table.Maps[nftablesFamilyName(nftablesExternalIPMap, false)].Elements).To(BeEmpty())
+	})
+
+	It("syncs services sharing an external IP with a single element", func() {
+		service2 := nodePortService("service2", false)
+		service2.Spec.ClusterIP = "10.129.0.3"
+		service2.Spec.ClusterIPs = []string{"10.129.0.3"}
+		service2.Spec.Ports[0].NodePort = 32222
+		syncer := &gatewayNFTSyncer{}
+		syncer.addService(service2, nil, false)
+		syncer.addService(nodePortService("service1", false), nil, false)
+		Expect(syncer.sync()).To(Succeed())
+
+		externalIPs := nft.Table.Maps[nftablesFamilyName(nftablesExternalIPMap, false)]
+		Expect(externalIPs.Elements).To(HaveLen(1))
+		Expect(*externalIPs.Elements[0].Comment).To(Equal("namespace1/service1"))
+
+		Expect(delGatewayNFTRules(nodePortService("service1", false))).To(Succeed())
+		element := nft.Table.Maps[nftablesFamilyName(nftablesExternalIPMap, false)].FindElement("8.8.8.8", "tcp", "8080")
+		Expect(element).NotTo(BeNil())
+		Expect(*element.Comment).To(Equal("namespace1/service2"))
+	})
+
+	It("steers ETP=local nodeport traffic to the masquerade IP and skips management port SNAT", func() {
+		service := nodePortService("service1", true)
+		Expect(addGatewayNFTRules(service, nil, false)).To(Succeed())
+
+		etpNodePorts := nft.Table.Maps[nftablesFamilyName(nftablesETPNodePortMap, false)]
+		element := etpNodePorts.FindElement("tcp", "31111")
+		Expect(element).NotTo(BeNil())
+		Expect(element.Value).To(Equal([]string{config.Gateway.MasqueradeIPs.V4HostETPLocalMasqueradeIP.String(), "31111"}))
+		Expect(nft.Table.Sets[nftablesMgmtPortNoSNATPorts].FindElement("tcp", "31111")).NotTo(BeNil())
+		etpExternalIPs := nft.Table.Maps[nftablesFamilyName(nftablesETPExternalIPMap, false)]
+		Expect(etpExternalIPs.FindElement("8.8.8.8", "tcp", "8080")).NotTo(BeNil())
+
+		// once there is a local host networked endpoint the ETP elements go away
+		Expect(addGatewayNFTRules(service, nil, true)).To(Succeed())
+		Expect(nft.Table.Maps[nftablesFamilyName(nftablesETPNodePortMap, false)].Elements).To(BeEmpty())
+		Expect(nft.Table.Sets[nftablesMgmtPortNoSNATPorts].Elements).To(BeEmpty())
+		Expect(nft.Table.Maps[nftablesFamilyName(nftablesNodePortMap, false)].FindElement("tcp", "31111")).NotTo(BeNil())
+	})
+
+	It("load balances ETP=local traffic of load balancers without nodeports across local endpoints", func() {
+		service := newServiceWithoutNodePortAllocation("service1", "namespace1", "10.129.0.2",
+			[]v1.ServicePort{{
+				Protocol:   v1.ProtocolTCP,
+				Port:       80,
+				TargetPort: intstr.FromInt(8080),
+			}},
+			v1.ServiceTypeLoadBalancer, nil,
+			v1.ServiceStatus{LoadBalancer: v1.LoadBalancerStatus{Ingress: []v1.LoadBalancerIngress{{IP: "5.5.5.5"}}}},
+			true, false)
+		Expect(addGatewayNFTRules(service, []string{"10.244.0.3", "10.244.0.4"}, false)).To(Succeed())
+
+		rules := nft.Table.Chains[nftablesETPNoNodePortChain].Rules
+		Expect(rules).To(HaveLen(1))
+		Expect(rules[0].Rule).To(Equal("ip daddr 5.5.5.5 meta l4proto tcp th dport 80 " +
+			"dnat ip addr . port to numgen random mod 2 map { 0 : 10.244.0.3 . 8080, 1 : 10.244.0.4 . 8080 }"))
+		noSNAT := nft.Table.Sets[nftablesFamilyName(nftablesMgmtPortNoSNATSvcSet, false)]
+		Expect(noSNAT.FindElement("10.244.0.3", "tcp", "8080")).NotTo(BeNil())
+		Expect(noSNAT.FindElement("10.244.0.4", "tcp", "8080")).NotTo(BeNil())
+
+		Expect(delGatewayNFTRules(service)).To(Succeed())
+		Expect(nft.Table.Chains[nftablesETPNoNodePortChain].Rules).To(BeEmpty())
+		Expect(nft.Table.Sets[nftablesFamilyName(nftablesMgmtPortNoSNATSvcSet, false)].Elements).To(BeEmpty())
+	})
+
+	It("syncs the elements of all services replacing stale ones", func() {
+		stale := nodePortService("stale", false)
+		stale.Spec.Ports[0].NodePort = 32222
+		Expect(addGatewayNFTRules(stale, nil, false)).To(Succeed())
+
+		syncer := &gatewayNFTSyncer{}
+		syncer.addService(nodePortService("service1", false), nil, false)
+		Expect(syncer.sync()).To(Succeed())
+
+		nodePorts := nft.Table.Maps[nftablesFamilyName(nftablesNodePortMap, false)]
+		Expect(nodePorts.FindElement("tcp", "32222")).To(BeNil())
+		Expect(nodePorts.FindElement("tcp", "31111")).NotTo(BeNil())
+	})
+
+	It("deletes the table when the iptables backend is configured", func() {
+		config.Gateway.FirewallBackend = config.FirewallBackendIPTables
+		Expect(initGatewayNFTables()).To(Succeed())
+		_, err := nft.List(context.TODO(), "chains")
+		Expect(knftables.IsNotFound(err)).To(BeTrue())
+	})
+})
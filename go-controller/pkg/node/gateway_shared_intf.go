@@ -553,20 +553,52 @@ func addServiceRules(service *kapi.Service, netInfo util.NetInfo, localEndpoints
 		npw.ofm.requestFlowSync()
 		if !npw.dpuMode {
 			// add iptable rules only in full mode
-			if err = insertIptRules(getGatewayIPTRules(service, localEndpoints, svcHasLocalHostNetEndPnt)); err != nil {
-				errors = append(errors, fmt.Errorf("failed to add iptables rules for service: %v", err))
+			if err = addGatewayServiceRules(service, localEndpoints, svcHasLocalHostNetEndPnt); err != nil {
+				errors = append(errors, err)
 			}
 		}
 	} else {
 		// For Host Only Mode
-		if err = insertIptRules(getGatewayIPTRules(service, localEndpoints, svcHasLocalHostNetEndPnt)); err != nil {
-			errors = append(errors, fmt.Errorf("failed to add iptables rules for service: %v", err))
+		if err = addGatewayServiceRules(service, localEndpoints, svcHasLocalHostNetEndPnt); err != nil {
+			errors = append(errors, err)
 		}
 
 	}
 	return utilerrors.Join(errors...)
 }
 
+// addGatewayServiceRules adds the host firewall rules for a service with the configured backend
+func addGatewayServiceRules(service *kapi.Service, localEndpoints []string, svcHasLocalHostNetEndPnt bool) error {
+	if useNFTablesBackend() {
+		if err := addGatewayNFTRules(service, localEndpoints, svcHasLocalHostNetEndPnt); err != nil {
+			return fmt.Errorf("failed to add nftables rules for service: %v", err)
+		}
+		return nil
+	}
+	if err := insertIptRules(getGatewayIPTRules(service, localEndpoints, svcHasLocalHostNetEndPnt)); err != nil {
+		return fmt.Errorf("failed to add iptables rules for service: %v", err)
+	}
+	return nil
+}
+
+// delGatewayServiceRules deletes all possible host firewall rules for a service with the configured backend
+func delGatewayServiceRules(service *kapi.Service, localEndpoints []string) error {
+	if useNFTablesBackend() {
+		if err := delGatewayNFTRules(service); err != nil {
+			return fmt.Errorf("failed to delete nftables rules for service: %v", err)
+		}
+		return nil
+	}
+	var errors []error
+	if err := nodeipt.DelRules(getGatewayIPTRules(service, localEndpoints, true)); err != nil {
+		errors = append(errors, fmt.Errorf("error updating service flow cache: %v", err))
+	}
+	if err := nodeipt.DelRules(getGatewayIPTRules(service, localEndpoints, false)); err != nil {
+		errors = append(errors, fmt.Errorf("error updating service flow cache: %v", err))
+	}
+	return utilerrors.Join(errors...)
+}
+
 // delServiceRules deletes all possible iptables rules and OpenFlow physical
 // flows for a service
 func delServiceRules(service *kapi.Service, localEndpoints []string, npw *nodePortWatcher) error {
@@ -605,20 +637,14 @@ func delServiceRules(service *kapi.Service, localEndpoints []string, npw *nodePo
 			// |                          |                       |                       |   + default dnat towards CIP   |
 			// +--------------------------+-----------------------+-----------------------+--------------------------------+
 
-			if err = nodeipt.DelRules(getGatewayIPTRules(service, localEndpoints, true)); err != nil {
-				errors = append(errors, fmt.Errorf("error updating service flow cache: %v", err))
-			}
-			if err = nodeipt.DelRules(getGatewayIPTRules(service, localEndpoints, false)); err != nil {
-				errors = append(errors, fmt.Errorf("error updating service flow cache: %v", err))
+			if err = delGatewayServiceRules(service, localEndpoints); err != nil {
+				errors = append(errors, err)
 			}
 		}
 	} else {
 
-		if err = nodeipt.DelRules(getGatewayIPTRules(service, localEndpoints, true)); err != nil {
-			errors = append(errors, fmt.Errorf("error updating service flow cache: %v", err))
-		}
-		if err = nodeipt.DelRules(getGatewayIPTRules(service, localEndpoints, false)); err != nil {
-			errors = append(errors, fmt.Errorf("error updating service flow cache: %v", err))
+		if err = delGatewayServiceRules(service, localEndpoints); err != nil {
+			errors = append(errors, err)
 		}
 	}
 	return utilerrors.Join(errors...)
@@ -811,6 +837,7 @@ func (npw *nodePortWatcher) SyncServices(services []interface{}) error {
 	var err error
 	var errors []error
 	keepIPTRules := []nodeipt.Rule{}
+	nftSyncer := &gatewayNFTSyncer{}
 	for _, serviceInterface := range services {
 		name := ktypes.NamespacedName{Namespace: serviceInterface.(*kapi.Service).Namespace, Name: serviceInterface.(*kapi.Service).Name}
 
@@ -854,7 +881,11 @@ func (npw *nodePortWatcher) SyncServices(services []interface{}) error {
 		}
 		// Add correct iptables rules only for Full mode
 		if !npw.dpuMode {
-			keepIPTRules = append(keepIPTRules, getGatewayIPTRules(service, sets.List(localEndpoints), hasLocalHostNetworkEp)...)
+			if useNFTablesBackend() {
+				nftSyncer.addService(service, sets.List(localEndpoints), hasLocalHostNetworkEp)
+			} else {
+				keepIPTRules = append(keepIPTRules, getGatewayIPTRules(service, sets.List(localEndpoints), hasLocalHostNetworkEp)...)
+			}
 		}
 	}
 
@@ -862,6 +893,15 @@ func (npw *nodePortWatcher) SyncServices(services []interface{}) error {
 	npw.ofm.requestFlowSync()
 	// sync IPtables rules once only for Full mode
 	if !npw.dpuMode {
+		if useNFTablesBackend() {
+			if err = nftSyncer.sync(); err != nil {
+				errors = append(errors, err)
+			}
+			if err = recreateIPTRules("nat", egressservice.Chain, keepIPTRules); err != nil {
+				errors = append(errors, err)
+			}
+			return utilerrors.Join(errors...)
+		}
 		// (NOTE: Order is important, add jump to iptableETPChain before jump to NP/EIP chains)
 		for _, chain := range []string{iptableITPChain, egressservice.Chain, iptableNodePortChain, iptableExternalIPChain, iptableETPChain, iptableMgmPortChain} {
 			if err = recreateIPTRules("nat", chain, keepIPTRules); err != nil {
@@ -1143,6 +1183,7 @@ func (npwipt *nodePortWatcherIptables) SyncServices(services []interface{}) erro
 	var err error
 	var errors []error
 	keepIPTRules := []nodeipt.Rule{}
+	nftSyncer := &gatewayNFTSyncer{}
 	for _, serviceInterface := range services {
 		service, ok := serviceInterface.(*kapi.Service)
 		if !ok {
@@ -1156,9 +1197,16 @@ func (npwipt *nodePortWatcherIptables) SyncServices(services []interface{}) erro
 		}
 		// Add correct iptables rules.
 		// TODO: ETP and ITP is not implemented for smart NIC mode.
-		keepIPTRules = append(keepIPTRules, getGatewayIPTRules(service, nil, false)...)
+		if useNFTablesBackend() {
+			nftSyncer.addService(service, nil, false)
+		} else {
+			keepIPTRules = append(keepIPTRules, getGatewayIPTRules(service, nil, false)...)
+		}
 	}
 
+	if useNFTablesBackend() {
+		return nftSyncer.sync()
+	}
 	// sync IPtables rules once
 	for _, chain := range []string{iptableNodePortChain, iptableExternalIPChain} {
 		if err = recreateIPTRules("nat", chain, keepIPTRules); err != nil {
@@ -1835,7 +1883,7 @@ func setBridgeOfPorts(bridge *bridgeConfiguration) error {
 // that let's us forward service traffic to ovn-k8s-mp0 as opposed to the default route towards breth0
 func initSvcViaMgmPortRoutingRules(hostSubnets []*net.IPNet) error {
 	// create ovnkubeSvcViaMgmPortRT and service route towards ovn-k8s-mp0
-	for _, hostSubnet := range hostSubnets {
+	for _, hostSubnet := range util.GetNodePrimaryHostSubnets(hostSubnets) {
 		isIPv6 := utilnet.IsIPv6CIDR(hostSubnet)
 		gatewayIP := util.GetNodeGatewayIfAddr(hostSubnet).IP.String()
 		for _, svcCIDR := range config.Kubernetes.ServiceCIDRs {
@@ -2067,6 +2115,9 @@ func newNodePortWatcher(gwBridge *bridgeConfiguration, ofm *openflowManager,
 				return nil, err
 			}
 		}
+		if err := initGatewayNFTables(); err != nil {
+			return nil, err
+		}
 	}
 
 	var subnets []*net.IPNet
@@ -0,0 +1,372 @@
+package ipsec
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	clientset "k8s.io/client-go/kubernetes"
+	listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/util/certificate"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/csrapprover"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/kube"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/metrics"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+const (
+	certNamePrefix = "ovn-ipsec"
+	// syncInterval is how often the OVS configuration and the tunnels status are reconciled
+	syncInterval = 30 * time.Second
+
+	tunnelStateEstablished    = "established"
+	tunnelStateNotEstablished = "not_established"
+
+	reasonCertificateNotReady   = "CertificateNotReady"
+	reasonTunnelsEstablished    = "TunnelsEstablished"
+	reasonTunnelsNotEstablished = "TunnelsNotEstablished"
+)
+
+// securityAssociation holds the endpoints of an IPsec security association installed in the kernel
+type securityAssociation struct {
+	src net.IP
+	dst net.IP
+}
+
+// listSecurityAssociationsFn is meant to be overridden in unit tests
+var listSecurityAssociationsFn = listSecurityAssociations
+
+// Manager manages the IPsec certificate of the node and the OVS configuration consumed by ovs-monitor-ipsec,
+// and reports the state of the IPsec tunnels towards the other nodes.
+type Manager struct {
+	nodeName   string
+	client     clientset.Interface
+	kube       kube.Interface
+	nodeLister listers.NodeLister
+
+	certStore   certificate.FileStore
+	certManager certificate.Manager
+
+	// remote IPs of the tunnels reported in the per tunnel metric
+	reportedTunnels sets.Set[string]
+}
+
+// NewManager creates a new IPsec manager for the node. The certificate is requested with the client
+// of ovnkube-node, so that the CSR is created by the system:ovn-node:<nodeName> user.
+func NewManager(nodeName string, client clientset.Interface, kube kube.Interface, nodeLister listers.NodeLister) *Manager {
+	return &Manager{
+		nodeName:   nodeName,
+		client:     client,
+		kube:       kube,
+		nodeLister: nodeLister,
+
+		reportedTunnels: sets.New[string](),
+	}
+}
+
+// Run starts the certificate manager and the periodic reconciliation of the OVS configuration
+// and the status of the tunnels.
+func (m *Manager) Run(stopCh <-chan struct{}, wg *sync.WaitGroup) error {
+	chassisID, err := util.GetNodeChassisID()
+	if err != nil {
+		return fmt.Errorf("failed to get the chassis ID of node %s: %w", m.nodeName, err)
+	}
+
+	m.certStore, err = certificate.NewFileStore(certNamePrefix, config.IPsec.CertDir, config.IPsec.CertDir, "", "")
+	if err != nil {
+		return fmt.Errorf("failed to initialize the IPsec certificate store: %w", err)
+	}
+	// ovs-monitor-ipsec requires the CommonName of the certificate to match the chassis ID
+	m.certManager, err = certificate.NewManager(&certificate.Config{
+		ClientsetFn: func(_ *tls.Certificate) (clientset.Interface, error) {
+			return m.client, nil
+		},
+		Template: &x509.CertificateRequest{
+			Subject: pkix.Name{
+				CommonName: chassisID,
+			},
+		},
+		RequestedCertificateLifetime: &config.IPsec.CertDuration,
+		SignerName:                   csrapprover.IPsecSignerName,
+		Usages:                       csrapprover.IPsecUsages.UnsortedList(),
+		CertificateStore:             m.certStore,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to initialize the IPsec certificate manager: %w", err)
+	}
+
+	metrics.RegisterIPsecMetrics()
+
+	klog.Infof("Starting the IPsec manager for node %s", m.nodeName)
+	// the certificate manager rotates the certificate when 70-90% of its lifetime has elapsed
+	m.certManager.Start()
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer m.certManager.Stop()
+		wait.Until(func() {
+			if err := m.sync(); err != nil {
+				klog.Errorf("Failed to sync IPsec on node %s: %v", m.nodeName, err)
+			}
+		}, syncInterval, stopCh)
+	}()
+	return nil
+}
+
+// sync points OVS to the current certificate and reports the status of the tunnels
+func (m *Manager) sync() error {
+	current := m.certManager.Current()
+	if current == nil || current.Leaf == nil {
+		return m.updateNodeCondition(corev1.ConditionFalse, reasonCertificateNotReady,
+			"The IPsec certificate of the node has not been issued yet")
+	}
+	metrics.MetricIPsecCertificateExpiry.Set(float64(current.Leaf.NotAfter.Unix()))
+
+	// the current path is a symlink to the latest certificate, use the resolved path so that
+	// ovs-monitor-ipsec notices the change and reloads the certificate after a rotation
+	certPath, err := filepath.EvalSymlinks(m.certStore.CurrentPath())
+	if err != nil {
+		return fmt.Errorf("failed to resolve the current IPsec certificate path: %w", err)
+	}
+	if err := ensureOVSIPsecConfig(certPath, certPath, config.IPsec.CACert); err != nil {
+		return err
+	}
+
+	remoteIPs, err := getTunnelRemoteIPs()
+	if err != nil {
+		return err
+	}
+	sas, err := listSecurityAssociationsFn()
+	if err != nil {
+		return fmt.Errorf("failed to list the IPsec security associations: %w", err)
+	}
+	notEstablished := tunnelsNotEstablished(remoteIPs, sas)
+	metrics.MetricIPsecTunnels.WithLabelValues(tunnelStateEstablished).Set(float64(len(remoteIPs) - len(notEstablished)))
+	metrics.MetricIPsecTunnels.WithLabelValues(tunnelStateNotEstablished).Set(float64(len(notEstablished)))
+	m.reportTunnels(remoteIPs, notEstablished)
+
+	if len(notEstablished) > 0 {
+		return m.updateNodeCondition(corev1.ConditionFalse, reasonTunnelsNotEstablished,
+			fmt.Sprintf("IPsec security associations are missing for the tunnels towards: %s", strings.Join(notEstablished, ", ")))
+	}
+	return m.updateNodeCondition(corev1.ConditionTrue, reasonTunnelsEstablished,
+		fmt.Sprintf("IPsec security associations are established for all %d tunnels", len(remoteIPs)))
+}
+
+// reportTunnels sets the per tunnel metric of the current tunnels and removes
+// the one of the tunnels that are gone
+func (m *Manager) reportTunnels(remoteIPs, notEstablished []string) {
+	current := sets.New(remoteIPs...)
+	failed := sets.New(notEstablished...)
+	for remoteIP := range current {
+		established := 1.0
+		if failed.Has(remoteIP) {
+			established = 0.0
+		}
+		metrics.MetricIPsecTunnelEstablished.WithLabelValues(remoteIP).Set(established)
+	}
+	for remoteIP := range m.reportedTunnels.Difference(current) {
+		metrics.MetricIPsecTunnelEstablished.DeleteLabelValues(remoteIP)
+	}
+	m.reportedTunnels = current
+}
+
+// Cleanup removes the OVS configuration, the certificates and the node
+// condition left by a previous run of the manager once IPsec is disabled.
+// The node condition is removed last, so that it marks the nodes that still
+// need to be cleaned up. The OVS configuration is only removed if it points to
+// a certificate managed by ovnkube-node, so that IPsec configured by other
+// means is left untouched.
+func (m *Manager) Cleanup() error {
+	node, err := m.nodeLister.Get(m.nodeName)
+	if err != nil {
+		return err
+	}
+	if !hasIPsecCondition(node) {
+		return nil
+	}
+
+	value, stderr, err := util.RunOVSVsctl("--if-exists", "get", "Open_vSwitch", ".", "other_config:certificate")
+	if err != nil {
+		return fmt.Errorf("failed to get OVS other_config:certificate, stderr: %q: %w", stderr, err)
+	}
+	certPath := strings.Trim(value, "\"")
+	if certPath != "" && filepath.Dir(certPath) == filepath.Clean(config.IPsec.CertDir) {
+		_, stderr, err = util.RunOVSVsctl("remove", "Open_vSwitch", ".", "other_config", "certificate", "private_key", "ca_cert")
+		if err != nil {
+			return fmt.Errorf("failed to remove the OVS IPsec configuration, stderr: %q: %w", stderr, err)
+		}
+		klog.Infof("Removed the OVS IPsec configuration of node %s", m.nodeName)
+	}
+
+	certFiles, err := filepath.Glob(filepath.Join(config.IPsec.CertDir, certNamePrefix+"-*.pem"))
+	if err != nil {
+		return err
+	}
+	for _, certFile := range certFiles {
+		if err := os.Remove(certFile); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to remove the IPsec certificate %s: %w", certFile, err)
+		}
+	}
+
+	return m.removeNodeCondition()
+}
+
+// ensureOVSIPsecConfig sets the certificate, private key and CA certificate ovs-monitor-ipsec uses
+// to configure the IKE daemon, if they are not set already
+func ensureOVSIPsecConfig(certPath, keyPath, caCertPath string) error {
+	expected := map[string]string{
+		"certificate": certPath,
+		"private_key": keyPath,
+		"ca_cert":     caCertPath,
+	}
+	args := []string{"set", "Open_vSwitch", "."}
+	for _, key := range []string{"certificate", "private_key", "ca_cert"} {
+		value, stderr, err := util.RunOVSVsctl("--if-exists", "get", "Open_vSwitch", ".", "other_config:"+key)
+		if err != nil {
+			return fmt.Errorf("failed to get OVS other_config:%s, stderr: %q: %w", key, stderr, err)
+		}
+		if strings.Trim(value, "\"") != expected[key] {
+			args = append(args, fmt.Sprintf("other_config:%s=%s", key, expected[key]))
+		}
+	}
+	if len(args) == 3 {
+		return nil
+	}
+	if _, stderr, err := util.RunOVSVsctl(args...); err != nil {
+		return fmt.Errorf("failed to set the OVS IPsec configuration, stderr: %q: %w", stderr, err)
+	}
+	klog.Infof("Updated the OVS IPsec configuration: %v", args[3:])
+	return nil
+}
+
+// getTunnelRemoteIPs returns the remote IPs of the geneve tunnels towards the other nodes
+func getTunnelRemoteIPs() ([]string, error) {
+	stdout, stderr, err := util.RunOVSVsctl("--no-headings", "--data=bare", "--columns=options",
+		"find", "Interface", "type=geneve")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the geneve tunnels, stderr: %q: %w", stderr, err)
+	}
+	var remoteIPs []string
+	for _, line := range strings.Split(stdout, "\n") {
+		for _, option := range strings.Fields(line) {
+			if remoteIP, ok := strings.CutPrefix(option, "remote_ip="); ok {
+				remoteIPs = append(remoteIPs, strings.Trim(remoteIP, "\""))
+			}
+		}
+	}
+	return remoteIPs, nil
+}
+
+// tunnelsNotEstablished returns the sorted remote IPs for which the inbound or the outbound
+// security association is missing
+func tunnelsNotEstablished(remoteIPs []string, sas []securityAssociation) []string {
+	var notEstablished []string
+	for _, remoteIP := range remoteIPs {
+		ip := net.ParseIP(remoteIP)
+		inbound, outbound := false, false
+		for _, sa := range sas {
+			if ip.Equal(sa.src) {
+				inbound = true
+			}
+			if ip.Equal(sa.dst) {
+				outbound = true
+			}
+		}
+		if !inbound || !outbound {
+			notEstablished = append(notEstablished, remoteIP)
+		}
+	}
+	sort.Strings(notEstablished)
+	return notEstablished
+}
+
+// updateNodeCondition sets the IPsec condition of the node, if it changed
+func (m *Manager) updateNodeCondition(status corev1.ConditionStatus, reason, message string) error {
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		oldNode, err := m.nodeLister.Get(m.nodeName)
+		if err != nil {
+			return err
+		}
+		// Informer cache should not be mutated, so get a copy of the object
+		node := oldNode.DeepCopy()
+
+		now := metav1.Now()
+		condition := corev1.NodeCondition{
+			Type:               types.NodeIPsecConditionType,
+			Status:             status,
+			Reason:             reason,
+			Message:            message,
+			LastHeartbeatTime:  now,
+			LastTransitionTime: now,
+		}
+		found := false
+		for i := range node.Status.Conditions {
+			existing := &node.Status.Conditions[i]
+			if existing.Type != types.NodeIPsecConditionType {
+				continue
+			}
+			found = true
+			if existing.Status == status && existing.Reason == reason && existing.Message == message {
+				return nil
+			}
+			if existing.Status == status {
+				condition.LastTransitionTime = existing.LastTransitionTime
+			}
+			*existing = condition
+		}
+		if !found {
+			node.Status.Conditions = append(node.Status.Conditions, condition)
+		}
+		return m.kube.UpdateNodeStatus(node)
+	})
+}
+
+// removeNodeCondition removes the IPsec condition of the node, if it is set
+func (m *Manager) removeNodeCondition() error {
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		oldNode, err := m.nodeLister.Get(m.nodeName)
+		if err != nil {
+			return err
+		}
+		if !hasIPsecCondition(oldNode) {
+			return nil
+		}
+		conditions := make([]corev1.NodeCondition, 0, len(oldNode.Status.Conditions))
+		for _, condition := range oldNode.Status.Conditions {
+			if condition.Type != types.NodeIPsecConditionType {
+				conditions = append(conditions, condition)
+			}
+		}
+		// Informer cache should not be mutated, so get a copy of the object
+		node := oldNode.DeepCopy()
+		node.Status.Conditions = conditions
+		return m.kube.UpdateNodeStatus(node)
+	})
+}
+
+func hasIPsecCondition(node *corev1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == types.NodeIPsecConditionType {
+			return true
+		}
+	}
+	return false
+}
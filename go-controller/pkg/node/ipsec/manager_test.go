@@ -0,0 +1,208 @@
+package ipsec
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	dto "github.com/prometheus/client_model/go"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes/fake"
+	listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/kube"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/metrics"
+	ovntest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+func TestTunnelsNotEstablished(t *testing.T) {
+	tests := []struct {
+		name      string
+		remoteIPs []string
+		sas       []securityAssociation
+		expected  []string
+	}{
+		{
+			name:      "all tunnels have inbound and outbound security associations",
+			remoteIPs: []string{"172.18.0.3", "fd00::3"},
+			sas: []securityAssociation{
+				{src: net.ParseIP("172.18.0.2"), dst: net.ParseIP("172.18.0.3")},
+				{src: net.ParseIP("172.18.0.3"), dst: net.ParseIP("172.18.0.2")},
+				{src: net.ParseIP("fd00::2"), dst: net.ParseIP("fd00::3")},
+				{src: net.ParseIP("fd00::3"), dst: net.ParseIP("fd00::2")},
+			},
+		},
+		{
+			name:      "tunnels with a missing security association are reported",
+			remoteIPs: []string{"172.18.0.5", "172.18.0.3", "172.18.0.4"},
+			sas: []securityAssociation{
+				{src: net.ParseIP("172.18.0.2"), dst: net.ParseIP("172.18.0.3")},
+				{src: net.ParseIP("172.18.0.3"), dst: net.ParseIP("172.18.0.2")},
+				{src: net.ParseIP("172.18.0.2"), dst: net.ParseIP("172.18.0.4")},
+			},
+			expected: []string{"172.18.0.4", "172.18.0.5"},
+		},
+		{
+			name: "no tunnels",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tunnelsNotEstablished(tt.remoteIPs, tt.sas)
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("tunnelsNotEstablished() = %v, expected %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestEnsureOVSIPsecConfig(t *testing.T) {
+	fexec := ovntest.NewFakeExec()
+	fexec.AddFakeCmd(&ovntest.ExpectedCmd{
+		Cmd:    "ovs-vsctl --timeout=15 --if-exists get Open_vSwitch . other_config:certificate",
+		Output: "\"/etc/ovn/ipsec/ovn-ipsec-old.pem\"",
+	})
+	fexec.AddFakeCmd(&ovntest.ExpectedCmd{
+		Cmd:    "ovs-vsctl --timeout=15 --if-exists get Open_vSwitch . other_config:private_key",
+		Output: "\"/etc/ovn/ipsec/ovn-ipsec-old.pem\"",
+	})
+	fexec.AddFakeCmd(&ovntest.ExpectedCmd{
+		Cmd:    "ovs-vsctl --timeout=15 --if-exists get Open_vSwitch . other_config:ca_cert",
+		Output: "\"/etc/ovn/ipsec-ca.pem\"",
+	})
+	fexec.AddFakeCmdsNoOutputNoError([]string{
+		"ovs-vsctl --timeout=15 set Open_vSwitch . other_config:certificate=/etc/ovn/ipsec/ovn-ipsec-new.pem other_config:private_key=/etc/ovn/ipsec/ovn-ipsec-new.pem",
+	})
+	if err := util.SetExec(fexec); err != nil {
+		t.Fatal(err)
+	}
+
+	err := ensureOVSIPsecConfig("/etc/ovn/ipsec/ovn-ipsec-new.pem", "/etc/ovn/ipsec/ovn-ipsec-new.pem", "/etc/ovn/ipsec-ca.pem")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !fexec.CalledMatchesExpected() {
+		t.Fatal(fexec.ErrorDesc())
+	}
+}
+
+func TestGetTunnelRemoteIPs(t *testing.T) {
+	fexec := ovntest.NewFakeExec()
+	fexec.AddFakeCmd(&ovntest.ExpectedCmd{
+		Cmd:    "ovs-vsctl --timeout=15 --no-headings --data=bare --columns=options find Interface type=geneve",
+		Output: "csum=true key=flow remote_ip=172.18.0.3\n\ncsum=true key=flow remote_ip=\"fd00::3\"\n",
+	})
+	if err := util.SetExec(fexec); err != nil {
+		t.Fatal(err)
+	}
+
+	remoteIPs, err := getTunnelRemoteIPs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"172.18.0.3", "fd00::3"}
+	if !reflect.DeepEqual(remoteIPs, expected) {
+		t.Errorf("getTunnelRemoteIPs() = %v, expected %v", remoteIPs, expected)
+	}
+	if !fexec.CalledMatchesExpected() {
+		t.Fatal(fexec.ErrorDesc())
+	}
+}
+
+func TestReportTunnels(t *testing.T) {
+	m := NewManager("node1", nil, nil, nil)
+	established := func(remoteIP string) float64 {
+		var metric dto.Metric
+		if err := metrics.MetricIPsecTunnelEstablished.WithLabelValues(remoteIP).Write(&metric); err != nil {
+			t.Fatal(err)
+		}
+		return metric.GetGauge().GetValue()
+	}
+
+	m.reportTunnels([]string{"172.18.0.3", "172.18.0.4"}, []string{"172.18.0.4"})
+	if established("172.18.0.3") != 1 || established("172.18.0.4") != 0 {
+		t.Errorf("unexpected per tunnel metrics after the first report")
+	}
+
+	// the metric of a removed tunnel is deleted
+	m.reportTunnels([]string{"172.18.0.3"}, nil)
+	if !m.reportedTunnels.Equal(sets.New("172.18.0.3")) {
+		t.Errorf("reported tunnels = %v, expected only 172.18.0.3", m.reportedTunnels.UnsortedList())
+	}
+	if metrics.MetricIPsecTunnelEstablished.DeleteLabelValues("172.18.0.4") {
+		t.Errorf("the metric of the removed tunnel was not deleted")
+	}
+}
+
+func TestCleanup(t *testing.T) {
+	if err := config.PrepareTestConfig(); err != nil {
+		t.Fatal(err)
+	}
+	config.IPsec.CertDir = t.TempDir()
+	certPath := filepath.Join(config.IPsec.CertDir, "ovn-ipsec-2024-01-01-00-00-00.pem")
+	currentPath := filepath.Join(config.IPsec.CertDir, "ovn-ipsec-current.pem")
+	if err := os.WriteFile(certPath, []byte("cert"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(certPath, currentPath); err != nil {
+		t.Fatal(err)
+	}
+
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node1"},
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{
+				{Type: corev1.NodeReady, Status: corev1.ConditionTrue},
+				{Type: types.NodeIPsecConditionType, Status: corev1.ConditionTrue},
+			},
+		},
+	}
+	client := fake.NewSimpleClientset(node)
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	if err := indexer.Add(node); err != nil {
+		t.Fatal(err)
+	}
+
+	fexec := ovntest.NewFakeExec()
+	fexec.AddFakeCmd(&ovntest.ExpectedCmd{
+		Cmd:    "ovs-vsctl --timeout=15 --if-exists get Open_vSwitch . other_config:certificate",
+		Output: "\"" + certPath + "\"",
+	})
+	fexec.AddFakeCmdsNoOutputNoError([]string{
+		"ovs-vsctl --timeout=15 remove Open_vSwitch . other_config certificate private_key ca_cert",
+	})
+	if err := util.SetExec(fexec); err != nil {
+		t.Fatal(err)
+	}
+
+	m := NewManager(node.Name, client, &kube.Kube{KClient: client}, listers.NewNodeLister(indexer))
+	if err := m.Cleanup(); err != nil {
+		t.Fatal(err)
+	}
+	if !fexec.CalledMatchesExpected() {
+		t.Fatal(fexec.ErrorDesc())
+	}
+	certFiles, err := filepath.Glob(filepath.Join(config.IPsec.CertDir, "*"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(certFiles) != 0 {
+		t.Errorf("certificates were not removed: %v", certFiles)
+	}
+	updated, err := client.CoreV1().Nodes().Get(context.TODO(), node.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hasIPsecCondition(updated) || len(updated.Status.Conditions) != 1 {
+		t.Errorf("unexpected node conditions after cleanup: %v", updated.Status.Conditions)
+	}
+}
@@ -0,0 +1,21 @@
+//go:build linux
+// +build linux
+
+package ipsec
+
+import (
+	"github.com/vishvananda/netlink"
+)
+
+// listSecurityAssociations returns the IPsec security associations installed in the kernel
+func listSecurityAssociations() ([]securityAssociation, error) {
+	states, err := netlink.XfrmStateList(netlink.FAMILY_ALL)
+	if err != nil {
+		return nil, err
+	}
+	sas := make([]securityAssociation, 0, len(states))
+	for _, state := range states {
+		sas = append(sas, securityAssociation{src: state.Src, dst: state.Dst})
+	}
+	return sas, nil
+}
@@ -0,0 +1,12 @@
+//go:build !linux
+// +build !linux
+
+package ipsec
+
+import (
+	"fmt"
+)
+
+func listSecurityAssociations() ([]securityAssociation, error) {
+	return nil, fmt.Errorf("IPsec security associations are supported on linux platform only")
+}
@@ -4,6 +4,7 @@
 package node
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"strings"
@@ -11,13 +12,16 @@ import (
 
 	"github.com/coreos/go-iptables/iptables"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	nodenft "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/node/nftables"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/node/routemanager"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
 	"github.com/vishvananda/netlink"
 
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
 	utilnet "k8s.io/utils/net"
+	"sigs.k8s.io/knftables"
 )
 
 const (
@@ -70,6 +74,10 @@ func newManagementPortIPFamilyConfig(hostSubnet *net.IPNet, isIPv6 bool) (*manag
 		cfg.allSubnets = append(cfg.allSubnets, masqueradeSubnet)
 	}
 
+	if useNFTablesBackend() {
+		// SNAT rules are programmed in nftables, see setupManagementPortNFTables
+		return cfg, nil
+	}
 	if utilnet.IsIPv6CIDR(cfg.ifAddr) {
 		cfg.ipt, err = util.GetIPTablesHelper(iptables.ProtocolIPv6)
 	} else {
@@ -157,6 +165,12 @@ func tearDownInterfaceIPConfig(link netlink.Link, ipt4, ipt6 util.IPTablesHelper
 		}
 	}
 
+	if useNFTablesBackend() {
+		if err := tearDownManagementPortNFTables(); err != nil {
+			return fmt.Errorf("could not flush the nftables chain for management port: %v", err)
+		}
+	}
+
 	return nil
 }
 
@@ -245,6 +259,11 @@ func setupManagementPortIPFamilyConfig(routeManager *routemanager.Controller, mp
 		}
 	}
 
+	if useNFTablesBackend() {
+		nftWarnings, err := setupManagementPortNFTables(mpcfg.ifName, cfg)
+		return append(warnings, nftWarnings...), err
+	}
+
 	if _, err = cfg.ipt.List("nat", iptableMgmPortChain); err != nil {
 		warnings = append(warnings, fmt.Sprintf("missing iptables chain %s in the nat table, adding it",
 			iptableMgmPortChain))
@@ -280,6 +299,113 @@ func setupManagementPortIPFamilyConfig(routeManager *routemanager.Controller, mp
 	return warnings, nil
 }
 
+// getManagementPortNFTablesRules returns the rules that SNAT traffic leaving through the
+// management port to the management port IP, except for ETP=local traffic which must
+// preserve its source IP. Rules are identified by their comment and the SNAT rule must
+// be the last one.
+func getManagementPortNFTablesRules(ifName string, ifAddr *net.IPNet) []*knftables.Rule {
+	isIPv6 := utilnet.IsIPv6CIDR(ifAddr)
+	family, _ := nftablesAddrFamily(isIPv6)
+	nfproto := "ipv4"
+	if isIPv6 {
+		nfproto = "ipv6"
+	}
+	noSNATServices := nftablesFamilyName(nftablesMgmtPortNoSNATSvcSet, isIPv6)
+	return []*knftables.Rule{
+		{
+			Chain:   nftablesNATPostroutingChain,
+			Rule:    knftables.Concat("oifname", ifName, "jump", nftablesMgmtPortSNATChain),
+			Comment: knftables.PtrTo("jump to " + nftablesMgmtPortSNATChain),
+		},
+		{
+			Chain:   nftablesMgmtPortSNATChain,
+			Rule:    "meta l4proto . th dport @" + nftablesMgmtPortNoSNATPorts + " return",
+			Comment: knftables.PtrTo(nftablesMgmtPortNoSNATPorts),
+		},
+		{
+			Chain:   nftablesMgmtPortSNATChain,
+			Rule:    knftables.Concat(family, "daddr . meta l4proto . th dport", "@"+noSNATServices, "return"),
+			Comment: knftables.PtrTo(noSNATServices),
+		},
+		{
+			Chain: nftablesMgmtPortSNATChain,
+			Rule: knftables.Concat(
+				"oifname", ifName, "meta nfproto", nfproto,
+				"snat", family, "to", ifAddr.IP,
+			),
+			Comment: knftables.PtrTo("OVN SNAT to Management Port " + nfproto),
+		},
+	}
+}
+
+// setupManagementPortNFTables is the nftables counterpart of the iptables
+// OVN-KUBE-SNAT-MGMTPORT chain setup. Missing rules are added back, inserting the
+// no-SNAT exceptions at the beginning of the chain and appending the SNAT rule.
+func setupManagementPortNFTables(ifName string, cfg *managementPortIPFamilyConfig) ([]string, error) {
+	var warnings []string
+	ctx := context.TODO()
+	nft, err := nodenft.GetNFTablesHelper()
+	if err != nil {
+		return warnings, err
+	}
+
+	tx := nft.NewTransaction()
+	ensureGatewayNFTablesObjects(tx)
+	if err = nft.Run(ctx, tx); err != nil {
+		return warnings, fmt.Errorf("could not create nftables objects for management port: %v", err)
+	}
+
+	existing := sets.New[string]()
+	for _, chain := range []string{nftablesNATPostroutingChain, nftablesMgmtPortSNATChain} {
+		rules, err := nft.ListRules(ctx, chain)
+		if err != nil {
+			return warnings, fmt.Errorf("could not list nftables chain %s for management port: %v", chain, err)
+		}
+		for _, rule := range rules {
+			if rule.Comment != nil {
+				existing.Insert(chain + "/" + *rule.Comment)
+			}
+		}
+	}
+
+	tx = nft.NewTransaction()
+	missing := 0
+	rules := getManagementPortNFTablesRules(ifName, cfg.ifAddr)
+	for i, rule := range rules {
+		if existing.Has(rule.Chain + "/" + *rule.Comment) {
+			continue
+		}
+		warnings = append(warnings, fmt.Sprintf("missing management port nftables rule %q in chain %s, adding it",
+			*rule.Comment, rule.Chain))
+		if rule.Chain == nftablesMgmtPortSNATChain && i < len(rules)-1 {
+			tx.Insert(rule)
+		} else {
+			// NOTE: SNAT to mp0 rule should be the last in the chain, so append it
+			tx.Add(rule)
+		}
+		missing++
+	}
+	if missing == 0 {
+		return warnings, nil
+	}
+	if err = nft.Run(ctx, tx); err != nil {
+		return warnings, fmt.Errorf("could not add nftables rules for management port: %v", err)
+	}
+	return warnings, nil
+}
+
+// tearDownManagementPortNFTables flushes the nftables SNAT rules of the management port
+func tearDownManagementPortNFTables() error {
+	nft, err := nodenft.GetNFTablesHelper()
+	if err != nil {
+		return err
+	}
+	tx := nft.NewTransaction()
+	ensureGatewayNFTablesObjects(tx)
+	tx.Flush(&knftables.Chain{Name: nftablesMgmtPortSNATChain})
+	return nft.Run(context.TODO(), tx)
+}
+
 func setupManagementPortConfig(routeManager *routemanager.Controller, cfg *managementPortConfig) ([]string, error) {
 	var warnings, allWarnings []string
 	var err error
@@ -322,6 +448,10 @@ func getIPTablesForHostSubnets(hostSubnets []*net.IPNet) (util.IPTablesHelper, u
 	var ipt4, ipt6 util.IPTablesHelper
 	var err error
 
+	if useNFTablesBackend() {
+		return nil, nil, nil
+	}
+
 	for _, hostSubnet := range hostSubnets {
 		if utilnet.IsIPv6CIDR(hostSubnet) {
 			if ipt6 != nil {
@@ -480,7 +610,7 @@ func DelMgtPortIptRules() {
 // checks to make sure that following configurations are present on the k8s node
 // 1. route entries to cluster CIDR and service CIDR through management port
 // 2. ARP entry for the node subnet's gateway ip
-// 3. IPtables chain and rule for SNATing packets entering the logical topology
+// 3. IPtables (or nftables) chain and rule for SNATing packets entering the logical topology
 func checkManagementPortHealth(routeManager *routemanager.Controller, cfg *managementPortConfig) {
 	warnings, err := setupManagementPortConfig(routeManager, cfg)
 	for _, warning := range warnings {
@@ -0,0 +1,338 @@
+package nftables
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/knftables"
+)
+
+// OVNKubernetesTable is the name of the inet table that holds all the nftables
+// objects owned by ovnkube-node.
+const OVNKubernetesTable = "ovn-kubernetes"
+
+var nftHelper knftables.Interface
+
+// SetNFTablesHelper sets the knftables.Interface used by GetNFTablesHelper. This is only
+// expected to be called from unit tests.
+func SetNFTablesHelper(nft knftables.Interface) {
+	nftHelper = nft
+	owned.Lock()
+	defer owned.Unlock()
+	owned.reset()
+}
+
+// SetFakeNFTablesHelper creates a fake knftables.Interface for the ovn-kubernetes table,
+// sets it as the current helper and returns it so tests can inspect its contents.
+func SetFakeNFTablesHelper() *knftables.Fake {
+	fake := knftables.NewFake(knftables.InetFamily, OVNKubernetesTable)
+	SetNFTablesHelper(fake)
+	return fake
+}
+
+// GetNFTablesHelper returns a knftables.Interface for the ovn-kubernetes table. If
+// SetNFTablesHelper has not yet been called, it will create a new helper wrapping the
+// "live" nft binary.
+func GetNFTablesHelper() (knftables.Interface, error) {
+	if nftHelper == nil {
+		nft, err := knftables.New(knftables.InetFamily, OVNKubernetesTable)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create nftables helper for table %s: %w", OVNKubernetesTable, err)
+		}
+		SetNFTablesHelper(nft)
+	}
+	return nftHelper, nil
+}
+
+// ElementKey returns a string that uniquely identifies an element, including its
+// value, within its set or map.
+func ElementKey(element *knftables.Element) string {
+	return fmt.Sprintf("%s : %v", elementID(element), element.Value)
+}
+
+// elementID returns a string that identifies the key of a set or map element within
+// its set or map. nftables holds a single element per ID.
+func elementID(element *knftables.Element) string {
+	return fmt.Sprintf("%s%s %v", element.Set, element.Map, element.Key)
+}
+
+// ownedObjects is a cached view of the set and map elements and of the rules owned by
+// each owner, so that replacing the objects of an owner doesn't need to list all the
+// elements of the sets and maps. Different owners may want an element with the same ID,
+// e.g. services sharing an external IP and port; nftables only holds one of them, and
+// when its owner no longer wants it, the element of another owner replaces it.
+type ownedObjects struct {
+	sync.Mutex
+	// valid is false until the cache is loaded from nftables, and after a failed
+	// transaction, which may have left nftables in an unknown state
+	valid bool
+	// wanted holds the elements wanted by each owner by ElementKey
+	wanted map[string]map[string]*knftables.Element
+	// wantedByID holds the elements wanted by each owner by element ID
+	wantedByID map[string]map[string]*knftables.Element
+	// installed holds the element programmed in nftables by element ID
+	installed map[string]*knftables.Element
+	// ownsRules holds the owners with rules in the chains
+	ownsRules map[string]bool
+}
+
+var owned = &ownedObjects{}
+
+// reset empties the cache; it has to be called with the lock held
+func (o *ownedObjects) reset() {
+	o.valid = false
+	o.wanted = map[string]map[string]*knftables.Element{}
+	o.wantedByID = map[string]map[string]*knftables.Element{}
+	o.installed = map[string]*knftables.Element{}
+	o.ownsRules = map[string]bool{}
+}
+
+// want records that owner wants element
+func (o *ownedObjects) want(owner string, element *knftables.Element) {
+	if o.wanted[owner] == nil {
+		o.wanted[owner] = map[string]*knftables.Element{}
+	}
+	o.wanted[owner][ElementKey(element)] = element
+	id := elementID(element)
+	if o.wantedByID[id] == nil {
+		o.wantedByID[id] = map[string]*knftables.Element{}
+	}
+	o.wantedByID[id][owner] = element
+}
+
+// unwant records that owner no longer wants element
+func (o *ownedObjects) unwant(owner string, element *knftables.Element) {
+	delete(o.wanted[owner], ElementKey(element))
+	if len(o.wanted[owner]) == 0 {
+		delete(o.wanted, owner)
+	}
+	id := elementID(element)
+	if o.wantedByID[id][owner] == element {
+		delete(o.wantedByID[id], owner)
+		if len(o.wantedByID[id]) == 0 {
+			delete(o.wantedByID, id)
+		}
+	}
+}
+
+// load fills the cache with the elements of the given sets and maps and the rules of
+// the given chains; it has to be called with the lock held
+func (o *ownedObjects) load(ctx context.Context, nft knftables.Interface, sets, maps, chains []string) error {
+	o.reset()
+	for objectType, names := range map[string][]string{"set": sets, "map": maps} {
+		for _, name := range names {
+			current, err := nft.ListElements(ctx, objectType, name)
+			if err != nil {
+				return fmt.Errorf("failed to list elements of nftables %s %s: %w", objectType, name, err)
+			}
+			for _, element := range current {
+				o.installed[elementID(element)] = element
+				if element.Comment != nil {
+					o.want(*element.Comment, element)
+				}
+			}
+		}
+	}
+	for _, chain := range chains {
+		current, err := nft.ListRules(ctx, chain)
+		if err != nil {
+			return fmt.Errorf("failed to list rules of nftables chain %s: %w", chain, err)
+		}
+		for _, rule := range current {
+			if rule.Comment != nil {
+				o.ownsRules[*rule.Comment] = true
+			}
+		}
+	}
+	o.valid = true
+	return nil
+}
+
+// nextOwner returns the element of another owner that wants an element with the given
+// ID, if any, picking the same owner regardless of the order of the updates
+func (o *ownedObjects) nextOwner(id string) *knftables.Element {
+	var next *knftables.Element
+	for owner, element := range o.wantedByID[id] {
+		if next == nil || owner < *next.Comment {
+			next = element
+		}
+	}
+	return next
+}
+
+// ReplaceOwnedObjects replaces, in a single transaction, all the elements of the given
+// sets and maps and all the rules of the given chains whose comment matches owner with
+// the provided elements and rules. Each provided element must reference one of sets or
+// maps, each provided rule one of chains, and they all get tagged with owner as comment.
+// The elements are diffed against a cached view of the sets and maps, so all the callers
+// are expected to manage the same sets, maps and chains.
+func ReplaceOwnedObjects(ctx context.Context, owner string, sets, maps, chains []string,
+	elements []*knftables.Element, rules []*knftables.Rule) error {
+	nft, err := GetNFTablesHelper()
+	if err != nil {
+		return err
+	}
+
+	owned.Lock()
+	defer owned.Unlock()
+	if !owned.valid {
+		if err := owned.load(ctx, nft, sets, maps, chains); err != nil {
+			return err
+		}
+	}
+
+	wanted := make(map[string]*knftables.Element, len(elements))
+	for _, element := range elements {
+		element.Comment = knftables.PtrTo(owner)
+		wanted[ElementKey(element)] = element
+	}
+
+	tx := nft.NewTransaction()
+	changes := 0
+	// elements of the owner that are no longer wanted are removed, and replaced by the
+	// element of another owner wanting the same ID, if any
+	var released []string
+	for key, element := range owned.wanted[owner] {
+		if _, ok := wanted[key]; ok {
+			continue
+		}
+		owned.unwant(owner, element)
+		id := elementID(element)
+		if installed := owned.installed[id]; installed != nil && installed.Comment != nil && *installed.Comment == owner {
+			tx.Delete(installed)
+			changes++
+			delete(owned.installed, id)
+			released = append(released, id)
+		}
+	}
+	for key, element := range wanted {
+		if _, ok := owned.wanted[owner][key]; ok {
+			continue
+		}
+		owned.want(owner, element)
+		id := elementID(element)
+		if owned.installed[id] == nil {
+			tx.Add(element)
+			changes++
+			owned.installed[id] = element
+		}
+	}
+	for _, id := range released {
+		if owned.installed[id] != nil {
+			continue
+		}
+		if next := owned.nextOwner(id); next != nil {
+			tx.Add(next)
+			changes++
+			owned.installed[id] = next
+		}
+	}
+
+	// rules are few and nft normalizes them when listing, so owned rules are
+	// always recreated rather than compared
+	if owned.ownsRules[owner] || len(rules) > 0 {
+		for _, chain := range chains {
+			current, err := nft.ListRules(ctx, chain)
+			if err != nil {
+				owned.valid = false
+				return fmt.Errorf("failed to list rules of nftables chain %s: %w", chain, err)
+			}
+			for _, rule := range current {
+				if rule.Comment == nil || *rule.Comment != owner {
+					continue
+				}
+				tx.Delete(&knftables.Rule{Chain: chain, Handle: rule.Handle})
+				changes++
+			}
+		}
+		for _, rule := range rules {
+			rule.Comment = knftables.PtrTo(owner)
+			tx.Add(rule)
+			changes++
+		}
+		owned.ownsRules[owner] = len(rules) > 0
+	}
+
+	if changes == 0 {
+		return nil
+	}
+	klog.V(5).Infof("Updating %d nftables objects owned by %s", changes, owner)
+	if err := nft.Run(ctx, tx); err != nil {
+		owned.valid = false
+		return fmt.Errorf("failed to update nftables objects owned by %s: %w", owner, err)
+	}
+	return nil
+}
+
+// SyncObjects flushes the given sets, maps and chains and fills them with the provided
+// elements and rules in a single transaction. When several elements share an ID, only
+// the one whose comment sorts first is added.
+func SyncObjects(ctx context.Context, sets, maps, chains []string, elements []*knftables.Element, rules []*knftables.Rule) error {
+	nft, err := GetNFTablesHelper()
+	if err != nil {
+		return err
+	}
+
+	owned.Lock()
+	defer owned.Unlock()
+	owned.reset()
+	for _, element := range elements {
+		if element.Comment != nil {
+			owned.want(*element.Comment, element)
+		}
+		id := elementID(element)
+		if installed := owned.installed[id]; installed == nil ||
+			(element.Comment != nil && (installed.Comment == nil || *element.Comment < *installed.Comment)) {
+			owned.installed[id] = element
+		}
+	}
+	for _, rule := range rules {
+		if rule.Comment != nil {
+			owned.ownsRules[*rule.Comment] = true
+		}
+	}
+
+	tx := nft.NewTransaction()
+	for _, name := range sets {
+		tx.Flush(&knftables.Set{Name: name})
+	}
+	for _, name := range maps {
+		tx.Flush(&knftables.Map{Name: name})
+	}
+	for _, name := range chains {
+		tx.Flush(&knftables.Chain{Name: name})
+	}
+	for _, element := range owned.installed {
+		tx.Add(element)
+	}
+	for _, rule := range rules {
+		tx.Add(rule)
+	}
+	if err := nft.Run(ctx, tx); err != nil {
+		owned.reset()
+		return fmt.Errorf("failed to sync nftables objects: %w", err)
+	}
+	owned.valid = true
+	return nil
+}
+
+// DeleteTable removes the ovn-kubernetes table and everything in it, if it exists.
+func DeleteTable(ctx context.Context) error {
+	nft, err := GetNFTablesHelper()
+	if err != nil {
+		return err
+	}
+	owned.Lock()
+	defer owned.Unlock()
+	owned.reset()
+	tx := nft.NewTransaction()
+	// adding the table first makes the delete a no-op if the table doesn't exist
+	tx.Add(&knftables.Table{})
+	tx.Delete(&knftables.Table{})
+	if err := nft.Run(ctx, tx); err != nil {
+		return fmt.Errorf("failed to delete nftables table %s: %w", OVNKubernetesTable, err)
+	}
+	return nil
+}
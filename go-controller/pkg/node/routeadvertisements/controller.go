@@ -0,0 +1,406 @@
+package routeadvertisements
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/controller"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/node/vrfmanager"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+	utilerrors "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util/errors"
+
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+	kexec "k8s.io/utils/exec"
+	utilnet "k8s.io/utils/net"
+)
+
+// importPeriod is how often the routes learned by the routing daemon are
+// re-imported in absence of changes to the node.
+var importPeriod = 30 * time.Second
+
+// Controller configures, from the OvnNodeRouteAdvertisements annotation of
+// its own node, the local FRR routing daemon to advertise the node's routes
+// and imports into the VRF of each advertised network the routes learned
+// for it on the VRF it is advertised in.
+type Controller struct {
+	nodeName   string
+	exec       kexec.Interface
+	nodeLister corev1listers.NodeLister
+	// vrfManager is nil if network segmentation is not enabled
+	vrfManager *vrfmanager.Controller
+
+	nodeController controller.Controller
+
+	// mu protects importedVRFs
+	mu sync.Mutex
+	// importedVRFs are the network VRFs routes have been imported into
+	importedVRFs sets.Set[string]
+
+	// appliedFRRConfig is the FRR configuration last applied with the reload
+	// command, only accessed from the single node controller worker
+	appliedFRRConfig []byte
+}
+
+// NewController returns a new node RouteAdvertisements controller.
+func NewController(nodeName string, wf factory.NodeWatchFactory, vrfManager *vrfmanager.Controller) *Controller {
+	c := &Controller{
+		nodeName:     nodeName,
+		exec:         kexec.New(),
+		nodeLister:   wf.NodeCoreInformer().Lister(),
+		vrfManager:   vrfManager,
+		importedVRFs: sets.New[string](),
+	}
+
+	nodeConfig := &controller.ControllerConfig[corev1.Node]{
+		RateLimiter:    workqueue.DefaultTypedControllerRateLimiter[string](),
+		Informer:       wf.NodeCoreInformer().Informer(),
+		Lister:         c.nodeLister.List,
+		ObjNeedsUpdate: c.nodeNeedsUpdate,
+		Reconcile:      c.reconcileNode,
+		Threadiness:    1,
+	}
+	c.nodeController = controller.NewController[corev1.Node]("node-route-advertisements-controller", nodeConfig)
+
+	return c
+}
+
+// Start starts the node RouteAdvertisements controller.
+func (c *Controller) Start() error {
+	klog.Info("Starting node route advertisements controller")
+	return controller.Start(c.nodeController)
+}
+
+// Stop stops the node RouteAdvertisements controller.
+func (c *Controller) Stop() {
+	klog.Info("Stopping node route advertisements controller")
+	controller.Stop(c.nodeController)
+}
+
+func (c *Controller) nodeNeedsUpdate(oldObj, newObj *corev1.Node) bool {
+	if newObj == nil {
+		return oldObj != nil && oldObj.Name == c.nodeName
+	}
+	if newObj.Name != c.nodeName {
+		return false
+	}
+	return oldObj == nil || util.NodeRouteAdvertisementsAnnotationChanged(oldObj, newObj)
+}
+
+func (c *Controller) reconcileNode(key string) error {
+	if key != c.nodeName {
+		return nil
+	}
+	node, err := c.nodeLister.Get(key)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			// the node is going away along with this ovnkube-node
+			return nil
+		}
+		return fmt.Errorf("failed to get node %s: %w", key, err)
+	}
+
+	advertisements, err := util.ParseNodeRouteAdvertisementsAnnotation(node)
+	if err != nil && !util.IsAnnotationNotSetError(err) {
+		return err
+	}
+
+	if err = c.applyFRRConfig(renderFRRConfig(uint32(config.OVNKubernetesFeature.RouteAdvertisementsASN),
+		getNeighbors(), advertisements)); err != nil {
+		return err
+	}
+
+	if err = c.importRoutes(advertisements); err != nil {
+		return err
+	}
+
+	// the learned routes change independently of the node, check them again
+	// in a while
+	if len(advertisements) > 0 {
+		c.nodeController.ReconcileAfter(key, importPeriod)
+	}
+	return nil
+}
+
+// frrNeighbor is a BGP neighbor of the node
+type frrNeighbor struct {
+	address string
+	asn     uint32
+	isIPv6  bool
+}
+
+// getNeighbors returns the configured BGP neighbors of the node.
+func getNeighbors() []frrNeighbor {
+	asn := uint32(config.OVNKubernetesFeature.RouteAdvertisementsNeighborASN)
+	if asn == 0 {
+		asn = uint32(config.OVNKubernetesFeature.RouteAdvertisementsASN)
+	}
+	var neighbors []frrNeighbor
+	for _, address := range config.RouteAdvertisementsNeighbors() {
+		neighbors = append(neighbors, frrNeighbor{address: address, asn: asn, isIPv6: utilnet.IsIPv6String(address)})
+	}
+	return neighbors
+}
+
+// renderFRRConfig renders the FRR configuration that advertises the given
+// prefixes to the given neighbors, with one BGP instance per target VRF. The
+// same neighbors are configured in every instance, as with VRF-lite peering.
+func renderFRRConfig(asn uint32, neighbors []frrNeighbor, advertisements map[string]util.NodeRouteAdvertisements) []byte {
+	prefixesByVRF := map[string]sets.Set[string]{}
+	for _, advertisement := range advertisements {
+		if prefixesByVRF[advertisement.TargetVRF] == nil {
+			prefixesByVRF[advertisement.TargetVRF] = sets.New[string]()
+		}
+		prefixesByVRF[advertisement.TargetVRF].Insert(advertisement.Prefixes...)
+	}
+	vrfs := make([]string, 0, len(prefixesByVRF))
+	for vrf := range prefixesByVRF {
+		vrfs = append(vrfs, vrf)
+	}
+	sort.Strings(vrfs)
+
+	var b bytes.Buffer
+	b.WriteString("! Generated by ovnkube-node, do not edit.\n")
+	for _, vrf := range vrfs {
+		b.WriteString("!\n")
+		if vrf == "" {
+			fmt.Fprintf(&b, "router bgp %d\n", asn)
+		} else {
+			fmt.Fprintf(&b, "router bgp %d vrf %s\n", asn, vrf)
+		}
+		b.WriteString(" no bgp ebgp-requires-policy\n")
+		b.WriteString(" no bgp network import-check\n")
+		for _, neighbor := range neighbors {
+			fmt.Fprintf(&b, " neighbor %s remote-as %d\n", neighbor.address, neighbor.asn)
+		}
+		var v4, v6 []string
+		for _, prefix := range sets.List(prefixesByVRF[vrf]) {
+			if utilnet.IsIPv6CIDRString(prefix) {
+				v6 = append(v6, prefix)
+			} else {
+				v4 = append(v4, prefix)
+			}
+		}
+		for _, family := range []struct {
+			name     string
+			isIPv6   bool
+			prefixes []string
+		}{{"ipv4 unicast", false, v4}, {"ipv6 unicast", true, v6}} {
+			if len(family.prefixes) == 0 {
+				continue
+			}
+			fmt.Fprintf(&b, " address-family %s\n", family.name)
+			for _, prefix := range family.prefixes {
+				fmt.Fprintf(&b, "  network %s\n", prefix)
+			}
+			// only the IPv4 unicast address family is active by default
+			for _, neighbor := range neighbors {
+				if family.isIPv6 && neighbor.isIPv6 {
+					fmt.Fprintf(&b, "  neighbor %s activate\n", neighbor.address)
+				}
+			}
+			b.WriteString(" exit-address-family\n")
+		}
+		b.WriteString("exit\n")
+	}
+	return b.Bytes()
+}
+
+// applyFRRConfig writes the FRR configuration file and reloads FRR with it,
+// unless it is the configuration last applied.
+func (c *Controller) applyFRRConfig(data []byte) error {
+	if bytes.Equal(c.appliedFRRConfig, data) {
+		return nil
+	}
+	path := config.OVNKubernetesFeature.RouteAdvertisementsFRRConfigFile
+	if err := writeFRRConfig(path, data); err != nil {
+		return err
+	}
+	args := strings.Fields(config.OVNKubernetesFeature.RouteAdvertisementsFRRReload)
+	if len(args) > 0 {
+		command, err := c.exec.LookPath(args[0])
+		if err != nil {
+			return fmt.Errorf("failed to find FRR reload command %s: %w", args[0], err)
+		}
+		args = append(args[1:], path)
+		output, err := c.exec.Command(command, args...).CombinedOutput()
+		if err != nil {
+			return fmt.Errorf("failed to reload FRR configuration %s: %w: %s", path, err, string(output))
+		}
+		klog.Infof("Reloaded FRR configuration %s", path)
+	}
+	c.appliedFRRConfig = data
+	return nil
+}
+
+// writeFRRConfig atomically replaces the contents of the given file, if they
+// differ.
+func writeFRRConfig(path string, data []byte) error {
+	existing, err := os.ReadFile(path)
+	if err == nil && bytes.Equal(existing, data) {
+		return nil
+	}
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to read FRR configuration %s: %w", path, err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return fmt.Errorf("failed to write FRR configuration %s: %w", path, err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write FRR configuration %s: %w", path, err)
+	}
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write FRR configuration %s: %w", path, err)
+	}
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to write FRR configuration %s: %w", path, err)
+	}
+	klog.Infof("Updated FRR configuration %s", path)
+	return nil
+}
+
+// importRoutes imports into the VRF of each advertised network the routes to
+// the network subnets learned through BGP on the VRF the network is
+// advertised in.
+func (c *Controller) importRoutes(advertisements map[string]util.NodeRouteAdvertisements) error {
+	if c.vrfManager == nil {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var errs []error
+	importedVRFs := sets.New[string]()
+	for network, advertisement := range advertisements {
+		if advertisement.NetworkVRF == "" || advertisement.NetworkVRF == advertisement.TargetVRF {
+			continue
+		}
+		routes, err := getLearnedRoutes(advertisement.TargetVRF, advertisement.Subnets)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to get learned routes for network %s: %w", network, err))
+			continue
+		}
+		if err = c.vrfManager.SyncImportedRoutes(advertisement.NetworkVRF, routes); err != nil {
+			errs = append(errs, fmt.Errorf("failed to import routes for network %s: %w", network, err))
+			continue
+		}
+		importedVRFs.Insert(advertisement.NetworkVRF)
+	}
+
+	for _, vrf := range sets.List(c.importedVRFs.Difference(importedVRFs)) {
+		if err := c.vrfManager.SyncImportedRoutes(vrf, nil); err != nil {
+			errs = append(errs, fmt.Errorf("failed to remove imported routes from VRF %s: %w", vrf, err))
+			importedVRFs.Insert(vrf)
+		}
+	}
+	c.importedVRFs = importedVRFs
+
+	return utilerrors.Join(errs...)
+}
+
+// getLearnedRoutes returns the routes learned through BGP on the given VRF,
+// or on the default VRF if empty, with a destination within the given
+// subnets.
+func getLearnedRoutes(vrf string, subnets []string) ([]netlink.Route, error) {
+	table := unix.RT_TABLE_MAIN
+	if vrf != "" {
+		link, err := util.GetNetLinkOps().LinkByName(vrf)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get VRF %s: %w", vrf, err)
+		}
+		vrfLink, ok := link.(*netlink.Vrf)
+		if !ok {
+			return nil, fmt.Errorf("link %s is not a VRF", vrf)
+		}
+		table = int(vrfLink.Table)
+	}
+
+	networks := make([]*net.IPNet, 0, len(subnets))
+	for _, subnet := range subnets {
+		_, network, err := net.ParseCIDR(subnet)
+		if err != nil {
+			return nil, fmt.Errorf("invalid subnet %s: %w", subnet, err)
+		}
+		networks = append(networks, network)
+	}
+
+	filter := &netlink.Route{Table: table, Protocol: unix.RTPROT_BGP}
+	routes, err := util.GetNetLinkOps().RouteListFiltered(netlink.FAMILY_ALL, filter, netlink.RT_FILTER_TABLE|netlink.RT_FILTER_PROTOCOL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list routes of table %d: %w", table, err)
+	}
+
+	var learned []netlink.Route
+	for _, route := range routes {
+		if route.Dst == nil || !subnetsContain(networks, route.Dst) {
+			continue
+		}
+		learnedRoute := netlink.Route{
+			Dst:       route.Dst,
+			Gw:        route.Gw,
+			LinkIndex: route.LinkIndex,
+		}
+		// the route manager programs a single nexthop per route, multipath
+		// routes are imported through their lowest nexthop so that all the
+		// nodes pick the same one
+		if nexthop := lowestNexthop(route.MultiPath); nexthop != nil {
+			klog.V(5).Infof("Importing multipath route %s learned on VRF %q through nexthop %s", route.Dst, vrf, nexthop)
+			learnedRoute.Gw = nexthop.Gw
+			learnedRoute.LinkIndex = nexthop.LinkIndex
+		}
+		if learnedRoute.LinkIndex == 0 {
+			klog.Warningf("Skipping route %s learned on VRF %q without an output interface", route.Dst, vrf)
+			continue
+		}
+		learned = append(learned, learnedRoute)
+	}
+	sort.Slice(learned, func(i, j int) bool {
+		return strings.Compare(learned[i].Dst.String(), learned[j].Dst.String()) < 0
+	})
+	return learned, nil
+}
+
+// lowestNexthop returns the nexthop with an output interface with the lowest
+// gateway address, or nil if there is none.
+func lowestNexthop(nexthops []*netlink.NexthopInfo) *netlink.NexthopInfo {
+	var lowest *netlink.NexthopInfo
+	for _, nexthop := range nexthops {
+		if nexthop == nil || nexthop.LinkIndex == 0 {
+			continue
+		}
+		if lowest == nil || bytes.Compare(nexthop.Gw.To16(), lowest.Gw.To16()) < 0 ||
+			nexthop.Gw.Equal(lowest.Gw) && nexthop.LinkIndex < lowest.LinkIndex {
+			lowest = nexthop
+		}
+	}
+	return lowest
+}
+
+func subnetsContain(subnets []*net.IPNet, prefix *net.IPNet) bool {
+	prefixOnes, _ := prefix.Mask.Size()
+	for _, subnet := range subnets {
+		ones, _ := subnet.Mask.Size()
+		if ones <= prefixOnes && subnet.Contains(prefix.IP) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,210 @@
+package routeadvertisements
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	ovntest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+	utilMocks "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util/mocks"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+var _ = ginkgo.Describe("Node route advertisements", func() {
+	ginkgo.Context("FRR configuration", func() {
+		ginkgo.It("renders an empty configuration without advertisements", func() {
+			gomega.Expect(string(renderFRRConfig(64512, nil, nil))).To(gomega.Equal("! Generated by ovnkube-node, do not edit.\n"))
+		})
+
+		ginkgo.It("renders a BGP instance per target VRF", func() {
+			advertisements := map[string]util.NodeRouteAdvertisements{
+				"default": {
+					Prefixes: []string{"10.128.1.0/24", "fd00:10:244:2::/64", "172.18.0.100/32"},
+				},
+				"blue": {
+					TargetVRF:  "mp3-udn-vrf",
+					NetworkVRF: "mp3-udn-vrf",
+					Prefixes:   []string{"10.10.1.0/24"},
+				},
+				"red": {
+					NetworkVRF: "mp4-udn-vrf",
+					Prefixes:   []string{"10.20.1.0/24"},
+				},
+			}
+			expected := `! Generated by ovnkube-node, do not edit.
+!
+router bgp 64512
+ no bgp ebgp-requires-policy
+ no bgp network import-check
+ neighbor 172.18.0.5 remote-as 64000
+ neighbor fd00::5 remote-as 64000
+ address-family ipv4 unicast
+  network 10.128.1.0/24
+  network 10.20.1.0/24
+  network 172.18.0.100/32
+ exit-address-family
+ address-family ipv6 unicast
+  network fd00:10:244:2::/64
+  neighbor fd00::5 activate
+ exit-address-family
+exit
+!
+router bgp 64512 vrf mp3-udn-vrf
+ no bgp ebgp-requires-policy
+ no bgp network import-check
+ neighbor 172.18.0.5 remote-as 64000
+ neighbor fd00::5 remote-as 64000
+ address-family ipv4 unicast
+  network 10.10.1.0/24
+ exit-address-family
+exit
+`
+			neighbors := []frrNeighbor{
+				{address: "172.18.0.5", asn: 64000},
+				{address: "fd00::5", asn: 64000, isIPv6: true},
+			}
+			gomega.Expect(string(renderFRRConfig(64512, neighbors, advertisements))).To(gomega.Equal(expected))
+		})
+
+		ginkgo.It("only rewrites the configuration file when it changes", func() {
+			path := filepath.Join(ginkgo.GinkgoT().TempDir(), "frr.conf")
+			gomega.Expect(writeFRRConfig(path, []byte("a\n"))).To(gomega.Succeed())
+			gomega.Expect(os.ReadFile(path)).To(gomega.Equal([]byte("a\n")))
+			info, err := os.Stat(path)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			gomega.Expect(writeFRRConfig(path, []byte("a\n"))).To(gomega.Succeed())
+			unchanged, err := os.Stat(path)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(os.SameFile(info, unchanged)).To(gomega.BeTrue())
+
+			gomega.Expect(writeFRRConfig(path, []byte("b\n"))).To(gomega.Succeed())
+			gomega.Expect(os.ReadFile(path)).To(gomega.Equal([]byte("b\n")))
+			entries, err := os.ReadDir(filepath.Dir(path))
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(entries).To(gomega.HaveLen(1))
+		})
+	})
+
+	ginkgo.Context("node reconciliation", func() {
+		var (
+			c        *Controller
+			fexec    *ovntest.FakeExec
+			indexer  cache.Indexer
+			confPath string
+		)
+
+		ginkgo.BeforeEach(func() {
+			gomega.Expect(config.PrepareTestConfig()).To(gomega.Succeed())
+			confPath = filepath.Join(ginkgo.GinkgoT().TempDir(), "frr.conf")
+			config.OVNKubernetesFeature.RouteAdvertisementsFRRConfigFile = confPath
+			config.OVNKubernetesFeature.RouteAdvertisementsFRRReload = "frr-reload --reload"
+			config.OVNKubernetesFeature.RouteAdvertisementsNeighbors = "172.18.0.5"
+			fexec = ovntest.NewFakeExec()
+			indexer = cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+			c = &Controller{
+				nodeName:     "node1",
+				exec:         fexec,
+				nodeLister:   corev1listers.NewNodeLister(indexer),
+				importedVRFs: sets.New[string](),
+			}
+		})
+
+		ginkgo.It("reloads FRR when the rendered configuration changes", func() {
+			node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node1"}}
+			gomega.Expect(indexer.Add(node)).To(gomega.Succeed())
+			fexec.AddFakeCmd(&ovntest.ExpectedCmd{Cmd: "frr-reload --reload " + confPath})
+			gomega.Expect(c.reconcileNode("node1")).To(gomega.Succeed())
+			gomega.Expect(fexec.CalledMatchesExpected()).To(gomega.BeTrue(), fexec.ErrorDesc)
+			data, err := os.ReadFile(confPath)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(string(data)).To(gomega.Equal("! Generated by ovnkube-node, do not edit.\n"))
+
+			ginkgo.By("not reloading FRR again for the same configuration")
+			gomega.Expect(c.reconcileNode("node1")).To(gomega.Succeed())
+			gomega.Expect(fexec.CalledMatchesExpected()).To(gomega.BeTrue(), fexec.ErrorDesc)
+
+			ginkgo.By("retrying a failed reload")
+			fexec.AddFakeCmd(&ovntest.ExpectedCmd{Cmd: "frr-reload --reload " + confPath, Err: fmt.Errorf("failed")})
+			gomega.Expect(c.applyFRRConfig([]byte("b\n"))).NotTo(gomega.Succeed())
+			gomega.Expect(os.ReadFile(confPath)).To(gomega.Equal([]byte("b\n")))
+			fexec.AddFakeCmd(&ovntest.ExpectedCmd{Cmd: "frr-reload --reload " + confPath})
+			gomega.Expect(c.applyFRRConfig([]byte("b\n"))).To(gomega.Succeed())
+			gomega.Expect(fexec.CalledMatchesExpected()).To(gomega.BeTrue(), fexec.ErrorDesc)
+		})
+
+		ginkgo.It("does not retry when its node is not found", func() {
+			gomega.Expect(c.reconcileNode("node1")).To(gomega.Succeed())
+			gomega.Expect(fexec.CalledMatchesExpected()).To(gomega.BeTrue(), fexec.ErrorDesc)
+		})
+	})
+
+	ginkgo.It("imports multipath routes through their lowest nexthop", func() {
+		netlinkOpsMock := &utilMocks.NetLinkOps{}
+		util.SetNetLinkOpMockInst(netlinkOpsMock)
+		defer util.ResetNetLinkOpMockInst()
+		netlinkOpsMock.On("RouteListFiltered", netlink.FAMILY_ALL,
+			&netlink.Route{Table: unix.RT_TABLE_MAIN, Protocol: unix.RTPROT_BGP},
+			uint64(netlink.RT_FILTER_TABLE|netlink.RT_FILTER_PROTOCOL)).Return([]netlink.Route{
+			{
+				Dst:       ovntest.MustParseIPNet("10.10.1.0/24"),
+				Gw:        ovntest.MustParseIP("172.18.0.2"),
+				LinkIndex: 3,
+			},
+			{
+				Dst: ovntest.MustParseIPNet("10.10.2.0/24"),
+				MultiPath: []*netlink.NexthopInfo{
+					{Gw: ovntest.MustParseIP("172.18.0.4"), LinkIndex: 3},
+					{Gw: ovntest.MustParseIP("172.18.0.3"), LinkIndex: 4},
+				},
+			},
+			{
+				// outside of the network subnets
+				Dst: ovntest.MustParseIPNet("10.20.1.0/24"),
+				MultiPath: []*netlink.NexthopInfo{
+					{Gw: ovntest.MustParseIP("172.18.0.3"), LinkIndex: 3},
+				},
+			},
+			{
+				// without an output interface
+				Dst: ovntest.MustParseIPNet("10.10.3.0/24"),
+			},
+		}, nil)
+
+		routes, err := getLearnedRoutes("", []string{"10.10.0.0/16"})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(routes).To(gomega.Equal([]netlink.Route{
+			{
+				Dst:       ovntest.MustParseIPNet("10.10.1.0/24"),
+				Gw:        ovntest.MustParseIP("172.18.0.2"),
+				LinkIndex: 3,
+			},
+			{
+				Dst:       ovntest.MustParseIPNet("10.10.2.0/24"),
+				Gw:        ovntest.MustParseIP("172.18.0.3"),
+				LinkIndex: 4,
+			},
+		}))
+		netlinkOpsMock.AssertExpectations(ginkgo.GinkgoT())
+	})
+
+	ginkgo.It("matches learned routes within the network subnets", func() {
+		subnets := ovntest.MustParseIPNets("10.10.0.0/16", "fd00:10::/48")
+		gomega.Expect(subnetsContain(subnets, ovntest.MustParseIPNet("10.10.2.0/24"))).To(gomega.BeTrue())
+		gomega.Expect(subnetsContain(subnets, ovntest.MustParseIPNet("fd00:10:0:1::/64"))).To(gomega.BeTrue())
+		gomega.Expect(subnetsContain(subnets, ovntest.MustParseIPNet("10.0.0.0/8"))).To(gomega.BeFalse())
+		gomega.Expect(subnetsContain(subnets, ovntest.MustParseIPNet("10.20.2.0/24"))).To(gomega.BeFalse())
+	})
+})
@@ -30,6 +30,9 @@ type vrf struct {
 	// It cannot be changed after VRF creation.
 	managedSlave string
 	routes       []netlink.Route
+	// importedRoutes are the routes learned on other VRFs that are leaked
+	// into this VRF. They are managed with SyncImportedRoutes.
+	importedRoutes []netlink.Route
 }
 
 type Controller struct {
@@ -231,6 +234,11 @@ func (vrfm *Controller) sync(vrf vrf) error {
 			return fmt.Errorf("failed to add route %v for VRF device %s, err: %w", route, vrf.name, err)
 		}
 	}
+	for _, route := range vrf.importedRoutes {
+		if err = vrfm.routeManager.Add(route); err != nil {
+			return fmt.Errorf("failed to add imported route %v for VRF device %s, err: %w", route, vrf.name, err)
+		}
+	}
 
 	vrfm.vrfs[vrfLink.Attrs().Index] = vrf
 	return nil
@@ -260,12 +268,12 @@ func (vrfm *Controller) AddVRF(name string, slaveInterface string, table uint32,
 				return fmt.Errorf("VRF Manager: table id mismatch for VRF device %s", name)
 			}
 		} else {
-			vrfDev = vrf{name, table, slaveInterface, routes}
+			vrfDev = vrf{name, table, slaveInterface, routes, nil}
 		}
 	}
 
 	if err != nil && util.GetNetLinkOps().IsLinkNotFoundError(err) {
-		vrfDev = vrf{name, table, slaveInterface, routes}
+		vrfDev = vrf{name, table, slaveInterface, routes, nil}
 	} else if err != nil {
 		return fmt.Errorf("failed to retrieve VRF device %s, err: %v", name, err)
 	}
@@ -273,6 +281,63 @@ func (vrfm *Controller) AddVRF(name string, slaveInterface string, table uint32,
 	return vrfm.sync(vrfDev)
 }
 
+// SyncImportedRoutes sets the routes imported into the routing table of an
+// existing VRF device previously added with AddVRF. Routes not present in
+// the given set anymore are removed from the table. Removing all the routes
+// of a VRF device that no longer exists is a no-op.
+func (vrfm *Controller) SyncImportedRoutes(name string, routes []netlink.Route) error {
+	vrfm.mu.Lock()
+	defer vrfm.mu.Unlock()
+
+	vrfLink, err := util.GetNetLinkOps().LinkByName(name)
+	if err != nil {
+		// nothing to remove if the VRF device is already gone
+		if len(routes) == 0 && util.GetNetLinkOps().IsLinkNotFoundError(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to retrieve VRF device %s, err: %v", name, err)
+	}
+	vrfDev, ok := vrfm.vrfs[vrfLink.Attrs().Index]
+	if !ok {
+		if len(routes) == 0 {
+			return nil
+		}
+		return fmt.Errorf("VRF Manager: VRF %s not found in the cache", name)
+	}
+
+	imported := make([]netlink.Route, 0, len(routes))
+	for _, route := range routes {
+		route.Table = int(vrfDev.table)
+		imported = append(imported, route)
+	}
+
+	var errorAggregate []error
+	for _, existing := range vrfDev.importedRoutes {
+		var found bool
+		for _, route := range imported {
+			if routemanager.RoutePartiallyEqual(existing, route) {
+				found = true
+				break
+			}
+		}
+		if found {
+			continue
+		}
+		if err = vrfm.routeManager.Del(existing); err != nil {
+			errorAggregate = append(errorAggregate, fmt.Errorf("failed to delete imported route %v for VRF device %s, err: %w", existing, name, err))
+		}
+	}
+	for _, route := range imported {
+		if err = vrfm.routeManager.Add(route); err != nil {
+			errorAggregate = append(errorAggregate, fmt.Errorf("failed to add imported route %v for VRF device %s, err: %w", route, name, err))
+		}
+	}
+
+	vrfDev.importedRoutes = imported
+	vrfm.vrfs[vrfLink.Attrs().Index] = vrfDev
+	return utilerrors.Join(errorAggregate...)
+}
+
 // Repair deletes stale VRF device(s) on the host. This helps remove
 // device(s) for which DeleteVRF is never invoked.
 // Assumptions: 1) The validVRFs list must contain device for which AddVRF
@@ -338,6 +403,11 @@ func (vrfm *Controller) DeleteVRF(name string) (err error) {
 			return fmt.Errorf("failed to delete route %v for VRF device %s, err: %w", route, vrf.name, err)
 		}
 	}
+	for _, route := range vrf.importedRoutes {
+		if err = vrfm.routeManager.Del(route); err != nil {
+			return fmt.Errorf("failed to delete imported route %v for VRF device %s, err: %w", route, vrf.name, err)
+		}
+	}
 
 	err = vrfm.deleteVRF(vrfLink)
 	if err != nil {
@@ -112,6 +112,39 @@ var _ = ginkgo.Describe("VRF manager", func() {
 			gomega.Expect(err).ShouldNot(gomega.HaveOccurred())
 		})
 
+		ginkgo.It("sync imported routes of a VRF", func() {
+			routeLinkMock := new(netlink_mocks.Link)
+			routeLinkMock.On("Attrs").Return(&netlink.LinkAttrs{Name: "breth0", Index: 5}, nil)
+			nlMock.On("LinkByIndex", 5).Return(routeLinkMock, nil)
+			importedRoute := netlink.Route{
+				Dst:       ovntest.MustParseIPNet("10.1.0.0/24"),
+				Gw:        ovntest.MustParseIP("172.18.0.3"),
+				LinkIndex: 5,
+				Table:     254,
+			}
+			expectedRoute := importedRoute
+			expectedRoute.Table = 20
+
+			err := c.AddVRF(vrfLinkName2, "", 20, nil)
+			gomega.Expect(err).ShouldNot(gomega.HaveOccurred())
+
+			nlMock.On("RouteListFiltered", netlink.FAMILY_V4, mock.Anything, mock.Anything).Return(nil, nil).Once()
+			nlMock.On("RouteAdd", mock.MatchedBy(func(r *netlink.Route) bool {
+				return r.Table == 20 && r.Dst.String() == "10.1.0.0/24" && r.Gw.Equal(importedRoute.Gw)
+			})).Return(nil).Once()
+			err = c.SyncImportedRoutes(vrfLinkName2, []netlink.Route{importedRoute})
+			gomega.Expect(err).ShouldNot(gomega.HaveOccurred())
+			gomega.Expect(c.vrfs[getLinkIndex(vrfLinkName2)].importedRoutes).To(gomega.Equal([]netlink.Route{expectedRoute}))
+
+			nlMock.On("RouteListFiltered", netlink.FAMILY_ALL, mock.Anything, mock.Anything).Return([]netlink.Route{expectedRoute}, nil).Once()
+			nlMock.On("RouteDel", &expectedRoute).Return(nil).Once()
+			err = c.SyncImportedRoutes(vrfLinkName2, nil)
+			gomega.Expect(err).ShouldNot(gomega.HaveOccurred())
+			gomega.Expect(c.vrfs[getLinkIndex(vrfLinkName2)].importedRoutes).To(gomega.BeEmpty())
+			nlMock.AssertNumberOfCalls(ginkgo.GinkgoT(), "RouteAdd", 1)
+			nlMock.AssertNumberOfCalls(ginkgo.GinkgoT(), "RouteDel", 1)
+		})
+
 		ginkgo.It("reconcile VRFs", func() {
 			nlMock.On("LinkList").Return([]netlink.Link{vrfLinkMock1, vrfLinkMock2, enslaveLinkMock1}, nil)
 			enslaveLinkMock1.On("Attrs").Return(&netlink.LinkAttrs{Name: enslaveLinkName1, MasterIndex: getLinkMasterIndex(enslaveLinkName1), Index: getLinkIndex(enslaveLinkName1)}, nil)
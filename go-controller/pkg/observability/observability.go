@@ -1,7 +1,10 @@
 package observability
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"reflect"
 	"slices"
 	"strings"
 	"sync"
@@ -9,11 +12,29 @@ import (
 
 	libovsdbclient "github.com/ovn-org/libovsdb/client"
 	libovsdb "github.com/ovn-org/libovsdb/ovsdb"
+	corev1 "k8s.io/api/core/v1"
+	knet "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
+	metaapplyv1 "k8s.io/client-go/applyconfigurations/meta/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	networkinglisters "k8s.io/client-go/listers/networking/v1"
+	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
 
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/controller"
+	networkobservabilityapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkobservability/v1"
+	networkobservabilityapply "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkobservability/v1/apis/applyconfiguration/networkobservability/v1"
+	networkobservabilityclientset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkobservability/v1/apis/clientset/versioned"
+	networkobservabilitylister "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkobservability/v1/apis/listers/networkobservability/v1"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
 	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util/batching"
 )
 
 // OVN observ app IDs. Make sure to always add new apps in the end.
@@ -23,13 +44,26 @@ const (
 	ACLEstTrafficSamplingID
 )
 
-// temporary const, until we have dynamic config
+// DefaultObservabilityCollectorSetID is the collector set used for all the features when no
+// NetworkObservability is configured.
 const DefaultObservabilityCollectorSetID = 42
 
+// NetworkObservabilityName is the name of the only NetworkObservability the Manager uses.
+const NetworkObservabilityName = "default"
+
 // this is inferred from nbdb schema, check Sample_Collector.id
 const maxCollectorID = 255
 const collectorFeaturesExternalID = "sample-features"
 
+// resampleACLsBatchSize is the max number of ACLs updated in a single transaction when the configuration changes
+const resampleACLsBatchSize = 1000
+
+const (
+	reasonApplied        = "Applied"
+	reasonConfigError    = "ConfigError"
+	reasonCollectorError = "CollectorError"
+)
+
 // collectorConfig holds the configuration for a collector.
 // It is allowed to set different probabilities for every feature.
 // collectorSetID is used to set up sampling via OVSDB.
@@ -37,11 +71,20 @@ type collectorConfig struct {
 	collectorSetID int
 	// probability in percent, 0 to 100
 	featuresProbability map[libovsdbops.SampleFeature]int
+	// namespaces limits the sampling of namespaced features to the ACLs of these namespaces, nil means all namespaces.
+	namespaces sets.Set[string]
+	// networkPolicies limits the sampling of network policy ACLs to these "namespace/name" policies,
+	// nil means all network policies.
+	networkPolicies sets.Set[string]
 }
 
 type Manager struct {
 	nbClient       libovsdbclient.Client
+	zone           string
+	sampConfigLock sync.RWMutex
 	sampConfig     *libovsdbops.SamplingConfig
+	// appliedConfigs are the collector configs sampConfig was built from
+	appliedConfigs []*collectorConfig
 	collectorsLock sync.Mutex
 	// nbdb Collectors have probability. To allow different probabilities for different features,
 	// multiple nbdb Collectors will be created, one per probability.
@@ -56,58 +99,491 @@ type Manager struct {
 	// Only maxCollectorID collectors are allowed, each should have unique ID.
 	// this set is tracking already assigned IDs.
 	takenCollectorIDs sets.Set[int]
+
+	// the following fields are only set when the configuration is read from the NetworkObservability,
+	// otherwise the default configuration is used.
+	client          networkobservabilityclientset.Interface
+	observLister    networkobservabilitylister.NetworkObservabilityLister
+	namespaceLister corelisters.NamespaceLister
+	podLister       corelisters.PodLister
+	netpolLister    networkinglisters.NetworkPolicyLister
+	// configReconciler recomputes the configuration every time one of the watched objects changes
+	configReconciler controller.Reconciler
+	controllers      []controller.Reconciler
 }
 
-func NewManager(nbClient libovsdbclient.Client) *Manager {
-	return &Manager{
+// NewManager creates a new observability Manager. If watchFactory is nil, the default configuration
+// is used, otherwise the configuration is read from the NetworkObservability and updated live, and
+// its status is reported for the given zone.
+func NewManager(nbClient libovsdbclient.Client, watchFactory *factory.WatchFactory,
+	client networkobservabilityclientset.Interface, zone string) *Manager {
+	m := &Manager{
 		nbClient:                      nbClient,
+		zone:                          zone,
 		collectorsLock:                sync.Mutex{},
 		dbCollectors:                  make(map[string]string),
 		unusedCollectors:              make(map[string]int),
 		unusedCollectorsRetryInterval: time.Minute,
 		takenCollectorIDs:             sets.New[int](),
 	}
+	if watchFactory == nil {
+		return m
+	}
+	m.client = client
+	m.observLister = watchFactory.NetworkObservabilityInformer().Lister()
+	m.namespaceLister = watchFactory.NamespaceCoreInformer().Lister()
+	m.podLister = watchFactory.PodCoreInformer().Lister()
+	m.netpolLister = watchFactory.NetworkPolicyCoreInformer().Lister()
+
+	m.configReconciler = controller.NewReconciler("observability-config-reconciler", &controller.ReconcilerConfig{
+		RateLimiter: workqueue.DefaultTypedControllerRateLimiter[string](),
+		Reconcile:   m.reconcileConfig,
+		Threadiness: 1,
+	})
+	observConfig := &controller.ControllerConfig[networkobservabilityapi.NetworkObservability]{
+		RateLimiter:    workqueue.DefaultTypedControllerRateLimiter[string](),
+		Informer:       watchFactory.NetworkObservabilityInformer().Informer(),
+		Lister:         m.observLister.List,
+		ObjNeedsUpdate: observabilityNeedsUpdate,
+		Reconcile:      m.requeueConfig,
+		Threadiness:    1,
+	}
+	namespaceConfig := &controller.ControllerConfig[corev1.Namespace]{
+		RateLimiter:    workqueue.DefaultTypedControllerRateLimiter[string](),
+		Informer:       watchFactory.NamespaceCoreInformer().Informer(),
+		Lister:         m.namespaceLister.List,
+		ObjNeedsUpdate: labelsNeedUpdate[corev1.Namespace],
+		Reconcile:      m.requeueConfig,
+		Threadiness:    1,
+	}
+	podConfig := &controller.ControllerConfig[corev1.Pod]{
+		RateLimiter:    workqueue.DefaultTypedControllerRateLimiter[string](),
+		Informer:       watchFactory.PodCoreInformer().Informer(),
+		Lister:         m.podLister.List,
+		ObjNeedsUpdate: labelsNeedUpdate[corev1.Pod],
+		Reconcile:      m.requeueConfig,
+		Threadiness:    1,
+	}
+	netpolConfig := &controller.ControllerConfig[knet.NetworkPolicy]{
+		RateLimiter:    workqueue.DefaultTypedControllerRateLimiter[string](),
+		Informer:       watchFactory.NetworkPolicyCoreInformer().Informer(),
+		Lister:         m.netpolLister.List,
+		ObjNeedsUpdate: netpolNeedsUpdate,
+		Reconcile:      m.requeueConfig,
+		Threadiness:    1,
+	}
+	m.controllers = []controller.Reconciler{
+		m.configReconciler,
+		controller.NewController[networkobservabilityapi.NetworkObservability]("observability-config-controller", observConfig),
+		controller.NewController[corev1.Namespace]("observability-namespace-controller", namespaceConfig),
+		controller.NewController[corev1.Pod]("observability-pod-controller", podConfig),
+		controller.NewController[knet.NetworkPolicy]("observability-netpol-controller", netpolConfig),
+	}
+	return m
 }
 
 func (m *Manager) SamplingConfig() *libovsdbops.SamplingConfig {
+	m.sampConfigLock.RLock()
+	defer m.sampConfigLock.RUnlock()
 	return m.sampConfig
 }
 
-func (m *Manager) Init() error {
-	// this will be read from the kube-api in the future
-	currentConfig := &collectorConfig{
-		collectorSetID: DefaultObservabilityCollectorSetID,
-		featuresProbability: map[libovsdbops.SampleFeature]int{
-			libovsdbops.EgressFirewallSample:     100,
-			libovsdbops.NetworkPolicySample:      100,
-			libovsdbops.AdminNetworkPolicySample: 100,
-			libovsdbops.MulticastSample:          100,
-			libovsdbops.UDNIsolationSample:       100,
+func defaultCollectorConfigs() []*collectorConfig {
+	return []*collectorConfig{
+		{
+			collectorSetID: DefaultObservabilityCollectorSetID,
+			featuresProbability: map[libovsdbops.SampleFeature]int{
+				libovsdbops.EgressFirewallSample:     100,
+				libovsdbops.NetworkPolicySample:      100,
+				libovsdbops.AdminNetworkPolicySample: 100,
+				libovsdbops.MulticastSample:          100,
+				libovsdbops.UDNIsolationSample:       100,
+			},
 		},
 	}
+}
+
+// Init sets up the sampling apps and the collectors of the current configuration, and starts watching
+// for configuration changes if the configuration is read from the NetworkObservability.
+// The ACLs are expected to be sampled with the current SamplingConfig by their controllers on their initial sync.
+func (m *Manager) Init() error {
+	if m.configReconciler == nil {
+		return m.initWithConfig(defaultCollectorConfigs()...)
+	}
+	return controller.StartWithInitialSync(m.initialSync, m.controllers...)
+}
 
-	return m.initWithConfig(currentConfig)
+func (m *Manager) initialSync() error {
+	configs, _, err := m.getCollectorConfigs()
+	if err != nil {
+		// use the default config until the NetworkObservability is fixed, the error is reported by the reconciler
+		klog.Errorf("Failed to get observability configuration, using the default one: %v", err)
+		configs = defaultCollectorConfigs()
+	}
+	return m.initWithConfig(configs...)
 }
 
-func (m *Manager) initWithConfig(config *collectorConfig) error {
-	if err := m.setSamplingAppIDs(); err != nil {
-		return err
+// Stop stops watching for configuration changes.
+func (m *Manager) Stop() {
+	if len(m.controllers) > 0 {
+		controller.Stop(m.controllers...)
 	}
-	if err := m.setDbCollectors(); err != nil {
+}
+
+func (m *Manager) initWithConfig(configs ...*collectorConfig) error {
+	if err := m.setSamplingAppIDs(); err != nil {
 		return err
 	}
-
-	featuresConfig, err := m.addCollector(config)
+	sampConfig, err := m.setCollectors(configs)
 	if err != nil {
 		return err
 	}
-	m.sampConfig = libovsdbops.NewSamplingConfig(featuresConfig)
+	m.setSamplingConfig(sampConfig, configs)
 
 	// now cleanup stale collectors
 	m.deleteStaleCollectorsWithRetry()
 	return nil
 }
 
+func (m *Manager) setSamplingConfig(sampConfig *libovsdbops.SamplingConfig, configs []*collectorConfig) {
+	m.sampConfigLock.Lock()
+	defer m.sampConfigLock.Unlock()
+	m.sampConfig = sampConfig
+	m.appliedConfigs = configs
+}
+
+// setCollectors creates the collectors required by configs, marks all other collectors as unused,
+// and returns the SamplingConfig using the created collectors.
+func (m *Manager) setCollectors(configs []*collectorConfig) (*libovsdbops.SamplingConfig, error) {
+	if err := m.setDbCollectors(); err != nil {
+		return nil, err
+	}
+	featuresConfig := make(map[libovsdbops.SampleFeature][]string)
+	filters := make(map[string]func(acl *nbdb.ACL) bool)
+	for _, config := range configs {
+		collectorFeatures, err := m.addCollector(config)
+		if err != nil {
+			return nil, err
+		}
+		filter := config.aclFilter()
+		for feature, collectors := range collectorFeatures {
+			featuresConfig[feature] = append(featuresConfig[feature], collectors...)
+			if filter == nil {
+				continue
+			}
+			for _, collector := range collectors {
+				filters[collector] = filter
+			}
+		}
+	}
+	return libovsdbops.NewSamplingConfigWithFilters(featuresConfig, filters), nil
+}
+
+func (m *Manager) requeueConfig(_ string) error {
+	m.configReconciler.Reconcile(NetworkObservabilityName)
+	return nil
+}
+
+// reconcileConfig computes the observability configuration from the NetworkObservability and the
+// objects selected by it, and re-samples all the ACLs when it changed.
+func (m *Manager) reconcileConfig(_ string) error {
+	configs, observ, err := m.getCollectorConfigs()
+	if err != nil {
+		return m.updateStatus(observ, nil, err, reasonConfigError)
+	}
+	if m.sameConfigs(configs) {
+		return m.updateStatus(observ, configs, nil, "")
+	}
+	klog.Infof("Observability configuration changed, updating sample collectors and ACL samples")
+	sampConfig, err := m.setCollectors(configs)
+	if err != nil {
+		statusErr := m.updateStatus(observ, nil, err, reasonCollectorError)
+		return errors.Join(fmt.Errorf("failed to set sample collectors: %w", err), statusErr)
+	}
+	m.setSamplingConfig(sampConfig, configs)
+	if err = m.resampleACLs(sampConfig); err != nil {
+		return fmt.Errorf("failed to update ACL samples: %w", err)
+	}
+	m.deleteStaleCollectorsWithRetry()
+	return m.updateStatus(observ, configs, nil, "")
+}
+
+func (m *Manager) sameConfigs(configs []*collectorConfig) bool {
+	m.sampConfigLock.RLock()
+	defer m.sampConfigLock.RUnlock()
+	return reflect.DeepEqual(m.appliedConfigs, configs)
+}
+
+// resampleACLs updates the samples of all the ACLs owned by a sampled feature with the given SamplingConfig,
+// in batches of resampleACLsBatchSize ACLs per transaction.
+// ACL controllers may update the same ACLs concurrently, but they use the latest SamplingConfig too.
+func (m *Manager) resampleACLs(sampConfig *libovsdbops.SamplingConfig) error {
+	acls, err := libovsdbops.FindACLsWithPredicate(m.nbClient, func(acl *nbdb.ACL) bool {
+		return acl.ExternalIDs[libovsdbops.OwnerTypeKey.String()] != ""
+	})
+	if err != nil {
+		return fmt.Errorf("failed to find ACLs: %w", err)
+	}
+	return batching.Batch[*nbdb.ACL](resampleACLsBatchSize, acls, func(batchACLs []*nbdb.ACL) error {
+		ops, err := libovsdbops.CreateOrUpdateACLsOps(m.nbClient, nil, sampConfig, batchACLs...)
+		if err != nil {
+			return err
+		}
+		_, err = libovsdbops.TransactAndCheck(m.nbClient, ops)
+		return err
+	})
+}
+
+// getCollectorConfigs returns the collector configs for the current NetworkObservability, or the default
+// collector configs if it doesn't exist. The returned configs are sorted by collector set ID.
+func (m *Manager) getCollectorConfigs() ([]*collectorConfig, *networkobservabilityapi.NetworkObservability, error) {
+	observ, err := m.observLister.Get(NetworkObservabilityName)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return defaultCollectorConfigs(), nil, nil
+		}
+		return nil, nil, fmt.Errorf("failed to get NetworkObservability %s: %w", NetworkObservabilityName, err)
+	}
+	configs := make([]*collectorConfig, 0, len(observ.Spec.Collectors))
+	for _, collector := range observ.Spec.Collectors {
+		config, err := m.getCollectorConfig(&collector)
+		if err != nil {
+			return nil, observ, fmt.Errorf("invalid collector set %d: %w", collector.CollectorSetID, err)
+		}
+		configs = append(configs, config)
+	}
+	slices.SortFunc(configs, func(a, b *collectorConfig) int {
+		return a.collectorSetID - b.collectorSetID
+	})
+	return configs, observ, nil
+}
+
+func (m *Manager) getCollectorConfig(collector *networkobservabilityapi.NetworkObservabilityCollector) (*collectorConfig, error) {
+	config := &collectorConfig{
+		collectorSetID:      int(collector.CollectorSetID),
+		featuresProbability: make(map[libovsdbops.SampleFeature]int, len(collector.Features)),
+	}
+	for _, feature := range collector.Features {
+		config.featuresProbability[libovsdbops.SampleFeature(feature.Feature)] = int(feature.Probability)
+	}
+	if collector.NamespaceSelector != nil {
+		nsSelector, err := metav1.LabelSelectorAsSelector(collector.NamespaceSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid namespace selector: %w", err)
+		}
+		namespaces, err := m.namespaceLister.List(nsSelector)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list namespaces: %w", err)
+		}
+		config.namespaces = sets.New[string]()
+		for _, namespace := range namespaces {
+			config.namespaces.Insert(namespace.Name)
+		}
+	}
+	if collector.PodSelector != nil {
+		podSelector, err := metav1.LabelSelectorAsSelector(collector.PodSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pod selector: %w", err)
+		}
+		networkPolicies, err := m.getSelectedNetworkPolicies(podSelector, config.namespaces)
+		if err != nil {
+			return nil, err
+		}
+		config.networkPolicies = networkPolicies
+	}
+	return config, nil
+}
+
+// getSelectedNetworkPolicies returns the "namespace/name" keys of the network policies that apply to at
+// least one pod selected by podSelector in the given namespaces (all namespaces if nil).
+// Only the network policies of the namespaces with selected pods are evaluated.
+func (m *Manager) getSelectedNetworkPolicies(podSelector labels.Selector, namespaces sets.Set[string]) (sets.Set[string], error) {
+	pods, err := m.podLister.List(podSelector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+	namespacePods := make(map[string][]*corev1.Pod)
+	for _, pod := range pods {
+		if namespaces != nil && !namespaces.Has(pod.Namespace) {
+			continue
+		}
+		namespacePods[pod.Namespace] = append(namespacePods[pod.Namespace], pod)
+	}
+	selected := sets.New[string]()
+	for namespace, pods := range namespacePods {
+		policies, err := m.netpolLister.NetworkPolicies(namespace).List(labels.Everything())
+		if err != nil {
+			return nil, fmt.Errorf("failed to list network policies in namespace %s: %w", namespace, err)
+		}
+		for _, policy := range policies {
+			policySelector, err := metav1.LabelSelectorAsSelector(&policy.Spec.PodSelector)
+			if err != nil {
+				klog.Warningf("Ignoring network policy %s/%s with invalid pod selector: %v", policy.Namespace, policy.Name, err)
+				continue
+			}
+			for _, pod := range pods {
+				if policySelector.Matches(labels.Set(pod.Labels)) {
+					selected.Insert(policy.Namespace + "/" + policy.Name)
+					break
+				}
+			}
+		}
+	}
+	return selected, nil
+}
+
+// aclFilter returns the filter of the ACLs sampled by the collector, or nil if all ACLs are sampled.
+// Only namespaced features are filtered, cluster-scoped ACLs are always sampled.
+func (c *collectorConfig) aclFilter() func(acl *nbdb.ACL) bool {
+	if c.namespaces == nil && c.networkPolicies == nil {
+		return nil
+	}
+	return func(acl *nbdb.ACL) bool {
+		objectName := acl.ExternalIDs[libovsdbops.ObjectNameKey.String()]
+		switch acl.ExternalIDs[libovsdbops.OwnerTypeKey.String()] {
+		case libovsdbops.NetworkPolicyOwnerType:
+			namespace, name, err := libovsdbops.ParseNamespaceNameKey(objectName)
+			if err != nil {
+				return false
+			}
+			return (c.namespaces == nil || c.namespaces.Has(namespace)) &&
+				(c.networkPolicies == nil || c.networkPolicies.Has(namespace+"/"+name))
+		case libovsdbops.NetpolNamespaceOwnerType, libovsdbops.EgressFirewallOwnerType, libovsdbops.MulticastNamespaceOwnerType:
+			return c.namespaces == nil || c.namespaces.Has(objectName)
+		}
+		return true
+	}
+}
+
+// updateStatus reports whether configs are applied in the zone, and the collectors created for them, in the
+// NetworkObservability status. Every zone owns its condition and collectors and applies them with server side
+// apply, using the zone as field manager. The overall status is aggregated by cluster-manager.
+func (m *Manager) updateStatus(observ *networkobservabilityapi.NetworkObservability, configs []*collectorConfig,
+	applyErr error, reason string) error {
+	if observ == nil {
+		return applyErr
+	}
+	condition := metav1.Condition{
+		Type:               networkobservabilityapi.NetworkObservabilityConditionAppliedInZonePrefix + m.zone,
+		Status:             metav1.ConditionTrue,
+		Reason:             reasonApplied,
+		Message:            types.GetZoneStatus(m.zone, "Observability configuration applied"),
+		ObservedGeneration: observ.Generation,
+	}
+	if applyErr != nil {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = reason
+		condition.Message = types.GetZoneStatus(m.zone, types.NetworkObservabilityErrorMsg)
+		if reason == reasonConfigError {
+			// configuration errors are only fixed by the user, report them
+			condition.Message += ": " + applyErr.Error()
+		}
+		// the previous configuration is still in use
+		m.sampConfigLock.RLock()
+		configs = m.appliedConfigs
+		m.sampConfigLock.RUnlock()
+	}
+	collectorStatuses, err := m.getCollectorStatuses(configs)
+	if err != nil {
+		return errors.Join(applyErr, err)
+	}
+
+	existingCondition := meta.FindStatusCondition(observ.Status.Conditions, condition.Type)
+	if existingCondition != nil && existingCondition.Status == condition.Status && existingCondition.Reason == condition.Reason &&
+		existingCondition.Message == condition.Message && existingCondition.ObservedGeneration == condition.ObservedGeneration &&
+		reflect.DeepEqual(m.getZoneCollectorStatuses(observ), collectorStatuses) {
+		// already set to the same value
+		return applyErr
+	}
+	condition.LastTransitionTime = metav1.NewTime(time.Now())
+	if existingCondition != nil && existingCondition.Status == condition.Status {
+		condition.LastTransitionTime = existingCondition.LastTransitionTime
+	}
+
+	applyStatus := networkobservabilityapply.NetworkObservabilityStatus().
+		WithConditions(&metaapplyv1.ConditionApplyConfiguration{
+			Type:               &condition.Type,
+			Status:             &condition.Status,
+			ObservedGeneration: &condition.ObservedGeneration,
+			LastTransitionTime: &condition.LastTransitionTime,
+			Reason:             &condition.Reason,
+			Message:            &condition.Message,
+		})
+	for _, collectorStatus := range collectorStatuses {
+		applyStatus.WithCollectors(networkobservabilityapply.CollectorStatus().
+			WithZone(collectorStatus.Zone).
+			WithCollectorSetID(collectorStatus.CollectorSetID).
+			WithCollectorIDs(collectorStatus.CollectorIDs...))
+	}
+	applyObj := networkobservabilityapply.NetworkObservability(observ.Name).
+		WithStatus(applyStatus)
+	_, err = m.client.K8sV1().NetworkObservabilities().ApplyStatus(context.TODO(), applyObj,
+		metav1.ApplyOptions{FieldManager: m.zone, Force: true})
+	if err != nil {
+		return errors.Join(applyErr, fmt.Errorf("failed to update status of NetworkObservability %s: %w", observ.Name, err))
+	}
+	return applyErr
+}
+
+// getZoneCollectorStatuses returns the collectors reported by the zone in the NetworkObservability status.
+func (m *Manager) getZoneCollectorStatuses(observ *networkobservabilityapi.NetworkObservability) []networkobservabilityapi.CollectorStatus {
+	statuses := []networkobservabilityapi.CollectorStatus{}
+	for _, status := range observ.Status.Collectors {
+		if status.Zone == m.zone {
+			statuses = append(statuses, status)
+		}
+	}
+	return statuses
+}
+
+// getCollectorStatuses returns the IDs of the nbdb collectors used by every collector set in configs.
+func (m *Manager) getCollectorStatuses(configs []*collectorConfig) ([]networkobservabilityapi.CollectorStatus, error) {
+	m.collectorsLock.Lock()
+	collectorUUIDs := sets.New[string]()
+	for key, uuid := range m.dbCollectors {
+		if _, unused := m.unusedCollectors[key]; !unused {
+			collectorUUIDs.Insert(uuid)
+		}
+	}
+	m.collectorsLock.Unlock()
+	collectors, err := libovsdbops.FindSampleCollectorWithPredicate(m.nbClient, func(collector *nbdb.SampleCollector) bool {
+		return collectorUUIDs.Has(collector.UUID)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find sample collectors: %w", err)
+	}
+	statuses := make([]networkobservabilityapi.CollectorStatus, 0, len(configs))
+	for _, config := range configs {
+		status := networkobservabilityapi.CollectorStatus{
+			Zone:           m.zone,
+			CollectorSetID: int64(config.collectorSetID),
+		}
+		for _, collector := range collectors {
+			if collector.SetID == config.collectorSetID {
+				status.CollectorIDs = append(status.CollectorIDs, int32(collector.ID))
+			}
+		}
+		slices.Sort(status.CollectorIDs)
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+func observabilityNeedsUpdate(oldObj, newObj *networkobservabilityapi.NetworkObservability) bool {
+	return oldObj == nil || newObj == nil || oldObj.Generation != newObj.Generation
+}
+
+func labelsNeedUpdate[T corev1.Namespace | corev1.Pod](oldObj, newObj *T) bool {
+	if oldObj == nil || newObj == nil {
+		return true
+	}
+	return !reflect.DeepEqual(any(oldObj).(metav1.Object).GetLabels(), any(newObj).(metav1.Object).GetLabels())
+}
+
+func netpolNeedsUpdate(oldObj, newObj *knet.NetworkPolicy) bool {
+	return oldObj == nil || newObj == nil || !reflect.DeepEqual(oldObj.Spec.PodSelector, newObj.Spec.PodSelector)
+}
+
 func (m *Manager) setDbCollectors() error {
 	m.collectorsLock.Lock()
 	defer m.collectorsLock.Unlock()
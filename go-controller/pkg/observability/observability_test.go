@@ -1,15 +1,34 @@
 package observability
 
 import (
-	. "github.com/onsi/ginkgo/v2"
-	. "github.com/onsi/gomega"
+	"context"
+	"encoding/json"
 	"strings"
+	"sync/atomic"
 	"time"
 
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
 	libovsdbclient "github.com/ovn-org/libovsdb/client"
+	corev1 "k8s.io/api/core/v1"
+	knet "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	networkobservabilityapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkobservability/v1"
+	networkobservabilityfake "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkobservability/v1/apis/clientset/versioned/fake"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
 	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
 	libovsdbtest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
 )
 
 var _ = Describe("Observability Manager", func() {
@@ -28,7 +47,7 @@ var _ = Describe("Observability Manager", func() {
 		nbClient, _, libovsdbCleanup, err = libovsdbtest.NewNBSBTestHarness(libovsdbtest.TestSetup{
 			NBData: data})
 		Expect(err).NotTo(HaveOccurred())
-		manager = NewManager(nbClient)
+		manager = NewManager(nbClient, nil, nil, "")
 		err = manager.Init()
 		Expect(err).NotTo(HaveOccurred())
 	}
@@ -257,7 +276,7 @@ var _ = Describe("Observability Manager", func() {
 			nbClient, _, libovsdbCleanup, err = libovsdbtest.NewNBSBTestHarness(libovsdbtest.TestSetup{
 				NBData: data})
 			Expect(err).NotTo(HaveOccurred())
-			manager = NewManager(nbClient)
+			manager = NewManager(nbClient, nil, nil, "")
 			// tweak retry interval for testing
 			manager.unusedCollectorsRetryInterval = time.Second
 			err = manager.initWithConfig(config)
@@ -371,4 +390,329 @@ var _ = Describe("Observability Manager", func() {
 			Eventually(nbClient, 2*manager.unusedCollectorsRetryInterval).Should(libovsdbtest.HaveData(expectedDB))
 		})
 	})
+
+	When("NetworkObservability is used", func() {
+		var (
+			fakeClient   *util.OVNClientset
+			watchFactory *factory.WatchFactory
+		)
+
+		startManagerWithObjects := func(data []libovsdbtest.TestData, objects ...runtime.Object) {
+			Expect(config.PrepareTestConfig()).To(Succeed())
+			config.OVNKubernetesFeature.EnableObservability = true
+			var err error
+			nbClient, _, libovsdbCleanup, err = libovsdbtest.NewNBSBTestHarness(libovsdbtest.TestSetup{
+				NBData: data})
+			Expect(err).NotTo(HaveOccurred())
+			fakeClient = util.GetOVNClientset(objects...)
+			watchFactory, err = factory.NewOVNKubeControllerWatchFactory(fakeClient.GetOVNKubeControllerClientset())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(watchFactory.Start()).To(Succeed())
+			manager = NewManager(nbClient, watchFactory, fakeClient.ObservabilityClient, config.Default.Zone)
+			// tweak retry interval for testing
+			manager.unusedCollectorsRetryInterval = time.Second
+			Expect(manager.Init()).To(Succeed())
+		}
+
+		appliedInZoneCondition := func() string {
+			return networkobservabilityapi.NetworkObservabilityConditionAppliedInZonePrefix + config.Default.Zone
+		}
+
+		getStatus := func() networkobservabilityapi.NetworkObservabilityStatus {
+			observ, err := fakeClient.ObservabilityClient.K8sV1().NetworkObservabilities().Get(context.TODO(),
+				NetworkObservabilityName, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			return observ.Status
+		}
+
+		newNetworkObservability := func(collectors ...networkobservabilityapi.NetworkObservabilityCollector) *networkobservabilityapi.NetworkObservability {
+			return &networkobservabilityapi.NetworkObservability{
+				ObjectMeta: metav1.ObjectMeta{Name: NetworkObservabilityName, Generation: 1},
+				Spec: networkobservabilityapi.NetworkObservabilitySpec{
+					Collectors: collectors,
+				},
+			}
+		}
+
+		egressFirewallACL := func(namespace string) *nbdb.ACL {
+			return &nbdb.ACL{
+				UUID: "acl-" + namespace + "-uuid",
+				ExternalIDs: map[string]string{
+					libovsdbops.OwnerTypeKey.String():  libovsdbops.EgressFirewallOwnerType,
+					libovsdbops.ObjectNameKey.String(): namespace,
+					libovsdbops.PrimaryIDKey.String():  "egress-firewall-" + namespace,
+				},
+			}
+		}
+
+		AfterEach(func() {
+			if manager != nil {
+				manager.Stop()
+			}
+			if watchFactory != nil {
+				watchFactory.Shutdown()
+			}
+		})
+
+		It("should create collectors for every collector set and report them in the status", func() {
+			startManagerWithObjects(samplingApps, newNetworkObservability(
+				networkobservabilityapi.NetworkObservabilityCollector{
+					CollectorSetID: 2,
+					Features: []networkobservabilityapi.FeatureSampling{
+						{Feature: networkobservabilityapi.NetworkPolicy, Probability: 50},
+					},
+				},
+				networkobservabilityapi.NetworkObservabilityCollector{
+					CollectorSetID: 1,
+					Features: []networkobservabilityapi.FeatureSampling{
+						{Feature: networkobservabilityapi.NetworkPolicy, Probability: 100},
+						{Feature: networkobservabilityapi.EgressFirewall, Probability: 100},
+					},
+				},
+			))
+			expectedDB := append(samplingApps,
+				&nbdb.SampleCollector{
+					UUID:        collectorUUID + "-1",
+					ID:          1,
+					SetID:       1,
+					Probability: 65535,
+					ExternalIDs: map[string]string{
+						collectorFeaturesExternalID: strings.Join([]string{libovsdbops.EgressFirewallSample,
+							libovsdbops.NetworkPolicySample}, ","),
+					},
+				},
+				&nbdb.SampleCollector{
+					UUID:        collectorUUID + "-2",
+					ID:          2,
+					SetID:       2,
+					Probability: 32767,
+					ExternalIDs: map[string]string{
+						collectorFeaturesExternalID: libovsdbops.NetworkPolicySample,
+					},
+				},
+			)
+			Eventually(nbClient).Should(libovsdbtest.HaveData(expectedDB))
+			Eventually(func() []networkobservabilityapi.CollectorStatus {
+				return getStatus().Collectors
+			}).Should(Equal([]networkobservabilityapi.CollectorStatus{
+				{Zone: config.Default.Zone, CollectorSetID: 1, CollectorIDs: []int32{1}},
+				{Zone: config.Default.Zone, CollectorSetID: 2, CollectorIDs: []int32{2}},
+			}))
+			Expect(meta.IsStatusConditionTrue(getStatus().Conditions, appliedInZoneCondition())).To(BeTrue())
+		})
+
+		It("should apply the status of its zone only, with the zone as field manager", func() {
+			observ := newNetworkObservability(networkobservabilityapi.NetworkObservabilityCollector{
+				CollectorSetID: 1,
+				Features: []networkobservabilityapi.FeatureSampling{
+					{Feature: networkobservabilityapi.NetworkPolicy, Probability: 100},
+				},
+			})
+			observ.Status = networkobservabilityapi.NetworkObservabilityStatus{
+				Collectors: []networkobservabilityapi.CollectorStatus{
+					{Zone: "other", CollectorSetID: 1, CollectorIDs: []int32{5}},
+				},
+				Conditions: []metav1.Condition{{
+					Type:    networkobservabilityapi.NetworkObservabilityConditionAppliedInZonePrefix + "other",
+					Status:  metav1.ConditionTrue,
+					Reason:  reasonApplied,
+					Message: types.GetZoneStatus("other", "Observability configuration applied"),
+				}},
+			}
+			var appliedStatuses atomic.Pointer[networkobservabilityapi.NetworkObservability]
+			var fieldManager atomic.Value
+			fakeObservClient := networkobservabilityfake.NewSimpleClientset(observ)
+			fakeObservClient.PrependReactor("patch", "networkobservabilities", func(action clienttesting.Action) (bool, runtime.Object, error) {
+				patchAction := action.(clienttesting.PatchActionImpl)
+				if patchAction.GetSubresource() != "status" || patchAction.GetPatchType() != k8stypes.ApplyPatchType {
+					return false, nil, nil
+				}
+				applied := &networkobservabilityapi.NetworkObservability{}
+				Expect(json.Unmarshal(patchAction.GetPatch(), applied)).To(Succeed())
+				appliedStatuses.Store(applied)
+				fieldManager.Store(patchAction.PatchOptions.FieldManager)
+				return false, nil, nil
+			})
+			startManagerWithObjects(samplingApps)
+			manager.client = fakeObservClient
+			Expect(manager.updateStatus(observ, manager.appliedConfigs, nil, "")).To(Succeed())
+
+			Expect(fieldManager.Load()).To(Equal(config.Default.Zone))
+			applied := appliedStatuses.Load()
+			Expect(applied).NotTo(BeNil())
+			Expect(applied.Status.Status).To(BeEmpty())
+			Expect(applied.Status.Conditions).To(HaveLen(1))
+			Expect(applied.Status.Conditions[0].Type).To(Equal(appliedInZoneCondition()))
+			Expect(applied.Status.Collectors).To(Equal([]networkobservabilityapi.CollectorStatus{
+				{Zone: config.Default.Zone, CollectorSetID: DefaultObservabilityCollectorSetID, CollectorIDs: []int32{1}},
+			}))
+		})
+
+		It("should re-sample existing ACLs and cleanup stale collectors when the configuration is created", func() {
+			acl := &nbdb.ACL{
+				UUID: "acl-uuid",
+				ExternalIDs: map[string]string{
+					libovsdbops.OwnerTypeKey.String(): libovsdbops.NetworkPolicyOwnerType,
+				},
+			}
+			pg := &nbdb.PortGroup{
+				UUID: "pg-uuid",
+				ACLs: []string{acl.UUID},
+			}
+			sample := &nbdb.Sample{
+				UUID:       "sample-uuid",
+				Metadata:   int(libovsdbops.GetACLSampleID(acl)),
+				Collectors: []string{collectorUUID},
+			}
+			acl.SampleNew = &sample.UUID
+			acl.SampleEst = &sample.UUID
+			// no NetworkObservability, the default collector is used
+			startManagerWithObjects(append(initialDB, sample, acl, pg))
+			Eventually(nbClient).Should(libovsdbtest.HaveData(append(initialDB, sample, acl, pg)))
+
+			_, err := fakeClient.ObservabilityClient.K8sV1().NetworkObservabilities().Create(context.TODO(),
+				newNetworkObservability(networkobservabilityapi.NetworkObservabilityCollector{
+					CollectorSetID: 7,
+					Features: []networkobservabilityapi.FeatureSampling{
+						{Feature: networkobservabilityapi.NetworkPolicy, Probability: 100},
+					},
+				}), metav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+
+			newCollector := &nbdb.SampleCollector{
+				UUID:        collectorUUID + "-2",
+				ID:          2,
+				SetID:       7,
+				Probability: 65535,
+				ExternalIDs: map[string]string{
+					collectorFeaturesExternalID: libovsdbops.NetworkPolicySample,
+				},
+			}
+			sample.Collectors = []string{newCollector.UUID}
+			Eventually(nbClient, 2*manager.unusedCollectorsRetryInterval).Should(
+				libovsdbtest.HaveData(append(samplingApps, newCollector, sample, acl, pg)))
+		})
+
+		It("should only sample the ACLs of the selected namespaces", func() {
+			sampledNamespace := util.NewNamespace("sampled")
+			sampledNamespace.Labels = map[string]string{"sampled": "true"}
+			otherNamespace := util.NewNamespace("other")
+			startManagerWithObjects(samplingApps,
+				&corev1.NamespaceList{Items: []corev1.Namespace{*sampledNamespace, *otherNamespace}},
+				newNetworkObservability(networkobservabilityapi.NetworkObservabilityCollector{
+					CollectorSetID: 1,
+					Features: []networkobservabilityapi.FeatureSampling{
+						{Feature: networkobservabilityapi.EgressFirewall, Probability: 100},
+					},
+					NamespaceSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"sampled": "true"},
+					},
+				}))
+			collector := &nbdb.SampleCollector{
+				UUID:        collectorUUID,
+				ID:          1,
+				SetID:       1,
+				Probability: 65535,
+				ExternalIDs: map[string]string{
+					collectorFeaturesExternalID: libovsdbops.EgressFirewallSample,
+				},
+			}
+			sampledACL := egressFirewallACL(sampledNamespace.Name)
+			otherACL := egressFirewallACL(otherNamespace.Name)
+			ops, err := libovsdbops.CreateOrUpdateACLsOps(nbClient, nil, manager.SamplingConfig(), sampledACL, otherACL)
+			Expect(err).NotTo(HaveOccurred())
+			pg := &nbdb.PortGroup{
+				UUID: "pg-uuid",
+				ACLs: []string{sampledACL.UUID, otherACL.UUID},
+			}
+			ops, err = libovsdbops.CreateOrUpdatePortGroupsOps(nbClient, ops, pg)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = libovsdbops.TransactAndCheck(nbClient, ops)
+			Expect(err).NotTo(HaveOccurred())
+
+			sample := &nbdb.Sample{
+				UUID:       "sample-sampled-uuid",
+				Metadata:   int(libovsdbops.GetACLSampleID(sampledACL)),
+				Collectors: []string{collector.UUID},
+			}
+			sampledACL.SampleNew = &sample.UUID
+			sampledACL.SampleEst = &sample.UUID
+			Eventually(nbClient).Should(libovsdbtest.HaveData(append(samplingApps, collector, sample, pg, sampledACL, otherACL)))
+
+			// select the other namespace too, its ACL should be sampled
+			otherNamespace.Labels = map[string]string{"sampled": "true"}
+			_, err = fakeClient.KubeClient.CoreV1().Namespaces().Update(context.TODO(), otherNamespace, metav1.UpdateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			otherSample := &nbdb.Sample{
+				UUID:       "sample-other-uuid",
+				Metadata:   int(libovsdbops.GetACLSampleID(otherACL)),
+				Collectors: []string{collector.UUID},
+			}
+			otherACL.SampleNew = &otherSample.UUID
+			otherACL.SampleEst = &otherSample.UUID
+			Eventually(nbClient).Should(libovsdbtest.HaveData(append(samplingApps, collector, sample, otherSample, pg, sampledACL, otherACL)))
+		})
+
+		It("should only select the network policies applying to the selected pods", func() {
+			newPod := func(namespace, name, app string) *corev1.Pod {
+				return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name,
+					Labels: map[string]string{"app": app, "sampled": "true"}}}
+			}
+			newPolicy := func(namespace, name, app string) *knet.NetworkPolicy {
+				return &knet.NetworkPolicy{
+					ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+					Spec: knet.NetworkPolicySpec{
+						PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": app}},
+					},
+				}
+			}
+			unselectedPod := newPod("ns1", "unselected", "db")
+			unselectedPod.Labels["sampled"] = "false"
+			startManagerWithObjects(samplingApps,
+				newPod("ns1", "web", "web"),
+				unselectedPod,
+				newPod("ns2", "web", "web"),
+				newPolicy("ns1", "web", "web"),
+				newPolicy("ns1", "db", "db"),
+				newPolicy("ns2", "web", "web"),
+				newPolicy("ns3", "web", "web"),
+			)
+			podSelector, err := metav1.LabelSelectorAsSelector(&metav1.LabelSelector{
+				MatchLabels: map[string]string{"sampled": "true"}})
+			Expect(err).NotTo(HaveOccurred())
+
+			policies, err := manager.getSelectedNetworkPolicies(podSelector, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(policies.UnsortedList()).To(ConsistOf("ns1/web", "ns2/web"))
+
+			policies, err = manager.getSelectedNetworkPolicies(podSelector, sets.New[string]("ns2", "ns3"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(policies.UnsortedList()).To(ConsistOf("ns2/web"))
+		})
+
+		It("should report invalid configuration and keep the default one", func() {
+			startManagerWithObjects(initialDB, newNetworkObservability(networkobservabilityapi.NetworkObservabilityCollector{
+				CollectorSetID: 1,
+				Features: []networkobservabilityapi.FeatureSampling{
+					{Feature: networkobservabilityapi.NetworkPolicy, Probability: 100},
+				},
+				NamespaceSelector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "sampled", Operator: "Invalid"}},
+				},
+			}))
+			Eventually(func() *metav1.Condition {
+				return meta.FindStatusCondition(getStatus().Conditions, appliedInZoneCondition())
+			}).Should(And(Not(BeNil()), HaveField("Reason", reasonConfigError)))
+			condition := meta.FindStatusCondition(getStatus().Conditions, appliedInZoneCondition())
+			Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+			Expect(condition.Message).To(Equal(types.GetZoneStatus(config.Default.Zone,
+				types.NetworkObservabilityErrorMsg+": invalid collector set 1: invalid namespace selector: "+
+					`"Invalid" is not a valid label selector operator`)))
+			// the collectors of the default configuration still in use are reported
+			Expect(getStatus().Collectors).To(Equal([]networkobservabilityapi.CollectorStatus{
+				{Zone: config.Default.Zone, CollectorSetID: DefaultObservabilityCollectorSetID, CollectorIDs: []int32{1}},
+			}))
+			Consistently(nbClient).Should(libovsdbtest.HaveData(initialDB))
+		})
+	})
 })
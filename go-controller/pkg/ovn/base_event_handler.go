@@ -12,6 +12,7 @@ import (
 	ipamclaimsapi "github.com/k8snetworkplumbingwg/ipamclaims/pkg/crd/ipamclaims/v1alpha1"
 	mnpapi "github.com/k8snetworkplumbingwg/multi-networkpolicy/pkg/apis/k8s.cni.cncf.io/v1beta1"
 	egressfirewall "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressfirewall/v1"
+	egressipv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/metrics"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
@@ -90,8 +91,20 @@ func (h *baseNetworkControllerEventHandler) areResourcesEqual(objType reflect.Ty
 		}
 		return reflect.DeepEqual(oldEgressFirewall.Spec, newEgressFirewall.Spec), nil
 
-	case factory.EgressIPType,
-		factory.EgressIPNamespaceType,
+	case factory.EgressIPType:
+		oldEIP, ok := obj1.(*egressipv1.EgressIP)
+		if !ok {
+			return false, fmt.Errorf("could not cast obj1 of type %T to *egressipv1.EgressIP", obj1)
+		}
+		newEIP, ok := obj2.(*egressipv1.EgressIP)
+		if !ok {
+			return false, fmt.Errorf("could not cast obj2 of type %T to *egressipv1.EgressIP", obj2)
+		}
+		// skip the status condition updates, otherwise force update path for
+		// EgressIP resource.
+		return util.IsEgressIPConditionsOnlyUpdate(oldEIP, newEIP), nil
+
+	case factory.EgressIPNamespaceType,
 		factory.EgressNodeType:
 		// force update path for EgressIP resource.
 		return false, nil
@@ -12,6 +12,9 @@ import (
 
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/allocator/pod"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/controller"
+	egressfirewallapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressfirewall/v1"
+	egressqoslisters "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressqos/v1/apis/listers/egressqos/v1"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/kube"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/kubevirt"
@@ -22,6 +25,9 @@ import (
 	nad "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/network-attach-def-controller"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/observability"
 	addressset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/address_set"
+	anpcontroller "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/controller/admin_network_policy"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/controller/networkpeering"
+	dnsnameresolver "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/dns_name_resolver"
 	lsm "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/logical_switch_manager"
 	zoneic "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/zone_interconnect"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/persistentips"
@@ -38,8 +44,11 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	clientset "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	ref "k8s.io/client-go/tools/reference"
+	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
 	utilnet "k8s.io/utils/net"
 )
@@ -63,8 +72,8 @@ type CommonNetworkControllerInfo struct {
 	// has SCTP support
 	SCTPSupport bool
 
-	// has multicast support; set to false for secondary networks.
-	// TBD: Changes need to be made to support multicast for secondary networks
+	// has multicast support; set to false for localnet networks.
+	// TBD: Changes need to be made to support multicast for localnet networks
 	multicastSupport bool
 
 	// Supports OVN Template Load Balancers?
@@ -175,6 +184,33 @@ type BaseNetworkController struct {
 	// connecting to the join switch
 	ovnClusterLRPToJoinIfAddrs []*net.IPNet
 
+	// EgressQoS
+	egressQoSLister egressqoslisters.EgressQoSLister
+	egressQoSSynced cache.InformerSynced
+	egressQoSQueue  workqueue.TypedRateLimitingInterface[string]
+	egressQoSCache  sync.Map
+
+	egressQoSPodLister corev1listers.PodLister
+	egressQoSPodSynced cache.InformerSynced
+	egressQoSPodQueue  workqueue.TypedRateLimitingInterface[string]
+
+	egressQoSNodeLister corev1listers.NodeLister
+	egressQoSNodeSynced cache.InformerSynced
+	egressQoSNodeQueue  workqueue.TypedRateLimitingInterface[string]
+	// event handlers registered by the EgressQoS controller
+	egressQoSHandlers []egressQoSHandler
+
+	// egressFirewalls is a map of namespaces and the egressFirewall attached to it
+	egressFirewalls sync.Map
+	// dnsNameResolver is used for resolving the IP addresses of DNS names
+	// used in egress firewall rules
+	dnsNameResolver  dnsnameresolver.DNSNameResolver
+	efNodeController controller.Controller
+	// retry framework for egress firewall
+	retryEgressFirewalls *ovnretry.RetryFramework
+	// egress firewall events factory handler
+	egressFirewallHandler *factory.Handler
+
 	observManager *observability.Manager
 }
 
@@ -189,6 +225,11 @@ type BaseSecondaryNetworkController struct {
 	netPolicyHandler *factory.Handler
 	// multi-network policy events factory handler
 	multiNetPolicyHandler *factory.Handler
+
+	// admin network policy controller of a primary network
+	anpController *anpcontroller.Controller
+	// network peering controller of a primary network
+	networkPeeringController *networkpeering.Controller
 }
 
 func getNetworkControllerName(netName string) string {
@@ -370,7 +411,7 @@ func (bnc *BaseNetworkController) createNodeLogicalSwitch(nodeName string, hostS
 	logicalSwitch.ExternalIDs = util.GenerateExternalIDsForSwitchOrRouter(bnc.NetInfo)
 	var v4Gateway, v6Gateway net.IP
 	logicalSwitch.OtherConfig = map[string]string{}
-	for _, hostSubnet := range hostSubnets {
+	for _, hostSubnet := range util.GetNodePrimaryHostSubnets(hostSubnets) {
 		gwIfAddr := util.GetNodeGatewayIfAddr(hostSubnet)
 		mgmtIfAddr := util.GetNodeManagementIfAddr(hostSubnet)
 
@@ -634,7 +675,8 @@ func (bnc *BaseNetworkController) syncNodeManagementPort(node *kapi.Node, switch
 	var v4Subnet *net.IPNet
 	addresses := macAddress.String()
 	mgmtPortIPs := []net.IP{}
-	for _, hostSubnet := range hostSubnets {
+	// the management port IPs are taken from the primary host subnets
+	for _, hostSubnet := range util.GetNodePrimaryHostSubnets(hostSubnets) {
 		mgmtIfAddr := util.GetNodeManagementIfAddr(hostSubnet)
 		addresses += " " + mgmtIfAddr.IP.String()
 		mgmtPortIPs = append(mgmtPortIPs, mgmtIfAddr.IP)
@@ -646,11 +688,18 @@ func (bnc *BaseNetworkController) syncNodeManagementPort(node *kapi.Node, switch
 		if !utilnet.IsIPv6CIDR(hostSubnet) {
 			v4Subnet = hostSubnet
 		}
-		if config.Gateway.Mode == config.GatewayModeLocal {
+	}
+
+	if config.Gateway.Mode == config.GatewayModeLocal {
+		for _, hostSubnet := range hostSubnets {
+			primarySubnet, err := util.MatchFirstIPNetFamily(utilnet.IsIPv6CIDR(hostSubnet), hostSubnets)
+			if err != nil {
+				return nil, err
+			}
 			lrsr := nbdb.LogicalRouterStaticRoute{
 				Policy:   &nbdb.LogicalRouterStaticRoutePolicySrcIP,
 				IPPrefix: hostSubnet.String(),
-				Nexthop:  mgmtIfAddr.IP.String(),
+				Nexthop:  util.GetNodeManagementIfAddr(primarySubnet).IP.String(),
 			}
 			if bnc.IsSecondary() {
 				lrsr.ExternalIDs = map[string]string{
@@ -661,7 +710,7 @@ func (bnc *BaseNetworkController) syncNodeManagementPort(node *kapi.Node, switch
 			p := func(item *nbdb.LogicalRouterStaticRoute) bool {
 				return item.IPPrefix == lrsr.IPPrefix && libovsdbops.PolicyEqualPredicate(lrsr.Policy, item.Policy)
 			}
-			err := libovsdbops.CreateOrReplaceLogicalRouterStaticRouteWithPredicate(bnc.nbClient, routerName,
+			err = libovsdbops.CreateOrReplaceLogicalRouterStaticRouteWithPredicate(bnc.nbClient, routerName,
 				&lrsr, p, &lrsr.Nexthop)
 			if err != nil {
 				return nil, fmt.Errorf("error creating static route %+v on router %s: %v", lrsr, routerName, err)
@@ -701,6 +750,56 @@ func (bnc *BaseNetworkController) syncNodeManagementPort(node *kapi.Node, switch
 	return mgmtPortIPs, nil
 }
 
+// requestNodeHostSubnets requests additional host subnets for a node whose
+// host subnets are running out of IPs, up to the configured maximum. The
+// cluster manager allocates them and sets them in the node subnets annotation.
+func (bnc *BaseNetworkController) requestNodeHostSubnets(nodeName, switchName string) error {
+	if config.Default.MaxHostSubnets < 2 || (bnc.IsSecondary() && bnc.TopologyType() != types.Layer3Topology) {
+		return nil
+	}
+	request, err := bnc.lsManager.GetHostSubnetRequest(switchName)
+	if err != nil {
+		return fmt.Errorf("failed to get host subnet usage of node %s: %w", nodeName, err)
+	}
+	request.IPv4 = min(request.IPv4, config.Default.MaxHostSubnets)
+	request.IPv6 = min(request.IPv6, config.Default.MaxHostSubnets)
+	current := util.CountHostSubnets(bnc.lsManager.GetSwitchSubnets(switchName))
+	if request.IPv4 <= current.IPv4 && request.IPv6 <= current.IPv6 {
+		return nil
+	}
+
+	networkName := bnc.GetNetworkName()
+	node, err := bnc.watchFactory.GetNode(nodeName)
+	if err != nil {
+		return err
+	}
+	existing, err := util.ParseNodeSubnetRequestAnnotation(node, networkName)
+	if err != nil && !util.IsAnnotationNotSetError(err) {
+		return err
+	}
+	if existing.IPv4 >= request.IPv4 && existing.IPv6 >= request.IPv6 {
+		// already requested
+		return nil
+	}
+	request.IPv4 = max(request.IPv4, existing.IPv4)
+	request.IPv6 = max(request.IPv6, existing.IPv6)
+	klog.Infof("Node %s is running out of IPs for network %s, requesting %d IPv4 and %d IPv6 host subnets",
+		nodeName, networkName, request.IPv4, request.IPv6)
+	annotations := map[string]string{}
+	if requests, ok := node.Annotations[util.OvnNodeSubnetRequests]; ok {
+		annotations[util.OvnNodeSubnetRequests] = requests
+	}
+	annotations, err = util.UpdateNodeSubnetRequestAnnotation(annotations, request, networkName)
+	if err != nil {
+		return err
+	}
+	// a request overwritten by a concurrent request of another network is
+	// requested again on the next IP allocation on the node
+	return bnc.kube.SetAnnotationsOnNode(nodeName, map[string]interface{}{
+		util.OvnNodeSubnetRequests: annotations[util.OvnNodeSubnetRequests],
+	})
+}
+
 // WatchNodes starts the watching of the nodes resource and calls back the appropriate handler logic
 func (bnc *BaseNetworkController) WatchNodes() error {
 	if bnc.nodeHandler != nil {
@@ -809,6 +908,19 @@ func (bnc *BaseNetworkController) getActiveNetworkForNamespace(namespace string)
 	return bnc.nadController.GetActiveNetworkForNamespace(namespace)
 }
 
+// isNamespaceServedByNetwork returns true if the network of the controller is
+// the active network of the given namespace
+func (bnc *BaseNetworkController) isNamespaceServedByNetwork(namespace string) (bool, error) {
+	if !util.IsNetworkSegmentationSupportEnabled() {
+		return bnc.IsDefault(), nil
+	}
+	netInfo, err := bnc.getActiveNetworkForNamespace(namespace)
+	if err != nil {
+		return false, err
+	}
+	return bnc.GetNetworkName() == netInfo.GetNetworkName(), nil
+}
+
 // GetNetworkRole returns the role of this controller's
 // network for the given pod
 // Expected values are:
@@ -958,6 +1070,25 @@ func (bnc *BaseNetworkController) AddResourceCommon(objType reflect.Type, obj in
 				np.Namespace, np.Name, err)
 			return err
 		}
+	case factory.EgressFirewallType:
+		egressFirewall, ok := obj.(*egressfirewallapi.EgressFirewall)
+		if !ok {
+			return fmt.Errorf("could not cast %T object to *egressfirewallapi.EgressFirewall", obj)
+		}
+		served, err := bnc.isNamespaceServedByNetwork(egressFirewall.Namespace)
+		if err != nil {
+			return fmt.Errorf("could not get active network for namespace %s: %v", egressFirewall.Namespace, err)
+		}
+		if !served {
+			return nil
+		}
+		egressFirewall = egressFirewall.DeepCopy()
+		err = bnc.addEgressFirewall(egressFirewall)
+		if statusErr := bnc.setEgressFirewallStatus(egressFirewall, err); statusErr != nil {
+			klog.Errorf("Failed to update egress firewall status %s, error: %v",
+				getEgressFirewallNamespacedName(egressFirewall), statusErr)
+		}
+		return err
 	default:
 		klog.Errorf("Can not process add resource event, object type %s is not supported", objType)
 	}
@@ -979,6 +1110,21 @@ func (bnc *BaseNetworkController) DeleteResourceCommon(objType reflect.Type, obj
 			return nil
 		}
 		return bnc.deleteNetworkPolicy(knp)
+	case factory.EgressFirewallType:
+		egressFirewall, ok := obj.(*egressfirewallapi.EgressFirewall)
+		if !ok {
+			return fmt.Errorf("could not cast obj of type %T to *egressfirewallapi.EgressFirewall", obj)
+		}
+		// only the network serving the namespace has the egress firewall
+		if _, loaded := bnc.egressFirewalls.Load(egressFirewall.Namespace); !loaded {
+			return nil
+		}
+		if err := bnc.deleteEgressFirewall(egressFirewall); err != nil {
+			return err
+		}
+		metrics.UpdateEgressFirewallRuleCount(float64(-len(egressFirewall.Spec.Egress)))
+		metrics.DecrementEgressFirewallCount()
+		return nil
 	default:
 		klog.Errorf("Can not process delete resource event, object type %s is not supported", objType)
 	}
@@ -77,9 +77,9 @@ func (bnc *BaseNetworkController) WatchNamespaces() error {
 		return nil
 	}
 
-	if bnc.IsSecondary() && !util.IsMultiNetworkPoliciesSupportEnabled() {
+	if bnc.IsSecondary() && !util.IsMultiNetworkPoliciesSupportEnabled() && !bnc.multicastSupport {
 		// For secondary networks, we don't have to watch namespace events if
-		// multi-network policy support is not enabled.
+		// multi-network policy and multicast support are not enabled.
 		return nil
 	}
 
@@ -164,8 +164,18 @@ func (bnc *BaseNetworkController) syncNamespaces(namespaces []interface{}) error
 			return fmt.Errorf("spurious object in syncNamespaces: %v", nsInterface)
 		}
 		expectedNs[ns.Name] = true
-		if bnc.multicastSupport && isNamespaceMulticastEnabled(ns.Annotations) {
-			nsWithMulticast[ns.Name] = true
+		if bnc.multicastSupport {
+			enabled, err := bnc.isNamespaceMulticastEnabledForNetwork(ns)
+			if err != nil {
+				// keep the existing multicast policy, the namespace will be
+				// reconciled by its add event
+				klog.Warningf("Unable to determine whether multicast is enabled for namespace %s on network %s: %v",
+					ns.Name, bnc.GetNetworkName(), err)
+				enabled = true
+			}
+			if enabled {
+				nsWithMulticast[ns.Name] = true
+			}
 		}
 	}
 
@@ -212,13 +222,15 @@ func (bnc *BaseNetworkController) multicastUpdateNamespace(ns *kapi.Namespace, n
 		return nil
 	}
 
-	enabled := isNamespaceMulticastEnabled(ns.Annotations)
+	enabled, err := bnc.isNamespaceMulticastEnabledForNetwork(ns)
+	if err != nil {
+		return err
+	}
 	enabledOld := nsInfo.multicastEnabled
 	if enabledOld == enabled {
 		return nil
 	}
 
-	var err error
 	nsInfo.multicastEnabled = enabled
 	if enabled {
 		err = bnc.createMulticastAllowPolicy(ns.Name, nsInfo)
@@ -231,6 +243,24 @@ func (bnc *BaseNetworkController) multicastUpdateNamespace(ns *kapi.Namespace, n
 	return nil
 }
 
+// isNamespaceMulticastEnabledForNetwork returns whether multicast is enabled
+// for the namespace on the network of the controller. The namespace annotation
+// only applies to the default or primary user-defined network serving the
+// namespace, and to the secondary networks its pods are attached to.
+func (bnc *BaseNetworkController) isNamespaceMulticastEnabledForNetwork(ns *kapi.Namespace) (bool, error) {
+	if !isNamespaceMulticastEnabled(ns.Annotations) {
+		return false, nil
+	}
+	if bnc.IsSecondary() && !bnc.IsPrimaryNetwork() {
+		return true, nil
+	}
+	served, err := bnc.isNamespaceServedByNetwork(ns.Name)
+	if err != nil {
+		return false, fmt.Errorf("failed to get active network for namespace %s: %w", ns.Name, err)
+	}
+	return served, nil
+}
+
 // Cleans up the multicast policy for this namespace if multicast was
 // previously allowed.
 func (bnc *BaseNetworkController) multicastDeleteNamespace(ns *kapi.Namespace, nsInfo *namespaceInfo) error {
@@ -14,6 +14,7 @@ import (
 	nadapi "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
 	ipallocator "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/allocator/ip"
 	subnetipallocator "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/allocator/ip/subnet"
+	podallocator "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/allocator/pod"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/kubevirt"
@@ -900,6 +901,12 @@ func (bnc *BaseNetworkController) allocatePodAnnotation(pod *kapi.Pod, existingL
 	}
 	releaseIPs = false
 
+	if needsNewMacOrIPAllocation && bnc.doesNetworkRequireIPAM() {
+		if err := bnc.requestNodeHostSubnets(pod.Spec.NodeName, switchName); err != nil {
+			klog.Warningf("Failed to request host subnets for node %s: %v", pod.Spec.NodeName, err)
+		}
+	}
+
 	return podAnnotation, true, nil
 }
 
@@ -957,6 +964,9 @@ func (bnc *BaseNetworkController) allocatePodAnnotationForSecondaryNetwork(pod *
 	)
 
 	if err != nil {
+		if podallocator.IsErrStaticAddressRequest(err) {
+			bnc.recordPodErrorEvent(pod, err)
+		}
 		return nil, false, err
 	}
 
@@ -969,6 +979,12 @@ func (bnc *BaseNetworkController) allocatePodAnnotationForSecondaryNetwork(pod *
 			pod.Namespace, pod.Name, nadName,
 		)
 
+		if ipAllocator != nil {
+			if err := bnc.requestNodeHostSubnets(pod.Spec.NodeName, switchName); err != nil {
+				klog.Warningf("Failed to request host subnets for node %s on network %s: %v", pod.Spec.NodeName, bnc.GetNetworkName(), err)
+			}
+		}
+
 		return podAnnotation, true, nil
 	}
 
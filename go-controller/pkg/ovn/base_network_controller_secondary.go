@@ -19,8 +19,11 @@ import (
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/generator/udn"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/kubevirt"
 	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	libovsdbutil "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/util"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/metrics"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	anpcontroller "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/controller/admin_network_policy"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/controller/networkpeering"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/persistentips"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
@@ -334,15 +337,30 @@ func (bsnc *BaseSecondaryNetworkController) addLogicalPortToNetworkForNAD(pod *k
 		}
 	}
 
-	if bsnc.doesNetworkRequireIPAM() && util.IsMultiNetworkPoliciesSupportEnabled() {
+	if bsnc.tracksNamespacePods() {
+		// only local pods are added to the namespace port group
+		var portUUID string
+		if isLocalPod && lsp != nil {
+			portUUID = lsp.UUID
+		}
 		// Ensure the namespace/nsInfo exists
-		addOps, err := bsnc.addPodToNamespaceForSecondaryNetwork(pod.Namespace, podAnnotation.IPs)
+		addOps, err := bsnc.addPodToNamespaceForSecondaryNetwork(pod.Namespace, podAnnotation.IPs, portUUID)
 		if err != nil {
 			return err
 		}
 		ops = append(ops, addOps...)
 	}
 
+	if bsnc.multicastSupport && isLocalPod && lsp != nil {
+		// the default multicast ACLs apply to the switches of the ports in
+		// the cluster port group
+		ops, err = libovsdbops.AddPortsToPortGroupOps(bsnc.nbClient, ops,
+			bsnc.getClusterPortGroupName(types.ClusterPortGroupNameBase), lsp.UUID)
+		if err != nil {
+			return err
+		}
+	}
+
 	if util.IsNetworkSegmentationSupportEnabled() && bsnc.IsPrimaryNetwork() && config.Gateway.DisableSNATMultipleGWs {
 		// we need to add per-pod SNATs for UDN networks
 		snatOps, err := bsnc.addPerPodSNATOps(pod, podAnnotation.IPs)
@@ -429,7 +447,7 @@ func (bsnc *BaseSecondaryNetworkController) removePodForSecondaryNetwork(pod *ka
 
 	// otherwise just delete pod IPs from the namespace address set
 	if !hasLogicalPort {
-		if bsnc.doesNetworkRequireIPAM() && util.IsMultiNetworkPoliciesSupportEnabled() {
+		if bsnc.tracksNamespacePods() {
 			return bsnc.removeRemoteZonePodFromNamespaceAddressSet(pod)
 		}
 
@@ -488,6 +506,8 @@ func (bsnc *BaseSecondaryNetworkController) removePodForSecondaryNetwork(pod *ka
 			continue
 		}
 
+		bsnc.podAnnotationAllocator.ReleasePodMAC(pod, nadName)
+
 		// do not release IP address unless we have validated no other pod is using it
 		if pInfo == nil || len(pInfo.ips) == 0 {
 			bsnc.forgetPodReleasedBeforeStartup(string(pod.UID), nadName)
@@ -615,6 +635,12 @@ func (bsnc *BaseSecondaryNetworkController) syncPodsForSecondaryNetwork(pods []i
 				if expectedLogicalPortName != "" {
 					expectedLogicalPorts[expectedLogicalPortName] = true
 				}
+				// likewise reserve the MAC address so that it is not
+				// requested by a new pod
+				if err = bsnc.podAnnotationAllocator.ReservePodMAC(pod, nadName); err != nil {
+					klog.Errorf("Failed to reserve the MAC address of pod %s/%s for NAD %s: %v",
+						pod.Namespace, pod.Name, nadName, err)
+				}
 
 				if annotatedLocalPods[pod] == nil {
 					annotatedLocalPods[pod] = map[string]*util.PodAnnotation{}
@@ -634,8 +660,73 @@ func (bsnc *BaseSecondaryNetworkController) syncPodsForSecondaryNetwork(pods []i
 	return bsnc.deleteStaleLogicalSwitchPorts(expectedLogicalPorts)
 }
 
-// addPodToNamespaceForSecondaryNetwork returns the ops needed to add pod's IP to the namespace's address set.
-func (bsnc *BaseSecondaryNetworkController) addPodToNamespaceForSecondaryNetwork(ns string, ips []*net.IPNet) ([]ovsdb.Operation, error) {
+// tracksNamespacePods returns whether the pods of the network are added to
+// their namespace address set and port group, as required by multi-network
+// policies, primary network features and multicast.
+func (bsnc *BaseSecondaryNetworkController) tracksNamespacePods() bool {
+	return bsnc.doesNetworkRequireIPAM() &&
+		(util.IsMultiNetworkPoliciesSupportEnabled() || bsnc.IsPrimaryNetwork() || bsnc.multicastSupport)
+}
+
+// initClusterMulticast creates the cluster port groups of the network and its
+// default multicast policies if multicast is supported, or removes them
+// otherwise. The router port group and the policy allowing multicast through
+// the cluster router are only created for networks with a cluster router.
+func (bsnc *BaseSecondaryNetworkController) initClusterMulticast(withClusterRouter bool) error {
+	if !bsnc.multicastSupport {
+		// the cluster port groups are only used by multicast on secondary networks
+		predicateIDs := libovsdbops.NewDbObjectIDs(libovsdbops.PortGroupCluster, bsnc.controllerName, nil)
+		p := libovsdbops.GetPredicate[*nbdb.PortGroup](predicateIDs, nil)
+		if err := libovsdbops.DeletePortGroupsWithPredicate(bsnc.nbClient, p); err != nil {
+			return fmt.Errorf("unable to delete cluster port groups: %w", err)
+		}
+		if err := bsnc.syncNsMulticast(map[string]bool{}); err != nil {
+			return fmt.Errorf("unable to delete namespaced multicast objects: %w", err)
+		}
+		return nil
+	}
+
+	pgBases := []string{types.ClusterPortGroupNameBase}
+	if withClusterRouter {
+		pgBases = append(pgBases, types.ClusterRtrPortGroupNameBase)
+	}
+	for _, pgBase := range pgBases {
+		pgIDs := bsnc.getClusterPortGroupDbIDs(pgBase)
+		pg := &nbdb.PortGroup{
+			Name: libovsdbutil.GetPortGroupName(pgIDs),
+		}
+		pg, err := libovsdbops.GetPortGroup(bsnc.nbClient, pg)
+		if err != nil && !errors.Is(err, libovsdbclient.ErrNotFound) {
+			return err
+		}
+		if pg != nil {
+			continue
+		}
+		// do not override the ports of an existing port group
+		pg = libovsdbutil.BuildPortGroup(pgIDs, nil, nil)
+		if err = libovsdbops.CreateOrUpdatePortGroups(bsnc.nbClient, pg); err != nil {
+			return fmt.Errorf("failed to create port group %s: %w", pgBase, err)
+		}
+	}
+
+	// Drop IP multicast globally. Multicast is allowed only if explicitly
+	// enabled in a namespace.
+	if err := bsnc.createDefaultDenyMulticastPolicy(); err != nil {
+		return fmt.Errorf("failed to create default deny multicast policy: %w", err)
+	}
+	if withClusterRouter {
+		// Allow IP multicast from node switch to cluster router and from
+		// cluster router to node switch.
+		if err := bsnc.createDefaultAllowMulticastPolicy(); err != nil {
+			return fmt.Errorf("failed to create default allow multicast policy: %w", err)
+		}
+	}
+	return nil
+}
+
+// addPodToNamespaceForSecondaryNetwork returns the ops needed to add pod's IP to the namespace's address set
+// and, if a port UUID is provided, the pod's port to the namespace's port group.
+func (bsnc *BaseSecondaryNetworkController) addPodToNamespaceForSecondaryNetwork(ns string, ips []*net.IPNet, portUUID string) ([]ovsdb.Operation, error) {
 	var ops []ovsdb.Operation
 	var err error
 	nsInfo, nsUnlock, err := bsnc.ensureNamespaceLockedForSecondaryNetwork(ns, true, nil)
@@ -649,6 +740,12 @@ func (bsnc *BaseSecondaryNetworkController) addPodToNamespaceForSecondaryNetwork
 		return nil, err
 	}
 
+	if nsInfo.portGroupName != "" && portUUID != "" {
+		if ops, err = libovsdbops.AddPortsToPortGroupOps(bsnc.nbClient, ops, nsInfo.portGroupName, portUUID); err != nil {
+			return nil, err
+		}
+	}
+
 	return ops, nil
 }
 
@@ -769,6 +866,107 @@ func cleanupPolicyLogicalEntities(nbClient libovsdbclient.Client, ops []ovsdb.Op
 	return ops, nil
 }
 
+// startANPController creates and runs the admin network policy controller of a
+// primary user defined network
+func (bsnc *BaseSecondaryNetworkController) startANPController() error {
+	if !config.OVNKubernetesFeature.EnableAdminNetworkPolicy || bsnc.anpController != nil {
+		return nil
+	}
+	var err error
+	bsnc.anpController, err = anpcontroller.NewController(
+		bsnc.controllerName,
+		bsnc.NetInfo,
+		bsnc.nbClient,
+		bsnc.kube.ANPClient,
+		bsnc.watchFactory.ANPInformer(),
+		bsnc.watchFactory.BANPInformer(),
+		bsnc.watchFactory.NamespaceCoreInformer(),
+		bsnc.watchFactory.PodCoreInformer(),
+		bsnc.watchFactory.NodeCoreInformer(),
+		bsnc.addressSetFactory,
+		bsnc.isPodScheduledinLocalZone,
+		bsnc.getActiveNetworkForNamespace,
+		bsnc.zone,
+		bsnc.recorder,
+		bsnc.observManager,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to create admin network policy controller for network %s: %w", bsnc.GetNetworkName(), err)
+	}
+	bsnc.wg.Add(1)
+	go func() {
+		defer bsnc.wg.Done()
+		bsnc.anpController.Run(1, bsnc.stopChan)
+	}()
+	return nil
+}
+
+// cleanupANPStatuses removes the conditions set by the admin network policy
+// controller of a primary user defined network from the status of the policies
+func (bsnc *BaseSecondaryNetworkController) cleanupANPStatuses() {
+	if !config.OVNKubernetesFeature.EnableAdminNetworkPolicy || !bsnc.IsPrimaryNetwork() {
+		return
+	}
+	anpcontroller.CleanupNetworkStatuses(
+		bsnc.kube.ANPClient,
+		bsnc.watchFactory.ANPInformer().Lister(),
+		bsnc.watchFactory.BANPInformer().Lister(),
+		bsnc.zone,
+		bsnc.NetInfo,
+	)
+}
+
+// startEgressQoSController initializes and runs the EgressQoS controller of a
+// primary network
+func (bsnc *BaseSecondaryNetworkController) startEgressQoSController() error {
+	if !config.OVNKubernetesFeature.EnableEgressQoS || bsnc.egressQoSQueue != nil {
+		return nil
+	}
+	err := bsnc.initEgressQoSController(
+		bsnc.watchFactory.EgressQoSInformer(),
+		bsnc.watchFactory.PodCoreInformer(),
+		bsnc.watchFactory.NodeCoreInformer())
+	if err != nil {
+		return fmt.Errorf("unable to create egress qos controller for network %s: %w", bsnc.GetNetworkName(), err)
+	}
+	return bsnc.runEgressQoSController(bsnc.wg, 1, bsnc.stopChan)
+}
+
+// startEgressFirewallController runs the handling of the EgressFirewalls of
+// the namespaces served by a primary network
+func (bsnc *BaseSecondaryNetworkController) startEgressFirewallController() error {
+	if !config.OVNKubernetesFeature.EnableEgressFirewall {
+		return nil
+	}
+	if err := bsnc.startEgressFirewall(); err != nil {
+		return fmt.Errorf("unable to start egress firewall for network %s: %w", bsnc.GetNetworkName(), err)
+	}
+	return nil
+}
+
+// startNetworkPeeringController creates and runs the network peering
+// controller of a primary user defined network
+func (bsnc *BaseSecondaryNetworkController) startNetworkPeeringController() error {
+	if !util.IsNetworkSegmentationSupportEnabled() || bsnc.TopologyType() == types.LocalnetTopology ||
+		bsnc.networkPeeringController != nil {
+		return nil
+	}
+	bsnc.networkPeeringController = networkpeering.NewController(
+		bsnc.controllerName,
+		bsnc.NetInfo,
+		bsnc.nbClient,
+		bsnc.zone,
+		bsnc.watchFactory.NetworkPeeringInformer(),
+		bsnc.watchFactory.ServiceCoreInformer(),
+		bsnc.watchFactory.EndpointSliceCoreInformer(),
+		bsnc.watchFactory.NodeCoreInformer(),
+	)
+	if err := bsnc.networkPeeringController.Start(); err != nil {
+		return fmt.Errorf("unable to start network peering controller for network %s: %w", bsnc.GetNetworkName(), err)
+	}
+	return nil
+}
+
 // WatchIPAMClaims starts the watching of IPAMClaim resources and calls
 // back the appropriate handler logic
 func (bsnc *BaseSecondaryNetworkController) WatchIPAMClaims() error {
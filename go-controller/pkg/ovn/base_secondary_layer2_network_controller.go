@@ -31,6 +31,11 @@ func (oc *BaseSecondaryLayer2NetworkController) stop() {
 	oc.cancelableCtx.Cancel()
 	oc.wg.Wait()
 
+	if oc.networkPeeringController != nil {
+		oc.networkPeeringController.Stop()
+	}
+	oc.stopEgressFirewall()
+
 	if oc.ipamClaimsHandler != nil {
 		oc.watchFactory.RemoveIPAMClaimsHandler(oc.ipamClaimsHandler)
 	}
@@ -75,6 +80,8 @@ func (oc *BaseSecondaryLayer2NetworkController) cleanup() error {
 		return fmt.Errorf("failed to deleting switches of network %s: %v", netName, err)
 	}
 
+	oc.cleanupANPStatuses()
+
 	return nil
 }
 
@@ -116,6 +123,20 @@ func (oc *BaseSecondaryLayer2NetworkController) run() error {
 		if err := oc.WatchNetworkPolicy(); err != nil {
 			return err
 		}
+		// the admin network policy controller depends on pods being set up
+		// on the network
+		if err := oc.startANPController(); err != nil {
+			return err
+		}
+		if err := oc.startEgressQoSController(); err != nil {
+			return err
+		}
+		if err := oc.startEgressFirewallController(); err != nil {
+			return err
+		}
+		if err := oc.startNetworkPeeringController(); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -150,6 +171,28 @@ func (oc *BaseSecondaryLayer2NetworkController) initializeLogicalSwitch(switchNa
 		logicalSwitch.LoadBalancerGroup = []string{clusterLoadBalancerGroupUUID, switchLoadBalancerGroupUUID}
 	}
 
+	// If supported, enable IGMP/MLD snooping on the switch. The querier is
+	// only configured for primary networks, where the gateway address is known.
+	if oc.multicastSupport {
+		if logicalSwitch.OtherConfig == nil {
+			logicalSwitch.OtherConfig = map[string]string{}
+		}
+		logicalSwitch.OtherConfig["mcast_snoop"] = "true"
+		logicalSwitch.OtherConfig["mcast_querier"] = "false"
+		if oc.IsPrimaryNetwork() && len(hostSubnets) > 0 {
+			gwMAC := util.IPAddrToHWAddr(util.GetNodeGatewayIfAddr(hostSubnets[0]).IP)
+			logicalSwitch.OtherConfig["mcast_querier"] = "true"
+			logicalSwitch.OtherConfig["mcast_eth_src"] = gwMAC.String()
+			for _, subnet := range hostSubnets {
+				if utilnet.IsIPv6CIDR(subnet) {
+					logicalSwitch.OtherConfig["mcast_ip6_src"] = util.HWAddrToIPv6LLA(gwMAC).String()
+				} else {
+					logicalSwitch.OtherConfig["mcast_ip4_src"] = util.GetNodeGatewayIfAddr(subnet).IP.String()
+				}
+			}
+		}
+	}
+
 	err := libovsdbops.CreateOrUpdateLogicalSwitch(oc.nbClient, &logicalSwitch)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create logical switch %+v: %v", logicalSwitch, err)
@@ -337,6 +337,14 @@ func (c *Controller) expandRulePeers(rule *gressRule) error {
 		namespaceCache := make(map[string]sets.Set[string])
 		// NOTE: Multiple peers may match on same podIP which is fine, we use sets to store them to avoid duplication
 		for _, namespace := range namespaces {
+			// pods of other networks are isolated from this network's pods
+			inNetwork, err := c.isNamespaceInNetwork(namespace.Name)
+			if err != nil {
+				return err
+			}
+			if !inNetwork {
+				continue
+			}
 			podCache, ok := namespaceCache[namespace.Name]
 			if !ok {
 				podCache = sets.Set[string]{}
@@ -352,7 +360,7 @@ func (c *Controller) expandRulePeers(rule *gressRule) error {
 				if util.PodWantsHostNetwork(pod) || util.PodCompleted(pod) || !util.PodScheduled(pod) {
 					continue
 				}
-				podIPs, err := util.GetPodIPsOfNetwork(pod, &util.DefaultNetInfo{})
+				podIPs, err := util.GetPodIPsOfNetwork(pod, c.netInfo)
 				if err != nil {
 					if errors.Is(err, util.ErrNoPodIPFound) {
 						// we ignore podIPsNotFound error here because onANPPodUpdate
@@ -431,6 +439,13 @@ func (c *Controller) convertANPSubjectToLSPs(anp *adminNetworkPolicyState) ([]*n
 	}
 	namespaceCache := make(map[string]sets.Set[string])
 	for _, namespace := range namespaces {
+		inNetwork, err := c.isNamespaceInNetwork(namespace.Name)
+		if err != nil {
+			return nil, err
+		}
+		if !inNetwork {
+			continue
+		}
 		podCache, ok := namespaceCache[namespace.Name]
 		if !ok {
 			podCache = sets.Set[string]{}
@@ -445,7 +460,10 @@ func (c *Controller) convertANPSubjectToLSPs(anp *adminNetworkPolicyState) ([]*n
 			if util.PodWantsHostNetwork(pod) || util.PodCompleted(pod) || !util.PodScheduled(pod) || !c.isPodScheduledinLocalZone(pod) {
 				continue
 			}
-			logicalPortName := util.GetLogicalPortName(pod.Namespace, pod.Name)
+			logicalPortName, err := c.getPodLogicalPortName(pod)
+			if err != nil {
+				return nil, err
+			}
 			lsp := &nbdb.LogicalSwitchPort{Name: logicalPortName}
 			lsp, err = libovsdbops.GetLogicalSwitchPort(c.nbClient, lsp)
 			if err != nil {
@@ -470,7 +488,7 @@ func (c *Controller) convertANPSubjectToLSPs(anp *adminNetworkPolicyState) ([]*n
 				continue
 			}
 			// we need to collect podIP:cPort information
-			podIPs, err := util.GetPodIPsOfNetwork(pod, &util.DefaultNetInfo{})
+			podIPs, err := util.GetPodIPsOfNetwork(pod, c.netInfo)
 			if err != nil {
 				if errors.Is(err, util.ErrNoPodIPFound) {
 					// we ignore podIPsNotFound error here because onANPPodUpdate
@@ -43,6 +43,9 @@ type Controller struct {
 	// name of the controller that starts the ANP controller
 	// (values are default-network-controller, secondary-network-controller etc..)
 	controllerName string
+	// network this controller enforces admin network policies on: the
+	// default network or a primary user defined network
+	netInfo util.NetInfo
 	sync.RWMutex
 	anpClientSet anpclientset.Interface
 
@@ -59,6 +62,10 @@ type Controller struct {
 	// determine if we need to add pod's port to port group or not - future updates should
 	// take care of reconciling the state of the cluster
 	isPodScheduledinLocalZone func(*v1.Pod) bool
+	// getActiveNetworkForNamespace returns the primary network of the given namespace.
+	// Only the pods of the namespaces whose primary network is netInfo are considered
+	// as subjects or peers of the policies by this controller.
+	getActiveNetworkForNamespace func(namespace string) (util.NetInfo, error)
 	// store's the name of the zone that this controller belongs to
 	zone string
 
@@ -97,13 +104,21 @@ type Controller struct {
 	anpNodeLister corev1listers.NodeLister
 	anpNodeSynced cache.InformerSynced
 	anpNodeQueue  workqueue.TypedRateLimitingInterface[string]
+	// event handlers added to the informers, removed when the controller stops
+	informerHandlers []informerHandler
 
 	observManager *observability.Manager
 }
 
+type informerHandler struct {
+	informer     cache.SharedIndexInformer
+	registration cache.ResourceEventHandlerRegistration
+}
+
 // NewController returns a new *Controller.
 func NewController(
 	controllerName string,
+	netInfo util.NetInfo,
 	nbClient libovsdbclient.Client,
 	anpClient anpclientset.Interface,
 	anpInformer anpinformer.AdminNetworkPolicyInformer,
@@ -113,21 +128,24 @@ func NewController(
 	nodeInformer corev1informers.NodeInformer,
 	addressSetFactory addressset.AddressSetFactory,
 	isPodScheduledinLocalZone func(*v1.Pod) bool,
+	getActiveNetworkForNamespace func(namespace string) (util.NetInfo, error),
 	zone string,
 	recorder record.EventRecorder,
 	observManager *observability.Manager) (*Controller, error) {
 
 	c := &Controller{
-		controllerName:            controllerName,
-		nbClient:                  nbClient,
-		anpClientSet:              anpClient,
-		addressSetFactory:         addressSetFactory,
-		isPodScheduledinLocalZone: isPodScheduledinLocalZone,
-		zone:                      zone,
-		anpCache:                  make(map[string]*adminNetworkPolicyState),
-		anpPriorityMap:            make(map[int32]string),
-		banpCache:                 &adminNetworkPolicyState{}, // safe to initialise pointer to empty struct than nil
-		observManager:             observManager,
+		controllerName:               controllerName,
+		netInfo:                      netInfo,
+		nbClient:                     nbClient,
+		anpClientSet:                 anpClient,
+		addressSetFactory:            addressSetFactory,
+		isPodScheduledinLocalZone:    isPodScheduledinLocalZone,
+		getActiveNetworkForNamespace: getActiveNetworkForNamespace,
+		zone:                         zone,
+		anpCache:                     make(map[string]*adminNetworkPolicyState),
+		anpPriorityMap:               make(map[int32]string),
+		banpCache:                    &adminNetworkPolicyState{}, // safe to initialise pointer to empty struct than nil
+		observManager:                observManager,
 	}
 
 	klog.V(5).Info("Setting up event handlers for Admin Network Policy")
@@ -138,7 +156,7 @@ func NewController(
 		workqueue.NewTypedItemFastSlowRateLimiter[string](1*time.Second, 5*time.Second, 5),
 		workqueue.TypedRateLimitingQueueConfig[string]{Name: "adminNetworkPolicy"},
 	)
-	_, err := anpInformer.Informer().AddEventHandler(factory.WithUpdateHandlingForObjReplace(cache.ResourceEventHandlerFuncs{
+	registration, err := anpInformer.Informer().AddEventHandler(factory.WithUpdateHandlingForObjReplace(cache.ResourceEventHandlerFuncs{
 		AddFunc:    c.onANPAdd,
 		UpdateFunc: c.onANPUpdate,
 		DeleteFunc: c.onANPDelete,
@@ -147,6 +165,7 @@ func NewController(
 		return nil, fmt.Errorf("could not add Event Handler for anpInformer during admin network policy controller initialization, %w", err)
 
 	}
+	c.informerHandlers = append(c.informerHandlers, informerHandler{anpInformer.Informer(), registration})
 
 	klog.V(5).Info("Setting up event handlers for Baseline Admin Network Policy")
 	// setup banp informers, listers, queue
@@ -156,7 +175,7 @@ func NewController(
 		workqueue.NewTypedItemFastSlowRateLimiter[string](1*time.Second, 5*time.Second, 5),
 		workqueue.TypedRateLimitingQueueConfig[string]{Name: "baselineAdminNetworkPolicy"},
 	)
-	_, err = banpInformer.Informer().AddEventHandler(factory.WithUpdateHandlingForObjReplace(cache.ResourceEventHandlerFuncs{
+	registration, err = banpInformer.Informer().AddEventHandler(factory.WithUpdateHandlingForObjReplace(cache.ResourceEventHandlerFuncs{
 		AddFunc:    c.onBANPAdd,
 		UpdateFunc: c.onBANPUpdate,
 		DeleteFunc: c.onBANPDelete,
@@ -164,6 +183,7 @@ func NewController(
 	if err != nil {
 		return nil, fmt.Errorf("could not add Event Handler for banpInformer during admin network policy controller initialization, %w", err)
 	}
+	c.informerHandlers = append(c.informerHandlers, informerHandler{banpInformer.Informer(), registration})
 
 	klog.V(5).Info("Setting up event handlers for Namespaces in Admin Network Policy controller")
 	c.anpNamespaceLister = namespaceInformer.Lister()
@@ -172,7 +192,7 @@ func NewController(
 		workqueue.NewTypedItemFastSlowRateLimiter[string](1*time.Second, 5*time.Second, 5),
 		workqueue.TypedRateLimitingQueueConfig[string]{Name: "anpNamespaces"},
 	)
-	_, err = namespaceInformer.Informer().AddEventHandler(factory.WithUpdateHandlingForObjReplace(cache.ResourceEventHandlerFuncs{
+	registration, err = namespaceInformer.Informer().AddEventHandler(factory.WithUpdateHandlingForObjReplace(cache.ResourceEventHandlerFuncs{
 		AddFunc:    c.onANPNamespaceAdd,
 		UpdateFunc: c.onANPNamespaceUpdate,
 		DeleteFunc: c.onANPNamespaceDelete,
@@ -180,6 +200,7 @@ func NewController(
 	if err != nil {
 		return nil, fmt.Errorf("could not add Event Handler for namespace Informer during admin network policy controller initialization, %w", err)
 	}
+	c.informerHandlers = append(c.informerHandlers, informerHandler{namespaceInformer.Informer(), registration})
 
 	klog.V(5).Info("Setting up event handlers for Pods in Admin Network Policy controller")
 	c.anpPodLister = podInformer.Lister()
@@ -188,7 +209,7 @@ func NewController(
 		workqueue.NewTypedItemFastSlowRateLimiter[string](1*time.Second, 5*time.Second, 5),
 		workqueue.TypedRateLimitingQueueConfig[string]{Name: "anpPods"},
 	)
-	_, err = podInformer.Informer().AddEventHandler(factory.WithUpdateHandlingForObjReplace(cache.ResourceEventHandlerFuncs{
+	registration, err = podInformer.Informer().AddEventHandler(factory.WithUpdateHandlingForObjReplace(cache.ResourceEventHandlerFuncs{
 		AddFunc:    c.onANPPodAdd,
 		UpdateFunc: c.onANPPodUpdate,
 		DeleteFunc: c.onANPPodDelete,
@@ -196,6 +217,7 @@ func NewController(
 	if err != nil {
 		return nil, fmt.Errorf("could not add Event Handler for pod Informer during admin network policy controller initialization, %w", err)
 	}
+	c.informerHandlers = append(c.informerHandlers, informerHandler{podInformer.Informer(), registration})
 
 	klog.V(5).Info("Setting up event handlers for Nodes in Admin Network Policy controller")
 	c.anpNodeLister = nodeInformer.Lister()
@@ -204,7 +226,7 @@ func NewController(
 		workqueue.NewTypedItemFastSlowRateLimiter[string](1*time.Second, 5*time.Second, 5),
 		workqueue.TypedRateLimitingQueueConfig[string]{Name: "anpNodes"},
 	)
-	_, err = nodeInformer.Informer().AddEventHandler(factory.WithUpdateHandlingForObjReplace(cache.ResourceEventHandlerFuncs{
+	registration, err = nodeInformer.Informer().AddEventHandler(factory.WithUpdateHandlingForObjReplace(cache.ResourceEventHandlerFuncs{
 		AddFunc:    c.onANPNodeAdd,
 		UpdateFunc: c.onANPNodeUpdate,
 		DeleteFunc: c.onANPNodeDelete,
@@ -212,6 +234,7 @@ func NewController(
 	if err != nil {
 		return nil, fmt.Errorf("could not add Event Handler for node Informer during admin network policy controller initialization, %w", err)
 	}
+	c.informerHandlers = append(c.informerHandlers, informerHandler{nodeInformer.Informer(), registration})
 
 	c.eventRecorder = recorder
 
@@ -300,7 +323,10 @@ func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) {
 			}, time.Second, stopCh)
 		}()
 	}
-	c.setupMetricsCollector()
+	// the metrics are aggregated across networks, only report them once
+	if c.netInfo.IsDefault() {
+		c.setupMetricsCollector()
+	}
 
 	<-stopCh
 
@@ -310,7 +336,14 @@ func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) {
 	c.anpNamespaceQueue.ShutDown()
 	c.anpPodQueue.ShutDown()
 	c.anpNodeQueue.ShutDown()
-	c.teardownMetricsCollector()
+	for _, handler := range c.informerHandlers {
+		if err := handler.informer.RemoveEventHandler(handler.registration); err != nil {
+			klog.Errorf("Failed to remove event handler of controller %s: %v", c.controllerName, err)
+		}
+	}
+	if c.netInfo.IsDefault() {
+		c.teardownMetricsCollector()
+	}
 	wg.Wait()
 }
 
@@ -513,8 +546,8 @@ func (c *Controller) onANPPodUpdate(oldObj, newObj interface{}) {
 	// zones. Rest of the cases we may return
 	oldPodLabels := labels.Set(oldPod.Labels)
 	newPodLabels := labels.Set(newPod.Labels)
-	oldPodIPs, _ := util.GetPodIPsOfNetwork(oldPod, &util.DefaultNetInfo{})
-	newPodIPs, _ := util.GetPodIPsOfNetwork(newPod, &util.DefaultNetInfo{})
+	oldPodIPs, _ := util.GetPodIPsOfNetwork(oldPod, c.netInfo)
+	newPodIPs, _ := util.GetPodIPsOfNetwork(newPod, c.netInfo)
 	oldPodRunning := util.PodRunning(oldPod)
 	newPodRunning := util.PodRunning(newPod)
 	oldPodCompleted := util.PodCompleted(oldPod)
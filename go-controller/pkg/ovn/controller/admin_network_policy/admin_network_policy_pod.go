@@ -102,6 +102,14 @@ func (c *Controller) syncAdminNetworkPolicyPod(key string) error {
 		// because anyways at that stage pod is considered to belong to remote zone
 		return nil
 	}
+	inNetwork, err := c.isNamespaceInNetwork(namespace)
+	if err != nil {
+		return err
+	}
+	if !inNetwork {
+		// pod is not on the network of this controller
+		return nil
+	}
 	// case (i)/(ii)
 	for _, anp := range existingANPs {
 		anpObj, loaded := c.anpCache[anp.Name]
@@ -0,0 +1,192 @@
+package adminnetworkpolicy
+
+import (
+	"net"
+	"testing"
+
+	cnitypes "github.com/containernetworking/cni/pkg/types"
+	"github.com/onsi/gomega"
+	ovncnitypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/cni/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	ovntest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing"
+	libovsdbtest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
+	ovntypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	anpapi "sigs.k8s.io/network-policy-api/apis/v1alpha1"
+)
+
+func newANPTestPod(namespace, name, ip string, nadName string) *v1.Pod {
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec:       v1.PodSpec{NodeName: "node1"},
+		Status:     v1.PodStatus{Phase: v1.PodRunning, PodIPs: []v1.PodIP{{IP: ip}}},
+	}
+	var err error
+	pod.Annotations, err = util.MarshalPodAnnotation(nil, &util.PodAnnotation{
+		IPs:  ovntest.MustParseIPNets(ip + "/24"),
+		MAC:  util.IPAddrToHWAddr(net.ParseIP(ip)),
+		Role: ovntypes.NetworkRolePrimary,
+	}, nadName)
+	if err != nil {
+		panic(err)
+	}
+	return pod
+}
+
+func TestAdminNetworkPolicyOnPrimaryUDN(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	config.PrepareTestConfig()
+	config.OVNKubernetesFeature.EnableAdminNetworkPolicy = true
+	netInfo, err := util.NewNetInfo(&ovncnitypes.NetConf{
+		NetConf:  cnitypes.NetConf{Name: "blue.tenant"},
+		Topology: ovntypes.Layer3Topology,
+		Role:     ovntypes.NetworkRolePrimary,
+		Subnets:  "10.128.0.0/14/24",
+		NADName:  "blue/tenant",
+	})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	netInfo.SetNADs("blue/tenant")
+	controllerName := netInfo.GetNetworkName() + "-network-controller"
+
+	bluePodLSPName := util.GetSecondaryNetworkLogicalPortName("blue", "pod1", "blue/tenant")
+	dbSetup := libovsdbtest.TestSetup{
+		NBData: []libovsdbtest.TestData{
+			&nbdb.LogicalSwitchPort{UUID: "blue-pod1-UUID", Name: bluePodLSPName},
+			&nbdb.LogicalSwitchPort{UUID: "red-pod1-UUID", Name: util.GetLogicalPortName("red", "pod1")},
+			&nbdb.LogicalSwitch{Name: "node1", Ports: []string{"blue-pod1-UUID", "red-pod1-UUID"}},
+		},
+	}
+	anp := anpapi.AdminNetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "harry-potter"},
+		Spec: anpapi.AdminNetworkPolicySpec{
+			Subject:  anpapi.AdminNetworkPolicySubject{Namespaces: &metav1.LabelSelector{}},
+			Priority: 20,
+			Egress: []anpapi.AdminNetworkPolicyEgressRule{
+				{
+					Name:   "deny-all",
+					Action: anpapi.AdminNetworkPolicyRuleActionDeny,
+					To:     []anpapi.AdminNetworkPolicyEgressPeer{{Namespaces: &metav1.LabelSelector{}}},
+				},
+			},
+		},
+	}
+	controller, err := newNetworkANPController(dbSetup, netInfo, controllerName,
+		func(namespace string) (util.NetInfo, error) {
+			if namespace == "blue" {
+				return netInfo, nil
+			}
+			return &util.DefaultNetInfo{}, nil
+		},
+		anpapi.AdminNetworkPolicyList{Items: []anpapi.AdminNetworkPolicy{anp}},
+		anpapi.BaselineAdminNetworkPolicyList{},
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "blue"}},
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "red"}},
+		newANPTestPod("blue", "pod1", "10.128.0.5", "blue/tenant"),
+		newANPTestPod("red", "pod1", "10.244.0.5", ovntypes.DefaultNetworkName),
+	)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	g.Expect(controller.ensureAdminNetworkPolicy(&anp)).To(gomega.Succeed())
+
+	// the port group of the policy only has the ports of the network's pods
+	pgs, err := libovsdbops.FindPortGroupsWithPredicate(controller.nbClient,
+		libovsdbops.GetPredicate[*nbdb.PortGroup](GetANPPortGroupDbIDs(anp.Name, false, controllerName), nil))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(pgs).To(gomega.HaveLen(1))
+	bluePodLSP, err := libovsdbops.GetLogicalSwitchPort(controller.nbClient, &nbdb.LogicalSwitchPort{Name: bluePodLSPName})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(pgs[0].Ports).To(gomega.ConsistOf(bluePodLSP.UUID))
+
+	// the ACLs of the policy are owned by the network's controller
+	acls, err := libovsdbops.FindACLsWithPredicate(controller.nbClient, func(acl *nbdb.ACL) bool {
+		return acl.ExternalIDs[libovsdbops.OwnerControllerKey.String()] == controllerName
+	})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(acls).NotTo(gomega.BeEmpty())
+	aclUUIDs := make([]string, 0, len(acls))
+	for _, acl := range acls {
+		aclUUIDs = append(aclUUIDs, acl.UUID)
+	}
+	g.Expect(pgs[0].ACLs).To(gomega.ConsistOf(aclUUIDs))
+
+	// the peers of the policy only have the IPs of the network's pods
+	addressSets, err := libovsdbops.FindAddressSetsWithPredicate(controller.nbClient, func(as *nbdb.AddressSet) bool {
+		return as.ExternalIDs[libovsdbops.OwnerControllerKey.String()] == controllerName
+	})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	var addresses []string
+	for _, as := range addressSets {
+		addresses = append(addresses, as.Addresses...)
+	}
+	g.Expect(addresses).To(gomega.ConsistOf("10.128.0.5"))
+}
+
+func TestAdminNetworkPolicyWithUnprocessedPrimaryUDN(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	config.PrepareTestConfig()
+	config.OVNKubernetesFeature.EnableAdminNetworkPolicy = true
+	controllerName := "default-network-controller"
+
+	redPodLSPName := util.GetLogicalPortName("red", "pod1")
+	dbSetup := libovsdbtest.TestSetup{
+		NBData: []libovsdbtest.TestData{
+			&nbdb.LogicalSwitchPort{UUID: "red-pod1-UUID", Name: redPodLSPName},
+			&nbdb.LogicalSwitch{Name: "node1", Ports: []string{"red-pod1-UUID"}},
+		},
+	}
+	anp := anpapi.AdminNetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "harry-potter"},
+		Spec: anpapi.AdminNetworkPolicySpec{
+			Subject:  anpapi.AdminNetworkPolicySubject{Namespaces: &metav1.LabelSelector{}},
+			Priority: 20,
+			Egress: []anpapi.AdminNetworkPolicyEgressRule{
+				{
+					Name:   "deny-all",
+					Action: anpapi.AdminNetworkPolicyRuleActionDeny,
+					To:     []anpapi.AdminNetworkPolicyEgressPeer{{Namespaces: &metav1.LabelSelector{}}},
+				},
+			},
+		},
+	}
+	// the primary UDN of the green namespace is not processed yet
+	controller, err := newNetworkANPController(dbSetup, &util.DefaultNetInfo{}, controllerName,
+		func(namespace string) (util.NetInfo, error) {
+			if namespace == "green" {
+				return nil, util.NewUnprocessedActiveNetworkError(namespace, "tenant")
+			}
+			return &util.DefaultNetInfo{}, nil
+		},
+		anpapi.AdminNetworkPolicyList{Items: []anpapi.AdminNetworkPolicy{anp}},
+		anpapi.BaselineAdminNetworkPolicyList{},
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "red"}},
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "green"}},
+		newANPTestPod("red", "pod1", "10.244.0.5", ovntypes.DefaultNetworkName),
+		newANPTestPod("green", "pod1", "10.128.0.5", "green/tenant"),
+	)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	// the policy is applied to the other namespaces
+	g.Expect(controller.ensureAdminNetworkPolicy(&anp)).To(gomega.Succeed())
+
+	pgs, err := libovsdbops.FindPortGroupsWithPredicate(controller.nbClient,
+		libovsdbops.GetPredicate[*nbdb.PortGroup](GetANPPortGroupDbIDs(anp.Name, false, controllerName), nil))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(pgs).To(gomega.HaveLen(1))
+	redPodLSP, err := libovsdbops.GetLogicalSwitchPort(controller.nbClient, &nbdb.LogicalSwitchPort{Name: redPodLSPName})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(pgs[0].Ports).To(gomega.ConsistOf(redPodLSP.UUID))
+
+	addressSets, err := libovsdbops.FindAddressSetsWithPredicate(controller.nbClient, func(as *nbdb.AddressSet) bool {
+		return as.ExternalIDs[libovsdbops.OwnerControllerKey.String()] == controllerName
+	})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	var addresses []string
+	for _, as := range addressSets {
+		addresses = append(addresses, as.Addresses...)
+	}
+	g.Expect(addresses).To(gomega.ConsistOf("10.244.0.5"))
+}
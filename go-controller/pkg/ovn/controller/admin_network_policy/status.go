@@ -7,12 +7,18 @@ import (
 
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/klog/v2"
 	anpapiapply "sigs.k8s.io/network-policy-api/pkg/client/applyconfiguration/apis/v1alpha1"
+	anpclientset "sigs.k8s.io/network-policy-api/pkg/client/clientset/versioned"
+	anplister "sigs.k8s.io/network-policy-api/pkg/client/listers/apis/v1alpha1"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
 )
 
 // Defined status.type fields for Admin Network Policy - This is prefixed with the zone name thus
-// creating one row per zone in the metav1.Condition array
+// creating one row per zone in the metav1.Condition array. The controllers of primary user defined
+// networks suffix it with the network name, creating one row per zone and network.
 // NOTE: On every update of ANP, related pods and namespaces - if anything goes wrong this
 // this status type flaps between true and false. Users can use this to narrow down the malfunctioning zone
 // (DANGER): If this feature is used at 500-1000 node scale, then that many status rows will be created
@@ -36,21 +42,96 @@ Status:
     Reason:                SetupSucceeded
     Status:                True
     Type:                  Ready-In-Zone-ovn-worker2
+    Last Transition Time:  2023-06-11T12:07:51Z
+    Message:               Setting up OVN DB plumbing was successful
+    Reason:                SetupSucceeded
+    Status:                True
+    Type:                  Ready-In-Zone-ovn-worker2-Network-blue.tenant
 Events:                    <none>
 */
 const (
 	// conditions.type can have max 316 characters (zone names are max 273 so keep this under allowed range)
 	policyReadyStatusType = "Ready-In-Zone-"
+	// infix between the zone and network names of the status.type of user defined networks
+	policyReadyStatusNetworkInfix = "-Network-"
 	// Defined status.reason fields for (Baseline)Admin Network Policy
 	policyReadyReason    = "SetupSucceeded"
 	policyNotReadyReason = "SetupFailed"
 )
 
+// getPolicyReadyStatusType returns the status.type of the conditions set by this
+// controller, unique for its zone and network
+func (c *Controller) getPolicyReadyStatusType() string {
+	return policyReadyStatusTypeOf(c.zone, c.netInfo)
+}
+
+// getStatusFieldManager returns the field manager of the status updates of this
+// controller. Each controller has to use its own field manager, otherwise applying
+// its condition would remove the conditions of the other controllers of the zone.
+func (c *Controller) getStatusFieldManager() string {
+	return statusFieldManagerOf(c.zone, c.netInfo)
+}
+
+func policyReadyStatusTypeOf(zone string, netInfo util.NetInfo) string {
+	if netInfo.IsDefault() {
+		return policyReadyStatusType + zone
+	}
+	return policyReadyStatusType + zone + policyReadyStatusNetworkInfix + netInfo.GetNetworkName()
+}
+
+func statusFieldManagerOf(zone string, netInfo util.NetInfo) string {
+	if netInfo.IsDefault() {
+		return zone
+	}
+	return zone + "-" + netInfo.GetNetworkName()
+}
+
+// CleanupNetworkStatuses removes the conditions set by the controller of the given
+// user defined network in the given zone from the status of all the ANPs and BANPs.
+// It is called when the network is deleted, the conditions of the zone being
+// otherwise cleaned up by the cluster manager when the zone is deleted.
+// This is best effort, so errors are silently ignored by emitting warning messages.
+func CleanupNetworkStatuses(anpClient anpclientset.Interface, anpLister anplister.AdminNetworkPolicyLister,
+	banpLister anplister.BaselineAdminNetworkPolicyLister, zone string, netInfo util.NetInfo) {
+	statusType := policyReadyStatusTypeOf(zone, netInfo)
+	applyOpts := metav1.ApplyOptions{FieldManager: statusFieldManagerOf(zone, netInfo), Force: true}
+	anps, err := anpLister.List(labels.Everything())
+	if err != nil {
+		klog.Warningf("Unable to list ANPs to remove the status of network %s: %v", netInfo.GetNetworkName(), err)
+	}
+	for _, anp := range anps {
+		if meta.FindStatusCondition(anp.Status.Conditions, statusType) == nil {
+			continue
+		}
+		applyObj := anpapiapply.AdminNetworkPolicy(anp.Name).
+			WithStatus(anpapiapply.AdminNetworkPolicyStatus())
+		_, err := anpClient.PolicyV1alpha1().AdminNetworkPolicies().ApplyStatus(context.TODO(), applyObj, applyOpts)
+		if err != nil {
+			klog.Warningf("Unable to remove network %s's status from ANP %s: %v", netInfo.GetNetworkName(), anp.Name, err)
+		}
+	}
+	banps, err := banpLister.List(labels.Everything())
+	if err != nil {
+		klog.Warningf("Unable to list BANPs to remove the status of network %s: %v", netInfo.GetNetworkName(), err)
+	}
+	for _, banp := range banps {
+		if meta.FindStatusCondition(banp.Status.Conditions, statusType) == nil {
+			continue
+		}
+		applyObj := anpapiapply.BaselineAdminNetworkPolicy(banp.Name).
+			WithStatus(anpapiapply.BaselineAdminNetworkPolicyStatus())
+		_, err := anpClient.PolicyV1alpha1().BaselineAdminNetworkPolicies().ApplyStatus(context.TODO(), applyObj, applyOpts)
+		if err != nil {
+			klog.Warningf("Unable to remove network %s's status from BANP %s: %v", netInfo.GetNetworkName(), banp.Name, err)
+		}
+	}
+}
+
 // updateANPStatusToReady updates the status of the policy to reflect that it is ready
 // Each zone's ovnkube-controller will call this, hence let's update status using server-side-apply
 func (c *Controller) updateANPStatusToReady(anpName string) error {
 	readyCondition := metav1.Condition{
-		Type:    policyReadyStatusType + c.zone,
+		Type:    c.getPolicyReadyStatusType(),
 		Status:  metav1.ConditionTrue,
 		Reason:  policyReadyReason,
 		Message: "Setting up OVN DB plumbing was successful",
@@ -60,7 +141,7 @@ func (c *Controller) updateANPStatusToReady(anpName string) error {
 		return fmt.Errorf("unable to update the status of ANP %s, err: %v", anpName, err)
 	}
 	klog.V(5).Infof("Patched the status of ANP %v with condition type %v/%v",
-		anpName, policyReadyStatusType+c.zone, metav1.ConditionTrue)
+		anpName, c.getPolicyReadyStatusType(), metav1.ConditionTrue)
 	return nil
 }
 
@@ -74,7 +155,7 @@ func (c *Controller) updateANPStatusToNotReady(anpName, message string) error {
 		message = message[:32766]
 	}
 	notReadyCondition := metav1.Condition{
-		Type:    policyReadyStatusType + c.zone,
+		Type:    c.getPolicyReadyStatusType(),
 		Status:  metav1.ConditionFalse,
 		Reason:  policyNotReadyReason,
 		Message: message,
@@ -84,7 +165,7 @@ func (c *Controller) updateANPStatusToNotReady(anpName, message string) error {
 		return fmt.Errorf("unable update the status of ANP %s, err: %v", anpName, err)
 	}
 	klog.V(3).Infof("Patched the status of ANP %v with condition type %v/%v and reason %s/%s",
-		anpName, policyReadyStatusType+c.zone, metav1.ConditionFalse, policyNotReadyReason, message)
+		anpName, c.getPolicyReadyStatusType(), metav1.ConditionFalse, policyNotReadyReason, message)
 	return nil
 }
 
@@ -108,7 +189,7 @@ func (c *Controller) updateANPZoneStatusCondition(newCondition metav1.Condition,
 	applyObj := anpapiapply.AdminNetworkPolicy(anpName).
 		WithStatus(anpapiapply.AdminNetworkPolicyStatus().WithConditions(newCondition))
 	_, err = c.anpClientSet.PolicyV1alpha1().AdminNetworkPolicies().
-		ApplyStatus(context.TODO(), applyObj, metav1.ApplyOptions{FieldManager: c.zone, Force: true})
+		ApplyStatus(context.TODO(), applyObj, metav1.ApplyOptions{FieldManager: c.getStatusFieldManager(), Force: true})
 	return err
 }
 
@@ -116,7 +197,7 @@ func (c *Controller) updateANPZoneStatusCondition(newCondition metav1.Condition,
 // Each zone's ovnkube-controller will call this, hence let's update status using server-side-apply
 func (c *Controller) updateBANPStatusToReady(banpName string) error {
 	readyCondition := metav1.Condition{
-		Type:    policyReadyStatusType + c.zone,
+		Type:    c.getPolicyReadyStatusType(),
 		Status:  metav1.ConditionTrue,
 		Reason:  policyReadyReason,
 		Message: "Setting up OVN DB plumbing was successful",
@@ -126,7 +207,7 @@ func (c *Controller) updateBANPStatusToReady(banpName string) error {
 		return fmt.Errorf("unable to update the status of BANP %s, err: %v", banpName, err)
 	}
 	klog.V(5).Infof("Patched the status of BANP %v with condition type %v/%v",
-		banpName, policyReadyStatusType+c.zone, metav1.ConditionTrue)
+		banpName, c.getPolicyReadyStatusType(), metav1.ConditionTrue)
 	return nil
 }
 
@@ -137,7 +218,7 @@ func (c *Controller) updateBANPStatusToReady(banpName string) error {
 // this ANP instead of having to manually check logs across zones
 func (c *Controller) updateBANPStatusToNotReady(banpName, message string) error {
 	notReadyCondition := metav1.Condition{
-		Type:    policyReadyStatusType + c.zone,
+		Type:    c.getPolicyReadyStatusType(),
 		Status:  metav1.ConditionFalse,
 		Reason:  policyNotReadyReason,
 		Message: message,
@@ -147,7 +228,7 @@ func (c *Controller) updateBANPStatusToNotReady(banpName, message string) error
 		return fmt.Errorf("unable update the status of BANP %s, err: %v", banpName, err)
 	}
 	klog.V(3).Infof("Patched the status of BANP %v with condition type %v/%v and reason %s",
-		banpName, policyReadyStatusType+c.zone, metav1.ConditionFalse, policyNotReadyReason)
+		banpName, c.getPolicyReadyStatusType(), metav1.ConditionFalse, policyNotReadyReason)
 	return nil
 }
 
@@ -171,6 +252,6 @@ func (c *Controller) updateBANPZoneStatusCondition(newCondition metav1.Condition
 	applyObj := anpapiapply.BaselineAdminNetworkPolicy(banpName).
 		WithStatus(anpapiapply.BaselineAdminNetworkPolicyStatus().WithConditions(newCondition))
 	_, err = c.anpClientSet.PolicyV1alpha1().BaselineAdminNetworkPolicies().
-		ApplyStatus(context.TODO(), applyObj, metav1.ApplyOptions{FieldManager: c.zone, Force: true})
+		ApplyStatus(context.TODO(), applyObj, metav1.ApplyOptions{FieldManager: c.getStatusFieldManager(), Force: true})
 	return err
 }
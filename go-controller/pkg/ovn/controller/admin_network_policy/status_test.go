@@ -4,18 +4,26 @@ import (
 	"context"
 	"testing"
 
+	cnitypes "github.com/containernetworking/cni/pkg/types"
 	"github.com/onsi/ginkgo/v2"
 	"github.com/onsi/gomega"
 	libovsdbclient "github.com/ovn-org/libovsdb/client"
+	ovncnitypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/cni/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
 	libovsdbutil "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/util"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
 	addressset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/address_set"
 	libovsdbtest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
+	ovntypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/record"
 	anpapi "sigs.k8s.io/network-policy-api/apis/v1alpha1"
 	anpfake "sigs.k8s.io/network-policy-api/pkg/client/clientset/versioned/fake"
@@ -84,15 +92,24 @@ func newANPController(initANPs anpapi.AdminNetworkPolicyList, initBANPs anpapi.B
 }
 
 func newANPControllerWithDBSetup(dbSetup libovsdbtest.TestSetup, initANPs anpapi.AdminNetworkPolicyList, initBANPs anpapi.BaselineAdminNetworkPolicyList) (*Controller, error) {
-	gomega.RegisterFailHandler(ginkgo.Fail)
 	config.PrepareTestConfig()
 	config.OVNKubernetesFeature.EnableAdminNetworkPolicy = true
+	return newNetworkANPController(dbSetup, &util.DefaultNetInfo{}, "default-network-controller", nil, initANPs, initBANPs)
+}
+
+// newNetworkANPController returns the controller of the given network, the
+// pods and namespaces being given as kubeObjects. The config has to be set up
+// by the caller.
+func newNetworkANPController(dbSetup libovsdbtest.TestSetup, netInfo util.NetInfo, controllerName string,
+	getActiveNetworkForNamespace func(namespace string) (util.NetInfo, error), initANPs anpapi.AdminNetworkPolicyList,
+	initBANPs anpapi.BaselineAdminNetworkPolicyList, kubeObjects ...runtime.Object) (*Controller, error) {
+	gomega.RegisterFailHandler(ginkgo.Fail)
 	nbClient, _, err := libovsdbtest.NewNBTestHarness(dbSetup, nil)
 	if err != nil {
 		return nil, err
 	}
 	fakeClient := &util.OVNClientset{
-		KubeClient: fake.NewSimpleClientset(),
+		KubeClient: fake.NewSimpleClientset(kubeObjects...),
 		ANPClient: anpfake.NewSimpleClientset(
 			&initANPs,
 			&initBANPs,
@@ -115,7 +132,8 @@ func newANPControllerWithDBSetup(dbSetup libovsdbtest.TestSetup, initANPs anpapi
 	addressSetFactory := addressset.NewOvnAddressSetFactory(nbClient, config.IPv4Mode, config.IPv6Mode)
 	recorder := record.NewFakeRecorder(10)
 	controller, err := NewController(
-		"default-network-controller",
+		controllerName,
+		netInfo,
 		nbClient,
 		fakeClient.ANPClient,
 		watcher.ANPInformer(),
@@ -124,7 +142,8 @@ func newANPControllerWithDBSetup(dbSetup libovsdbtest.TestSetup, initANPs anpapi
 		watcher.PodCoreInformer(),
 		watcher.NodeCoreInformer(),
 		addressSetFactory,
-		nil, // we don't care about pods in this test
+		func(*v1.Pod) bool { return true },
+		getActiveNetworkForNamespace,
 		"targaryen",
 		recorder,
 		nil,
@@ -214,3 +233,55 @@ func TestAddOrUpdateAdminNetworkPolicyStatus(t *testing.T) {
 	g.Expect(banp.Status.Conditions[0].Reason).To(gomega.Equal(policyReadyReason))
 	g.Expect(banp.Status.Conditions[0].Status).To(gomega.Equal(metav1.ConditionTrue))
 }
+
+// the actual removal of the conditions can't be tested as the fake client doesn't
+// support ApplyStatus with FieldManagers, check the policies that are patched
+func TestCleanupNetworkStatuses(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	config.PrepareTestConfig()
+	config.OVNKubernetesFeature.EnableAdminNetworkPolicy = true
+	netInfo, err := util.NewNetInfo(&ovncnitypes.NetConf{
+		NetConf:  cnitypes.NetConf{Name: "blue.tenant"},
+		Topology: ovntypes.Layer3Topology,
+		Role:     ovntypes.NetworkRolePrimary,
+		Subnets:  "10.128.0.0/14/24",
+		NADName:  "blue/tenant",
+	})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	networkCondition := metav1.Condition{Type: "Ready-In-Zone-targaryen-Network-blue.tenant", Status: metav1.ConditionTrue}
+	zoneCondition := metav1.Condition{Type: "Ready-In-Zone-targaryen", Status: metav1.ConditionTrue}
+	anpWithNetworkStatus := *initialANP.DeepCopy()
+	anpWithNetworkStatus.Status.Conditions = []metav1.Condition{zoneCondition, networkCondition}
+	anpWithoutNetworkStatus := *initialANP.DeepCopy()
+	anpWithoutNetworkStatus.Name = "hermione-granger"
+	anpWithoutNetworkStatus.Spec.Priority = 21
+	anpWithoutNetworkStatus.Status.Conditions = []metav1.Condition{zoneCondition}
+	banp := *initialBANP.DeepCopy()
+	banp.Status.Conditions = []metav1.Condition{networkCondition}
+	controller, err := newNetworkANPController(libovsdbtest.TestSetup{}, netInfo, "blue.tenant-network-controller", nil,
+		anpapi.AdminNetworkPolicyList{Items: []anpapi.AdminNetworkPolicy{anpWithNetworkStatus, anpWithoutNetworkStatus}},
+		anpapi.BaselineAdminNetworkPolicyList{Items: []anpapi.BaselineAdminNetworkPolicy{banp}},
+	)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Eventually(func() int {
+		anps, err := controller.anpLister.List(labels.Everything())
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		return len(anps)
+	}).Should(gomega.Equal(2))
+
+	var patched []string
+	fakeANPClient := controller.anpClientSet.(*anpfake.Clientset)
+	fakeANPClient.PrependReactor("patch", "*", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		patch := action.(clienttesting.PatchAction)
+		g.Expect(patch.GetSubresource()).To(gomega.Equal("status"))
+		g.Expect(patch.GetPatchType()).To(gomega.Equal(types.ApplyPatchType))
+		g.Expect(string(patch.GetPatch())).To(gomega.ContainSubstring(`"status":{}`))
+		patched = append(patched, action.GetResource().Resource+"/"+patch.GetName())
+		return true, nil, nil
+	})
+	CleanupNetworkStatuses(controller.anpClientSet, controller.anpLister, controller.banpLister, controller.zone, netInfo)
+	g.Expect(patched).To(gomega.ConsistOf(
+		"adminnetworkpolicies/"+anpWithNetworkStatus.Name,
+		"baselineadminnetworkpolicies/"+banp.Name,
+	))
+}
@@ -9,6 +9,7 @@ import (
 
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
 	utilnet "k8s.io/utils/net"
 	anpapi "sigs.k8s.io/network-policy-api/apis/v1alpha1"
 
@@ -56,6 +57,41 @@ func getANPRuleACLDbIDs(name, gressPrefix, gressIndex, protocol, controller stri
 	})
 }
 
+// isNamespaceInNetwork returns whether the primary network of the given namespace
+// is the network of this controller. The namespaces whose primary network is not
+// processed yet are skipped, they are requeued by the namespace and pod events
+// once their network is processed.
+func (c *Controller) isNamespaceInNetwork(namespace string) (bool, error) {
+	if c.getActiveNetworkForNamespace == nil {
+		return c.netInfo.IsDefault(), nil
+	}
+	activeNetwork, err := c.getActiveNetworkForNamespace(namespace)
+	if err != nil {
+		if util.IsUnprocessedActiveNetworkError(err) {
+			klog.V(5).Infof("Skipping namespace %s for network %s: %v", namespace, c.netInfo.GetNetworkName(), err)
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get active network for namespace %s: %w", namespace, err)
+	}
+	return activeNetwork.GetNetworkName() == c.netInfo.GetNetworkName(), nil
+}
+
+// getPodLogicalPortName returns the name of the LSP of the given pod on the
+// network of this controller
+func (c *Controller) getPodLogicalPortName(pod *v1.Pod) (string, error) {
+	if c.netInfo.IsDefault() {
+		return util.GetLogicalPortName(pod.Namespace, pod.Name), nil
+	}
+	nadNames, err := util.PodNadNames(pod, c.netInfo)
+	if err != nil {
+		return "", err
+	}
+	if len(nadNames) == 0 {
+		return "", fmt.Errorf("no NAD found for pod %s/%s on network %s", pod.Namespace, pod.Name, c.netInfo.GetNetworkName())
+	}
+	return util.GetSecondaryNetworkLogicalPortName(pod.Namespace, pod.Name, nadNames[0]), nil
+}
+
 // GetACLActionForANPRule returns the corresponding OVN ACL action for a given ANP rule action
 func GetACLActionForANPRule(action anpapi.AdminNetworkPolicyRuleAction) string {
 	var ovnACLAction string
@@ -5,10 +5,16 @@ import (
 	"strings"
 	"testing"
 
+	cnitypes "github.com/containernetworking/cni/pkg/types"
 	"github.com/onsi/gomega"
+	ovncnitypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/cni/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 	libovsdbutil "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/util"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	ovntypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestGetACLLoggingLevelsForANP(t *testing.T) {
@@ -130,3 +136,50 @@ func TestGetACLLoggingLevelsForANP(t *testing.T) {
 	}
 
 }
+
+func TestNetworkScopedPodsOfPrimaryUDN(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	config.PrepareTestConfig()
+	netInfo, err := util.NewNetInfo(&ovncnitypes.NetConf{
+		NetConf:  cnitypes.NetConf{Name: "blue.tenant"},
+		Topology: ovntypes.Layer3Topology,
+		Role:     ovntypes.NetworkRolePrimary,
+		Subnets:  "10.128.0.0/14/23",
+		NADName:  "blue/tenant",
+	})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	netInfo.SetNADs("blue/tenant")
+
+	c := &Controller{
+		netInfo: netInfo,
+		getActiveNetworkForNamespace: func(namespace string) (util.NetInfo, error) {
+			if namespace == "blue" {
+				return netInfo, nil
+			}
+			return &util.DefaultNetInfo{}, nil
+		},
+		zone: "targaryen",
+	}
+	inNetwork, err := c.isNamespaceInNetwork("blue")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(inNetwork).To(gomega.BeTrue())
+	inNetwork, err = c.isNamespaceInNetwork("red")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(inNetwork).To(gomega.BeFalse())
+
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "blue", Name: "pod1"}}
+	lspName, err := c.getPodLogicalPortName(pod)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(lspName).To(gomega.Equal(util.GetSecondaryNetworkLogicalPortName("blue", "pod1", "blue/tenant")))
+
+	g.Expect(c.getPolicyReadyStatusType()).To(gomega.Equal("Ready-In-Zone-targaryen-Network-blue.tenant"))
+	g.Expect(c.getStatusFieldManager()).To(gomega.Equal("targaryen-blue.tenant"))
+
+	// the default network controller keeps the legacy names
+	c.netInfo = &util.DefaultNetInfo{}
+	lspName, err = c.getPodLogicalPortName(pod)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(lspName).To(gomega.Equal("blue_pod1"))
+	g.Expect(c.getPolicyReadyStatusType()).To(gomega.Equal("Ready-In-Zone-targaryen"))
+	g.Expect(c.getStatusFieldManager()).To(gomega.Equal("targaryen"))
+}
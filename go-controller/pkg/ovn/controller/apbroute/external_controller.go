@@ -808,7 +808,7 @@ func (m *externalPolicyManager) getStaticGatewayIPsForTargetNamespace(namespaceN
 		for _, targetNS := range targetNamespaces {
 			if targetNS.Name == namespaceName {
 				// only collect the static gateways
-				staticGWInfo, err := m.processStaticHopsGatewayInformation(routePolicy.Spec.NextHops.StaticHops)
+				staticGWInfo, err := gateway_info.NewGatewayInfoListFromStaticHops(routePolicy.Spec.NextHops.StaticHops)
 				if err != nil {
 					klog.Errorf("Failed to process Admin Policy Based External Route %s: %v", routePolicy.Name, err)
 					return nil, err
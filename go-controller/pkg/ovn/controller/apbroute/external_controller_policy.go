@@ -2,7 +2,6 @@ package apbroute
 
 import (
 	"fmt"
-	"net"
 	"strings"
 
 	v1 "k8s.io/api/core/v1"
@@ -321,20 +320,6 @@ func (m *externalPolicyManager) calculateAnnotatedPodGatewayIPsForNamespace(targ
 	return gwIPs, nil
 }
 
-func (m *externalPolicyManager) processStaticHopsGatewayInformation(hops []*adminpolicybasedrouteapi.StaticHop) (*gateway_info.GatewayInfoList, error) {
-	gwList := gateway_info.NewGatewayInfoList()
-
-	// collect all the static gateway information from the nextHops slice
-	for _, h := range hops {
-		ip := net.ParseIP(h.IP)
-		if ip == nil {
-			return nil, fmt.Errorf("could not parse routing static gw annotation value '%s'", h.IP)
-		}
-		gwList.InsertOverwrite(gateway_info.NewGatewayInfo(sets.New(ip.String()), h.BFDEnabled))
-	}
-	return gwList, nil
-}
-
 func (m *externalPolicyManager) processDynamicHopsGatewayInformation(hops []*adminpolicybasedrouteapi.DynamicHop) (*gateway_info.GatewayInfoList,
 	sets.Set[string], sets.Set[ktypes.NamespacedName], error) {
 	podsInfo := gateway_info.NewGatewayInfoList()
@@ -384,7 +369,7 @@ func (m *externalPolicyManager) processDynamicHopsGatewayInformation(hops []*adm
 // This function should be the only one that lists referenced objects, and updates policyReferencedObjects atomically.
 func (m *externalPolicyManager) getPolicyConfigAndUpdatePolicyRefs(policy *adminpolicybasedrouteapi.AdminPolicyBasedExternalRoute,
 	updateRefs bool) (*routePolicyConfig, error) {
-	staticGWInfo, err := m.processStaticHopsGatewayInformation(policy.Spec.NextHops.StaticHops)
+	staticGWInfo, err := gateway_info.NewGatewayInfoListFromStaticHops(policy.Spec.NextHops.StaticHops)
 	if err != nil {
 		return nil, fmt.Errorf("failed to process static GW: %w", err)
 	}
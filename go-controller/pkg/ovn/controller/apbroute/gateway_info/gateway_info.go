@@ -2,9 +2,12 @@ package gateway_info
 
 import (
 	"fmt"
+	"net"
 	"strings"
 
 	"k8s.io/apimachinery/pkg/util/sets"
+
+	adminpolicybasedrouteapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/adminpolicybasedroute/v1"
 )
 
 // GatewayInfoList stores a list of GatewayInfo with unique ips.
@@ -19,6 +22,22 @@ func NewGatewayInfoList(elems ...*GatewayInfo) *GatewayInfoList {
 	return gil
 }
 
+// NewGatewayInfoListFromStaticHops returns the list of the gateways of the
+// static hops of an AdminPolicyBasedExternalRoute.
+func NewGatewayInfoListFromStaticHops(hops []*adminpolicybasedrouteapi.StaticHop) (*GatewayInfoList, error) {
+	gwList := NewGatewayInfoList()
+
+	// collect all the static gateway information from the nextHops slice
+	for _, h := range hops {
+		ip := net.ParseIP(h.IP)
+		if ip == nil {
+			return nil, fmt.Errorf("could not parse routing static gw annotation value '%s'", h.IP)
+		}
+		gwList.InsertOverwrite(NewGatewayInfo(sets.New(ip.String()), h.BFDEnabled))
+	}
+	return gwList, nil
+}
+
 func (g *GatewayInfoList) Elems() []*GatewayInfo {
 	return g.elems
 }
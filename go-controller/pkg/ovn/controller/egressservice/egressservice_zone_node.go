@@ -210,6 +210,7 @@ func (c *Controller) nodeStateFor(name string) (*nodeState, error) {
 		return nil, fmt.Errorf("failed to parse node %s subnets annotation %v", node.Name, err)
 	}
 
+	nodeSubnets = util.GetNodePrimaryHostSubnets(nodeSubnets)
 	mgmtIPs := make([]net.IP, len(nodeSubnets))
 	for i, subnet := range nodeSubnets {
 		mgmtIPs[i] = util.GetNodeManagementIfAddr(subnet).IP
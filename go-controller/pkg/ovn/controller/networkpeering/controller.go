@@ -0,0 +1,885 @@
+package networkpeering
+
+import (
+	"fmt"
+	"net"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	discovery "k8s.io/api/discovery/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	discoveryinformers "k8s.io/client-go/informers/discovery/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	discoverylisters "k8s.io/client-go/listers/discovery/v1"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+	utilnet "k8s.io/utils/net"
+	"k8s.io/utils/ptr"
+
+	libovsdbclient "github.com/ovn-org/libovsdb/client"
+	"github.com/ovn-org/libovsdb/ovsdb"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/controller"
+	udnv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1"
+	udninformer "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1/apis/informers/externalversions/userdefinednetwork/v1"
+	udnlister "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1/apis/listers/userdefinednetwork/v1"
+	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+const (
+	ipFamilyV4 = "ip4"
+	ipFamilyV6 = "ip6"
+
+	// loadBalancerKind is the kind external-id of the load balancers of the
+	// services exposed by the peer networks
+	loadBalancerKind = "NetworkPeering"
+)
+
+// Controller connects the router of a primary user defined network in the
+// local zone to the routers of the networks it is peered with, as configured by
+// the cluster manager in the NetworkPeerings.
+//
+// For every NetworkPeering of the network, a router port is connected to the
+// router port of the peer network and reroute policies send the traffic
+// destined to the peer network subnets through it. When the network only
+// exposes some services to its peer, policies on the router drop the traffic
+// coming from the peer unless it is destined to the endpoints of those
+// services. The cluster IPs of the services the peer network exposes are load
+// balanced to their endpoints by load balancers on the switches of the network.
+type Controller struct {
+	controllerName string
+	netInfo        util.NetInfo
+	nbClient       libovsdbclient.Client
+	zone           string
+
+	peeringLister       udnlister.NetworkPeeringLister
+	serviceLister       corelisters.ServiceLister
+	endpointSliceLister discoverylisters.EndpointSliceLister
+	nodeLister          corelisters.NodeLister
+
+	peeringController       controller.Controller
+	serviceController       controller.Controller
+	endpointSliceController controller.Controller
+	nodeController          controller.Controller
+}
+
+// NewController returns a new NetworkPeering controller for the given network.
+func NewController(
+	controllerName string,
+	netInfo util.NetInfo,
+	nbClient libovsdbclient.Client,
+	zone string,
+	peeringInformer udninformer.NetworkPeeringInformer,
+	serviceInformer coreinformers.ServiceInformer,
+	endpointSliceInformer discoveryinformers.EndpointSliceInformer,
+	nodeInformer coreinformers.NodeInformer,
+) *Controller {
+	c := &Controller{
+		controllerName:      controllerName,
+		netInfo:             netInfo,
+		nbClient:            nbClient,
+		zone:                zone,
+		peeringLister:       peeringInformer.Lister(),
+		serviceLister:       serviceInformer.Lister(),
+		endpointSliceLister: endpointSliceInformer.Lister(),
+		nodeLister:          nodeInformer.Lister(),
+	}
+
+	peeringConfig := &controller.ControllerConfig[udnv1.NetworkPeering]{
+		RateLimiter:    workqueue.NewTypedItemFastSlowRateLimiter[string](time.Second, 5*time.Second, 5),
+		Informer:       peeringInformer.Informer(),
+		Lister:         c.peeringLister.List,
+		ObjNeedsUpdate: peeringNeedsUpdate,
+		Reconcile:      c.reconcileNetworkPeering,
+		Threadiness:    1,
+	}
+	c.peeringController = controller.NewController[udnv1.NetworkPeering](netInfo.GetNetworkName()+"-network-peering-controller", peeringConfig)
+
+	serviceConfig := &controller.ControllerConfig[corev1.Service]{
+		RateLimiter:    workqueue.DefaultTypedControllerRateLimiter[string](),
+		Informer:       serviceInformer.Informer(),
+		Lister:         c.serviceLister.List,
+		ObjNeedsUpdate: serviceNeedsUpdate,
+		Reconcile:      c.reconcileAllNetworkPeerings,
+		Threadiness:    1,
+	}
+	c.serviceController = controller.NewController[corev1.Service](netInfo.GetNetworkName()+"-network-peering-service-controller", serviceConfig)
+
+	endpointSliceConfig := &controller.ControllerConfig[discovery.EndpointSlice]{
+		RateLimiter:    workqueue.DefaultTypedControllerRateLimiter[string](),
+		Informer:       endpointSliceInformer.Informer(),
+		Lister:         c.endpointSliceLister.List,
+		ObjNeedsUpdate: c.endpointSliceNeedsUpdate,
+		Reconcile:      c.reconcileAllNetworkPeerings,
+		Threadiness:    1,
+	}
+	c.endpointSliceController = controller.NewController[discovery.EndpointSlice](netInfo.GetNetworkName()+"-network-peering-endpointslice-controller", endpointSliceConfig)
+
+	nodeConfig := &controller.ControllerConfig[corev1.Node]{
+		RateLimiter:    workqueue.DefaultTypedControllerRateLimiter[string](),
+		Informer:       nodeInformer.Informer(),
+		Lister:         c.nodeLister.List,
+		ObjNeedsUpdate: c.nodeNeedsUpdate,
+		Reconcile:      c.reconcileAllNetworkPeerings,
+		Threadiness:    1,
+	}
+	c.nodeController = controller.NewController[corev1.Node](netInfo.GetNetworkName()+"-network-peering-node-controller", nodeConfig)
+
+	return c
+}
+
+// Start starts the NetworkPeering controller.
+func (c *Controller) Start() error {
+	klog.Infof("Starting network peering controller for network %s", c.netInfo.GetNetworkName())
+	return controller.StartWithInitialSync(c.syncNetworkPeerings, c.peeringController, c.serviceController, c.endpointSliceController, c.nodeController)
+}
+
+// Stop stops the NetworkPeering controller.
+func (c *Controller) Stop() {
+	klog.Infof("Stopping network peering controller for network %s", c.netInfo.GetNetworkName())
+	controller.Stop(c.peeringController, c.serviceController, c.endpointSliceController, c.nodeController)
+}
+
+func peeringNeedsUpdate(oldObj, newObj *udnv1.NetworkPeering) bool {
+	return oldObj == nil || newObj == nil ||
+		oldObj.Annotations[util.OvnNetworkPeering] != newObj.Annotations[util.OvnNetworkPeering]
+}
+
+func serviceNeedsUpdate(oldObj, newObj *corev1.Service) bool {
+	return oldObj == nil || newObj == nil || !reflect.DeepEqual(oldObj.Labels, newObj.Labels) ||
+		!reflect.DeepEqual(oldObj.Spec.ClusterIPs, newObj.Spec.ClusterIPs) || !reflect.DeepEqual(oldObj.Spec.Ports, newObj.Spec.Ports)
+}
+
+// endpointSliceNeedsUpdate returns true for the changes of the EndpointSlices
+// mirrored for this network or for the networks it is peered with.
+func (c *Controller) endpointSliceNeedsUpdate(oldObj, newObj *discovery.EndpointSlice) bool {
+	isMirrored := func(obj *discovery.EndpointSlice) bool {
+		if obj == nil {
+			return false
+		}
+		network := obj.Labels[types.LabelUserDefinedEndpointSliceNetwork]
+		return network != "" && (network == c.netInfo.GetNetworkName() || c.isPeerNetwork(network))
+	}
+	if !isMirrored(oldObj) && !isMirrored(newObj) {
+		return false
+	}
+	return oldObj == nil || newObj == nil || !reflect.DeepEqual(oldObj.Endpoints, newObj.Endpoints) ||
+		!reflect.DeepEqual(oldObj.Ports, newObj.Ports) || !reflect.DeepEqual(oldObj.Labels, newObj.Labels)
+}
+
+// nodeNeedsUpdate returns true when the nodes of the local zone might have
+// changed; only the routers of layer2 networks depend on the local nodes.
+func (c *Controller) nodeNeedsUpdate(oldObj, newObj *corev1.Node) bool {
+	if c.netInfo.TopologyType() != types.Layer2Topology {
+		return false
+	}
+	return oldObj == nil || newObj == nil || util.GetNodeZone(oldObj) != util.GetNodeZone(newObj)
+}
+
+// isPeerNetwork returns true if the given network is peered with this network.
+func (c *Controller) isPeerNetwork(network string) bool {
+	peerings, err := c.peeringLister.List(labels.Everything())
+	if err != nil {
+		klog.Errorf("Failed to list network peerings: %v", err)
+		return false
+	}
+	for _, peering := range peerings {
+		peeringConfig, err := util.ParseNetworkPeeringAnnotation(peering)
+		if err != nil {
+			continue
+		}
+		names := sets.New[string]()
+		for _, peered := range peeringConfig.Networks {
+			names.Insert(peered.Name)
+		}
+		if names.Has(c.netInfo.GetNetworkName()) && names.Has(network) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Controller) reconcileAllNetworkPeerings(_ string) error {
+	c.peeringController.ReconcileAll()
+	return nil
+}
+
+// syncNetworkPeerings removes the router ports, policies and load balancers of
+// the NetworkPeerings that were deleted while the controller was not running.
+func (c *Controller) syncNetworkPeerings() error {
+	peerings, err := c.peeringLister.List(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("failed to list network peerings: %w", err)
+	}
+	existing := sets.New[string]()
+	for _, peering := range peerings {
+		existing.Insert(peering.Name)
+	}
+
+	ports, err := libovsdbops.FindLogicalRouterPortWithPredicate(c.nbClient, func(item *nbdb.LogicalRouterPort) bool {
+		return item.ExternalIDs[types.NetworkExternalID] == c.netInfo.GetNetworkName() &&
+			item.ExternalIDs[types.NetworkPeeringExternalID] != ""
+	})
+	if err != nil {
+		return fmt.Errorf("failed to find network peering router ports of network %s: %w", c.netInfo.GetNetworkName(), err)
+	}
+	stale := sets.New[string]()
+	for _, port := range ports {
+		stale.Insert(port.ExternalIDs[types.NetworkPeeringExternalID])
+	}
+	policies, err := libovsdbops.FindLogicalRouterPoliciesWithPredicate(c.nbClient,
+		libovsdbops.GetPredicate[*nbdb.LogicalRouterPolicy](c.getPolicyDbIDs("", "", ""), nil))
+	if err != nil {
+		return fmt.Errorf("failed to find network peering router policies of network %s: %w", c.netInfo.GetNetworkName(), err)
+	}
+	for _, policy := range policies {
+		stale.Insert(policy.ExternalIDs[libovsdbops.ObjectNameKey.String()])
+	}
+	lbs, err := c.findLoadBalancers("")
+	if err != nil {
+		return err
+	}
+	for _, lb := range lbs {
+		stale.Insert(lb.ExternalIDs[types.LoadBalancerOwnerExternalID])
+	}
+
+	for _, peeringName := range sets.List(stale.Difference(existing)) {
+		if err := c.deleteNetworkPeering(peeringName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reconcileNetworkPeering connects the network router to the router of its
+// peer if the network is part of the NetworkPeering, or removes the connection
+// otherwise.
+func (c *Controller) reconcileNetworkPeering(key string) error {
+	startTime := time.Now()
+	klog.V(5).Infof("Reconciling network peering %s for network %s", key, c.netInfo.GetNetworkName())
+	defer func() {
+		klog.V(5).Infof("Finished reconciling network peering %s for network %s, took %v", key, c.netInfo.GetNetworkName(), time.Since(startTime))
+	}()
+
+	peering, err := c.peeringLister.Get(key)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to get NetworkPeering %q from cache: %w", key, err)
+	}
+	var peeringConfig *util.NetworkPeeringConfig
+	if peering != nil {
+		peeringConfig, err = util.ParseNetworkPeeringAnnotation(peering)
+		if err != nil && !util.IsAnnotationNotSetError(err) {
+			return err
+		}
+	}
+
+	local := -1
+	if peeringConfig != nil {
+		for i := range peeringConfig.Networks {
+			if peeringConfig.Networks[i].Name == c.netInfo.GetNetworkName() {
+				local = i
+				break
+			}
+		}
+	}
+	if local < 0 {
+		return c.deleteNetworkPeering(key)
+	}
+
+	routerName, err := c.getRouterName()
+	if err != nil {
+		return err
+	}
+	if routerName == "" {
+		// no router of this network in the local zone
+		return c.deleteNetworkPeering(key)
+	}
+
+	var exposedServices, peerExposedServices *metav1.LabelSelector
+	if len(peering.Spec.Networks) == len(peeringConfig.Networks) {
+		exposedServices = peering.Spec.Networks[local].ExposedServices
+		peerExposedServices = peering.Spec.Networks[1-local].ExposedServices
+	}
+	if err := c.ensureNetworkPeering(key, routerName, &peeringConfig.Networks[local], &peeringConfig.Networks[1-local], exposedServices); err != nil {
+		return err
+	}
+	return c.ensurePeerServiceLoadBalancers(key, &peeringConfig.Networks[1-local], peerExposedServices)
+}
+
+// getRouterName returns the name of the router of the network in the local
+// zone, or an empty string if there is none.
+func (c *Controller) getRouterName() (string, error) {
+	if c.netInfo.TopologyType() != types.Layer2Topology {
+		return c.netInfo.GetNetworkScopedClusterRouterName(), nil
+	}
+	// the gateway router of the local node: a router port can only be
+	// connected to a single peer, so there must be a single node in the zone
+	nodes, err := c.nodeLister.List(labels.Everything())
+	if err != nil {
+		return "", fmt.Errorf("failed to list nodes: %w", err)
+	}
+	var localNodes []string
+	for _, node := range nodes {
+		if util.GetNodeZone(node) == c.zone {
+			localNodes = append(localNodes, node.Name)
+		}
+	}
+	switch len(localNodes) {
+	case 0:
+		return "", nil
+	case 1:
+		return c.netInfo.GetNetworkScopedGWRouterName(localNodes[0]), nil
+	default:
+		return "", fmt.Errorf("network peering of layer2 network %s requires a single node in zone %s, found %d",
+			c.netInfo.GetNetworkName(), c.zone, len(localNodes))
+	}
+}
+
+// getPolicyDbIDs returns the IDs of the network peering router policies; empty
+// values are left unset so that the IDs can be used as a predicate.
+func (c *Controller) getPolicyDbIDs(peeringName, priority, ipFamily string) *libovsdbops.DbObjectIDs {
+	ids := map[libovsdbops.ExternalIDKey]string{}
+	for key, value := range map[libovsdbops.ExternalIDKey]string{
+		libovsdbops.PriorityKey:   priority,
+		libovsdbops.ObjectNameKey: peeringName,
+		libovsdbops.IPFamilyKey:   ipFamily,
+	} {
+		if value != "" {
+			ids[key] = value
+		}
+	}
+	return libovsdbops.NewDbObjectIDs(libovsdbops.LogicalRouterPolicyNetworkPeering, c.controllerName, ids)
+}
+
+// ensureNetworkPeering creates or updates the router port connected to the
+// peer network and the router policies of the NetworkPeering, and removes the
+// stale ones.
+func (c *Controller) ensureNetworkPeering(peeringName, routerName string, local, peer *util.PeeredNetworkConfig,
+	exposedServices *metav1.LabelSelector) error {
+	linkIPs, err := util.ParseIPNets(local.LinkIPs)
+	if err != nil {
+		return err
+	}
+	portName := util.GetNetworkPeeringPortName(local.Name, peeringName)
+	peerPortName := util.GetNetworkPeeringPortName(peer.Name, peeringName)
+	port := &nbdb.LogicalRouterPort{
+		Name:     portName,
+		MAC:      util.IPAddrToHWAddr(linkIPs[0].IP).String(),
+		Networks: local.LinkIPs,
+		Peer:     &peerPortName,
+		ExternalIDs: map[string]string{
+			types.NetworkExternalID:        local.Name,
+			types.NetworkPeeringExternalID: peeringName,
+		},
+	}
+	// the port and policies move to another router when the local node of a
+	// layer2 network changes
+	routers, err := c.findNetworkPeeringRouters(peeringName)
+	if err != nil {
+		return err
+	}
+	if len(routers) > 1 || (len(routers) == 1 && routers[0].Name != routerName) {
+		if err := c.deleteNetworkPeering(peeringName); err != nil {
+			return err
+		}
+	}
+	err = libovsdbops.CreateOrUpdateLogicalRouterPort(c.nbClient, &nbdb.LogicalRouter{Name: routerName}, port, nil,
+		&port.MAC, &port.Networks, &port.Peer, &port.ExternalIDs)
+	if err != nil {
+		return fmt.Errorf("failed to create or update router port %s on router %s: %w", portName, routerName, err)
+	}
+
+	policies, err := c.buildPolicies(peeringName, portName, local, peer, exposedServices)
+	if err != nil {
+		return err
+	}
+	var ops []ovsdb.Operation
+	for _, policy := range policies {
+		ops, err = libovsdbops.CreateOrUpdateLogicalRouterPolicyWithPredicateOps(c.nbClient, ops, routerName, policy,
+			libovsdbops.GetPredicate[*nbdb.LogicalRouterPolicy](c.getPolicyDbIDs(peeringName,
+				policy.ExternalIDs[libovsdbops.PriorityKey.String()], policy.ExternalIDs[libovsdbops.IPFamilyKey.String()]), nil))
+		if err != nil {
+			return fmt.Errorf("failed to create or update network peering %s policy on router %s: %w", peeringName, routerName, err)
+		}
+	}
+	desired := sets.New[string]()
+	for _, policy := range policies {
+		desired.Insert(policy.ExternalIDs[libovsdbops.PrimaryIDKey.String()])
+	}
+	stalePredicate := libovsdbops.GetPredicate[*nbdb.LogicalRouterPolicy](c.getPolicyDbIDs(peeringName, "", ""),
+		func(item *nbdb.LogicalRouterPolicy) bool {
+			return !desired.Has(item.ExternalIDs[libovsdbops.PrimaryIDKey.String()])
+		})
+	ops, err = libovsdbops.DeleteLogicalRouterPolicyWithPredicateOps(c.nbClient, ops, routerName, stalePredicate)
+	if err != nil {
+		return fmt.Errorf("failed to delete stale network peering %s policies on router %s: %w", peeringName, routerName, err)
+	}
+	if _, err := libovsdbops.TransactAndCheck(c.nbClient, ops); err != nil {
+		return fmt.Errorf("failed to configure network peering %s policies on router %s: %w", peeringName, routerName, err)
+	}
+	return nil
+}
+
+// buildPolicies returns the router policies of the NetworkPeering, for every IP
+// family of the link:
+//   - a reroute policy sending the traffic from the network to the peer network
+//     subnets through the peer router port
+//   - if the network only exposes some services to its peer, an allow policy
+//     for the traffic from the peer to the endpoints of those services and a
+//     drop policy for the rest of the traffic from the peer. The policies are
+//     stateless, so the replies to the connections the network initiates to
+//     its peer are dropped as well: the network can only be reached by its peer.
+func (c *Controller) buildPolicies(peeringName, portName string, local, peer *util.PeeredNetworkConfig,
+	exposedServices *metav1.LabelSelector) ([]*nbdb.LogicalRouterPolicy, error) {
+	var allowMatches map[string]string
+	if exposedServices != nil {
+		var err error
+		allowMatches, err = c.getExposedEndpointsMatches(exposedServices)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	newPolicy := func(priority, ipFamily, match string, action nbdb.LogicalRouterPolicyAction, nexthops ...string) *nbdb.LogicalRouterPolicy {
+		intPriority, _ := strconv.Atoi(priority)
+		return &nbdb.LogicalRouterPolicy{
+			Priority:    intPriority,
+			Match:       match,
+			Action:      action,
+			Nexthops:    nexthops,
+			ExternalIDs: c.getPolicyDbIDs(peeringName, priority, ipFamily).GetExternalIDs(),
+		}
+	}
+
+	var policies []*nbdb.LogicalRouterPolicy
+	for _, peerLinkIP := range peer.LinkIPs {
+		ip, _, err := net.ParseCIDR(peerLinkIP)
+		if err != nil {
+			return nil, err
+		}
+		ipFamily := ipFamilyV4
+		if utilnet.IsIPv6(ip) {
+			ipFamily = ipFamilyV6
+		}
+		localSubnets := filterSubnets(local.Subnets, ipFamily)
+		peerSubnets := filterSubnets(peer.Subnets, ipFamily)
+		if len(localSubnets) == 0 || len(peerSubnets) == 0 {
+			continue
+		}
+		// only the traffic originating from the network is rerouted, the
+		// peer of a peer network is not reachable
+		match := fmt.Sprintf("%s && %s", setMatch(ipFamily+".src", localSubnets), setMatch(ipFamily+".dst", peerSubnets))
+		policies = append(policies, newPolicy(types.NetworkPeeringReroutePriority, ipFamily, match,
+			nbdb.LogicalRouterPolicyActionReroute, ip.String()))
+
+		if exposedServices == nil {
+			continue
+		}
+		inport := fmt.Sprintf("inport == %q && %s", portName, ipFamily)
+		if allowMatch, ok := allowMatches[ipFamily]; ok {
+			policies = append(policies, newPolicy(types.NetworkPeeringAllowPriority, ipFamily,
+				fmt.Sprintf("%s && (%s)", inport, allowMatch), nbdb.LogicalRouterPolicyActionAllow))
+		}
+		policies = append(policies, newPolicy(types.NetworkPeeringDropPriority, ipFamily, inport,
+			nbdb.LogicalRouterPolicyActionDrop))
+	}
+	return policies, nil
+}
+
+// exposedService is a service of a network exposed to its peer, with the
+// EndpointSlices mirrored for the network.
+type exposedService struct {
+	service        *corev1.Service
+	endpointSlices []*discovery.EndpointSlice
+}
+
+// getExposedServices returns the services of the given network selected by the
+// given selector, or all of them if the selector is nil.
+func (c *Controller) getExposedServices(networkName string, exposedServices *metav1.LabelSelector) ([]*exposedService, error) {
+	selector := labels.Everything()
+	if exposedServices != nil {
+		var err error
+		selector, err = metav1.LabelSelectorAsSelector(exposedServices)
+		if err != nil {
+			return nil, fmt.Errorf("invalid exposed services selector: %w", err)
+		}
+	}
+	endpointSlices, err := c.endpointSliceLister.List(labels.SelectorFromSet(labels.Set{
+		types.LabelUserDefinedEndpointSliceNetwork: networkName,
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list endpoint slices of network %s: %w", networkName, err)
+	}
+
+	services := map[string]*exposedService{}
+	for _, endpointSlice := range endpointSlices {
+		serviceName := endpointSlice.Labels[types.LabelUserDefinedServiceName]
+		if serviceName == "" {
+			continue
+		}
+		key := endpointSlice.Namespace + "/" + serviceName
+		if services[key] == nil {
+			service, err := c.serviceLister.Services(endpointSlice.Namespace).Get(serviceName)
+			if err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return nil, fmt.Errorf("failed to get service %s: %w", key, err)
+			}
+			if !selector.Matches(labels.Set(service.Labels)) {
+				continue
+			}
+			services[key] = &exposedService{service: service}
+		}
+		services[key].endpointSlices = append(services[key].endpointSlices, endpointSlice)
+	}
+
+	keys := make([]string, 0, len(services))
+	for key := range services {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	exposed := make([]*exposedService, 0, len(keys))
+	for _, key := range keys {
+		exposed = append(exposed, services[key])
+	}
+	return exposed, nil
+}
+
+func endpointSliceIPFamily(endpointSlice *discovery.EndpointSlice) string {
+	switch endpointSlice.AddressType {
+	case discovery.AddressTypeIPv4:
+		return ipFamilyV4
+	case discovery.AddressTypeIPv6:
+		return ipFamilyV6
+	default:
+		return ""
+	}
+}
+
+func isEndpointReady(endpoint discovery.Endpoint) bool {
+	return endpoint.Conditions.Ready == nil || *endpoint.Conditions.Ready
+}
+
+// getExposedEndpointsMatches returns, per IP family, the match of the traffic
+// destined to the ready endpoints of the network services selected by the given
+// selector.
+func (c *Controller) getExposedEndpointsMatches(exposedServices *metav1.LabelSelector) (map[string]string, error) {
+	services, err := c.getExposedServices(c.netInfo.GetNetworkName(), exposedServices)
+	if err != nil {
+		return nil, err
+	}
+
+	// IP family -> destination port match -> endpoint IPs
+	endpoints := map[string]map[string]sets.Set[string]{}
+	for _, service := range services {
+		for _, endpointSlice := range service.endpointSlices {
+			ipFamily := endpointSliceIPFamily(endpointSlice)
+			if ipFamily == "" {
+				continue
+			}
+			for _, port := range endpointSlice.Ports {
+				if port.Port == nil {
+					continue
+				}
+				protocol := corev1.ProtocolTCP
+				if port.Protocol != nil {
+					protocol = *port.Protocol
+				}
+				portMatch := fmt.Sprintf("%s.dst == %d", strings.ToLower(string(protocol)), *port.Port)
+				for _, endpoint := range endpointSlice.Endpoints {
+					if !isEndpointReady(endpoint) {
+						continue
+					}
+					if endpoints[ipFamily] == nil {
+						endpoints[ipFamily] = map[string]sets.Set[string]{}
+					}
+					if endpoints[ipFamily][portMatch] == nil {
+						endpoints[ipFamily][portMatch] = sets.New[string]()
+					}
+					endpoints[ipFamily][portMatch].Insert(endpoint.Addresses...)
+				}
+			}
+		}
+	}
+
+	matches := map[string]string{}
+	for ipFamily, portEndpoints := range endpoints {
+		var portMatches []string
+		for portMatch, ips := range portEndpoints {
+			portMatches = append(portMatches, fmt.Sprintf("(%s && %s)", setMatch(ipFamily+".dst", sets.List(ips)), portMatch))
+		}
+		sort.Strings(portMatches)
+		matches[ipFamily] = strings.Join(portMatches, " || ")
+	}
+	return matches, nil
+}
+
+// ensurePeerServiceLoadBalancers creates or updates, per protocol, the load
+// balancers of the cluster IPs of the services exposed by the peer network, and
+// adds them to the switch load balancer group of the network so that the pods
+// of the network reach the endpoints of those services through the peering.
+func (c *Controller) ensurePeerServiceLoadBalancers(peeringName string, peer *util.PeeredNetworkConfig,
+	peerExposedServices *metav1.LabelSelector) error {
+	services, err := c.getExposedServices(peer.Name, peerExposedServices)
+	if err != nil {
+		return err
+	}
+	// only the IP families of the link are routed to the peer
+	ipFamilies := sets.New[string]()
+	for _, linkIP := range peer.LinkIPs {
+		if utilnet.IsIPv6CIDRString(linkIP) {
+			ipFamilies.Insert(ipFamilyV6)
+		} else {
+			ipFamilies.Insert(ipFamilyV4)
+		}
+	}
+
+	// protocol -> VIP -> backends
+	vips := map[corev1.Protocol]map[string]sets.Set[string]{}
+	for _, exposed := range services {
+		if !util.IsClusterIPSet(exposed.service) {
+			continue
+		}
+		for _, clusterIP := range exposed.service.Spec.ClusterIPs {
+			ipFamily := ipFamilyV4
+			if utilnet.IsIPv6String(clusterIP) {
+				ipFamily = ipFamilyV6
+			}
+			if !ipFamilies.Has(ipFamily) {
+				continue
+			}
+			for _, servicePort := range exposed.service.Spec.Ports {
+				backends := getServicePortBackends(exposed.endpointSlices, servicePort, ipFamily)
+				if len(backends) == 0 {
+					continue
+				}
+				if vips[servicePort.Protocol] == nil {
+					vips[servicePort.Protocol] = map[string]sets.Set[string]{}
+				}
+				vips[servicePort.Protocol][util.JoinHostPortInt32(clusterIP, servicePort.Port)] = backends
+			}
+		}
+	}
+
+	existing, err := c.findLoadBalancers(peeringName)
+	if err != nil {
+		return err
+	}
+	existingByName := map[string]*nbdb.LoadBalancer{}
+	for _, lb := range existing {
+		existingByName[lb.Name] = lb
+	}
+	var lbs []*nbdb.LoadBalancer
+	for _, protocol := range []corev1.Protocol{corev1.ProtocolTCP, corev1.ProtocolUDP, corev1.ProtocolSCTP} {
+		if len(vips[protocol]) == 0 {
+			continue
+		}
+		lbVips := map[string]string{}
+		for vip, backends := range vips[protocol] {
+			lbVips[vip] = strings.Join(sets.List(backends), ",")
+		}
+		lb := libovsdbops.BuildLoadBalancer(
+			c.netInfo.GetNetworkScopedLoadBalancerName(fmt.Sprintf("NetworkPeering_%s_%s", peeringName, protocol)),
+			nbdb.LoadBalancerProtocol(strings.ToLower(string(protocol))),
+			nil,
+			lbVips,
+			map[string]string{},
+			map[string]string{
+				types.LoadBalancerKindExternalID:  loadBalancerKind,
+				types.LoadBalancerOwnerExternalID: peeringName,
+				types.NetworkExternalID:           c.netInfo.GetNetworkName(),
+			},
+		)
+		if current, ok := existingByName[lb.Name]; ok {
+			lb.UUID = current.UUID
+			delete(existingByName, lb.Name)
+		}
+		lbs = append(lbs, lb)
+	}
+	stale := make([]*nbdb.LoadBalancer, 0, len(existingByName))
+	for _, lb := range existingByName {
+		stale = append(stale, lb)
+	}
+
+	group := &nbdb.LoadBalancerGroup{Name: c.netInfo.GetNetworkScopedLoadBalancerGroupName(types.ClusterSwitchLBGroupName)}
+	ops, err := libovsdbops.CreateOrUpdateLoadBalancersOps(c.nbClient, nil, lbs...)
+	if err != nil {
+		return fmt.Errorf("failed to create or update network peering %s load balancers: %w", peeringName, err)
+	}
+	if len(lbs) > 0 {
+		ops, err = libovsdbops.AddLoadBalancersToGroupOps(c.nbClient, ops, group, lbs...)
+		if err != nil {
+			return fmt.Errorf("failed to add network peering %s load balancers to group %s: %w", peeringName, group.Name, err)
+		}
+	}
+	ops, err = c.deleteLoadBalancersOps(ops, stale)
+	if err != nil {
+		return fmt.Errorf("failed to delete stale network peering %s load balancers: %w", peeringName, err)
+	}
+	if _, err := libovsdbops.TransactAndCheck(c.nbClient, ops); err != nil {
+		return fmt.Errorf("failed to configure network peering %s load balancers: %w", peeringName, err)
+	}
+	return nil
+}
+
+// getServicePortBackends returns the addresses of the ready endpoints of the
+// given service port in the given IP family.
+func getServicePortBackends(endpointSlices []*discovery.EndpointSlice, servicePort corev1.ServicePort, ipFamily string) sets.Set[string] {
+	backends := sets.New[string]()
+	for _, endpointSlice := range endpointSlices {
+		if endpointSliceIPFamily(endpointSlice) != ipFamily {
+			continue
+		}
+		for _, port := range endpointSlice.Ports {
+			protocol := corev1.ProtocolTCP
+			if port.Protocol != nil {
+				protocol = *port.Protocol
+			}
+			if port.Port == nil || ptr.Deref(port.Name, "") != servicePort.Name || protocol != servicePort.Protocol {
+				continue
+			}
+			for _, endpoint := range endpointSlice.Endpoints {
+				if !isEndpointReady(endpoint) {
+					continue
+				}
+				for _, address := range endpoint.Addresses {
+					backends.Insert(util.JoinHostPortInt32(address, *port.Port))
+				}
+			}
+		}
+	}
+	return backends
+}
+
+// findLoadBalancers returns the load balancers of the services exposed by the
+// peer networks, for the given NetworkPeering or for all of them if empty.
+func (c *Controller) findLoadBalancers(peeringName string) ([]*nbdb.LoadBalancer, error) {
+	lbs, err := libovsdbops.FindLoadBalancersWithPredicate(c.nbClient, func(item *nbdb.LoadBalancer) bool {
+		return item.ExternalIDs[types.LoadBalancerKindExternalID] == loadBalancerKind &&
+			item.ExternalIDs[types.NetworkExternalID] == c.netInfo.GetNetworkName() &&
+			(peeringName == "" || item.ExternalIDs[types.LoadBalancerOwnerExternalID] == peeringName)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find network peering load balancers of network %s: %w", c.netInfo.GetNetworkName(), err)
+	}
+	return lbs, nil
+}
+
+// deleteLoadBalancersOps removes the given load balancers from the switch load
+// balancer group of the network and deletes them.
+func (c *Controller) deleteLoadBalancersOps(ops []ovsdb.Operation, lbs []*nbdb.LoadBalancer) ([]ovsdb.Operation, error) {
+	if len(lbs) == 0 {
+		return ops, nil
+	}
+	group := &nbdb.LoadBalancerGroup{Name: c.netInfo.GetNetworkScopedLoadBalancerGroupName(types.ClusterSwitchLBGroupName)}
+	ops, err := libovsdbops.RemoveLoadBalancersFromGroupOps(c.nbClient, ops, group, lbs...)
+	if err != nil {
+		return nil, err
+	}
+	return libovsdbops.DeleteLoadBalancersOps(c.nbClient, ops, lbs...)
+}
+
+// findNetworkPeeringRouters returns the routers with the router port or
+// policies of the NetworkPeering.
+func (c *Controller) findNetworkPeeringRouters(peeringName string) ([]*nbdb.LogicalRouter, error) {
+	uuids := sets.New[string]()
+	ports, err := libovsdbops.FindLogicalRouterPortWithPredicate(c.nbClient, func(item *nbdb.LogicalRouterPort) bool {
+		return item.ExternalIDs[types.NetworkExternalID] == c.netInfo.GetNetworkName() &&
+			item.ExternalIDs[types.NetworkPeeringExternalID] == peeringName
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find network peering %s router ports: %w", peeringName, err)
+	}
+	for _, port := range ports {
+		uuids.Insert(port.UUID)
+	}
+	policies, err := libovsdbops.FindLogicalRouterPoliciesWithPredicate(c.nbClient,
+		libovsdbops.GetPredicate[*nbdb.LogicalRouterPolicy](c.getPolicyDbIDs(peeringName, "", ""), nil))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find network peering %s policies: %w", peeringName, err)
+	}
+	for _, policy := range policies {
+		uuids.Insert(policy.UUID)
+	}
+	if len(uuids) == 0 {
+		return nil, nil
+	}
+	routers, err := libovsdbops.FindLogicalRoutersWithPredicate(c.nbClient, func(item *nbdb.LogicalRouter) bool {
+		return uuids.HasAny(item.Ports...) || uuids.HasAny(item.Policies...)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find routers of network peering %s: %w", peeringName, err)
+	}
+	return routers, nil
+}
+
+// deleteNetworkPeering removes the router port, policies and load balancers of
+// the NetworkPeering from the network.
+func (c *Controller) deleteNetworkPeering(peeringName string) error {
+	lbs, err := c.findLoadBalancers(peeringName)
+	if err != nil {
+		return err
+	}
+	ops, err := c.deleteLoadBalancersOps(nil, lbs)
+	if err != nil {
+		return fmt.Errorf("failed to delete network peering %s load balancers: %w", peeringName, err)
+	}
+	if _, err := libovsdbops.TransactAndCheck(c.nbClient, ops); err != nil {
+		return fmt.Errorf("failed to delete network peering %s load balancers: %w", peeringName, err)
+	}
+
+	routers, err := c.findNetworkPeeringRouters(peeringName)
+	if err != nil {
+		return err
+	}
+	portPredicate := func(item *nbdb.LogicalRouterPort) bool {
+		return item.ExternalIDs[types.NetworkExternalID] == c.netInfo.GetNetworkName() &&
+			item.ExternalIDs[types.NetworkPeeringExternalID] == peeringName
+	}
+	policyPredicate := libovsdbops.GetPredicate[*nbdb.LogicalRouterPolicy](c.getPolicyDbIDs(peeringName, "", ""), nil)
+	for _, router := range routers {
+		ports, err := libovsdbops.FindLogicalRouterPortWithPredicate(c.nbClient, func(item *nbdb.LogicalRouterPort) bool {
+			return portPredicate(item) && sets.New(router.Ports...).Has(item.UUID)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to find network peering %s router ports: %w", peeringName, err)
+		}
+		if err := libovsdbops.DeleteLogicalRouterPorts(c.nbClient, router, ports...); err != nil {
+			return fmt.Errorf("failed to delete network peering %s ports from router %s: %w", peeringName, router.Name, err)
+		}
+		if err := libovsdbops.DeleteLogicalRouterPoliciesWithPredicate(c.nbClient, router.Name, policyPredicate); err != nil {
+			return fmt.Errorf("failed to delete network peering %s policies from router %s: %w", peeringName, router.Name, err)
+		}
+	}
+	return nil
+}
+
+func filterSubnets(subnets []string, ipFamily string) []string {
+	var filtered []string
+	for _, subnet := range subnets {
+		if utilnet.IsIPv6CIDRString(subnet) == (ipFamily == ipFamilyV6) {
+			filtered = append(filtered, subnet)
+		}
+	}
+	return filtered
+}
+
+// setMatch returns the match of the field against the given values.
+func setMatch(field string, values []string) string {
+	if len(values) == 1 {
+		return fmt.Sprintf("%s == %s", field, values[0])
+	}
+	return fmt.Sprintf("%s == {%s}", field, strings.Join(values, ", "))
+}
@@ -0,0 +1,273 @@
+package networkpeering
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	cnitypes "github.com/containernetworking/cni/pkg/types"
+	"github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	discovery "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilpointer "k8s.io/utils/pointer"
+
+	ovncnitypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/cni/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	udnv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	libovsdbtest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+const (
+	netName        = "blue.tenant"
+	controllerName = netName + "-network-controller"
+	routerName     = "blue.tenant_ovn_cluster_router"
+	portName       = "blue.tenant_rtop-peering"
+	peerPortName   = "cluster.udn.shared_rtop-peering"
+	peeringConfig  = `{"id":1,"networks":[` +
+		`{"name":"cluster.udn.shared","linkIPs":["100.91.0.2/31"],"subnets":["10.20.0.0/16"]},` +
+		`{"name":"blue.tenant","linkIPs":["100.91.0.3/31"],"subnets":["10.10.0.0/16"]}]}`
+)
+
+func newNetworkPeering(exposedServices *metav1.LabelSelector) *udnv1.NetworkPeering {
+	return &udnv1.NetworkPeering{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "peering",
+			Annotations: map[string]string{util.OvnNetworkPeering: peeringConfig},
+		},
+		Spec: udnv1.NetworkPeeringSpec{
+			Networks: []udnv1.PeeredNetwork{
+				{Kind: udnv1.PeeredNetworkKindClusterUserDefinedNetwork, Name: "shared"},
+				{Kind: udnv1.PeeredNetworkKindUserDefinedNetwork, Namespace: "blue", Name: "tenant", ExposedServices: exposedServices},
+			},
+		},
+	}
+}
+
+func newService(name string, labels map[string]string) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "blue", Name: name, Labels: labels},
+	}
+}
+
+func newMirroredEndpointSlice(serviceName string, port int32, ips ...string) *discovery.EndpointSlice {
+	endpointSlice := &discovery.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "blue",
+			Name:      serviceName + "-mirrored",
+			Labels: map[string]string{
+				types.LabelUserDefinedServiceName:          serviceName,
+				types.LabelUserDefinedEndpointSliceNetwork: netName,
+			},
+		},
+		AddressType: discovery.AddressTypeIPv4,
+		Ports:       []discovery.EndpointPort{{Port: utilpointer.Int32(port)}},
+	}
+	for _, ip := range ips {
+		endpointSlice.Endpoints = append(endpointSlice.Endpoints, discovery.Endpoint{
+			Addresses:  []string{ip},
+			Conditions: discovery.EndpointConditions{Ready: utilpointer.Bool(true)},
+		})
+	}
+	return endpointSlice
+}
+
+// newPeerService returns a service of the peer network with its mirrored
+// EndpointSlice.
+func newPeerService(clusterIP string, port, targetPort int32, ips ...string) (*corev1.Service, *discovery.EndpointSlice) {
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "shared", Name: "api"},
+		Spec: corev1.ServiceSpec{
+			ClusterIP:  clusterIP,
+			ClusterIPs: []string{clusterIP},
+			Ports:      []corev1.ServicePort{{Protocol: corev1.ProtocolTCP, Port: port}},
+		},
+	}
+	endpointSlice := newMirroredEndpointSlice("api", targetPort, ips...)
+	endpointSlice.Namespace = "shared"
+	endpointSlice.Labels[types.LabelUserDefinedEndpointSliceNetwork] = "cluster.udn.shared"
+	return service, endpointSlice
+}
+
+func TestNetworkPeeringController(t *testing.T) {
+	newPort := func() *nbdb.LogicalRouterPort {
+		return &nbdb.LogicalRouterPort{
+			UUID:     "port-UUID",
+			Name:     portName,
+			MAC:      "0a:58:64:5b:00:03",
+			Networks: []string{"100.91.0.3/31"},
+			Peer:     utilpointer.String(peerPortName),
+			ExternalIDs: map[string]string{
+				types.NetworkExternalID:        netName,
+				types.NetworkPeeringExternalID: "peering",
+			},
+		}
+	}
+	c := &Controller{controllerName: controllerName}
+	newPolicy := func(uuid string, priority int, match string, action nbdb.LogicalRouterPolicyAction, nexthops ...string) *nbdb.LogicalRouterPolicy {
+		return &nbdb.LogicalRouterPolicy{
+			UUID:        uuid,
+			Priority:    priority,
+			Match:       match,
+			Action:      action,
+			Nexthops:    nexthops,
+			ExternalIDs: c.getPolicyDbIDs("peering", fmt.Sprint(priority), ipFamilyV4).GetExternalIDs(),
+		}
+	}
+	reroutePolicy := newPolicy("reroute-UUID", 1006, "ip4.src == 10.10.0.0/16 && ip4.dst == 10.20.0.0/16",
+		nbdb.LogicalRouterPolicyActionReroute, "100.91.0.2")
+
+	lbGroupName := "blue.tenant_clusterSwitchLBGroup"
+	newPeerServiceLB := func(vips map[string]string) *nbdb.LoadBalancer {
+		protocol := nbdb.LoadBalancerProtocolTCP
+		return &nbdb.LoadBalancer{
+			UUID:     "lb-UUID",
+			Name:     "blue.tenant_NetworkPeering_peering_TCP",
+			Protocol: &protocol,
+			Vips:     vips,
+			ExternalIDs: map[string]string{
+				types.LoadBalancerKindExternalID:  loadBalancerKind,
+				types.LoadBalancerOwnerExternalID: "peering",
+				types.NetworkExternalID:           netName,
+			},
+		}
+	}
+	peerService, peerEndpointSlice := newPeerService("172.30.0.10", 80, 8080, "10.20.1.5", "10.20.2.5")
+
+	tests := []struct {
+		name       string
+		objects    []runtime.Object
+		initialDB  []libovsdbtest.TestData
+		expectedDB []libovsdbtest.TestData
+	}{
+		{
+			name:    "connects the network router to its peer",
+			objects: []runtime.Object{newNetworkPeering(nil)},
+			initialDB: []libovsdbtest.TestData{
+				&nbdb.LogicalRouter{UUID: "router-UUID", Name: routerName},
+			},
+			expectedDB: []libovsdbtest.TestData{
+				&nbdb.LogicalRouter{UUID: "router-UUID", Name: routerName, Ports: []string{"port-UUID"}, Policies: []string{"reroute-UUID"}},
+				newPort(),
+				reroutePolicy,
+			},
+		},
+		{
+			name: "only allows the traffic from the peer to the exposed services",
+			objects: []runtime.Object{
+				newNetworkPeering(&metav1.LabelSelector{MatchLabels: map[string]string{"exposed": "true"}}),
+				newService("web", map[string]string{"exposed": "true"}),
+				newMirroredEndpointSlice("web", 8080, "10.10.1.5", "10.10.2.5"),
+				newService("db", nil),
+				newMirroredEndpointSlice("db", 5432, "10.10.1.6"),
+			},
+			initialDB: []libovsdbtest.TestData{
+				&nbdb.LogicalRouter{UUID: "router-UUID", Name: routerName},
+			},
+			expectedDB: []libovsdbtest.TestData{
+				&nbdb.LogicalRouter{UUID: "router-UUID", Name: routerName, Ports: []string{"port-UUID"},
+					Policies: []string{"reroute-UUID", "allow-UUID", "drop-UUID"}},
+				newPort(),
+				reroutePolicy,
+				newPolicy("allow-UUID", 1008,
+					`inport == "blue.tenant_rtop-peering" && ip4 && ((ip4.dst == {10.10.1.5, 10.10.2.5} && tcp.dst == 8080))`,
+					nbdb.LogicalRouterPolicyActionAllow),
+				newPolicy("drop-UUID", 1007, `inport == "blue.tenant_rtop-peering" && ip4`, nbdb.LogicalRouterPolicyActionDrop),
+			},
+		},
+		{
+			// the network can't initiate connections to its peer as the
+			// replies coming through the peer port are not allowed
+			name: "drops all the traffic from the peer, replies included, when no service is exposed",
+			objects: []runtime.Object{
+				newNetworkPeering(&metav1.LabelSelector{MatchLabels: map[string]string{"exposed": "true"}}),
+				newService("db", nil),
+				newMirroredEndpointSlice("db", 5432, "10.10.1.6"),
+			},
+			initialDB: []libovsdbtest.TestData{
+				&nbdb.LogicalRouter{UUID: "router-UUID", Name: routerName},
+			},
+			expectedDB: []libovsdbtest.TestData{
+				&nbdb.LogicalRouter{UUID: "router-UUID", Name: routerName, Ports: []string{"port-UUID"},
+					Policies: []string{"reroute-UUID", "drop-UUID"}},
+				newPort(),
+				reroutePolicy,
+				newPolicy("drop-UUID", 1007, `inport == "blue.tenant_rtop-peering" && ip4`, nbdb.LogicalRouterPolicyActionDrop),
+			},
+		},
+		{
+			name:    "load balances the cluster IPs of the services exposed by the peer",
+			objects: []runtime.Object{newNetworkPeering(nil), peerService, peerEndpointSlice},
+			initialDB: []libovsdbtest.TestData{
+				&nbdb.LogicalRouter{UUID: "router-UUID", Name: routerName},
+				&nbdb.LoadBalancerGroup{UUID: "lb-group-UUID", Name: lbGroupName},
+			},
+			expectedDB: []libovsdbtest.TestData{
+				&nbdb.LogicalRouter{UUID: "router-UUID", Name: routerName, Ports: []string{"port-UUID"}, Policies: []string{"reroute-UUID"}},
+				newPort(),
+				reroutePolicy,
+				newPeerServiceLB(map[string]string{"172.30.0.10:80": "10.20.1.5:8080,10.20.2.5:8080"}),
+				&nbdb.LoadBalancerGroup{UUID: "lb-group-UUID", Name: lbGroupName, LoadBalancer: []string{"lb-UUID"}},
+			},
+		},
+		{
+			name: "removes the connection of a deleted peering",
+			initialDB: []libovsdbtest.TestData{
+				&nbdb.LogicalRouter{UUID: "router-UUID", Name: routerName, Ports: []string{"port-UUID"}, Policies: []string{"reroute-UUID"}},
+				newPort(),
+				reroutePolicy,
+				newPeerServiceLB(map[string]string{"172.30.0.10:80": "10.20.1.5:8080"}),
+				&nbdb.LoadBalancerGroup{UUID: "lb-group-UUID", Name: lbGroupName, LoadBalancer: []string{"lb-UUID"}},
+			},
+			expectedDB: []libovsdbtest.TestData{
+				&nbdb.LogicalRouter{UUID: "router-UUID", Name: routerName},
+				&nbdb.LoadBalancerGroup{UUID: "lb-group-UUID", Name: lbGroupName},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			g.Expect(config.PrepareTestConfig()).To(gomega.Succeed())
+			config.OVNKubernetesFeature.EnableMultiNetwork = true
+			config.OVNKubernetesFeature.EnableNetworkSegmentation = true
+			config.IPv4Mode = true
+
+			nbClient, cleanup, err := libovsdbtest.NewNBTestHarness(libovsdbtest.TestSetup{NBData: tt.initialDB}, nil)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			t.Cleanup(cleanup.Cleanup)
+
+			netInfo, err := util.NewNetInfo(&ovncnitypes.NetConf{
+				NetConf:  cnitypes.NetConf{Name: netName},
+				Topology: types.Layer3Topology,
+				Role:     types.NetworkRolePrimary,
+				Subnets:  "10.10.0.0/16/24",
+				NADName:  "blue/tenant",
+			})
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+
+			client := util.GetOVNClientset(tt.objects...).GetOVNKubeControllerClientset()
+			wf, err := factory.NewOVNKubeControllerWatchFactory(client)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			c := NewController(controllerName, netInfo, nbClient, "global",
+				wf.NetworkPeeringInformer(),
+				wf.ServiceCoreInformer(),
+				wf.EndpointSliceCoreInformer(),
+				wf.NodeCoreInformer(),
+			)
+			g.Expect(wf.Start()).To(gomega.Succeed())
+			t.Cleanup(wf.Shutdown)
+			g.Expect(c.Start()).To(gomega.Succeed())
+			t.Cleanup(c.Stop)
+
+			g.Eventually(nbClient, 5*time.Second).Should(libovsdbtest.HaveData(tt.expectedDB))
+		})
+	}
+}
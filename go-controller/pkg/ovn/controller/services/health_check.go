@@ -0,0 +1,346 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	libovsdbcache "github.com/ovn-org/libovsdb/cache"
+	"github.com/ovn-org/libovsdb/model"
+
+	globalconfig "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/metrics"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/sbdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+
+	v1 "k8s.io/api/core/v1"
+	discovery "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+	utilnet "k8s.io/utils/net"
+)
+
+// lbHealthCheckOptions are the options of the OVN health checks of the
+// service backends
+var lbHealthCheckOptions = map[string]string{
+	"interval":      "5",
+	"timeout":       "3",
+	"success_count": "2",
+	"failure_count": "3",
+}
+
+// healthCheckBackend is a service backend health checked by OVN, as
+// identified by its Service_Monitor in the southbound database.
+type healthCheckBackend struct {
+	logicalPort string
+	ip          string
+	port        int
+	// one of tcp, udp
+	protocol string
+}
+
+func (b healthCheckBackend) String() string {
+	return fmt.Sprintf("%s/%s", util.JoinHostPortInt32(b.ip, int32(b.port)), b.protocol)
+}
+
+// getHealthCheckBackends returns the backends of the given service that OVN
+// can health check, that is the pods of the default network on the nodes of
+// the zone, along with the mapping of their IPs to their logical port and the
+// source IP of the health checks.
+func getHealthCheckBackends(endpointSlices []*discovery.EndpointSlice, nodeInfos []nodeInfo) ([]healthCheckBackend, map[string]string) {
+	nodes := make(map[string]*nodeInfo, len(nodeInfos))
+	for i := range nodeInfos {
+		nodes[nodeInfos[i].name] = &nodeInfos[i]
+	}
+
+	var backends []healthCheckBackend
+	mappings := map[string]string{}
+	for _, slice := range endpointSlices {
+		for _, endpoint := range slice.Endpoints {
+			if endpoint.TargetRef == nil || endpoint.TargetRef.Kind != "Pod" || endpoint.NodeName == nil {
+				continue
+			}
+			node, ok := nodes[*endpoint.NodeName]
+			if !ok {
+				continue
+			}
+			logicalPort := util.GetLogicalPortName(endpoint.TargetRef.Namespace, endpoint.TargetRef.Name)
+			for _, address := range endpoint.Addresses {
+				sourceIP := getHealthCheckSourceIP(node, address)
+				if sourceIP == nil {
+					// not a pod network address, i.e. host networked pod
+					continue
+				}
+				if utilnet.IsIPv6(sourceIP) {
+					mappings[address] = fmt.Sprintf("%s:[%s]", logicalPort, sourceIP)
+				} else {
+					mappings[address] = fmt.Sprintf("%s:%s", logicalPort, sourceIP)
+				}
+				for _, port := range slice.Ports {
+					if port.Port == nil || port.Protocol == nil {
+						continue
+					}
+					if *port.Protocol != v1.ProtocolTCP && *port.Protocol != v1.ProtocolUDP {
+						continue
+					}
+					backends = append(backends, healthCheckBackend{
+						logicalPort: logicalPort,
+						ip:          address,
+						port:        int(*port.Port),
+						protocol:    strings.ToLower(string(*port.Protocol)),
+					})
+				}
+			}
+		}
+	}
+	return backends, mappings
+}
+
+// getHealthCheckSourceIP returns the source IP of the health checks of the
+// given backend address, or nil if it is not within the node pod subnets.
+func getHealthCheckSourceIP(node *nodeInfo, address string) net.IP {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return nil
+	}
+	for i := range node.podSubnets {
+		if node.podSubnets[i].Contains(ip) {
+			return util.GetNodeServiceMonitorIfAddr(&node.podSubnets[i]).IP
+		}
+	}
+	return nil
+}
+
+// healthCheckMappingKey returns the key of the given backend IP in the
+// ip_port_mappings of an OVN load balancer, where IPv6 addresses are enclosed
+// in brackets.
+func healthCheckMappingKey(ip string) string {
+	if utilnet.IsIPv6String(ip) {
+		return "[" + ip + "]"
+	}
+	return ip
+}
+
+// getHealthCheckNodes returns the given nodes but those whose service monitor
+// address, the source IP of the health checks, is in use by a logical switch
+// port of their switch. This happens on upgrades when the address was given to
+// a pod before it was reserved; the backends of such nodes are not health
+// checked until the next node event after the pod is gone, as the address is
+// then kept reserved. As it scans the logical switch ports, it is only called
+// on node events rather than on every service sync.
+func (c *Controller) getHealthCheckNodes(nodeInfos []nodeInfo) ([]nodeInfo, error) {
+	monitorAddrs := map[string]string{}
+	for _, node := range nodeInfos {
+		for i := range node.podSubnets {
+			monitorAddrs[util.GetNodeServiceMonitorIfAddr(&node.podSubnets[i]).IP.String()] = node.name
+		}
+	}
+	// pod logical switch port addresses are formatted as "MAC IP [IP]"
+	monitorAddrsOf := func(lsp *nbdb.LogicalSwitchPort) []string {
+		var addrs []string
+		for _, address := range lsp.Addresses {
+			for _, field := range strings.Fields(address) {
+				if _, ok := monitorAddrs[field]; ok {
+					addrs = append(addrs, field)
+				}
+			}
+		}
+		return addrs
+	}
+	lsps, err := libovsdbops.FindLogicalSwitchPortsWithPredicate(c.nbClient, func(lsp *nbdb.LogicalSwitchPort) bool {
+		return lsp.Type == "" && len(monitorAddrsOf(lsp)) > 0
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find logical switch ports using the service monitor addresses: %w", err)
+	}
+	conflicts := sets.New[string]()
+	for _, lsp := range lsps {
+		for _, addr := range monitorAddrsOf(lsp) {
+			klog.Warningf("Service monitor address %s of node %s is in use by logical switch port %s, "+
+				"the service backends of the node are not health checked", addr, monitorAddrs[addr], lsp.Name)
+			conflicts.Insert(monitorAddrs[addr])
+		}
+	}
+	if len(conflicts) == 0 {
+		return nodeInfos, nil
+	}
+	nodes := make([]nodeInfo, 0, len(nodeInfos))
+	for _, node := range nodeInfos {
+		if !conflicts.Has(node.name) {
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes, nil
+}
+
+// setLBHealthChecks enables the health checks of the given groups of LBs, the
+// cluster-wide, template and per-node LBs of a service, for the given backends
+// and returns the backends actually health checked. Only TCP and UDP LBs can
+// be health checked.
+func setLBHealthChecks(backends []healthCheckBackend, mappings map[string]string, lbGroups ...[]LB) sets.Set[healthCheckBackend] {
+	backendsByTarget := make(map[string][]healthCheckBackend, len(backends))
+	for _, backend := range backends {
+		target := backend.protocol + "/" + util.JoinHostPortInt32(backend.ip, int32(backend.port))
+		backendsByTarget[target] = append(backendsByTarget[target], backend)
+	}
+
+	checked := sets.New[healthCheckBackend]()
+	for _, lbs := range lbGroups {
+		for i := range lbs {
+			lb := &lbs[i]
+			protocol := strings.ToLower(lb.Protocol)
+			if protocol != "tcp" && protocol != "udp" {
+				continue
+			}
+			lb.Opts.HealthCheck = true
+			lb.HealthCheckMappings = map[string]string{}
+			for _, rule := range lb.Rules {
+				for _, target := range rule.Targets {
+					if target.Template != nil {
+						continue
+					}
+					targetBackends := backendsByTarget[protocol+"/"+target.String()]
+					if len(targetBackends) == 0 {
+						continue
+					}
+					lb.HealthCheckMappings[target.IP] = mappings[target.IP]
+					checked.Insert(targetBackends...)
+				}
+			}
+		}
+	}
+	return checked
+}
+
+// addServiceMonitorHandler tracks the status of the service backends OVN
+// health checks through their Service_Monitor in the southbound database.
+func (c *Controller) addServiceMonitorHandler() {
+	c.sbClient.Cache().AddEventHandler(&libovsdbcache.EventHandlerFuncs{
+		AddFunc: func(table string, m model.Model) {
+			if serviceMonitor, ok := m.(*sbdb.ServiceMonitor); ok {
+				c.onServiceMonitorUpdate(nil, serviceMonitor)
+			}
+		},
+		UpdateFunc: func(table string, old model.Model, new model.Model) {
+			oldServiceMonitor, ok := old.(*sbdb.ServiceMonitor)
+			if !ok {
+				return
+			}
+			c.onServiceMonitorUpdate(oldServiceMonitor, new.(*sbdb.ServiceMonitor))
+		},
+	})
+}
+
+func serviceMonitorBackend(serviceMonitor *sbdb.ServiceMonitor) healthCheckBackend {
+	protocol := sbdb.ServiceMonitorProtocolTCP
+	if serviceMonitor.Protocol != nil {
+		protocol = *serviceMonitor.Protocol
+	}
+	return healthCheckBackend{
+		logicalPort: serviceMonitor.LogicalPort,
+		ip:          serviceMonitor.IP,
+		port:        serviceMonitor.Port,
+		protocol:    protocol,
+	}
+}
+
+func serviceMonitorStatus(serviceMonitor *sbdb.ServiceMonitor) string {
+	if serviceMonitor == nil || serviceMonitor.Status == nil {
+		return ""
+	}
+	return *serviceMonitor.Status
+}
+
+// onServiceMonitorUpdate records the health of the backend of the given
+// Service_Monitor for the services it backs, and emits an event on them when
+// it changes.
+func (c *Controller) onServiceMonitorUpdate(oldServiceMonitor, newServiceMonitor *sbdb.ServiceMonitor) {
+	oldStatus := serviceMonitorStatus(oldServiceMonitor)
+	newStatus := serviceMonitorStatus(newServiceMonitor)
+	if oldStatus == newStatus || newStatus == "" {
+		return
+	}
+	backend := serviceMonitorBackend(newServiceMonitor)
+	healthy := newStatus == sbdb.ServiceMonitorStatusOnline
+
+	c.healthCheckLock.Lock()
+	defer c.healthCheckLock.Unlock()
+	for _, key := range sets.List(c.healthCheckedServices[backend]) {
+		namespace, name, err := cache.SplitMetaNamespaceKey(key)
+		if err != nil {
+			continue
+		}
+		metrics.SetServiceBackendHealthy(namespace, name, backend.String(), healthy)
+		serviceRef := &v1.ObjectReference{Kind: "Service", Namespace: namespace, Name: name}
+		if !healthy {
+			c.eventRecorder.Eventf(serviceRef, v1.EventTypeWarning, "BackendUnhealthy",
+				"Backend %s of pod %s is %s according to OVN health checks", backend, backend.logicalPort, newStatus)
+		} else if oldStatus != "" {
+			c.eventRecorder.Eventf(serviceRef, v1.EventTypeNormal, "BackendHealthy",
+				"Backend %s of pod %s is %s again according to OVN health checks", backend, backend.logicalPort, newStatus)
+		}
+	}
+}
+
+// getServiceMonitorStatus returns the current status of the Service_Monitor
+// of the given backend, or "" if unknown.
+func (c *Controller) getServiceMonitorStatus(backend healthCheckBackend) string {
+	if c.sbClient == nil {
+		return ""
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), globalconfig.Default.OVSDBTxnTimeout)
+	defer cancel()
+	var serviceMonitors []*sbdb.ServiceMonitor
+	err := c.sbClient.WhereCache(func(item *sbdb.ServiceMonitor) bool {
+		return serviceMonitorBackend(item) == backend
+	}).List(ctx, &serviceMonitors)
+	if err != nil || len(serviceMonitors) == 0 {
+		return ""
+	}
+	return serviceMonitorStatus(serviceMonitors[0])
+}
+
+// syncHealthCheckedBackends tracks the given backends as the ones OVN health
+// checks for the service with the given key.
+func (c *Controller) syncHealthCheckedBackends(key string, backends sets.Set[healthCheckBackend]) {
+	c.healthCheckLock.Lock()
+	defer c.healthCheckLock.Unlock()
+
+	existing := c.healthCheckedBackends[key]
+	if len(existing) == 0 && len(backends) == 0 {
+		return
+	}
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		klog.Errorf("Failed to split service key %s: %v", key, err)
+		return
+	}
+
+	for backend := range existing.Difference(backends) {
+		services := c.healthCheckedServices[backend]
+		services.Delete(key)
+		if len(services) == 0 {
+			delete(c.healthCheckedServices, backend)
+		}
+		metrics.DeleteServiceBackendHealthy(namespace, name, backend.String())
+	}
+	for backend := range backends.Difference(existing) {
+		if c.healthCheckedServices[backend] == nil {
+			c.healthCheckedServices[backend] = sets.New[string]()
+		}
+		c.healthCheckedServices[backend].Insert(key)
+		if status := c.getServiceMonitorStatus(backend); status != "" {
+			metrics.SetServiceBackendHealthy(namespace, name, backend.String(), status == sbdb.ServiceMonitorStatusOnline)
+		}
+	}
+
+	if len(backends) == 0 {
+		delete(c.healthCheckedBackends, key)
+	} else {
+		c.healthCheckedBackends[key] = backends
+	}
+}
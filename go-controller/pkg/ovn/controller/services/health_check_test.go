@@ -0,0 +1,392 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	globalconfig "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	libovsdbtest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+
+	v1 "k8s.io/api/core/v1"
+	discovery "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+)
+
+func Test_setLBHealthChecks(t *testing.T) {
+	nodes := []nodeInfo{
+		{
+			name: nodeA,
+			podSubnets: []net.IPNet{
+				{IP: net.ParseIP("10.128.0.0"), Mask: net.CIDRMask(24, 32)},
+				{IP: net.ParseIP("fe00::1:0:0"), Mask: net.CIDRMask(112, 128)},
+			},
+		},
+	}
+	podEndpoint := func(node, pod string, addresses ...string) discovery.Endpoint {
+		return discovery.Endpoint{
+			Addresses: addresses,
+			NodeName:  ptr.To(node),
+			TargetRef: &v1.ObjectReference{Kind: "Pod", Namespace: namespace, Name: pod},
+		}
+	}
+	slices := []*discovery.EndpointSlice{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo-ab23", Namespace: namespace},
+			Ports: []discovery.EndpointPort{
+				{Name: ptr.To("http"), Protocol: ptr.To(v1.ProtocolTCP), Port: ptr.To(int32(8080))},
+			},
+			AddressType: discovery.AddressTypeIPv4,
+			Endpoints: []discovery.Endpoint{
+				podEndpoint(nodeA, "pod-a", "10.128.0.5"),
+				podEndpoint(nodeB, "pod-b", "10.128.1.5"), // not in the zone
+				podEndpoint(nodeA, "host-a", "10.0.0.1"),  // host networked
+				{Addresses: []string{"10.128.0.6"}},       // not a pod
+				podEndpoint(nodeA, "pod-c", "10.128.0.7"), // not a target
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo-cd45", Namespace: namespace},
+			Ports: []discovery.EndpointPort{
+				{Name: ptr.To("http"), Protocol: ptr.To(v1.ProtocolTCP), Port: ptr.To(int32(8080))},
+			},
+			AddressType: discovery.AddressTypeIPv6,
+			Endpoints: []discovery.Endpoint{
+				podEndpoint(nodeA, "pod-a", "fe00::1:0:5"),
+			},
+		},
+	}
+
+	lbs := []LB{
+		{
+			Name:     "Service_testns/foo_TCP_cluster",
+			Protocol: "TCP",
+			Rules: []LBRule{
+				{
+					Source: Addr{IP: "192.168.1.1", Port: 80},
+					Targets: []Addr{
+						{IP: "10.128.0.5", Port: 8080},
+						{IP: "10.128.1.5", Port: 8080},
+						{IP: "10.0.0.1", Port: 8080},
+						{IP: "10.128.0.6", Port: 8080},
+					},
+				},
+				{
+					Source:  Addr{IP: "fd00::1", Port: 80},
+					Targets: []Addr{{IP: "fe00::1:0:5", Port: 8080}},
+				},
+			},
+		},
+		{
+			Name:     "Service_testns/foo_SCTP_cluster",
+			Protocol: "SCTP",
+			Rules: []LBRule{
+				{
+					Source:  Addr{IP: "192.168.1.1", Port: 80},
+					Targets: []Addr{{IP: "10.128.0.5", Port: 8080}},
+				},
+			},
+		},
+	}
+
+	perNodeLBs := []LB{
+		{
+			Name:     "Service_testns/foo_TCP_node_router_" + nodeA,
+			Protocol: "TCP",
+			Rules: []LBRule{
+				{
+					Source:  Addr{IP: "10.0.0.1", Port: 30080},
+					Targets: []Addr{{IP: "10.128.0.5", Port: 8080}},
+				},
+			},
+		},
+	}
+
+	backends, mappings := getHealthCheckBackends(slices, nodes)
+	checked := setLBHealthChecks(backends, mappings, lbs, perNodeLBs)
+
+	assert.Equal(t, sets.New(
+		healthCheckBackend{logicalPort: "testns_pod-a", ip: "10.128.0.5", port: 8080, protocol: "tcp"},
+		healthCheckBackend{logicalPort: "testns_pod-a", ip: "fe00::1:0:5", port: 8080, protocol: "tcp"},
+	), checked)
+
+	assert.True(t, lbs[0].Opts.HealthCheck)
+	assert.Equal(t, map[string]string{
+		"10.128.0.5":  "testns_pod-a:10.128.0.4",
+		"fe00::1:0:5": "testns_pod-a:[fe00::1:0:4]",
+	}, lbs[0].HealthCheckMappings)
+
+	// SCTP is not supported by OVN health checks
+	assert.False(t, lbs[1].Opts.HealthCheck)
+	assert.Nil(t, lbs[1].HealthCheckMappings)
+
+	// per-node LBs, e.g. nodeports, are health checked as well
+	assert.True(t, perNodeLBs[0].Opts.HealthCheck)
+	assert.Equal(t, map[string]string{
+		"10.128.0.5": "testns_pod-a:10.128.0.4",
+	}, perNodeLBs[0].HealthCheckMappings)
+}
+
+func TestGetHealthCheckNodes(t *testing.T) {
+	nodes := []nodeInfo{
+		{
+			name:       nodeA,
+			switchName: nodeA,
+			podSubnets: []net.IPNet{{IP: net.ParseIP("10.128.0.0"), Mask: net.CIDRMask(24, 32)}},
+		},
+		{
+			name:       nodeB,
+			switchName: nodeB,
+			podSubnets: []net.IPNet{{IP: net.ParseIP("10.128.1.0"), Mask: net.CIDRMask(24, 32)}},
+		},
+	}
+	nbClient, cleanup, err := libovsdbtest.NewNBTestHarness(libovsdbtest.TestSetup{
+		NBData: []libovsdbtest.TestData{
+			// the pod of node B got the service monitor address before the
+			// upgrade
+			&nbdb.LogicalSwitchPort{
+				UUID:      "pod-b-UUID",
+				Name:      "testns_pod-b",
+				Addresses: []string{"0a:58:0a:80:01:04 10.128.1.4"},
+			},
+			&nbdb.LogicalSwitchPort{
+				UUID:      "pod-a-UUID",
+				Name:      "testns_pod-a",
+				Addresses: []string{"0a:58:0a:80:00:05 10.128.0.5"},
+			},
+			&nbdb.LogicalSwitch{
+				Name:  nodeA,
+				Ports: []string{"pod-a-UUID"},
+			},
+			&nbdb.LogicalSwitch{
+				Name:  nodeB,
+				Ports: []string{"pod-b-UUID"},
+			},
+		},
+	}, nil)
+	if err != nil {
+		t.Fatalf("Failed to set up test harness: %v", err)
+	}
+	t.Cleanup(cleanup.Cleanup)
+
+	c := &Controller{nbClient: nbClient}
+	healthCheckNodes, err := c.getHealthCheckNodes(nodes)
+	assert.NoError(t, err)
+	assert.Equal(t, nodes[:1], healthCheckNodes)
+
+	// the nodes are only looked up on node events, not on service syncs
+	globalconfig.OVNKubernetesFeature.EnableLBHealthChecks = true
+	t.Cleanup(func() { globalconfig.OVNKubernetesFeature.EnableLBHealthChecks = false })
+	c.netInfo = &util.DefaultNetInfo{}
+	c.syncNodeInfos(nodes)
+	assert.Equal(t, nodes[:1], c.healthCheckNodeInfos)
+
+	err = libovsdbops.DeleteLogicalSwitchPorts(nbClient, &nbdb.LogicalSwitch{Name: nodeB}, &nbdb.LogicalSwitchPort{Name: "testns_pod-b"})
+	assert.NoError(t, err)
+	assert.Equal(t, nodes[:1], c.healthCheckNodeInfos)
+	c.syncNodeInfos(nodes)
+	assert.Equal(t, nodes, c.healthCheckNodeInfos)
+}
+
+func TestEnsureLBsHealthChecks(t *testing.T) {
+	service := &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: v1.ServiceSpec{
+			Type: v1.ServiceTypeClusterIP,
+		},
+	}
+	lbName := "Service_testns/foo_TCP_cluster"
+	lb := LB{
+		Name:        lbName,
+		ExternalIDs: loadBalancerExternalIDs(namespacedServiceName(namespace, name)),
+		Routers:     []string{"gr-node-a"},
+		Protocol:    "TCP",
+		Rules: []LBRule{
+			{
+				Source:  Addr{IP: "192.168.1.1", Port: 80},
+				Targets: []Addr{{IP: "10.128.0.5", Port: 8080}, {IP: "10.128.1.5", Port: 8080}},
+			},
+			{
+				Source:  Addr{IP: "192.168.1.1", Port: 443},
+				Targets: []Addr{{IP: "10.128.1.5", Port: 8443}},
+			},
+		},
+		Opts: LBOpts{
+			Reject:      true,
+			HealthCheck: true,
+		},
+		HealthCheckMappings: map[string]string{
+			"10.128.0.5": "testns_pod-a:10.128.0.4",
+		},
+	}
+
+	nbClient, cleanup, err := libovsdbtest.NewNBTestHarness(libovsdbtest.TestSetup{
+		NBData: []libovsdbtest.TestData{
+			&nbdb.LogicalRouter{
+				Name: "gr-node-a",
+			},
+		},
+	}, nil)
+	if err != nil {
+		t.Fatalf("Failed to set up test harness: %v", err)
+	}
+	t.Cleanup(cleanup.Cleanup)
+
+	expectedData := func(vips map[string]string) []libovsdbtest.TestData {
+		return []libovsdbtest.TestData{
+			&nbdb.LoadBalancerHealthCheck{
+				UUID:        "hc-UUID",
+				Vip:         "192.168.1.1:80",
+				Options:     lbHealthCheckOptions,
+				ExternalIDs: loadBalancerExternalIDs(namespacedServiceName(namespace, name)),
+			},
+			&nbdb.LoadBalancer{
+				UUID:        lbName,
+				Name:        lbName,
+				Options:     servicesOptions(),
+				Protocol:    &nbdb.LoadBalancerProtocolTCP,
+				Vips:        vips,
+				ExternalIDs: loadBalancerExternalIDs(namespacedServiceName(namespace, name)),
+				HealthCheck: []string{"hc-UUID"},
+				IPPortMappings: map[string]string{
+					"10.128.0.5": "testns_pod-a:10.128.0.4",
+				},
+			},
+			&nbdb.LogicalRouter{
+				Name:         "gr-node-a",
+				LoadBalancer: []string{lbName},
+			},
+		}
+	}
+
+	lbs := []LB{lb}
+	err = EnsureLBs(nbClient, service, nil, lbs, &util.DefaultNetInfo{})
+	if err != nil {
+		t.Fatalf("Error EnsureLBs: %v", err)
+	}
+	matcher := libovsdbtest.HaveDataIgnoringUUIDs(expectedData(map[string]string{
+		"192.168.1.1:80":  "10.128.0.5:8080,10.128.1.5:8080",
+		"192.168.1.1:443": "10.128.1.5:8443",
+	}))
+	if success, err := matcher.Match(nbClient); !success || err != nil {
+		t.Fatal(fmt.Errorf("didn't match expected with actual, err: %v, %v", err, matcher.FailureMessage(nbClient)))
+	}
+
+	// the existing health check is updated rather than replaced
+	updated := lb
+	updated.Rules = []LBRule{
+		{
+			Source:  Addr{IP: "192.168.1.1", Port: 80},
+			Targets: []Addr{{IP: "10.128.0.5", Port: 8080}},
+		},
+	}
+	updatedLBs := []LB{updated}
+	err = EnsureLBs(nbClient, service, lbs, updatedLBs, &util.DefaultNetInfo{})
+	if err != nil {
+		t.Fatalf("Error EnsureLBs: %v", err)
+	}
+	matcher = libovsdbtest.HaveDataIgnoringUUIDs(expectedData(map[string]string{
+		"192.168.1.1:80": "10.128.0.5:8080",
+	}))
+	if success, err := matcher.Match(nbClient); !success || err != nil {
+		t.Fatal(fmt.Errorf("didn't match expected with actual, err: %v, %v", err, matcher.FailureMessage(nbClient)))
+	}
+
+	// health checks are cleared when no longer needed
+	cleared := updated
+	cleared.Opts = LBOpts{Reject: true}
+	cleared.HealthCheckMappings = nil
+	err = EnsureLBs(nbClient, service, updatedLBs, []LB{cleared}, &util.DefaultNetInfo{})
+	if err != nil {
+		t.Fatalf("Error EnsureLBs: %v", err)
+	}
+	nbLB := &nbdb.LoadBalancer{UUID: updatedLBs[0].UUID}
+	if err = nbClient.Get(context.Background(), nbLB); err != nil {
+		t.Fatalf("Error getting load balancer: %v", err)
+	}
+	assert.Empty(t, nbLB.HealthCheck)
+	assert.Empty(t, nbLB.IPPortMappings)
+}
+
+func TestEnsureLBsHealthChecksIPv6(t *testing.T) {
+	service := &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: v1.ServiceSpec{
+			Type: v1.ServiceTypeClusterIP,
+		},
+	}
+	lbName := "Service_testns/foo_TCP_cluster"
+	lb := LB{
+		Name:        lbName,
+		ExternalIDs: loadBalancerExternalIDs(namespacedServiceName(namespace, name)),
+		Routers:     []string{"gr-node-a"},
+		Protocol:    "TCP",
+		Rules: []LBRule{
+			{
+				Source:  Addr{IP: "fd00::1", Port: 80},
+				Targets: []Addr{{IP: "fe00::1:0:5", Port: 8080}},
+			},
+		},
+		Opts: LBOpts{
+			Reject:      true,
+			HealthCheck: true,
+		},
+		HealthCheckMappings: map[string]string{
+			"fe00::1:0:5": "testns_pod-a:[fe00::1:0:4]",
+		},
+	}
+
+	nbClient, cleanup, err := libovsdbtest.NewNBTestHarness(libovsdbtest.TestSetup{
+		NBData: []libovsdbtest.TestData{
+			&nbdb.LogicalRouter{
+				Name: "gr-node-a",
+			},
+		},
+	}, nil)
+	if err != nil {
+		t.Fatalf("Failed to set up test harness: %v", err)
+	}
+	t.Cleanup(cleanup.Cleanup)
+
+	err = EnsureLBs(nbClient, service, nil, []LB{lb}, &util.DefaultNetInfo{})
+	if err != nil {
+		t.Fatalf("Error EnsureLBs: %v", err)
+	}
+	// OVN expects both the backend IPv6 address and the source IPv6 address
+	// of the health checks in brackets
+	matcher := libovsdbtest.HaveDataIgnoringUUIDs([]libovsdbtest.TestData{
+		&nbdb.LoadBalancerHealthCheck{
+			UUID:        "hc-UUID",
+			Vip:         "[fd00::1]:80",
+			Options:     lbHealthCheckOptions,
+			ExternalIDs: loadBalancerExternalIDs(namespacedServiceName(namespace, name)),
+		},
+		&nbdb.LoadBalancer{
+			UUID:        lbName,
+			Name:        lbName,
+			Options:     servicesOptions(),
+			Protocol:    &nbdb.LoadBalancerProtocolTCP,
+			Vips:        map[string]string{"[fd00::1]:80": "[fe00::1:0:5]:8080"},
+			ExternalIDs: loadBalancerExternalIDs(namespacedServiceName(namespace, name)),
+			HealthCheck: []string{"hc-UUID"},
+			IPPortMappings: map[string]string{
+				"[fe00::1:0:5]": "testns_pod-a:[fe00::1:0:4]",
+			},
+		},
+		&nbdb.LogicalRouter{
+			Name:         "gr-node-a",
+			LoadBalancer: []string{lbName},
+		},
+	})
+	if success, err := matcher.Match(nbClient); !success || err != nil {
+		t.Fatal(fmt.Errorf("didn't match expected with actual, err: %v, %v", err, matcher.FailureMessage(nbClient)))
+	}
+}
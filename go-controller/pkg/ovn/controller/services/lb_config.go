@@ -33,6 +33,9 @@ type lbConfig struct {
 
 	clusterEndpoints lbEndpoints            // addresses of cluster-wide endpoints
 	nodeEndpoints    map[string]lbEndpoints // node -> addresses of local endpoints
+	// topology zone -> addresses of endpoints hinted for the zone,
+	// only set when the service uses topology aware routing
+	zoneEndpoints map[string]lbEndpoints
 
 	// if true, then vips added on the router are in "local" mode
 	// that means, skipSNAT, and remove any non-local endpoints.
@@ -51,17 +54,38 @@ type lbEndpoints struct {
 	V6IPs []string
 }
 
-func makeNodeSwitchTargetIPs(service *v1.Service, node string, c *lbConfig) (targetIPsV4, targetIPsV6 []string, v4Changed, v6Changed bool) {
+// makeNodeClusterTargetIPs returns the cluster-wide endpoints the given node
+// should use. For services with topology aware routing these are the endpoints
+// hinted for the node's zone, falling back to all the endpoints if there are
+// none for the zone.
+func makeNodeClusterTargetIPs(node *nodeInfo, c *lbConfig) (targetIPsV4, targetIPsV6 []string) {
 	targetIPsV4 = c.clusterEndpoints.V4IPs
 	targetIPsV6 = c.clusterEndpoints.V6IPs
 
+	if len(c.zoneEndpoints) == 0 || node.topologyZone == "" {
+		return
+	}
+	if zoneEndpoints, ok := c.zoneEndpoints[node.topologyZone]; ok {
+		if len(zoneEndpoints.V4IPs) > 0 {
+			targetIPsV4 = zoneEndpoints.V4IPs
+		}
+		if len(zoneEndpoints.V6IPs) > 0 {
+			targetIPsV6 = zoneEndpoints.V6IPs
+		}
+	}
+	return
+}
+
+func makeNodeSwitchTargetIPs(service *v1.Service, node *nodeInfo, c *lbConfig) (targetIPsV4, targetIPsV6 []string, v4Changed, v6Changed bool) {
+	targetIPsV4, targetIPsV6 = makeNodeClusterTargetIPs(node, c)
+
 	if c.externalTrafficLocal || c.internalTrafficLocal {
 		// For ExternalTrafficPolicy=Local, remove non-local endpoints from the router/switch targets
 		// NOTE: on the switches, filtered eps are used only by masqueradeVIP
 		// for InternalTrafficPolicy=Local, remove non-local endpoints from the switch targets only
 		localIPsV4 := []string{}
 		localIPsV6 := []string{}
-		if localEndpoints, ok := c.nodeEndpoints[node]; ok {
+		if localEndpoints, ok := c.nodeEndpoints[node.name]; ok {
 			localIPsV4 = localEndpoints.V4IPs
 			localIPsV6 = localEndpoints.V6IPs
 		}
@@ -90,8 +114,7 @@ func makeNodeSwitchTargetIPs(service *v1.Service, node string, c *lbConfig) (tar
 }
 
 func makeNodeRouterTargetIPs(service *v1.Service, node *nodeInfo, c *lbConfig, hostMasqueradeIPV4, hostMasqueradeIPV6 string) (targetIPsV4, targetIPsV6 []string, v4Changed, v6Changed bool, zeroRouterLocalEndpointsV4, zeroRouterLocalEndpointsV6 bool) {
-	targetIPsV4 = c.clusterEndpoints.V4IPs
-	targetIPsV6 = c.clusterEndpoints.V6IPs
+	targetIPsV4, targetIPsV6 = makeNodeClusterTargetIPs(node, c)
 
 	if c.externalTrafficLocal {
 		// For ExternalTrafficPolicy=Local, remove non-local endpoints from the router/switch targets
@@ -156,6 +179,7 @@ var protos = []v1.Protocol{
 // - services with host-network endpoints
 // - services with ExternalTrafficPolicy=Local
 // - services with InternalTrafficPolicy=Local
+// - services with topology aware routing (trafficDistribution PreferClose) whose endpoints have zone hints
 //
 // Template LBs will be created for
 //   - services with NodePort set but *without* ExternalTrafficPolicy=Local or
@@ -170,7 +194,7 @@ func buildServiceLBConfigs(service *v1.Service, endpointSlices []*discovery.Endp
 		nodes.Insert(n.name)
 	}
 	// get all the endpoints classified by port and by port,node
-	portToClusterEndpoints, portToNodeToEndpoints := getEndpointsForService(endpointSlices, service, nodes, networkName)
+	portToClusterEndpoints, portToNodeToEndpoints, portToZoneToEndpoints := getEndpointsForService(endpointSlices, service, nodes, networkName)
 	for _, svcPort := range service.Spec.Ports {
 		svcPortKey := getServicePortKey(svcPort.Protocol, svcPort.Name)
 		clusterEndpoints := portToClusterEndpoints[svcPortKey]
@@ -178,6 +202,7 @@ func buildServiceLBConfigs(service *v1.Service, endpointSlices []*discovery.Endp
 		if nodeEndpoints == nil {
 			nodeEndpoints = make(map[string]lbEndpoints)
 		}
+		zoneEndpoints := portToZoneToEndpoints[svcPortKey]
 		// if ExternalTrafficPolicy or InternalTrafficPolicy is local, then we need to do things a bit differently
 		externalTrafficLocal := util.ServiceExternalTrafficPolicyLocal(service)
 		internalTrafficLocal := util.ServiceInternalTrafficPolicyLocal(service)
@@ -192,6 +217,7 @@ func buildServiceLBConfigs(service *v1.Service, endpointSlices []*discovery.Endp
 				vips:                 []string{placeholderNodeIPs}, // shortcut for all-physical-ips
 				clusterEndpoints:     clusterEndpoints,
 				nodeEndpoints:        nodeEndpoints,
+				zoneEndpoints:        zoneEndpoints,
 				externalTrafficLocal: externalTrafficLocal,
 				internalTrafficLocal: false, // always false for non-ClusterIPs
 				hasNodePort:          true,
@@ -236,6 +262,7 @@ func buildServiceLBConfigs(service *v1.Service, endpointSlices []*discovery.Endp
 			vips:                 vips,
 			clusterEndpoints:     clusterEndpoints,
 			nodeEndpoints:        nodeEndpoints,
+			zoneEndpoints:        zoneEndpoints,
 			externalTrafficLocal: false, // always false for ClusterIPs
 			internalTrafficLocal: internalTrafficLocal,
 			hasNodePort:          false,
@@ -245,10 +272,12 @@ func buildServiceLBConfigs(service *v1.Service, endpointSlices []*discovery.Endp
 		// unless any of the following are true:
 		// - Any of the endpoints are host-network
 		// - ETP=local service backed by non-local-host-networked endpoints
+		// - the service uses topology aware routing and its endpoints have zone hints
 		// - OCP only HACK: It's an openshift-dns:default-dns service
 		//
 		// In that case, we need to create per-node LBs.
 		if hasHostEndpoints(clusterEndpoints.V4IPs) || hasHostEndpoints(clusterEndpoints.V6IPs) || internalTrafficLocal ||
+			len(zoneEndpoints) > 0 ||
 			// OCP only hack begin
 			(service.Namespace == "openshift-dns" && service.Name == "dns-default") {
 			// OCP only hack end
@@ -446,7 +475,7 @@ func buildTemplateLBs(service *v1.Service, configs []lbConfig, nodes []nodeInfo,
 
 				for _, node := range nodes {
 
-					switchV4TargetIPs, switchV6TargetIPs, v4Changed, v6Changed := makeNodeSwitchTargetIPs(service, node.name, &config)
+					switchV4TargetIPs, switchV6TargetIPs, v4Changed, v6Changed := makeNodeSwitchTargetIPs(service, &node, &config)
 					if !switchV4TargetNeedsTemplate && v4Changed {
 						switchV4TargetNeedsTemplate = true
 					}
@@ -646,7 +675,7 @@ func buildPerNodeLBs(service *v1.Service, configs []lbConfig, nodes []nodeInfo,
 
 			for _, config := range configs {
 
-				switchV4TargetIPs, switchV6TargetIPs, _, _ := makeNodeSwitchTargetIPs(service, node.name, &config)
+				switchV4TargetIPs, switchV6TargetIPs, _, _ := makeNodeSwitchTargetIPs(service, &node, &config)
 
 				routerV4TargetIPs, routerV6TargetIPs, _, _, zeroRouterV4LocalEndpoints, zeroRouterV6LocalEndpoints := makeNodeRouterTargetIPs(
 					service,
@@ -658,8 +687,9 @@ func buildPerNodeLBs(service *v1.Service, configs []lbConfig, nodes []nodeInfo,
 				routerV4targets := joinHostsPort(routerV4TargetIPs, config.clusterEndpoints.Port)
 				routerV6targets := joinHostsPort(routerV6TargetIPs, config.clusterEndpoints.Port)
 
-				switchV4targets := joinHostsPort(config.clusterEndpoints.V4IPs, config.clusterEndpoints.Port)
-				switchV6targets := joinHostsPort(config.clusterEndpoints.V6IPs, config.clusterEndpoints.Port)
+				clusterV4TargetIPs, clusterV6TargetIPs := makeNodeClusterTargetIPs(&node, &config)
+				switchV4targets := joinHostsPort(clusterV4TargetIPs, config.clusterEndpoints.Port)
+				switchV6targets := joinHostsPort(clusterV6TargetIPs, config.clusterEndpoints.Port)
 
 				// OCP HACK begin
 				// TODO: Remove this hack once we add support for ITP:preferLocal and DNS operator starts using it.
@@ -945,12 +975,15 @@ func getServicePortKey(protocol v1.Protocol, name string) string {
 }
 
 // GetEndpointsForService takes a service, all its slices and the list of nodes in the OVN zone
-// and returns two maps that hold all the endpoint addresses for the service:
-// one classified by port, one classified by port,node. This second map is only filled in
-// when the service needs local (per-node) endpoints, that is when ETP=local or ITP=local.
-// The node list helps to keep the resulting map small, since we're only interested in local endpoints.
+// and returns three maps that hold all the endpoint addresses for the service:
+// one classified by port, one classified by port,node and one classified by port,topology zone.
+// The second map is only filled in when the service needs local (per-node) endpoints,
+// that is when ETP=local or ITP=local. The node list helps to keep the resulting map small,
+// since we're only interested in local endpoints.
+// The third map is only filled in for the ports of services with topology aware routing
+// whose ready endpoints all have zone hints.
 func getEndpointsForService(slices []*discovery.EndpointSlice, service *v1.Service, nodes sets.Set[string],
-	networkName string) (map[string]lbEndpoints, map[string]map[string]lbEndpoints) {
+	networkName string) (map[string]lbEndpoints, map[string]map[string]lbEndpoints, map[string]map[string]lbEndpoints) {
 
 	// classify endpoints
 	ports := map[string]int32{}
@@ -1055,5 +1088,52 @@ func getEndpointsForService(slices []*discovery.EndpointSlice, service *v1.Servi
 			service.Namespace, service.Name, networkName, portToNodeToLBEndpoints)
 	}
 
-	return portToLBEndpoints, portToNodeToLBEndpoints
+	portToZoneToLBEndpoints := make(map[string]map[string]lbEndpoints, len(portToEndpoints))
+	if util.ServiceTopologyAwareRouting(service) {
+		for port, endpoints := range portToEndpoints {
+			zoneToEndpoints := getEndpointsByZoneHint(endpoints)
+			zoneToLBEndpoints := make(map[string]lbEndpoints, len(zoneToEndpoints))
+			for zone, endpoints := range zoneToEndpoints {
+				addresses := util.GetEligibleEndpointAddresses(endpoints, service)
+				v4IPs, _ := util.MatchAllIPStringFamily(false, addresses)
+				v6IPs, _ := util.MatchAllIPStringFamily(true, addresses)
+				if len(v4IPs) > 0 || len(v6IPs) > 0 {
+					zoneToLBEndpoints[zone] = lbEndpoints{
+						V4IPs: v4IPs,
+						V6IPs: v6IPs,
+						Port:  ports[port],
+					}
+				}
+			}
+			// no zone hints selecting any endpoint is the same as no hints at
+			// all, the endpoints are used cluster-wide
+			if len(zoneToLBEndpoints) > 0 {
+				portToZoneToLBEndpoints[port] = zoneToLBEndpoints
+			}
+		}
+		klog.V(5).Infof("Zone endpoints for %s/%s for network=%s are: %v",
+			service.Namespace, service.Name, networkName, portToZoneToLBEndpoints)
+	}
+
+	return portToLBEndpoints, portToNodeToLBEndpoints, portToZoneToLBEndpoints
+}
+
+// getEndpointsByZoneHint classifies the given endpoints by the zones they are
+// hinted for. Like kube-proxy, it returns nil if any ready endpoint has no zone
+// hints, in which case hints must be ignored for the whole service port.
+func getEndpointsByZoneHint(endpoints []discovery.Endpoint) map[string][]discovery.Endpoint {
+	zoneToEndpoints := map[string][]discovery.Endpoint{}
+	for _, endpoint := range endpoints {
+		ready := endpoint.Conditions.Ready == nil || *endpoint.Conditions.Ready
+		if endpoint.Hints == nil || len(endpoint.Hints.ForZones) == 0 {
+			if ready {
+				return nil
+			}
+			continue
+		}
+		for _, zone := range endpoint.Hints.ForZones {
+			zoneToEndpoints[zone.Name] = append(zoneToEndpoints[zone.Name], endpoint)
+		}
+	}
+	return zoneToEndpoints
 }
@@ -4179,7 +4179,7 @@ func Test_getEndpointsForService(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			portToClusterEndpoints, portToNodeToEndpoints := getEndpointsForService(
+			portToClusterEndpoints, portToNodeToEndpoints, _ := getEndpointsForService(
 				tt.args.slices, tt.args.svc, tt.args.nodes, types.DefaultNetworkName)
 			assert.Equal(t, tt.wantClusterEndpoints, portToClusterEndpoints)
 			assert.Equal(t, tt.wantNodeEndpoints, portToNodeToEndpoints)
@@ -4344,7 +4344,7 @@ func Test_makeNodeSwitchTargetIPs(t *testing.T) {
 	}
 	for i, tt := range tc {
 		t.Run(fmt.Sprintf("%d_%s", i, tt.name), func(t *testing.T) {
-			actualTargetIPsV4, actualTargetIPsV6, actualV4Changed, actualV6Changed := makeNodeSwitchTargetIPs(tt.service, tt.node, tt.config)
+			actualTargetIPsV4, actualTargetIPsV6, actualV4Changed, actualV6Changed := makeNodeSwitchTargetIPs(tt.service, &nodeInfo{name: tt.node}, tt.config)
 			assert.Equal(t, tt.expectedTargetIPsV4, actualTargetIPsV4)
 			assert.Equal(t, tt.expectedTargetIPsV6, actualTargetIPsV6)
 			assert.Equal(t, tt.expectedV4Changed, actualV4Changed)
@@ -4353,3 +4353,177 @@ func Test_makeNodeSwitchTargetIPs(t *testing.T) {
 		})
 	}
 }
+
+func Test_getEndpointsForService_topologyAwareRouting(t *testing.T) {
+	zoneA := "zone-a"
+	zoneB := "zone-b"
+	hinted := func(node, zone string, addresses ...string) discovery.Endpoint {
+		ep := kubetest.MakeReadyEndpoint(node, addresses...)
+		ep.Hints = &discovery.EndpointHints{ForZones: []discovery.ForZone{{Name: zone}}}
+		return ep
+	}
+	makeSlice := func(endpoints ...discovery.Endpoint) []*discovery.EndpointSlice {
+		return []*discovery.EndpointSlice{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "svc-ab23",
+					Namespace: "ns",
+					Labels:    map[string]string{discovery.LabelServiceName: "svc"},
+				},
+				Ports: []discovery.EndpointPort{
+					{
+						Name:     ptr.To("tcp-example"),
+						Protocol: &tcp,
+						Port:     ptr.To(int32(80)),
+					},
+				},
+				AddressType: discovery.AddressTypeIPv4,
+				Endpoints:   endpoints,
+			},
+		}
+	}
+	preferClose := func() *v1.Service {
+		svc := getSampleServiceWithOnePort("tcp-example", 80, tcp)
+		svc.Spec.TrafficDistribution = ptr.To(v1.ServiceTrafficDistributionPreferClose)
+		return svc
+	}
+	portKey := getServicePortKey(tcp, "tcp-example")
+
+	tests := []struct {
+		name              string
+		slices            []*discovery.EndpointSlice
+		svc               *v1.Service
+		wantZoneEndpoints map[string]map[string]lbEndpoints
+	}{
+		{
+			name:              "service without topology aware routing ignores hints",
+			slices:            makeSlice(hinted(nodeA, zoneA, "10.0.0.2"), hinted(nodeB, zoneB, "10.0.0.3")),
+			svc:               getSampleServiceWithOnePort("tcp-example", 80, tcp),
+			wantZoneEndpoints: map[string]map[string]lbEndpoints{},
+		},
+		{
+			name:   "PreferClose service with hinted endpoints",
+			slices: makeSlice(hinted(nodeA, zoneA, "10.0.0.2"), hinted(nodeB, zoneB, "10.0.0.3"), hinted(nodeB, zoneA, "10.0.0.4")),
+			svc:    preferClose(),
+			wantZoneEndpoints: map[string]map[string]lbEndpoints{
+				portKey: {
+					zoneA: {V4IPs: []string{"10.0.0.2", "10.0.0.4"}, Port: 80},
+					zoneB: {V4IPs: []string{"10.0.0.3"}, Port: 80},
+				},
+			},
+		},
+		{
+			name:              "PreferClose service with a ready endpoint without hints",
+			slices:            makeSlice(hinted(nodeA, zoneA, "10.0.0.2"), kubetest.MakeReadyEndpoint(nodeB, "10.0.0.3")),
+			svc:               preferClose(),
+			wantZoneEndpoints: map[string]map[string]lbEndpoints{},
+		},
+		{
+			name: "PreferClose service without eligible hinted endpoints",
+			slices: makeSlice(func() discovery.Endpoint {
+				ep := kubetest.MakeTerminatingNonServingEndpoint(nodeA, "10.0.0.2")
+				ep.Hints = &discovery.EndpointHints{ForZones: []discovery.ForZone{{Name: zoneA}}}
+				return ep
+			}()),
+			svc:               preferClose(),
+			wantZoneEndpoints: map[string]map[string]lbEndpoints{},
+		},
+		{
+			name: "service with topology mode annotation",
+			slices: makeSlice(hinted(nodeA, zoneA, "10.0.0.2"), hinted(nodeB, zoneB, "10.0.0.3"),
+				kubetest.MakeTerminatingNonServingEndpoint(nodeB, "10.0.0.4")),
+			svc: func() *v1.Service {
+				svc := getSampleServiceWithOnePort("tcp-example", 80, tcp)
+				svc.Annotations = map[string]string{v1.AnnotationTopologyMode: "Auto"}
+				return svc
+			}(),
+			wantZoneEndpoints: map[string]map[string]lbEndpoints{
+				portKey: {
+					zoneA: {V4IPs: []string{"10.0.0.2"}, Port: 80},
+					zoneB: {V4IPs: []string{"10.0.0.3"}, Port: 80},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, portToZoneToEndpoints := getEndpointsForService(tt.slices, tt.svc, sets.New(nodeA, nodeB), types.DefaultNetworkName)
+			assert.Equal(t, tt.wantZoneEndpoints, portToZoneToEndpoints)
+		})
+	}
+}
+
+func Test_makeNodeClusterTargetIPs(t *testing.T) {
+	config := &lbConfig{
+		clusterEndpoints: lbEndpoints{
+			V4IPs: []string{"10.128.0.2", "10.128.1.2"},
+			V6IPs: []string{"fe00::1:2", "fe00::2:2"},
+			Port:  8080,
+		},
+		zoneEndpoints: map[string]lbEndpoints{
+			"zone-a": {V4IPs: []string{"10.128.0.2"}, V6IPs: []string{"fe00::1:2"}, Port: 8080},
+			"zone-b": {V4IPs: []string{"10.128.1.2"}, Port: 8080},
+		},
+	}
+
+	tc := []struct {
+		name                string
+		node                *nodeInfo
+		config              *lbConfig
+		expectedTargetIPsV4 []string
+		expectedTargetIPsV6 []string
+	}{
+		{
+			name:                "no zone endpoints",
+			node:                &nodeInfo{name: nodeA, topologyZone: "zone-a"},
+			config:              &lbConfig{clusterEndpoints: config.clusterEndpoints},
+			expectedTargetIPsV4: []string{"10.128.0.2", "10.128.1.2"},
+			expectedTargetIPsV6: []string{"fe00::1:2", "fe00::2:2"},
+		},
+		{
+			name: "empty zone endpoints",
+			node: &nodeInfo{name: nodeA, topologyZone: "zone-a"},
+			config: &lbConfig{
+				clusterEndpoints: config.clusterEndpoints,
+				zoneEndpoints:    map[string]lbEndpoints{},
+			},
+			expectedTargetIPsV4: []string{"10.128.0.2", "10.128.1.2"},
+			expectedTargetIPsV6: []string{"fe00::1:2", "fe00::2:2"},
+		},
+		{
+			name:                "node in a zone with endpoints",
+			node:                &nodeInfo{name: nodeA, topologyZone: "zone-a"},
+			config:              config,
+			expectedTargetIPsV4: []string{"10.128.0.2"},
+			expectedTargetIPsV6: []string{"fe00::1:2"},
+		},
+		{
+			name:                "node in a zone with endpoints of one IP family only",
+			node:                &nodeInfo{name: nodeB, topologyZone: "zone-b"},
+			config:              config,
+			expectedTargetIPsV4: []string{"10.128.1.2"},
+			expectedTargetIPsV6: []string{"fe00::1:2", "fe00::2:2"}, // fallback to cluster endpoints
+		},
+		{
+			name:                "node in a zone without endpoints",
+			node:                &nodeInfo{name: nodeB, topologyZone: "zone-c"},
+			config:              config,
+			expectedTargetIPsV4: []string{"10.128.0.2", "10.128.1.2"}, // fallback to cluster endpoints
+			expectedTargetIPsV6: []string{"fe00::1:2", "fe00::2:2"},   // fallback to cluster endpoints
+		},
+		{
+			name:                "node without a zone",
+			node:                &nodeInfo{name: nodeB},
+			config:              config,
+			expectedTargetIPsV4: []string{"10.128.0.2", "10.128.1.2"},
+			expectedTargetIPsV6: []string{"fe00::1:2", "fe00::2:2"},
+		},
+	}
+	for i, tt := range tc {
+		t.Run(fmt.Sprintf("%d_%s", i, tt.name), func(t *testing.T) {
+			actualTargetIPsV4, actualTargetIPsV6 := makeNodeClusterTargetIPs(tt.node, tt.config)
+			assert.Equal(t, tt.expectedTargetIPsV4, actualTargetIPsV4)
+			assert.Equal(t, tt.expectedTargetIPsV6, actualTargetIPsV6)
+		})
+	}
+}
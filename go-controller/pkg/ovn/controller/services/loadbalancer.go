@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	libovsdbclient "github.com/ovn-org/libovsdb/client"
+	libovsdb "github.com/ovn-org/libovsdb/ovsdb"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/metrics"
@@ -31,6 +32,11 @@ type LB struct {
 
 	Templates TemplateMap // Templates that this LB uses as backends.
 
+	// HealthCheckMappings maps the IPs of the backends OVN health checks to
+	// the logical port they are on and the source IP of the health checks,
+	// as "logical_port:source_ip". Only used if Opts.HealthCheck is set.
+	HealthCheckMappings map[string]string
+
 	// the names of logical switches, routers and LB groups that this LB should be attached to
 	Switches []string
 	Routers  []string
@@ -55,6 +61,9 @@ type LBOpts struct {
 
 	// Only useful for template LBs.
 	AddressFamily corev1.IPFamily
+
+	// If true, OVN health checks the backends in HealthCheckMappings
+	HealthCheck bool
 }
 
 type Addr struct {
@@ -93,6 +102,8 @@ func addrsToString(addrs []Addr) string {
 type templateLoadBalancer struct {
 	nbLB      *nbdb.LoadBalancer
 	templates TemplateMap
+	// healthChecks is nil if the LB backends are not health checked
+	healthChecks []*nbdb.LoadBalancerHealthCheck
 }
 
 func toNBLoadBalancerList(tlbs []*templateLoadBalancer) []*nbdb.LoadBalancer {
@@ -161,6 +172,11 @@ func EnsureLBs(nbClient libovsdbclient.Client, service *corev1.Service, existing
 		if existingLB != nil {
 			blb.nbLB.UUID = existingLB.UUID
 			delete(toDelete, existingLB.UUID)
+			if existingLB.Opts.HealthCheck && blb.healthChecks == nil {
+				// clear the health checks the LB does not need anymore
+				blb.nbLB.HealthCheck = []string{}
+				blb.nbLB.IPPortMappings = map[string]string{}
+			}
 			existingRouters = sets.New[string](existingLB.Routers...)
 			existingSwitches = sets.New[string](existingLB.Switches...)
 			existingGroups = sets.New[string](existingLB.Groups...)
@@ -176,7 +192,24 @@ func EnsureLBs(nbClient libovsdbclient.Client, service *corev1.Service, existing
 		mapLBDifferenceByKey(removeLBsFromGroups, existingGroups, wantGroups, blb)
 	}
 
-	ops, err := libovsdbops.CreateOrUpdateLoadBalancersOps(nbClient, nil, toNBLoadBalancerList(tlbs)...)
+	var ops []libovsdb.Operation
+	var err error
+	for _, tlb := range tlbs {
+		if tlb.healthChecks == nil {
+			continue
+		}
+		ops, err = libovsdbops.CreateOrUpdateLoadBalancerHealthChecksOps(nbClient, ops, tlb.nbLB, tlb.healthChecks...)
+		if err != nil {
+			return fmt.Errorf("failed to create ops for ensuring health checks of load balancer %s for service %s/%s: %w",
+				tlb.nbLB.Name, service.Namespace, service.Name, err)
+		}
+		tlb.nbLB.HealthCheck = make([]string, 0, len(tlb.healthChecks))
+		for _, hc := range tlb.healthChecks {
+			tlb.nbLB.HealthCheck = append(tlb.nbLB.HealthCheck, hc.UUID)
+		}
+	}
+
+	ops, err = libovsdbops.CreateOrUpdateLoadBalancersOps(nbClient, ops, toNBLoadBalancerList(tlbs)...)
 	if err != nil {
 		return err
 	}
@@ -385,10 +418,44 @@ func buildLB(lb *LB) *templateLoadBalancer {
 		}
 	}
 
-	return &templateLoadBalancer{
+	tlb := &templateLoadBalancer{
 		nbLB:      libovsdbops.BuildLoadBalancer(lb.Name, strings.ToLower(lb.Protocol), selectionFields, buildVipMap(lb.Rules), options, lb.ExternalIDs),
 		templates: lb.Templates,
 	}
+
+	if lb.Opts.HealthCheck {
+		tlb.nbLB.IPPortMappings = make(map[string]string, len(lb.HealthCheckMappings))
+		for ip, mapping := range lb.HealthCheckMappings {
+			tlb.nbLB.IPPortMappings[healthCheckMappingKey(ip)] = mapping
+		}
+		tlb.healthChecks = buildHealthChecks(lb)
+	}
+
+	return tlb
+}
+
+// buildHealthChecks returns the health checks of the VIPs of the given LB
+// that have backends with health check mappings.
+func buildHealthChecks(lb *LB) []*nbdb.LoadBalancerHealthCheck {
+	hcs := make([]*nbdb.LoadBalancerHealthCheck, 0, len(lb.Rules))
+	for _, rule := range lb.Rules {
+		for _, target := range rule.Targets {
+			if _, ok := lb.HealthCheckMappings[target.IP]; !ok {
+				continue
+			}
+			options := make(map[string]string, len(lbHealthCheckOptions))
+			for k, v := range lbHealthCheckOptions {
+				options[k] = v
+			}
+			hcs = append(hcs, &nbdb.LoadBalancerHealthCheck{
+				Vip:         rule.Source.String(),
+				Options:     options,
+				ExternalIDs: lb.ExternalIDs,
+			})
+			break
+		}
+	}
+	return hcs
 }
 
 // buildVipMap returns a viups map from a set of rules
@@ -502,6 +569,8 @@ func _getLBsCommon(nbClient libovsdbclient.Client, allTemplates TemplateMap, wit
 		if lb.Protocol != nil {
 			res.Protocol = *lb.Protocol
 		}
+		// needed to clear the health checks of LBs that do not need them anymore
+		res.Opts.HealthCheck = len(lb.HealthCheck) > 0
 
 		outMap[lb.UUID] = &res
 	}
@@ -56,6 +56,8 @@ type nodeInfo struct {
 
 	// The node's zone
 	zone string
+	// The node's topology zone, as set by the topology.kubernetes.io/zone label
+	topologyZone string
 	/** HACK BEGIN **/
 	// has the node migrated to remote?
 	migrated bool
@@ -117,6 +119,7 @@ func (nt *nodeTracker) Start(nodeInformer coreinformers.NodeInformer) (cache.Res
 			// - the name of the node (very rare) has changed
 			// - the `host-cidrs` annotation changed
 			// - node changes its zone
+			// - node changes its topology zone label
 			// - node becomes a hybrid overlay node from a ovn node or vice verse
 			// . No need to trigger update for any other field change.
 			if util.NodeSubnetAnnotationChanged(oldObj, newObj) ||
@@ -125,6 +128,7 @@ func (nt *nodeTracker) Start(nodeInformer coreinformers.NodeInformer) (cache.Res
 				util.NodeHostCIDRsAnnotationChanged(oldObj, newObj) ||
 				util.NodeZoneAnnotationChanged(oldObj, newObj) ||
 				util.NodeMigratedZoneAnnotationChanged(oldObj, newObj) ||
+				oldObj.Labels[v1.LabelTopologyZone] != newObj.Labels[v1.LabelTopologyZone] ||
 				util.NoHostSubnet(oldObj) != util.NoHostSubnet(newObj) {
 				nt.updateNode(newObj)
 			}
@@ -152,7 +156,7 @@ func (nt *nodeTracker) Start(nodeInformer coreinformers.NodeInformer) (cache.Res
 // updateNodeInfo updates the node info cache, and syncs all services
 // if it changed.
 func (nt *nodeTracker) updateNodeInfo(nodeName, switchName, routerName, chassisID string, l3gatewayAddresses,
-	hostAddresses []net.IP, podSubnets []*net.IPNet, zone string, nodePortDisabled, migrated bool) {
+	hostAddresses []net.IP, podSubnets []*net.IPNet, zone, topologyZone string, nodePortDisabled, migrated bool) {
 	ni := nodeInfo{
 		name:               nodeName,
 		l3gatewayAddresses: l3gatewayAddresses,
@@ -163,6 +167,7 @@ func (nt *nodeTracker) updateNodeInfo(nodeName, switchName, routerName, chassisI
 		chassisID:          chassisID,
 		nodePortDisabled:   nodePortDisabled,
 		zone:               zone,
+		topologyZone:       topologyZone,
 		migrated:           migrated,
 	}
 	for i := range podSubnets {
@@ -265,6 +270,7 @@ func (nt *nodeTracker) updateNode(node *v1.Node) {
 		hostAddressesIPs,
 		hsn,
 		util.GetNodeZone(node),
+		node.Labels[v1.LabelTopologyZone],
 		!nodePortEnabled,
 		util.HasNodeMigratedZone(node),
 	)
@@ -28,6 +28,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	ktypes "k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	discoverylisters "k8s.io/client-go/listers/discovery/v1"
 	utilnet "k8s.io/utils/net"
@@ -60,6 +61,7 @@ var NoServiceLabelError = fmt.Errorf("endpointSlice missing the service name lab
 // NewController returns a new *Controller.
 func NewController(client clientset.Interface,
 	nbClient libovsdbclient.Client,
+	sbClient libovsdbclient.Client,
 	serviceInformer coreinformers.ServiceInformer,
 	endpointSliceInformer discoveryinformers.EndpointSliceInformer,
 	nodeInformer coreinformers.NodeInformer,
@@ -71,6 +73,7 @@ func NewController(client clientset.Interface,
 	c := &Controller{
 		client:   client,
 		nbClient: nbClient,
+		sbClient: sbClient,
 		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
 			newRatelimiter(100),
 			workqueue.TypedRateLimitingQueueConfig[string]{Name: controllerName},
@@ -90,6 +93,9 @@ func NewController(client clientset.Interface,
 		nodeInformer:  nodeInformer,
 		nodesSynced:   nodeInformer.Informer().HasSynced,
 		netInfo:       netInfo,
+
+		healthCheckedServices: map[healthCheckBackend]sets.Set[string]{},
+		healthCheckedBackends: map[string]sets.Set[healthCheckBackend]{},
 	}
 	zone, err := libovsdbutil.GetNBZone(c.nbClient)
 	if err != nil {
@@ -107,7 +113,9 @@ type Controller struct {
 	client clientset.Interface
 
 	// libovsdb northbound client interface
-	nbClient      libovsdbclient.Client
+	nbClient libovsdbclient.Client
+	// libovsdb southbound client interface
+	sbClient      libovsdbclient.Client
 	eventRecorder record.EventRecorder
 
 	serviceInformer coreinformers.ServiceInformer
@@ -152,6 +160,11 @@ type Controller struct {
 	nodeIPv6Templates *NodeIPsTemplates
 	nodeInfoRWLock    sync.RWMutex
 
+	// healthCheckNodeInfos are the nodeInfos whose service backends can be
+	// health checked, see getHealthCheckNodes. Written in RequestFullSync()
+	// along with nodeInfos, under the same mutex.
+	healthCheckNodeInfos []nodeInfo
+
 	// alreadyApplied is a map of service key -> already applied configuration, so we can short-circuit
 	// if a service's config hasn't changed
 	alreadyApplied       map[string][]LB
@@ -168,6 +181,13 @@ type Controller struct {
 	useTemplates bool
 
 	netInfo util.NetInfo
+
+	// healthCheckedServices maps the backends OVN health checks to the keys of
+	// the services they back, and healthCheckedBackends the other way around.
+	// Must be accessed only with the healthCheckLock taken.
+	healthCheckedServices map[healthCheckBackend]sets.Set[string]
+	healthCheckedBackends map[string]sets.Set[healthCheckBackend]
+	healthCheckLock       sync.Mutex
 }
 
 // Run will not return until stopCh is closed. workers determines how many
@@ -235,6 +255,11 @@ func (c *Controller) Run(workers int, stopCh <-chan struct{}, runRepair, useLBGr
 		return fmt.Errorf("error initializing alreadyApplied cache: %w", err)
 	}
 
+	if globalconfig.OVNKubernetesFeature.EnableLBHealthChecks && c.netInfo.IsDefault() {
+		klog.Infof("Setting up event handlers for service monitors for network=%s", c.netInfo.GetNetworkName())
+		c.addServiceMonitorHandler()
+	}
+
 	c.startupDoneLock.Lock()
 	c.startupDone = true
 	c.startupDoneLock.Unlock()
@@ -420,6 +445,7 @@ func (c *Controller) syncService(key string) error {
 			c.alreadyAppliedRWLock.Unlock()
 		}
 
+		c.syncHealthCheckedBackends(key, nil)
 		c.repair.serviceSynced(key)
 		return nil
 	}
@@ -442,6 +468,11 @@ func (c *Controller) syncService(key string) error {
 	clusterLBs := buildClusterLBs(service, clusterConfigs, c.nodeInfos, c.useLBGroups, c.netInfo)
 	templateLBs := buildTemplateLBs(service, templateConfigs, c.nodeInfos, c.nodeIPv4Templates, c.nodeIPv6Templates, c.netInfo)
 	perNodeLBs := buildPerNodeLBs(service, perNodeConfigs, c.nodeInfos, c.netInfo)
+	var healthCheckedBackends sets.Set[healthCheckBackend]
+	if util.ServiceLBHealthCheckEnabled(service) && c.netInfo.IsDefault() {
+		backends, mappings := getHealthCheckBackends(endpointSlices, c.healthCheckNodeInfos)
+		healthCheckedBackends = setLBHealthChecks(backends, mappings, clusterLBs, templateLBs, perNodeLBs)
+	}
 	klog.V(5).Infof("Built service %s cluster-wide LB for network=%s: %#v", key, c.netInfo.GetNetworkName(), clusterLBs)
 	klog.V(5).Infof("Built service %s per-node LB for network=%s: %#v", key, c.netInfo.GetNetworkName(), perNodeLBs)
 	klog.V(5).Infof("Built service %s template LB for network=%s:  %#v", key, c.netInfo.GetNetworkName(), templateLBs)
@@ -478,6 +509,7 @@ func (c *Controller) syncService(key string) error {
 		c.alreadyAppliedRWLock.Unlock()
 	}
 
+	c.syncHealthCheckedBackends(key, healthCheckedBackends)
 	c.repair.serviceSynced(key)
 	return nil
 }
@@ -487,6 +519,16 @@ func (c *Controller) syncNodeInfos(nodeInfos []nodeInfo) {
 	defer c.nodeInfoRWLock.Unlock()
 
 	c.nodeInfos = nodeInfos
+	if globalconfig.OVNKubernetesFeature.EnableLBHealthChecks && c.netInfo.IsDefault() {
+		healthCheckNodeInfos, err := c.getHealthCheckNodes(nodeInfos)
+		if err != nil {
+			// rather than health check backends from an address in use,
+			// don't health check any until the next node event
+			klog.Errorf("Failed to find the nodes whose service backends can be health checked for network=%s: %v",
+				c.netInfo.GetNetworkName(), err)
+		}
+		c.healthCheckNodeInfos = healthCheckNodeInfos
+	}
 	if !c.useTemplates {
 		return
 	}
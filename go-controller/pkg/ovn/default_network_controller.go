@@ -8,10 +8,7 @@ import (
 	"time"
 
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
-	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/controller"
-	egressfirewall "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressfirewall/v1"
 	egressipv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1"
-	egressqoslisters "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressqos/v1/apis/listers/egressqos/v1"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/metrics"
 	nad "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/network-attach-def-controller"
@@ -22,7 +19,6 @@ import (
 	egresssvc "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/controller/egressservice"
 	svccontroller "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/controller/services"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/controller/unidling"
-	dnsnameresolver "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/dns_name_resolver"
 	aclsyncer "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/external_ids_syncer/acl"
 	addrsetsyncer "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/external_ids_syncer/address_set"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/external_ids_syncer/port_group"
@@ -39,9 +35,6 @@ import (
 	kapi "k8s.io/api/core/v1"
 	knet "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
-	corev1listers "k8s.io/client-go/listers/core/v1"
-	"k8s.io/client-go/tools/cache"
-	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
 )
 
@@ -58,23 +51,6 @@ type DefaultNetworkController struct {
 
 	externalGatewayRouteInfo *apbroutecontroller.ExternalGatewayRouteInfoCache
 
-	// egressFirewalls is a map of namespaces and the egressFirewall attached to it
-	egressFirewalls sync.Map
-
-	// EgressQoS
-	egressQoSLister egressqoslisters.EgressQoSLister
-	egressQoSSynced cache.InformerSynced
-	egressQoSQueue  workqueue.TypedRateLimitingInterface[string]
-	egressQoSCache  sync.Map
-
-	egressQoSPodLister corev1listers.PodLister
-	egressQoSPodSynced cache.InformerSynced
-	egressQoSPodQueue  workqueue.TypedRateLimitingInterface[string]
-
-	egressQoSNodeLister corev1listers.NodeLister
-	egressQoSNodeSynced cache.InformerSynced
-	egressQoSNodeQueue  workqueue.TypedRateLimitingInterface[string]
-
 	// Cluster wide Load_Balancer_Group UUID.
 	// Includes all node switches and node gateway routers.
 	clusterLoadBalancerGroupUUID string
@@ -104,14 +80,6 @@ type DefaultNetworkController struct {
 	// Controller used to handle the admin policy based external route resources
 	apbExternalRouteController *apbroutecontroller.ExternalGatewayMasterController
 
-	// dnsNameResolver is used for resolving the IP addresses of DNS names
-	// used in egress firewall rules
-	dnsNameResolver  dnsnameresolver.DNSNameResolver
-	efNodeController controller.Controller
-
-	// retry framework for egress firewall
-	retryEgressFirewalls *retry.RetryFramework
-
 	// retry framework for egress IP
 	retryEgressIPs *retry.RetryFramework
 	// retry framework for egress IP Namespaces
@@ -160,7 +128,7 @@ func newDefaultNetworkControllerCommon(cnci *CommonNetworkControllerInfo,
 	}
 
 	svcController, err := svccontroller.NewController(
-		cnci.client, cnci.nbClient,
+		cnci.client, cnci.nbClient, cnci.sbClient,
 		cnci.watchFactory.ServiceCoreInformer(),
 		cnci.watchFactory.EndpointSliceCoreInformer(),
 		cnci.watchFactory.NodeCoreInformer(),
@@ -343,12 +311,7 @@ func (oc *DefaultNetworkController) Start(ctx context.Context) error {
 
 // Stop gracefully stops the controller
 func (oc *DefaultNetworkController) Stop() {
-	if oc.dnsNameResolver != nil {
-		oc.dnsNameResolver.Shutdown()
-	}
-	if oc.efNodeController != nil {
-		controller.Stop(oc.efNodeController)
-	}
+	oc.stopEgressFirewall()
 
 	close(oc.stopChan)
 	oc.cancelableCtx.Cancel()
@@ -487,30 +450,7 @@ func (oc *DefaultNetworkController) Run(ctx context.Context) error {
 	}
 
 	if config.OVNKubernetesFeature.EnableEgressFirewall {
-		var err error
-		// If DNSNameResolver is enabled, then initialize dnsNameResolver to ExternalEgressDNS
-		// for maintaining the address sets corresponding to the DNS names and start watching
-		// DNSNameResolver resources. Otherwise initialize dnsNameResolver to EgressDNS.
-		if config.OVNKubernetesFeature.EnableDNSNameResolver {
-			oc.dnsNameResolver, err = dnsnameresolver.NewExternalEgressDNS(oc.addressSetFactory, oc.controllerName, true,
-				oc.watchFactory.DNSNameResolverInformer().Informer(), oc.watchFactory.EgressFirewallInformer().Lister())
-		} else {
-			oc.dnsNameResolver, err = dnsnameresolver.NewEgressDNS(oc.addressSetFactory, oc.controllerName, oc.stopChan, egressFirewallDNSDefaultDuration)
-		}
-		if err != nil {
-			return err
-		}
-		err = oc.dnsNameResolver.Run()
-		if err != nil {
-			return err
-		}
-		err = WithSyncDurationMetric("egress firewall", oc.WatchEgressFirewall)
-		if err != nil {
-			return err
-		}
-		oc.efNodeController = oc.newEFNodeController(oc.watchFactory.NodeCoreInformer())
-		err = controller.Start(oc.efNodeController)
-		if err != nil {
+		if err := oc.startEgressFirewall(); err != nil {
 			return err
 		}
 	}
@@ -780,18 +720,13 @@ func (h *defaultNetworkControllerEventHandler) AddResource(obj interface{}, from
 		}
 		return utilerrors.Join(aggregatedErrors...)
 
-	case factory.EgressFirewallType:
-		egressFirewall := obj.(*egressfirewall.EgressFirewall).DeepCopy()
-		err := h.oc.addEgressFirewall(egressFirewall)
-		if statusErr := h.oc.setEgressFirewallStatus(egressFirewall, err); statusErr != nil {
-			klog.Errorf("Failed to update egress firewall status %s, error: %v",
-				getEgressFirewallNamespacedName(egressFirewall), statusErr)
-		}
-		return err
-
 	case factory.EgressIPType:
 		eIP := obj.(*egressipv1.EgressIP)
-		return h.oc.reconcileEgressIP(nil, eIP)
+		err := h.oc.reconcileEgressIP(nil, eIP)
+		if statusErr := h.oc.setEgressIPZoneStatus(eIP, err); statusErr != nil {
+			klog.Errorf("Failed to update EgressIP %s status, error: %v", eIP.Name, statusErr)
+		}
+		return err
 
 	case factory.EgressIPNamespaceType:
 		namespace := obj.(*kapi.Namespace)
@@ -899,6 +834,8 @@ func (h *defaultNetworkControllerEventHandler) UpdateResource(oldObj, newObj int
 			if h.oc.isLocalZoneNode(oldNode) {
 				// determine what actually changed in this update
 				_, nodeSync := h.oc.addNodeFailed.Load(newNode.Name)
+				// the node switch IPAM is updated with the additional host subnets
+				nodeSync = nodeSync || nodeHostSubnetsAdded(oldNode, newNode, types.DefaultNetworkName)
 				_, failed := h.oc.nodeClusterRouterPortFailed.Load(newNode.Name)
 				clusterRtrSync := failed || nodeChassisChanged(oldNode, newNode) || nodeSubnetChanged
 				_, failed = h.oc.mgmtPortFailed.Load(newNode.Name)
@@ -956,7 +893,11 @@ func (h *defaultNetworkControllerEventHandler) UpdateResource(oldObj, newObj int
 	case factory.EgressIPType:
 		oldEIP := oldObj.(*egressipv1.EgressIP)
 		newEIP := newObj.(*egressipv1.EgressIP)
-		return h.oc.reconcileEgressIP(oldEIP, newEIP)
+		err := h.oc.reconcileEgressIP(oldEIP, newEIP)
+		if statusErr := h.oc.setEgressIPZoneStatus(newEIP, err); statusErr != nil {
+			klog.Errorf("Failed to update EgressIP %s status, error: %v", newEIP.Name, statusErr)
+		}
+		return err
 
 	case factory.EgressIPNamespaceType:
 		oldNamespace := oldObj.(*kapi.Namespace)
@@ -1024,15 +965,6 @@ func (h *defaultNetworkControllerEventHandler) DeleteResource(obj, cachedObj int
 		}
 		return h.oc.deleteNodeEvent(node)
 
-	case factory.EgressFirewallType:
-		egressFirewall := obj.(*egressfirewall.EgressFirewall)
-		if err := h.oc.deleteEgressFirewall(egressFirewall); err != nil {
-			return err
-		}
-		metrics.UpdateEgressFirewallRuleCount(float64(-len(egressFirewall.Spec.Egress)))
-		metrics.DecrementEgressFirewallCount()
-		return nil
-
 	case factory.EgressIPType:
 		eIP := obj.(*egressipv1.EgressIP)
 		return h.oc.reconcileEgressIP(eIP, nil)
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
@@ -19,6 +20,10 @@ import (
 	addressset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/address_set"
 )
 
+// addressExpiryGracePeriod is how long an address is kept after its TTL
+// expired, to give the DNSNameResolver status the time to be refreshed.
+const addressExpiryGracePeriod = 5 * time.Second
+
 // ExternalEgressDNS keeps track of DNS names and the corresponding IP addresses.
 // For each DNS name, an address set is allocated and the address set is
 // kept updated with the corresponding IP addresses. Whenever a DNS name
@@ -149,16 +154,50 @@ func (extEgDNS *ExternalEgressDNS) reconcileDNSNameResolver(key string) error {
 	extEgDNS.resolverToDNSName[name] = dnsName
 	extEgDNS.dnsNameToResolver[dnsName] = name
 
-	// Get the addresses corresponding to the DNS name and add them to
-	// the address set corresponding to the DNS name.
-	addresses := []string{}
+	// Get the addresses corresponding to the DNS name which have not
+	// expired yet and set them as the addresses of the address set
+	// corresponding to the DNS name. For a wildcard DNS name, these are the
+	// addresses of all the matching DNS names observed in DNS responses.
+	addresses, nextExpiry := getUnexpiredAddresses(obj, time.Now())
+	err = extEgDNS.dnsTracker.addDNSName(dnsName, addresses)
+	if err != nil {
+		return err
+	}
+
+	// Reconcile the object again when the next address expires, in case its
+	// status is not refreshed before that.
+	if nextExpiry > 0 {
+		extEgDNS.controller.ReconcileAfter(key, nextExpiry)
+	}
+	return nil
+}
+
+// getUnexpiredAddresses returns the addresses in the status of the
+// DNSNameResolver object which are still valid at the given time, along with
+// the duration after which the first of them expires, or 0 if none of them
+// does. An address is valid until lastLookupTime + ttlSeconds +
+// addressExpiryGracePeriod. Addresses without a lastLookupTime never expire.
+func getUnexpiredAddresses(obj *ocpnetworkapiv1alpha1.DNSNameResolver, now time.Time) ([]string, time.Duration) {
+	addresses := sets.New[string]()
+	var nextExpiry time.Duration
 	for _, resolvedName := range obj.Status.ResolvedNames {
 		for _, resolvedAddress := range resolvedName.ResolvedAddresses {
-			addresses = append(addresses, resolvedAddress.IP)
+			if resolvedAddress.LastLookupTime == nil {
+				addresses.Insert(resolvedAddress.IP)
+				continue
+			}
+			expiry := resolvedAddress.LastLookupTime.Add(time.Duration(resolvedAddress.TTLSeconds)*time.Second +
+				addressExpiryGracePeriod).Sub(now)
+			if expiry <= 0 {
+				continue
+			}
+			addresses.Insert(resolvedAddress.IP)
+			if nextExpiry == 0 || expiry < nextExpiry {
+				nextExpiry = expiry
+			}
 		}
 	}
-	err = extEgDNS.dnsTracker.addDNSName(dnsName, addresses)
-	return err
+	return sets.List(addresses), nextExpiry
 }
 
 // Add adds the namespace to the set of namespaces where the DNS name is used in the
@@ -226,6 +226,72 @@ var _ = ginkgo.Describe("Egress Firewall External DNS Operations", func() {
 		})
 	})
 
+	ginkgo.Context("on dns name resolver resource update", func() {
+		ginkgo.It("Should remove addresses which are no longer resolved", func() {
+			start()
+
+			config.IPv4Mode = true
+			config.IPv6Mode = false
+
+			dnsNameResolver := newDNSNameResolverObject("dns-default", config.Kubernetes.OVNConfigNamespace, dnsName, []string{"1.1.1.1", "2.2.2.2"})
+
+			_, err := fakeClient.OCPNetworkClient.NetworkV1alpha1().DNSNameResolvers(dnsNameResolver.Namespace).
+				Create(context.TODO(), dnsNameResolver, metav1.CreateOptions{})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			expectDNSNameWithAddresses(extEgDNS, dnsName, []string{"1.1.1.1", "2.2.2.2"})
+
+			dnsNameResolver = newDNSNameResolverObject("dns-default", config.Kubernetes.OVNConfigNamespace, dnsName, []string{"2.2.2.2", "3.3.3.3"})
+			_, err = fakeClient.OCPNetworkClient.NetworkV1alpha1().DNSNameResolvers(dnsNameResolver.Namespace).
+				Update(context.TODO(), dnsNameResolver, metav1.UpdateOptions{})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			gomega.Eventually(func() []string {
+				resolvedName, _ := extEgDNS.getResolvedName(dnsName)
+				v4, _ := resolvedName.dnsAddressSet.GetAddresses()
+				return v4
+			}).Should(gomega.ConsistOf("2.2.2.2", "3.3.3.3"))
+		})
+
+		ginkgo.It("Should add the addresses of the DNS names matching a wildcard DNS name and remove them once expired", func() {
+			start()
+
+			config.IPv4Mode = true
+			config.IPv6Mode = false
+
+			const wildcardDNSName = "*.example.com."
+			dnsNameResolver := newDNSNameResolverObject("dns-default", config.Kubernetes.OVNConfigNamespace, wildcardDNSName, nil)
+			dnsNameResolver.Status.ResolvedNames = []ocpnetworkapiv1alpha1.DNSNameResolverResolvedName{
+				{
+					DNSName: "www.example.com.",
+					ResolvedAddresses: []ocpnetworkapiv1alpha1.DNSNameResolverResolvedAddress{
+						{IP: "1.1.1.1", TTLSeconds: 3600, LastLookupTime: &metav1.Time{Time: time.Now()}},
+					},
+				},
+				{
+					DNSName: "api.example.com.",
+					ResolvedAddresses: []ocpnetworkapiv1alpha1.DNSNameResolverResolvedAddress{
+						{IP: "2.2.2.2", TTLSeconds: 1, LastLookupTime: &metav1.Time{Time: time.Now()}},
+						{IP: "3.3.3.3", TTLSeconds: 1, LastLookupTime: &metav1.Time{Time: time.Now().Add(-time.Hour)}},
+					},
+				},
+			}
+
+			_, err := fakeClient.OCPNetworkClient.NetworkV1alpha1().DNSNameResolvers(dnsNameResolver.Namespace).
+				Create(context.TODO(), dnsNameResolver, metav1.CreateOptions{})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			expectDNSNameWithAddresses(extEgDNS, wildcardDNSName, []string{"1.1.1.1", "2.2.2.2"})
+
+			// the TTL of 2.2.2.2 expires without its lookup being refreshed
+			gomega.Eventually(func() []string {
+				resolvedName, _ := extEgDNS.getResolvedName(wildcardDNSName)
+				v4, _ := resolvedName.dnsAddressSet.GetAddresses()
+				return v4
+			}).WithTimeout(2 * addressExpiryGracePeriod).Should(gomega.ConsistOf("1.1.1.1"))
+		})
+	})
+
 	ginkgo.It("Should not delete added addresses if DNS name is still used in a namespace", func() {
 		start()
 
@@ -90,8 +90,10 @@ func (dnsTracker *dnsTracker) addDNSName(dnsName string, addresses []string) err
 		addresses = filteredIPs
 	}
 
-	if err := resolvedName.dnsAddressSet.AddAddresses(addresses); err != nil {
-		return fmt.Errorf("cannot add IPs to AddressSet for DNS name %s: %v", dnsName, err)
+	// Replace the addresses so that the ones no longer resolved for the DNS
+	// name, e.g. expired ones, are removed.
+	if err := resolvedName.dnsAddressSet.SetAddresses(addresses); err != nil {
+		return fmt.Errorf("cannot set IPs of AddressSet for DNS name %s: %v", dnsName, err)
 	}
 
 	return nil
@@ -3,6 +3,7 @@ package ovn
 import (
 	"context"
 	"fmt"
+	"net"
 	"reflect"
 	"slices"
 	"strconv"
@@ -19,6 +20,7 @@ import (
 	libovsdbutil "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/util"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/metrics"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	dnsnameresolver "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/dns_name_resolver"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util/batching"
@@ -56,6 +58,7 @@ type egressFirewallRule struct {
 	id     int
 	access egressfirewallapi.EgressFirewallRuleType
 	ports  []egressfirewallapi.EgressFirewallPort
+	icmp   []egressfirewallapi.EgressFirewallICMP
 	to     destination
 }
 
@@ -85,7 +88,7 @@ func cloneEgressFirewall(originalEgressfirewall *egressfirewallapi.EgressFirewal
 
 // newEgressFirewallRule creates a new egressFirewallRule. For the logging level, it will pick either of
 // aclLoggingAllow or aclLoggingDeny depending if this is an allow or deny rule.
-func (oc *DefaultNetworkController) newEgressFirewallRule(rawEgressFirewallRule egressfirewallapi.EgressFirewallRule, id int) (*egressFirewallRule, error) {
+func (bnc *BaseNetworkController) newEgressFirewallRule(rawEgressFirewallRule egressfirewallapi.EgressFirewallRule, id int) (*egressFirewallRule, error) {
 	efr := &egressFirewallRule{
 		id:     id,
 		access: rawEgressFirewallRule.Type,
@@ -99,10 +102,15 @@ func (oc *DefaultNetworkController) newEgressFirewallRule(rawEgressFirewallRule
 	if err != nil {
 		return efr, err
 	}
+	// the intersection is computed against the default cluster subnets, for
+	// user defined networks it has to be computed against the network subnets
+	if efr.to.cidrSelector != "" && !bnc.IsDefault() {
+		efr.to.clusterSubnetIntersection = cidrIntersectsSubnets(efr.to.cidrSelector, bnc.Subnets())
+	}
 	// If nodeSelector is set then fetch the node addresses.
 	if efr.to.nodeSelector != nil {
 		efr.to.nodeAddrs = map[string][]string{}
-		nodes, err := oc.watchFactory.GetNodesByLabelSelector(*rawEgressFirewallRule.To.NodeSelector)
+		nodes, err := bnc.watchFactory.GetNodesByLabelSelector(*rawEgressFirewallRule.To.NodeSelector)
 		if err != nil {
 			return efr, fmt.Errorf("unable to query nodes for egress firewall: %w", err)
 		}
@@ -114,7 +122,11 @@ func (oc *DefaultNetworkController) newEgressFirewallRule(rawEgressFirewallRule
 			efr.to.nodeAddrs[node.Name] = hostAddresses
 		}
 	}
+	if err = util.ValidateEgressFirewallPortsAndICMP(rawEgressFirewallRule.Ports, rawEgressFirewallRule.ICMP); err != nil {
+		return efr, err
+	}
 	efr.ports = rawEgressFirewallRule.Ports
+	efr.icmp = rawEgressFirewallRule.ICMP
 
 	return efr, nil
 }
@@ -123,10 +135,12 @@ func (oc *DefaultNetworkController) newEgressFirewallRule(rawEgressFirewallRule
 // stale db entries for Egress Firewalls that don't exist anymore.
 // Egress firewall implementation had many versions, the latest one makes no difference for gateway modes, and creates
 // ACLs on namespaced port groups.
-func (oc *DefaultNetworkController) syncEgressFirewall(egressFirewalls []interface{}) error {
-	err := oc.deleteStaleACLs()
-	if err != nil {
-		return err
+func (bnc *BaseNetworkController) syncEgressFirewall(egressFirewalls []interface{}) error {
+	// previous implementations only existed for the default network
+	if bnc.IsDefault() {
+		if err := bnc.deleteStaleACLs(); err != nil {
+			return err
+		}
 	}
 
 	existingEFNamespaces := map[string]bool{}
@@ -135,21 +149,32 @@ func (oc *DefaultNetworkController) syncEgressFirewall(egressFirewalls []interfa
 		if !ok {
 			return fmt.Errorf("spurious object in syncEgressFirewall: %v", efInterface)
 		}
-		existingEFNamespaces[ef.Namespace] = true
+		served, err := bnc.isNamespaceServedByNetwork(ef.Namespace)
+		if err != nil {
+			// keep the existing ACLs, the egress firewall handler will take
+			// care of them
+			klog.Warningf("Failed to get the active network of namespace %s: %v", ef.Namespace, err)
+			served = true
+		}
+		if served {
+			existingEFNamespaces[ef.Namespace] = true
+		}
 	}
 
 	// find all existing egress firewall ACLs
-	predicateIDs := libovsdbops.NewDbObjectIDs(libovsdbops.ACLEgressFirewall, oc.controllerName, nil)
+	predicateIDs := libovsdbops.NewDbObjectIDs(libovsdbops.ACLEgressFirewall, bnc.controllerName, nil)
 	aclP := libovsdbops.GetPredicate[*nbdb.ACL](predicateIDs, nil)
-	efACLs, err := libovsdbops.FindACLsWithPredicate(oc.nbClient, aclP)
+	efACLs, err := libovsdbops.FindACLsWithPredicate(bnc.nbClient, aclP)
 	if err != nil {
 		return fmt.Errorf("cannot find Egress Firewall ACLs: %v", err)
 	}
 
 	// another sync to move ACLs to the right port group
-	err = oc.moveACLsToNamespacedPortGroups(existingEFNamespaces, efACLs)
-	if err != nil {
-		return err
+	if bnc.IsDefault() {
+		err = bnc.moveACLsToNamespacedPortGroups(existingEFNamespaces, efACLs)
+		if err != nil {
+			return err
+		}
 	}
 
 	var deletedNSACLs = map[string][]*nbdb.ACL{}
@@ -164,16 +189,16 @@ func (oc *DefaultNetworkController) syncEgressFirewall(egressFirewalls []interfa
 		var ops []libovsdb.Operation
 		var err error
 		for namespace, acls := range batchNsACLs {
-			pgName := oc.getNamespacePortGroupName(namespace)
+			pgName := bnc.getNamespacePortGroupName(namespace)
 			// delete stale ACLs from namespaced port group
 			// both port group and acls may not exist after moveACLsToNamespacedPortGroups,
 			// but DeleteACLsFromPortGroupOps doesn't return error in these cases
-			ops, err = libovsdbops.DeleteACLsFromPortGroupOps(oc.nbClient, ops, pgName, acls...)
+			ops, err = libovsdbops.DeleteACLsFromPortGroupOps(bnc.nbClient, ops, pgName, acls...)
 			if err != nil {
 				return fmt.Errorf("failed to build cleanup ops: %w", err)
 			}
 		}
-		_, err = libovsdbops.TransactAndCheck(oc.nbClient, ops)
+		_, err = libovsdbops.TransactAndCheck(bnc.nbClient, ops)
 		if err != nil {
 			return fmt.Errorf("failed to clean up egress firewall ACLs: %w", err)
 		}
@@ -184,7 +209,7 @@ func (oc *DefaultNetworkController) syncEgressFirewall(egressFirewalls []interfa
 	}
 
 	// Delete stale address sets related to EgressFirewallDNS which are not referenced by any ACL.
-	return oc.dnsNameResolver.DeleteStaleAddrSets(oc.nbClient)
+	return bnc.dnsNameResolver.DeleteStaleAddrSets(bnc.nbClient)
 }
 
 // deleteStaleACLs cleans up 2 previous implementations:
@@ -192,14 +217,14 @@ func (oc *DefaultNetworkController) syncEgressFirewall(egressFirewalls []interfa
 //     For this it just deletes all LRP setup done for egress firewall
 //   - Cleanup the old implementation (using ACLs on the join and node switches)
 //     For this it deletes all the ACLs on the join and node switches, they will be created from scratch later.
-func (oc *DefaultNetworkController) deleteStaleACLs() error {
+func (bnc *BaseNetworkController) deleteStaleACLs() error {
 	// In any gateway mode, make sure to delete all LRPs on ovn_cluster_router.
 	p := func(item *nbdb.LogicalRouterPolicy) bool {
 		return item.Priority <= types.EgressFirewallStartPriority && item.Priority >= types.MinimumReservedEgressFirewallPriority
 	}
-	err := libovsdbops.DeleteLogicalRouterPoliciesWithPredicate(oc.nbClient, oc.GetNetworkScopedClusterRouterName(), p)
+	err := libovsdbops.DeleteLogicalRouterPoliciesWithPredicate(bnc.nbClient, bnc.GetNetworkScopedClusterRouterName(), p)
 	if err != nil {
-		return fmt.Errorf("error deleting egress firewall policies on router %s: %v", oc.GetNetworkScopedClusterRouterName(), err)
+		return fmt.Errorf("error deleting egress firewall policies on router %s: %v", bnc.GetNetworkScopedClusterRouterName(), err)
 	}
 
 	// delete acls from all switches, they reside on the port group now
@@ -207,7 +232,7 @@ func (oc *DefaultNetworkController) deleteStaleACLs() error {
 	aclPred := func(item *nbdb.ACL) bool {
 		return item.Priority >= types.MinimumReservedEgressFirewallPriority && item.Priority <= types.EgressFirewallStartPriority
 	}
-	egressFirewallACLs, err := libovsdbops.FindACLsWithPredicate(oc.nbClient, aclPred)
+	egressFirewallACLs, err := libovsdbops.FindACLsWithPredicate(bnc.nbClient, aclPred)
 	if err != nil {
 		return fmt.Errorf("unable to list egress firewall ACLs, cannot cleanup old stale data, err: %v", err)
 	}
@@ -221,7 +246,7 @@ func (oc *DefaultNetworkController) deleteStaleACLs() error {
 			swWithACLsPred := func(sw *nbdb.LogicalSwitch) bool {
 				return aclsToDelete.HasAny(sw.ACLs...)
 			}
-			return libovsdbops.RemoveACLsFromLogicalSwitchesWithPredicate(oc.nbClient, swWithACLsPred, batchACLs...)
+			return libovsdbops.RemoveACLsFromLogicalSwitchesWithPredicate(bnc.nbClient, swWithACLsPred, batchACLs...)
 		})
 		if err != nil {
 			return fmt.Errorf("failed to remove egress firewall acls from node logical switches: %v", err)
@@ -232,10 +257,10 @@ func (oc *DefaultNetworkController) deleteStaleACLs() error {
 
 // moveACLsToNamespacedPortGroups syncs db from the previous version where all ACLs were attached to the ClusterPortGroup
 // to the new version where ACLs are attached to the namespace port groups.
-func (oc *DefaultNetworkController) moveACLsToNamespacedPortGroups(existingEFNamespaces map[string]bool, efACLs []*nbdb.ACL) error {
+func (bnc *BaseNetworkController) moveACLsToNamespacedPortGroups(existingEFNamespaces map[string]bool, efACLs []*nbdb.ACL) error {
 	// find stale ACLs attached to a cluster port group, and move them to namespaced port groups
-	clusterPG, err := libovsdbops.GetPortGroup(oc.nbClient, &nbdb.PortGroup{
-		Name: oc.getClusterPortGroupName(types.ClusterPortGroupNameBase),
+	clusterPG, err := libovsdbops.GetPortGroup(bnc.nbClient, &nbdb.PortGroup{
+		Name: bnc.getClusterPortGroupName(types.ClusterPortGroupNameBase),
 	})
 	if err != nil {
 		return fmt.Errorf("failed to get cluster port gorup: %w", err)
@@ -262,22 +287,22 @@ func (oc *DefaultNetworkController) moveACLsToNamespacedPortGroups(existingEFNam
 		var err error
 		for namespace, acls := range batchNsACLs {
 			if namespace != "" && existingEFNamespaces[namespace] {
-				pgName := oc.getNamespacePortGroupName(namespace)
+				pgName := bnc.getNamespacePortGroupName(namespace)
 				// re-attach from ClusterPortGroupNameBase to namespaced port group.
 				// port group should exist, because namespace handler will create it.
-				ops, err = libovsdbops.AddACLsToPortGroupOps(oc.nbClient, ops, pgName, acls...)
+				ops, err = libovsdbops.AddACLsToPortGroupOps(bnc.nbClient, ops, pgName, acls...)
 				if err != nil {
 					return fmt.Errorf("failed to build cleanup ops: %w", err)
 				}
 			}
 			// delete all EF ACLs from ClusterPortGroupNameBase
-			ops, err = libovsdbops.DeleteACLsFromPortGroupOps(oc.nbClient, ops,
-				oc.getClusterPortGroupName(types.ClusterPortGroupNameBase), acls...)
+			ops, err = libovsdbops.DeleteACLsFromPortGroupOps(bnc.nbClient, ops,
+				bnc.getClusterPortGroupName(types.ClusterPortGroupNameBase), acls...)
 			if err != nil {
 				return fmt.Errorf("failed to build cleanup from ClusterPortGroup ops: %w", err)
 			}
 		}
-		_, err = libovsdbops.TransactAndCheck(oc.nbClient, ops)
+		_, err = libovsdbops.TransactAndCheck(bnc.nbClient, ops)
 		if err != nil {
 			return fmt.Errorf("failed to clean up egress firewall ACLs: %w", err)
 		}
@@ -286,16 +311,16 @@ func (oc *DefaultNetworkController) moveACLsToNamespacedPortGroups(existingEFNam
 	return err
 }
 
-func (oc *DefaultNetworkController) addEgressFirewall(egressFirewall *egressfirewallapi.EgressFirewall) error {
+func (bnc *BaseNetworkController) addEgressFirewall(egressFirewall *egressfirewallapi.EgressFirewall) error {
 	klog.Infof("Adding egressFirewall %s in namespace %s", egressFirewall.Name, egressFirewall.Namespace)
 
 	ef := cloneEgressFirewall(egressFirewall)
 	ef.Lock()
 	defer ef.Unlock()
 	// egressFirewall may already exist, if previous add failed, cleanup
-	if _, loaded := oc.egressFirewalls.Load(egressFirewall.Namespace); loaded {
+	if _, loaded := bnc.egressFirewalls.Load(egressFirewall.Namespace); loaded {
 		klog.Infof("Egress firewall in namespace %s already exists, cleanup", egressFirewall.Namespace)
-		err := oc.deleteEgressFirewall(egressFirewall)
+		err := bnc.deleteEgressFirewall(egressFirewall)
 		if err != nil {
 			return fmt.Errorf("failed to cleanup existing egress firewall %s on add: %v", egressFirewall.Namespace, err)
 		}
@@ -309,7 +334,7 @@ func (oc *DefaultNetworkController) addEgressFirewall(egressFirewall *egressfire
 				egressFirewall.Namespace, types.EgressFirewallStartPriority-types.MinimumReservedEgressFirewallPriority))
 			break
 		}
-		efr, err := oc.newEgressFirewallRule(egressFirewallRule, i)
+		efr, err := bnc.newEgressFirewallRule(egressFirewallRule, i)
 		if err != nil {
 			errorList = append(errorList, fmt.Errorf("cannot create EgressFirewall Rule to destination %s for namespace %s: %w",
 				egressFirewallRule.To.CIDRSelector, egressFirewall.Namespace, err))
@@ -322,21 +347,21 @@ func (oc *DefaultNetworkController) addEgressFirewall(egressFirewall *egressfire
 		return utilerrors.Join(errorList...)
 	}
 
-	pgName := oc.getNamespacePortGroupName(egressFirewall.Namespace)
-	aclLoggingLevels := oc.GetNamespaceACLLogging(ef.namespace)
-	// store egress firewall before calling addEgressFirewallRules, since it doesn't have a cleanup, and oc.egressFirewalls
+	pgName := bnc.getNamespacePortGroupName(egressFirewall.Namespace)
+	aclLoggingLevels := bnc.GetNamespaceACLLogging(ef.namespace)
+	// store egress firewall before calling addEgressFirewallRules, since it doesn't have a cleanup, and bnc.egressFirewalls
 	// object will be used on retry to cleanup
-	oc.egressFirewalls.Store(egressFirewall.Namespace, ef)
-	if err := oc.addEgressFirewallRules(ef, pgName, aclLoggingLevels); err != nil {
+	bnc.egressFirewalls.Store(egressFirewall.Namespace, ef)
+	if err := bnc.addEgressFirewallRules(ef, pgName, aclLoggingLevels); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (oc *DefaultNetworkController) deleteEgressFirewall(egressFirewallObj *egressfirewallapi.EgressFirewall) error {
+func (bnc *BaseNetworkController) deleteEgressFirewall(egressFirewallObj *egressfirewallapi.EgressFirewall) error {
 	klog.Infof("Deleting egress Firewall %s in namespace %s", egressFirewallObj.Name, egressFirewallObj.Namespace)
 	deleteDNS := false
-	obj, loaded := oc.egressFirewalls.Load(egressFirewallObj.Namespace)
+	obj, loaded := bnc.egressFirewalls.Load(egressFirewallObj.Namespace)
 	if !loaded {
 		return nil
 	}
@@ -357,19 +382,19 @@ func (oc *DefaultNetworkController) deleteEgressFirewall(egressFirewallObj *egre
 		}
 	}
 	// delete acls first, then dns address set that is referenced in these acls
-	if err := oc.deleteEgressFirewallRules(egressFirewallObj.Namespace); err != nil {
+	if err := bnc.deleteEgressFirewallRules(egressFirewallObj.Namespace); err != nil {
 		return err
 	}
 	if deleteDNS {
-		if err := oc.dnsNameResolver.Delete(egressFirewallObj.Namespace); err != nil {
+		if err := bnc.dnsNameResolver.Delete(egressFirewallObj.Namespace); err != nil {
 			return err
 		}
 	}
-	oc.egressFirewalls.Delete(egressFirewallObj.Namespace)
+	bnc.egressFirewalls.Delete(egressFirewallObj.Namespace)
 	return nil
 }
 
-func (oc *DefaultNetworkController) addEgressFirewallRules(ef *egressFirewall, pgName string,
+func (bnc *BaseNetworkController) addEgressFirewallRules(ef *egressFirewall, pgName string,
 	aclLogging *libovsdbutil.ACLLoggingLevels, ruleIDs ...int) error {
 	var ops []libovsdb.Operation
 	var err error
@@ -426,7 +451,7 @@ func (oc *DefaultNetworkController) addEgressFirewallRules(ef *egressFirewall, p
 				// Convert the DNS name to lower case fully qualified domain name.
 				dnsName = util.LowerCaseFQDN(rule.to.dnsName)
 			}
-			dnsNameAddressSets, err := oc.dnsNameResolver.Add(ef.namespace, dnsName)
+			dnsNameAddressSets, err := bnc.dnsNameResolver.Add(ef.namespace, dnsName)
 			if err != nil {
 				return fmt.Errorf("error with DNSNameResolver - %v", err)
 			}
@@ -442,19 +467,19 @@ func (oc *DefaultNetworkController) addEgressFirewallRules(ef *egressFirewall, p
 		if len(matchTargets) == 0 {
 			klog.Warningf("Egress Firewall rule: %#v has no destination...ignoring", *rule)
 			// ensure the ACL is removed from OVN
-			if err := oc.deleteEgressFirewallRule(ef.namespace, pgName, rule.id); err != nil {
+			if err := bnc.deleteEgressFirewallRule(ef.namespace, pgName, rule.id); err != nil {
 				return err
 			}
 			continue
 		}
 
-		match := generateMatch(pgName, matchTargets, rule.ports)
-		ops, err = oc.createEgressFirewallACLOps(ops, rule.id, match, action, ef.namespace, pgName, aclLogging)
+		match := generateMatch(pgName, bnc.Subnets(), matchTargets, rule.ports, rule.icmp)
+		ops, err = bnc.createEgressFirewallACLOps(ops, rule.id, match, action, ef.namespace, pgName, aclLogging)
 		if err != nil {
 			return err
 		}
 	}
-	_, err = libovsdbops.TransactAndCheck(oc.nbClient, ops)
+	_, err = libovsdbops.TransactAndCheck(bnc.nbClient, ops)
 	if err != nil {
 		return fmt.Errorf("failed to transact egressFirewall ACL: %v", err)
 	}
@@ -463,8 +488,8 @@ func (oc *DefaultNetworkController) addEgressFirewallRules(ef *egressFirewall, p
 
 // createEgressFirewallACLOps uses the previously generated elements and creates the
 // acls for all node switches
-func (oc *DefaultNetworkController) createEgressFirewallACLOps(ops []libovsdb.Operation, ruleIdx int, match, action, namespace, pgName string, aclLogging *libovsdbutil.ACLLoggingLevels) ([]libovsdb.Operation, error) {
-	aclIDs := oc.getEgressFirewallACLDbIDs(namespace, ruleIdx)
+func (bnc *BaseNetworkController) createEgressFirewallACLOps(ops []libovsdb.Operation, ruleIdx int, match, action, namespace, pgName string, aclLogging *libovsdbutil.ACLLoggingLevels) ([]libovsdb.Operation, error) {
+	aclIDs := bnc.getEgressFirewallACLDbIDs(namespace, ruleIdx)
 	priority := types.EgressFirewallStartPriority - ruleIdx
 	egressFirewallACL := libovsdbutil.BuildACL(
 		aclIDs,
@@ -476,12 +501,12 @@ func (oc *DefaultNetworkController) createEgressFirewallACLOps(ops []libovsdb.Op
 		libovsdbutil.LportIngress,
 	)
 	var err error
-	ops, err = libovsdbops.CreateOrUpdateACLsOps(oc.nbClient, ops, oc.GetSamplingConfig(), egressFirewallACL)
+	ops, err = libovsdbops.CreateOrUpdateACLsOps(bnc.nbClient, ops, bnc.GetSamplingConfig(), egressFirewallACL)
 	if err != nil {
 		return ops, fmt.Errorf("failed to create egressFirewall ACL %v: %v", egressFirewallACL, err)
 	}
 
-	ops, err = libovsdbops.AddACLsToPortGroupOps(oc.nbClient, ops, pgName, egressFirewallACL)
+	ops, err = libovsdbops.AddACLsToPortGroupOps(bnc.nbClient, ops, pgName, egressFirewallACL)
 	if err != nil {
 		return ops, fmt.Errorf("failed to add egressFirewall ACL %v to port group %s: %v",
 			egressFirewallACL, pgName, err)
@@ -490,11 +515,11 @@ func (oc *DefaultNetworkController) createEgressFirewallACLOps(ops []libovsdb.Op
 	return ops, nil
 }
 
-func (oc *DefaultNetworkController) deleteEgressFirewallRule(namespace, pgName string, ruleIdx int) error {
+func (bnc *BaseNetworkController) deleteEgressFirewallRule(namespace, pgName string, ruleIdx int) error {
 	// Find ACLs for a given egressFirewall
-	aclIDs := oc.getEgressFirewallACLDbIDs(namespace, ruleIdx)
+	aclIDs := bnc.getEgressFirewallACLDbIDs(namespace, ruleIdx)
 	pACL := libovsdbops.GetPredicate[*nbdb.ACL](aclIDs, nil)
-	egressFirewallACLs, err := libovsdbops.FindACLsWithPredicate(oc.nbClient, pACL)
+	egressFirewallACLs, err := libovsdbops.FindACLsWithPredicate(bnc.nbClient, pACL)
 	if err != nil {
 		return fmt.Errorf("unable to list egress firewall ACLs, cannot cleanup old stale data, err: %v", err)
 	}
@@ -507,19 +532,19 @@ func (oc *DefaultNetworkController) deleteEgressFirewallRule(namespace, pgName s
 		klog.Errorf("Duplicate ACL found for egress firewall %s, ruleIdx: %d", namespace, ruleIdx)
 	}
 
-	err = libovsdbops.DeleteACLsFromPortGroups(oc.nbClient, []string{pgName}, egressFirewallACLs...)
+	err = libovsdbops.DeleteACLsFromPortGroups(bnc.nbClient, []string{pgName}, egressFirewallACLs...)
 	return err
 }
 
 // deleteEgressFirewallRules delete egress firewall Acls
-func (oc *DefaultNetworkController) deleteEgressFirewallRules(namespace string) error {
+func (bnc *BaseNetworkController) deleteEgressFirewallRules(namespace string) error {
 	// Find ACLs for a given egressFirewall
-	predicateIDs := libovsdbops.NewDbObjectIDs(libovsdbops.ACLEgressFirewall, oc.controllerName,
+	predicateIDs := libovsdbops.NewDbObjectIDs(libovsdbops.ACLEgressFirewall, bnc.controllerName,
 		map[libovsdbops.ExternalIDKey]string{
 			libovsdbops.ObjectNameKey: namespace,
 		})
 	pACL := libovsdbops.GetPredicate[*nbdb.ACL](predicateIDs, nil)
-	egressFirewallACLs, err := libovsdbops.FindACLsWithPredicate(oc.nbClient, pACL)
+	egressFirewallACLs, err := libovsdbops.FindACLsWithPredicate(bnc.nbClient, pACL)
 	if err != nil {
 		return fmt.Errorf("unable to list egress firewall ACLs, cannot cleanup old stale data, err: %v", err)
 	}
@@ -528,8 +553,8 @@ func (oc *DefaultNetworkController) deleteEgressFirewallRules(namespace string)
 		klog.Warningf("No egressFirewall ACLs to delete in ns: %s", namespace)
 		return nil
 	}
-	pgName := oc.getNamespacePortGroupName(namespace)
-	err = libovsdbops.DeleteACLsFromPortGroups(oc.nbClient, []string{pgName}, egressFirewallACLs...)
+	pgName := bnc.getNamespacePortGroupName(namespace)
+	err = libovsdbops.DeleteACLsFromPortGroups(bnc.nbClient, []string{pgName}, egressFirewallACLs...)
 	if err != nil {
 		return err
 	}
@@ -556,18 +581,18 @@ const (
 	matchKindV6AddressSet
 )
 
-func (m *matchTarget) toExpr() (string, error) {
+func (m *matchTarget) toExpr(clusterSubnets []config.CIDRNetworkEntry) (string, error) {
 	var match string
 	switch m.kind {
 	case matchKindV4CIDR:
 		match = fmt.Sprintf("ip4.dst == %s", m.value)
 		if m.clusterSubnetIntersection {
-			match = fmt.Sprintf("%s && %s", match, getV4ClusterSubnetsExclusion())
+			match = fmt.Sprintf("%s && %s", match, getV4ClusterSubnetsExclusion(clusterSubnets))
 		}
 	case matchKindV6CIDR:
 		match = fmt.Sprintf("ip6.dst == %s", m.value)
 		if m.clusterSubnetIntersection {
-			match = fmt.Sprintf("%s && %s", match, getV6ClusterSubnetsExclusion())
+			match = fmt.Sprintf("%s && %s", match, getV6ClusterSubnetsExclusion(clusterSubnets))
 		}
 	case matchKindV4AddressSet:
 		if m.value != "" {
@@ -585,9 +610,11 @@ func (m *matchTarget) toExpr() (string, error) {
 
 // generateMatch generates the "match" section of ACL generation for egressFirewallRules.
 // It is referentially transparent as all the elements have been validated before this function is called
+// clusterSubnets are the subnets of the network, excluded from destinations intersecting with them.
 // sample output:
 // match=\"(ip4.dst == 1.2.3.4/32) && ip4.src == $testv4 && ip4.dst != 10.128.0.0/14\
-func generateMatch(pgName string, destinations []matchTarget, dstPorts []egressfirewallapi.EgressFirewallPort) string {
+func generateMatch(pgName string, clusterSubnets []config.CIDRNetworkEntry, destinations []matchTarget,
+	dstPorts []egressfirewallapi.EgressFirewallPort, icmps []egressfirewallapi.EgressFirewallICMP) string {
 	var dst string
 	src := "inport == @" + pgName
 
@@ -595,7 +622,7 @@ func generateMatch(pgName string, destinations []matchTarget, dstPorts []egressf
 		if entry.value == "" {
 			continue
 		}
-		ipDst, err := entry.toExpr()
+		ipDst, err := entry.toExpr(clusterSubnets)
 		if err != nil {
 			klog.Error(err)
 			continue
@@ -607,38 +634,55 @@ func generateMatch(pgName string, destinations []matchTarget, dstPorts []egressf
 		}
 	}
 	match := fmt.Sprintf("(%s) && %s", dst, src)
-	if len(dstPorts) > 0 {
-		match = fmt.Sprintf("%s && %s", match, egressGetL4Match(dstPorts))
+	if len(dstPorts) > 0 || len(icmps) > 0 {
+		match = fmt.Sprintf("%s && %s", match, egressGetL4Match(dstPorts, icmps))
 	}
 	return match
 }
 
-// egressGetL4Match generates the rules for when ports are specified in an egressFirewall Rule
+// egressGetL4Match generates the rules for when ports or ICMP messages are specified in an egressFirewall Rule
 // since the ports can be specified in any order in an egressFirewallRule the best way to build up
 // a single rule is to build up each protocol as you walk through the list and place the appropriate logic
 // between the elements.
-func egressGetL4Match(ports []egressfirewallapi.EgressFirewallPort) string {
+func egressGetL4Match(ports []egressfirewallapi.EgressFirewallPort, icmps []egressfirewallapi.EgressFirewallICMP) string {
 	var udpString string
 	var tcpString string
 	var sctpString string
+	var icmp4String string
+	var icmp6String string
 	for _, port := range ports {
 		if kapi.Protocol(port.Protocol) == kapi.ProtocolUDP && udpString != "udp" {
 			if port.Port == 0 {
 				udpString = "udp"
 			} else {
-				udpString = fmt.Sprintf("%s udp.dst == %d ||", udpString, port.Port)
+				udpString = fmt.Sprintf("%s %s ||", udpString, egressGetPortMatch("udp", port))
 			}
 		} else if kapi.Protocol(port.Protocol) == kapi.ProtocolTCP && tcpString != "tcp" {
 			if port.Port == 0 {
 				tcpString = "tcp"
 			} else {
-				tcpString = fmt.Sprintf("%s tcp.dst == %d ||", tcpString, port.Port)
+				tcpString = fmt.Sprintf("%s %s ||", tcpString, egressGetPortMatch("tcp", port))
 			}
 		} else if kapi.Protocol(port.Protocol) == kapi.ProtocolSCTP && sctpString != "sctp" {
 			if port.Port == 0 {
 				sctpString = "sctp"
 			} else {
-				sctpString = fmt.Sprintf("%s sctp.dst == %d ||", sctpString, port.Port)
+				sctpString = fmt.Sprintf("%s %s ||", sctpString, egressGetPortMatch("sctp", port))
+			}
+		}
+	}
+	for _, icmp := range icmps {
+		if icmp.Protocol == "ICMP" && icmp4String != "icmp4" {
+			if icmp.Type == nil {
+				icmp4String = "icmp4"
+			} else {
+				icmp4String = fmt.Sprintf("%s %s ||", icmp4String, egressGetICMPMatch("icmp4", icmp))
+			}
+		} else if icmp.Protocol == "ICMPv6" && icmp6String != "icmp6" {
+			if icmp.Type == nil {
+				icmp6String = "icmp6"
+			} else {
+				icmp6String = fmt.Sprintf("%s %s ||", icmp6String, egressGetICMPMatch("icmp6", icmp))
 			}
 		}
 	}
@@ -661,6 +705,14 @@ func egressGetL4Match(ports []egressfirewallapi.EgressFirewallPort) string {
 			protocolName:     "sctp",
 			protocolFormated: sctpString,
 		},
+		{
+			protocolName:     "icmp4",
+			protocolFormated: icmp4String,
+		},
+		{
+			protocolName:     "icmp6",
+			protocolFormated: icmp6String,
+		},
 	}
 	for _, entry := range list {
 		if entry.protocolName == entry.protocolFormated {
@@ -680,9 +732,27 @@ func egressGetL4Match(ports []egressfirewallapi.EgressFirewallPort) string {
 	return fmt.Sprintf("(%s)", l4Match)
 }
 
-func getV4ClusterSubnetsExclusion() string {
+// egressGetPortMatch returns the match of the given destination port, or port
+// range if it has an end port, of the given protocol.
+func egressGetPortMatch(protocol string, port egressfirewallapi.EgressFirewallPort) string {
+	if port.EndPort != 0 && port.EndPort != port.Port {
+		return fmt.Sprintf("%d <= %s.dst <= %d", port.Port, protocol, port.EndPort)
+	}
+	return fmt.Sprintf("%s.dst == %d", protocol, port.Port)
+}
+
+// egressGetICMPMatch returns the match of the given ICMP type, and code if
+// set, of the given ICMP protocol.
+func egressGetICMPMatch(protocol string, icmp egressfirewallapi.EgressFirewallICMP) string {
+	if icmp.Code == nil {
+		return fmt.Sprintf("%s.type == %d", protocol, *icmp.Type)
+	}
+	return fmt.Sprintf("(%s.type == %d && %s.code == %d)", protocol, *icmp.Type, protocol, *icmp.Code)
+}
+
+func getV4ClusterSubnetsExclusion(clusterSubnets []config.CIDRNetworkEntry) string {
 	var exclusions []string
-	for _, clusterSubnet := range config.Default.ClusterSubnets {
+	for _, clusterSubnet := range clusterSubnets {
 		if utilnet.IsIPv4CIDR(clusterSubnet.CIDR) {
 			exclusions = append(exclusions, fmt.Sprintf("%s.dst != %s", "ip4", clusterSubnet.CIDR))
 		}
@@ -690,9 +760,9 @@ func getV4ClusterSubnetsExclusion() string {
 	return strings.Join(exclusions, "&&")
 }
 
-func getV6ClusterSubnetsExclusion() string {
+func getV6ClusterSubnetsExclusion(clusterSubnets []config.CIDRNetworkEntry) string {
 	var exclusions []string
-	for _, clusterSubnet := range config.Default.ClusterSubnets {
+	for _, clusterSubnet := range clusterSubnets {
 		if utilnet.IsIPv6CIDR(clusterSubnet.CIDR) {
 			exclusions = append(exclusions, fmt.Sprintf("%s.dst != %s", "ip6", clusterSubnet.CIDR))
 		}
@@ -700,6 +770,21 @@ func getV6ClusterSubnetsExclusion() string {
 	return strings.Join(exclusions, "&&")
 }
 
+// cidrIntersectsSubnets returns true if the given CIDR intersects with any of
+// the given subnets.
+func cidrIntersectsSubnets(cidr string, subnets []config.CIDRNetworkEntry) bool {
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return false
+	}
+	for _, subnet := range subnets {
+		if subnet.CIDR.Contains(ipNet.IP) || ipNet.Contains(subnet.CIDR.IP) {
+			return true
+		}
+	}
+	return false
+}
+
 func getEgressFirewallNamespacedName(egressFirewall *egressfirewallapi.EgressFirewall) string {
 	return fmt.Sprintf("%v/%v", egressFirewall.Namespace, egressFirewall.Name)
 }
@@ -709,9 +794,9 @@ func getEgressFirewallNamespacedName(egressFirewall *egressfirewallapi.EgressFir
 // namespace annotations change.
 // Return values are: bool - if the egressFirewall's ACL was updated or not, error in case of errors. If a namespace
 // does not contain an egress firewall ACL, then this returns false, nil instead of a NotFound error.
-func (oc *DefaultNetworkController) updateACLLoggingForEgressFirewall(egressFirewallNamespace string, nsInfo *namespaceInfo) (bool, error) {
+func (bnc *BaseNetworkController) updateACLLoggingForEgressFirewall(egressFirewallNamespace string, nsInfo *namespaceInfo) (bool, error) {
 	// Retrieve the egress firewall object from cache and lock it.
-	obj, loaded := oc.egressFirewalls.Load(egressFirewallNamespace)
+	obj, loaded := bnc.egressFirewalls.Load(egressFirewallNamespace)
 	if !loaded {
 		return false, nil
 	}
@@ -727,38 +812,98 @@ func (oc *DefaultNetworkController) updateACLLoggingForEgressFirewall(egressFire
 	defer ef.Unlock()
 
 	// Predicate for given egress firewall ACLs
-	predicateIDs := libovsdbops.NewDbObjectIDs(libovsdbops.ACLEgressFirewall, oc.controllerName,
+	predicateIDs := libovsdbops.NewDbObjectIDs(libovsdbops.ACLEgressFirewall, bnc.controllerName,
 		map[libovsdbops.ExternalIDKey]string{
 			libovsdbops.ObjectNameKey: ef.namespace,
 		})
 	p := libovsdbops.GetPredicate[*nbdb.ACL](predicateIDs, nil)
-	if err := libovsdbutil.UpdateACLLoggingWithPredicate(oc.nbClient, p, &nsInfo.aclLogging); err != nil {
+	if err := libovsdbutil.UpdateACLLoggingWithPredicate(bnc.nbClient, p, &nsInfo.aclLogging); err != nil {
 		return false, fmt.Errorf("unable to update ACL logging in ns %s, err: %v", ef.namespace, err)
 	}
 	return true, nil
 }
 
-func (oc *DefaultNetworkController) getEgressFirewallACLDbIDs(namespace string, ruleIdx int) *libovsdbops.DbObjectIDs {
-	return libovsdbops.NewDbObjectIDs(libovsdbops.ACLEgressFirewall, oc.controllerName,
+func (bnc *BaseNetworkController) getEgressFirewallACLDbIDs(namespace string, ruleIdx int) *libovsdbops.DbObjectIDs {
+	return libovsdbops.NewDbObjectIDs(libovsdbops.ACLEgressFirewall, bnc.controllerName,
 		map[libovsdbops.ExternalIDKey]string{
 			libovsdbops.ObjectNameKey: namespace,
 			libovsdbops.RuleIndex:     strconv.Itoa(ruleIdx),
 		})
 }
 
-func (oc *DefaultNetworkController) newEFNodeController(nodeInformer coreinformers.NodeInformer) controller.Controller {
+// startEgressFirewall starts the DNS name resolver, the egress firewall handler
+// and the node controller of the network.
+func (bnc *BaseNetworkController) startEgressFirewall() error {
+	if bnc.egressFirewallHandler != nil {
+		return nil
+	}
+	var err error
+	// If DNSNameResolver is enabled, then initialize dnsNameResolver to ExternalEgressDNS
+	// for maintaining the address sets corresponding to the DNS names and start watching
+	// DNSNameResolver resources. Otherwise initialize dnsNameResolver to EgressDNS.
+	if config.OVNKubernetesFeature.EnableDNSNameResolver {
+		bnc.dnsNameResolver, err = dnsnameresolver.NewExternalEgressDNS(bnc.addressSetFactory, bnc.controllerName, true,
+			bnc.watchFactory.DNSNameResolverInformer().Informer(), bnc.watchFactory.EgressFirewallInformer().Lister())
+	} else {
+		bnc.dnsNameResolver, err = dnsnameresolver.NewEgressDNS(bnc.addressSetFactory, bnc.controllerName, bnc.stopChan, egressFirewallDNSDefaultDuration)
+	}
+	if err != nil {
+		return err
+	}
+	err = bnc.dnsNameResolver.Run()
+	if err != nil {
+		return err
+	}
+	err = WithSyncDurationMetric("egress firewall", bnc.WatchEgressFirewall)
+	if err != nil {
+		return err
+	}
+	bnc.efNodeController = bnc.newEFNodeController(bnc.watchFactory.NodeCoreInformer())
+	return controller.Start(bnc.efNodeController)
+}
+
+// stopEgressFirewall stops everything started by startEgressFirewall.
+func (bnc *BaseNetworkController) stopEgressFirewall() {
+	if bnc.egressFirewallHandler != nil {
+		bnc.watchFactory.RemoveEgressFirewallHandler(bnc.egressFirewallHandler)
+		bnc.egressFirewallHandler = nil
+	}
+	if bnc.dnsNameResolver != nil {
+		bnc.dnsNameResolver.Shutdown()
+	}
+	if bnc.efNodeController != nil {
+		controller.Stop(bnc.efNodeController)
+	}
+}
+
+// WatchEgressFirewall starts the watching of egressfirewall resource and calls
+// back the appropriate handler logic
+func (bnc *BaseNetworkController) WatchEgressFirewall() error {
+	handler, err := bnc.retryEgressFirewalls.WatchResource()
+	if err != nil {
+		return err
+	}
+	bnc.egressFirewallHandler = handler
+	return nil
+}
+
+func (bnc *BaseNetworkController) newEFNodeController(nodeInformer coreinformers.NodeInformer) controller.Controller {
 	controllerConfig := &controller.ControllerConfig[kapi.Node]{
 		RateLimiter:    workqueue.NewTypedItemFastSlowRateLimiter[string](time.Second, 5*time.Second, 5),
 		Informer:       nodeInformer.Informer(),
 		Lister:         nodeInformer.Lister().List,
-		ObjNeedsUpdate: oc.efNodeNeedsUpdate,
-		Reconcile:      oc.updateEgressFirewallForNode,
+		ObjNeedsUpdate: bnc.efNodeNeedsUpdate,
+		Reconcile:      bnc.updateEgressFirewallForNode,
 		Threadiness:    1,
 	}
-	return controller.NewController[kapi.Node]("ef_node_controller", controllerConfig)
+	name := "ef_node_controller"
+	if !bnc.IsDefault() {
+		name = bnc.GetNetworkName() + "-" + name
+	}
+	return controller.NewController[kapi.Node](name, controllerConfig)
 }
 
-func (oc *DefaultNetworkController) efNodeNeedsUpdate(oldNode, newNode *kapi.Node) bool {
+func (bnc *BaseNetworkController) efNodeNeedsUpdate(oldNode, newNode *kapi.Node) bool {
 	if oldNode == nil || newNode == nil {
 		return true
 	}
@@ -766,8 +911,8 @@ func (oc *DefaultNetworkController) efNodeNeedsUpdate(oldNode, newNode *kapi.Nod
 		util.NodeHostCIDRsAnnotationChanged(oldNode, newNode)
 }
 
-func (oc *DefaultNetworkController) updateEgressFirewallForNode(nodeName string) error {
-	node, err := oc.watchFactory.GetNode(nodeName)
+func (bnc *BaseNetworkController) updateEgressFirewallForNode(nodeName string) error {
+	node, err := bnc.watchFactory.GetNode(nodeName)
 	// It´s unlikely that we have an error different that "Not Found Object"
 	// because we are getting the object from the informer´s cache
 	if err != nil && !apierrors.IsNotFound(err) {
@@ -784,7 +929,7 @@ func (oc *DefaultNetworkController) updateEgressFirewallForNode(nodeName string)
 
 	// cycle through egress firewalls and check if any match this node's labels
 	var efErr error
-	oc.egressFirewalls.Range(func(k, v interface{}) bool {
+	bnc.egressFirewalls.Range(func(k, v interface{}) bool {
 		ef := v.(*egressFirewall)
 		namespace := k.(string)
 		ef.Lock()
@@ -814,9 +959,9 @@ func (oc *DefaultNetworkController) updateEgressFirewallForNode(nodeName string)
 			return true
 		}
 		// update egress firewall rules
-		pgName := oc.getNamespacePortGroupName(ef.namespace)
-		aclLoggingLevels := oc.GetNamespaceACLLogging(ef.namespace)
-		if err := oc.addEgressFirewallRules(ef, pgName,
+		pgName := bnc.getNamespacePortGroupName(ef.namespace)
+		aclLoggingLevels := bnc.GetNamespaceACLLogging(ef.namespace)
+		if err := bnc.addEgressFirewallRules(ef, pgName,
 			aclLoggingLevels, modifiedRuleIDs...); err != nil {
 			efErr = fmt.Errorf("failed to add egress firewall for namespace: %s, error: %w", namespace, err)
 			return false
@@ -827,17 +972,25 @@ func (oc *DefaultNetworkController) updateEgressFirewallForNode(nodeName string)
 	return efErr
 }
 
-func (oc *DefaultNetworkController) setEgressFirewallStatus(egressFirewall *egressfirewallapi.EgressFirewall, handlerErr error) error {
+func (bnc *BaseNetworkController) setEgressFirewallStatus(egressFirewall *egressfirewallapi.EgressFirewall, handlerErr error) error {
 	var newMsg string
 	if handlerErr != nil {
-		newMsg = types.EgressFirewallErrorMsg + ": " + handlerErr.Error()
+		newMsg = types.EgressFirewallErrorMsg
 	} else {
 		newMsg = egressFirewallAppliedCorrectly
 		metrics.UpdateEgressFirewallRuleCount(float64(len(egressFirewall.Spec.Egress)))
 		metrics.IncrementEgressFirewallCount()
 	}
+	// indicate which network enforces the egress firewall when it is not the
+	// default one
+	if !bnc.IsDefault() {
+		newMsg = fmt.Sprintf("%s on network %s", newMsg, bnc.GetNetworkName())
+	}
+	if handlerErr != nil {
+		newMsg = newMsg + ": " + handlerErr.Error()
+	}
 
-	newMsg = types.GetZoneStatus(oc.zone, newMsg)
+	newMsg = types.GetZoneStatus(bnc.zone, newMsg)
 	needsUpdate := true
 	for _, message := range egressFirewall.Status.Messages {
 		if message == newMsg {
@@ -852,13 +1005,13 @@ func (oc *DefaultNetworkController) setEgressFirewallStatus(egressFirewall *egre
 
 	applyOptions := metav1.ApplyOptions{
 		Force:        true,
-		FieldManager: oc.zone,
+		FieldManager: bnc.zone,
 	}
 
 	applyObj := egressfirewallapply.EgressFirewall(egressFirewall.Name, egressFirewall.Namespace).
 		WithStatus(egressfirewallapply.EgressFirewallStatus().
 			WithMessages(newMsg))
-	_, err := oc.kube.EgressFirewallClient.K8sV1().EgressFirewalls(egressFirewall.Namespace).ApplyStatus(context.TODO(), applyObj, applyOptions)
+	_, err := bnc.kube.EgressFirewallClient.K8sV1().EgressFirewalls(egressFirewall.Namespace).ApplyStatus(context.TODO(), applyObj, applyOptions)
 
 	return err
 }
@@ -36,6 +36,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	utilnet "k8s.io/utils/net"
+	"k8s.io/utils/ptr"
 )
 
 func newObjectMeta(name, namespace string) metav1.ObjectMeta {
@@ -1487,6 +1488,7 @@ var _ = ginkgo.Describe("OVN test basic functions", func() {
 	ginkgo.It("computes correct L4Match", func() {
 		type testcase struct {
 			ports         []egressfirewallapi.EgressFirewallPort
+			icmps         []egressfirewallapi.EgressFirewallICMP
 			expectedMatch string
 		}
 		testcases := []testcase{
@@ -1532,9 +1534,56 @@ var _ = ginkgo.Describe("OVN test basic functions", func() {
 				},
 				expectedMatch: "((udp && ( udp.dst == 400 )) || (tcp && ( tcp.dst == 100 || tcp.dst == 102 )) || (sctp && ( sctp.dst == 13 )))",
 			},
+			{
+				ports: []egressfirewallapi.EgressFirewallPort{
+					{
+						Protocol: "TCP",
+						Port:     30000,
+						EndPort:  32767,
+					},
+					{
+						Protocol: "TCP",
+						Port:     443,
+						EndPort:  443,
+					},
+				},
+				expectedMatch: "((tcp && ( 30000 <= tcp.dst <= 32767 || tcp.dst == 443 )))",
+			},
+			{
+				icmps: []egressfirewallapi.EgressFirewallICMP{
+					{
+						Protocol: "ICMP",
+						Type:     ptr.To[int32](8),
+					},
+					{
+						Protocol: "ICMP",
+						Type:     ptr.To[int32](3),
+						Code:     ptr.To[int32](4),
+					},
+					{
+						Protocol: "ICMPv6",
+					},
+				},
+				expectedMatch: "((icmp4 && ( icmp4.type == 8 || (icmp4.type == 3 && icmp4.code == 4) )) || (icmp6))",
+			},
+			{
+				ports: []egressfirewallapi.EgressFirewallPort{
+					{
+						Protocol: "UDP",
+						Port:     53,
+					},
+				},
+				icmps: []egressfirewallapi.EgressFirewallICMP{
+					{
+						Protocol: "ICMPv6",
+						Type:     ptr.To[int32](128),
+					},
+				},
+				expectedMatch: "((udp && ( udp.dst == 53 )) || (icmp6 && ( icmp6.type == 128 )))",
+			},
 		}
 		for _, test := range testcases {
-			l4Match := egressGetL4Match(test.ports)
+			l4Match := egressGetL4Match(test.ports, test.icmps)
 			gomega.Expect(test.expectedMatch).To(gomega.Equal(l4Match))
 		}
 	})
@@ -1644,7 +1693,7 @@ var _ = ginkgo.Describe("OVN test basic functions", func() {
 			config.Default.ClusterSubnets = subnets
 
 			config.Gateway.Mode = config.GatewayModeShared
-			matchExpression := generateMatch(tc.pgName, tc.destinations, tc.ports)
+			matchExpression := generateMatch(tc.pgName, config.Default.ClusterSubnets, tc.destinations, tc.ports, nil)
 			gomega.Expect(matchExpression).To(gomega.Equal(tc.output))
 		}
 	})
@@ -1841,6 +1890,44 @@ var _ = ginkgo.Describe("OVN test basic functions", func() {
 					to:     destination{nodeAddrs: map[string][]string{node1Name: {node1Addr}}, nodeSelector: &metav1.LabelSelector{MatchLabels: nodeLabel}},
 				},
 			},
+			// port range and icmp tests
+			{
+				egressFirewallRule: egressfirewallapi.EgressFirewallRule{
+					Type:  egressfirewallapi.EgressFirewallRuleAllow,
+					Ports: []egressfirewallapi.EgressFirewallPort{{Protocol: "TCP", Port: 30000, EndPort: 32767}},
+					ICMP:  []egressfirewallapi.EgressFirewallICMP{{Protocol: "ICMP", Type: ptr.To[int32](8)}},
+					To:    egressfirewallapi.EgressFirewallDestination{CIDRSelector: "1.2.3.4/32"},
+				},
+				id:  1,
+				err: false,
+				output: egressFirewallRule{
+					id:     1,
+					access: egressfirewallapi.EgressFirewallRuleAllow,
+					ports:  []egressfirewallapi.EgressFirewallPort{{Protocol: "TCP", Port: 30000, EndPort: 32767}},
+					icmp:   []egressfirewallapi.EgressFirewallICMP{{Protocol: "ICMP", Type: ptr.To[int32](8)}},
+					to:     destination{cidrSelector: "1.2.3.4/32"},
+				},
+			},
+			{
+				egressFirewallRule: egressfirewallapi.EgressFirewallRule{
+					Type:  egressfirewallapi.EgressFirewallRuleAllow,
+					Ports: []egressfirewallapi.EgressFirewallPort{{Protocol: "TCP", Port: 32767, EndPort: 30000}},
+					To:    egressfirewallapi.EgressFirewallDestination{CIDRSelector: "1.2.3.4/32"},
+				},
+				id:        1,
+				err:       true,
+				errOutput: "rule port range end 30000 is lower than start 32767",
+			},
+			{
+				egressFirewallRule: egressfirewallapi.EgressFirewallRule{
+					Type: egressfirewallapi.EgressFirewallRuleAllow,
+					ICMP: []egressfirewallapi.EgressFirewallICMP{{Protocol: "ICMPv6", Code: ptr.To[int32](0)}},
+					To:   egressfirewallapi.EgressFirewallDestination{CIDRSelector: "1.2.3.4/32"},
+				},
+				id:        1,
+				err:       true,
+				errOutput: "rule icmp code 0 requires a type",
+			},
 		}
 		for _, tc := range testcases {
 			subnets := []config.CIDRNetworkEntry{}
@@ -1859,4 +1946,28 @@ var _ = ginkgo.Describe("OVN test basic functions", func() {
 			}
 		}
 	})
+	ginkgo.It("correctly checks destination intersection with the network subnets", func() {
+		type testcase struct {
+			cidr    string
+			subnets []string
+			output  bool
+		}
+		testcases := []testcase{
+			{cidr: "1.2.3.4/32", subnets: []string{"10.128.0.0/16"}, output: false},
+			{cidr: "10.128.1.0/24", subnets: []string{"10.128.0.0/16"}, output: true},
+			{cidr: "10.0.0.0/8", subnets: []string{"10.128.0.0/16"}, output: true},
+			{cidr: "10.128.1.0/24", subnets: []string{"192.168.0.0/16", "10.128.0.0/16"}, output: true},
+			{cidr: "2002::1235:abcd:ffff:c0a8:101/64", subnets: []string{"2002:0:0:1234::/64"}, output: false},
+			{cidr: "2002:0:0:1234::1/128", subnets: []string{"2002:0:0:1234::/64"}, output: true},
+			{cidr: "1.2.3./32", subnets: []string{"10.128.0.0/16"}, output: false},
+		}
+		for _, tc := range testcases {
+			subnets := []config.CIDRNetworkEntry{}
+			for _, subnet := range tc.subnets {
+				_, cidr, _ := net.ParseCIDR(subnet)
+				subnets = append(subnets, config.CIDRNetworkEntry{CIDR: cidr})
+			}
+			gomega.Expect(cidrIntersectsSubnets(tc.cidr, subnets)).To(gomega.Equal(tc.output), tc.cidr)
+		}
+	})
 })
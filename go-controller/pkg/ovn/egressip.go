@@ -1,6 +1,7 @@
 package ovn
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -17,6 +18,7 @@ import (
 
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 	egressipv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1"
+	egressipapply "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1/apis/applyconfiguration/egressip/v1"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
 	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
 	libovsdbutil "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/util"
@@ -32,9 +34,11 @@ import (
 	kapi "k8s.io/api/core/v1"
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
+	metaapplyv1 "k8s.io/client-go/applyconfigurations/meta/v1"
 	listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/util/retry"
 	"k8s.io/klog/v2"
@@ -57,6 +61,12 @@ const (
 	ReplyTrafficMark      egressIPQoSRuleName         = "EgressIP-Mark-Reply-Traffic"
 )
 
+const (
+	egressIPProgrammedCorrectly = "EgressIP programmed"
+	egressIPProgrammedReason    = "ProgrammingSucceeded"
+	egressIPNotProgrammedReason = "ProgrammingFailed"
+)
+
 func getEgressIPAddrSetDbIDs(name egressIPAddrSetName, controller string) *libovsdbops.DbObjectIDs {
 	return libovsdbops.NewDbObjectIDs(libovsdbops.AddressSetEgressIP, controller, map[libovsdbops.ExternalIDKey]string{
 		// egress ip creates cluster-wide address sets with egressIpAddrSetName
@@ -1240,6 +1250,54 @@ func (oc *DefaultNetworkController) generateCacheForEgressIP() (map[string]egres
 	return egressIPCache, nil
 }
 
+// setEgressIPZoneStatus reports whether the EgressIP is programmed in the zone
+// with the Programmed-In-Zone-<zone> status condition. Each zone's
+// ovnkube-controller owns its condition, hence it is updated using server side
+// apply with the zone as field manager. The status manager aggregates the zone
+// conditions into the Programmed condition.
+func (oc *DefaultNetworkController) setEgressIPZoneStatus(eIP *egressipv1.EgressIP, handlerErr error) error {
+	condition := metav1.Condition{
+		Type:    egressipv1.EgressIPConditionProgrammedInZonePrefix + oc.zone,
+		Status:  metav1.ConditionTrue,
+		Reason:  egressIPProgrammedReason,
+		Message: types.GetZoneStatus(oc.zone, egressIPProgrammedCorrectly),
+	}
+	if handlerErr != nil {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = egressIPNotProgrammedReason
+		condition.Message = types.GetZoneStatus(oc.zone, types.EgressIPErrorMsg+": "+handlerErr.Error())
+	}
+	// the handlers may be retried with an outdated object, compare with the
+	// latest one
+	latest, err := oc.watchFactory.GetEgressIP(eIP.Name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	existing := meta.FindStatusCondition(latest.Status.Conditions, condition.Type)
+	if existing != nil && existing.Status == condition.Status && existing.Reason == condition.Reason &&
+		existing.Message == condition.Message {
+		return nil
+	}
+	condition.LastTransitionTime = metav1.NewTime(time.Now())
+	if existing != nil && existing.Status == condition.Status {
+		condition.LastTransitionTime = existing.LastTransitionTime
+	}
+	applyObj := egressipapply.EgressIP(eIP.Name).
+		WithStatus(egressipapply.EgressIPStatus().WithConditions(&metaapplyv1.ConditionApplyConfiguration{
+			Type:               &condition.Type,
+			Status:             &condition.Status,
+			LastTransitionTime: &condition.LastTransitionTime,
+			Reason:             &condition.Reason,
+			Message:            &condition.Message,
+		}))
+	_, err = oc.kube.EIPClient.K8sV1().EgressIPs().ApplyStatus(context.TODO(), applyObj,
+		metav1.ApplyOptions{FieldManager: oc.zone, Force: true})
+	return err
+}
+
 type EgressIPPatchStatus struct {
 	Op    string                    `json:"op"`
 	Path  string                    `json:"path"`
@@ -1270,7 +1328,7 @@ func (oc *DefaultNetworkController) patchReplaceEgressIPStatus(name string, stat
 		if err != nil {
 			return fmt.Errorf("error serializing status patch operation: %+v, err: %v", statusItems, err)
 		}
-		return oc.kube.PatchEgressIP(name, op)
+		return oc.kube.PatchEgressIPStatus(name, op)
 	})
 }
 
@@ -24,6 +24,7 @@ import (
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
 	"github.com/urfave/cli/v2"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8stypes "k8s.io/apimachinery/pkg/types"
 	utilpointer "k8s.io/utils/pointer"
@@ -129,6 +130,14 @@ var _ = ginkgo.Describe("OVN master EgressIP Operations", func() {
 		return egressIPs, nodes
 	}
 
+	getEgressIPZoneCondition := func(egressIPName string) func() *metav1.Condition {
+		return func() *metav1.Condition {
+			tmp, err := fakeOvn.fakeClient.EgressIPClient.K8sV1().EgressIPs().Get(context.TODO(), egressIPName, metav1.GetOptions{})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			return meta.FindStatusCondition(tmp.Status.Conditions, egressipv1.EgressIPConditionProgrammedInZonePrefix+fakeOvn.controller.zone)
+		}
+	}
+
 	getEgressIPReassignmentCount := func() int {
 		reAssignmentCount := 0
 		egressIPs, err := fakeOvn.fakeClient.EgressIPClient.K8sV1().EgressIPs().List(context.TODO(), metav1.ListOptions{})
@@ -4742,6 +4751,11 @@ var _ = ginkgo.Describe("OVN master EgressIP Operations", func() {
 					key, err := retry.GetResourceKey(&eIP)
 					gomega.Expect(err).NotTo(gomega.HaveOccurred())
 					retry.CheckRetryObjectEventually(key, true, fakeOvn.controller.retryEgressIPs)
+					gomega.Eventually(getEgressIPZoneCondition(eIP.Name)).Should(gomega.And(
+						gomega.Not(gomega.BeNil()),
+						gomega.HaveField("Status", metav1.ConditionFalse),
+						gomega.HaveField("Message", gomega.ContainSubstring(types.EgressIPErrorMsg)),
+					))
 
 					connCtx, cancel := context.WithTimeout(context.Background(), config.Default.OVSDBTxnTimeout)
 					defer cancel()
@@ -4750,6 +4764,7 @@ var _ = ginkgo.Describe("OVN master EgressIP Operations", func() {
 					fakeOvn.controller.retryEgressIPs.RequestRetryObjs()
 					// check the cache no longer has the entry
 					retry.CheckRetryObjectEventually(key, false, fakeOvn.controller.retryEgressIPs)
+					gomega.Eventually(getEgressIPZoneCondition(eIP.Name)).Should(gomega.HaveField("Status", metav1.ConditionTrue))
 
 					gomega.Eventually(getEgressIPStatusLen(eIP.Name)).Should(gomega.Equal(1))
 
@@ -7253,6 +7268,14 @@ var _ = ginkgo.Describe("OVN master EgressIP Operations", func() {
 					gomega.Expect(err).NotTo(gomega.HaveOccurred())
 
 					fakeOvn.patchEgressIPObj(node1Name, egressIPName, egressIP1, node1IPv4Net)
+					// the EgressIP handlers do not guarantee ordering between objects, make sure the first
+					// egressIP object serves the pod before assigning the second one
+					gomega.Eventually(func() string {
+						if pas := getPodAssignmentState(&egressPod1); pas != nil {
+							return pas.egressIPName
+						}
+						return ""
+					}).Should(gomega.Equal(egressIPName))
 
 					// NOTE: Cluster manager is the one who patches the egressIP object.
 					// For the sake of unit testing egressip zone controller we need to patch egressIP object manually
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -31,6 +32,7 @@ import (
 	egressqosinformer "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressqos/v1/apis/informers/externalversions/egressqos/v1"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
 	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	libovsdbutil "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/util"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
 	addressset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/address_set"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
@@ -59,13 +61,22 @@ type egressQoS struct {
 
 type egressQoSRule struct {
 	priority    int
-	dscp        int
+	dscp        *int
+	bandwidth   map[string]int
 	destination string
+	ports       []*libovsdbutil.NetworkPolicyPort
 	addrSet     addressset.AddressSet
 	pods        *sync.Map // pods name -> ips in the addrSet
 	podSelector metav1.LabelSelector
 }
 
+// egressQoSHandler is an event handler registered by the EgressQoS controller,
+// removed when the controller stops.
+type egressQoSHandler struct {
+	informer     cache.SharedIndexInformer
+	registration cache.ResourceEventHandlerRegistration
+}
+
 func getEgressQosAddrSetDbIDs(namespace, priority, controller string) *libovsdbops.DbObjectIDs {
 	return libovsdbops.NewDbObjectIDs(libovsdbops.AddressSetEgressQoS, controller, map[libovsdbops.ExternalIDKey]string{
 		libovsdbops.ObjectNameKey: namespace,
@@ -74,15 +85,15 @@ func getEgressQosAddrSetDbIDs(namespace, priority, controller string) *libovsdbo
 	})
 }
 
-func getEgressQoSRuleDbIDs(namespace string, rulePriority int) *libovsdbops.DbObjectIDs {
-	return libovsdbops.NewDbObjectIDs(libovsdbops.QoSEgressQoS, DefaultNetworkControllerName, map[libovsdbops.ExternalIDKey]string{
+func getEgressQoSRuleDbIDs(namespace string, rulePriority int, controller string) *libovsdbops.DbObjectIDs {
+	return libovsdbops.NewDbObjectIDs(libovsdbops.QoSEgressQoS, controller, map[libovsdbops.ExternalIDKey]string{
 		libovsdbops.ObjectNameKey: namespace,
 		libovsdbops.PriorityKey:   fmt.Sprintf("%d", rulePriority),
 	})
 }
 
 // shallow copies the EgressQoS object provided.
-func (oc *DefaultNetworkController) cloneEgressQoS(raw *egressqosapi.EgressQoS) (*egressQoS, error) {
+func (bnc *BaseNetworkController) cloneEgressQoS(raw *egressqosapi.EgressQoS) (*egressQoS, error) {
 	eq := &egressQoS{
 		name:      raw.Name,
 		namespace: raw.Namespace,
@@ -95,7 +106,7 @@ func (oc *DefaultNetworkController) cloneEgressQoS(raw *egressqosapi.EgressQoS)
 
 	var errs []error
 	for i, rule := range raw.Spec.Egress {
-		eqr, err := oc.cloneEgressQoSRule(rule, EgressQoSFlowStartPriority-i)
+		eqr, err := bnc.cloneEgressQoSRule(rule, EgressQoSFlowStartPriority-i)
 		if err != nil {
 			dst := "any"
 			if rule.DstCIDR != nil {
@@ -112,7 +123,7 @@ func (oc *DefaultNetworkController) cloneEgressQoS(raw *egressqosapi.EgressQoS)
 }
 
 // shallow copies the EgressQoSRule object provided.
-func (oc *DefaultNetworkController) cloneEgressQoSRule(raw egressqosapi.EgressQoSRule, priority int) (*egressQoSRule, error) {
+func (bnc *BaseNetworkController) cloneEgressQoSRule(raw egressqosapi.EgressQoSRule, priority int) (*egressQoSRule, error) {
 	dst := ""
 	if raw.DstCIDR != nil {
 		_, _, err := net.ParseCIDR(*raw.DstCIDR)
@@ -127,6 +138,10 @@ func (oc *DefaultNetworkController) cloneEgressQoSRule(raw egressqosapi.EgressQo
 		return nil, err
 	}
 
+	if raw.DSCP == nil && raw.Bandwidth == nil {
+		return nil, fmt.Errorf("at least one of dscp or bandwidth must be set")
+	}
+
 	eqr := &egressQoSRule{
 		priority:    priority,
 		dscp:        raw.DSCP,
@@ -134,10 +149,27 @@ func (oc *DefaultNetworkController) cloneEgressQoSRule(raw egressqosapi.EgressQo
 		podSelector: raw.PodSelector,
 	}
 
+	if raw.Bandwidth != nil {
+		if raw.Bandwidth.Rate <= 0 || raw.Bandwidth.Burst < 0 {
+			return nil, fmt.Errorf("invalid bandwidth rate %d or burst %d", raw.Bandwidth.Rate, raw.Bandwidth.Burst)
+		}
+		eqr.bandwidth = map[string]int{nbdb.QoSBandwidthRate: int(raw.Bandwidth.Rate)}
+		if raw.Bandwidth.Burst > 0 {
+			eqr.bandwidth[nbdb.QoSBandwidthBurst] = int(raw.Bandwidth.Burst)
+		}
+	}
+
+	for _, port := range raw.Ports {
+		if port.EndPort != 0 && (port.Port == 0 || port.EndPort < port.Port) {
+			return nil, fmt.Errorf("invalid port range %d-%d", port.Port, port.EndPort)
+		}
+		eqr.ports = append(eqr.ports, libovsdbutil.GetNetworkPolicyPort(kapi.Protocol(port.Protocol), port.Port, port.EndPort))
+	}
+
 	return eqr, nil
 }
 
-func (oc *DefaultNetworkController) createASForEgressQoSRule(podSelector metav1.LabelSelector, namespace string, priority int) (addressset.AddressSet, *sync.Map, error) {
+func (bnc *BaseNetworkController) createASForEgressQoSRule(podSelector metav1.LabelSelector, namespace string, priority int) (addressset.AddressSet, *sync.Map, error) {
 	var addrSet addressset.AddressSet
 
 	selector, err := metav1.LabelSelectorAsSelector(&podSelector)
@@ -145,8 +177,8 @@ func (oc *DefaultNetworkController) createASForEgressQoSRule(podSelector metav1.
 		return nil, nil, err
 	}
 	if selector.Empty() { // empty selector means that the rule applies to all pods in the namespace
-		asIndex := getNamespaceAddrSetDbIDs(namespace, oc.controllerName)
-		addrSet, err := oc.addressSetFactory.EnsureAddressSet(asIndex)
+		asIndex := getNamespaceAddrSetDbIDs(namespace, bnc.controllerName)
+		addrSet, err := bnc.addressSetFactory.EnsureAddressSet(asIndex)
 		if err != nil {
 			return nil, nil, fmt.Errorf("cannot ensure that addressSet for namespace %s exists %v", namespace, err)
 		}
@@ -155,20 +187,20 @@ func (oc *DefaultNetworkController) createASForEgressQoSRule(podSelector metav1.
 
 	podsCache := sync.Map{}
 
-	pods, err := oc.watchFactory.GetPodsBySelector(namespace, podSelector)
+	pods, err := bnc.watchFactory.GetPodsBySelector(namespace, podSelector)
 	if err != nil {
 		return nil, nil, err
 	}
-	asIndex := getEgressQosAddrSetDbIDs(namespace, fmt.Sprintf("%d", priority), oc.controllerName)
-	addrSet, err = oc.addressSetFactory.EnsureAddressSet(asIndex)
+	asIndex := getEgressQosAddrSetDbIDs(namespace, fmt.Sprintf("%d", priority), bnc.controllerName)
+	addrSet, err = bnc.addressSetFactory.EnsureAddressSet(asIndex)
 	if err != nil {
 		return nil, nil, err
 	}
 	podsIps := []net.IP{}
 	for _, pod := range pods {
 		// we don't handle HostNetworked or completed pods or not-scheduled pods or remote-zone pods
-		if !util.PodWantsHostNetwork(pod) && !util.PodCompleted(pod) && util.PodScheduled(pod) && oc.isPodScheduledinLocalZone(pod) {
-			podIPs, err := util.GetPodIPsOfNetwork(pod, oc.NetInfo)
+		if !util.PodWantsHostNetwork(pod) && !util.PodCompleted(pod) && util.PodScheduled(pod) && bnc.isPodScheduledinLocalZone(pod) {
+			podIPs, err := util.GetPodIPsOfNetwork(pod, bnc.NetInfo)
 			if err != nil && !errors.Is(err, util.ErrNoPodIPFound) {
 				return nil, nil, err
 			}
@@ -185,78 +217,81 @@ func (oc *DefaultNetworkController) createASForEgressQoSRule(podSelector metav1.
 }
 
 // initEgressQoSController initializes the EgressQoS controller.
-func (oc *DefaultNetworkController) initEgressQoSController(
+func (bnc *BaseNetworkController) initEgressQoSController(
 	eqInformer egressqosinformer.EgressQoSInformer,
 	podInformer v1coreinformers.PodInformer,
 	nodeInformer v1coreinformers.NodeInformer) error {
 	klog.Info("Setting up event handlers for EgressQoS")
-	oc.egressQoSLister = eqInformer.Lister()
-	oc.egressQoSSynced = eqInformer.Informer().HasSynced
-	oc.egressQoSQueue = workqueue.NewTypedRateLimitingQueueWithConfig(
+	bnc.egressQoSLister = eqInformer.Lister()
+	bnc.egressQoSSynced = eqInformer.Informer().HasSynced
+	bnc.egressQoSQueue = workqueue.NewTypedRateLimitingQueueWithConfig(
 		workqueue.NewTypedItemFastSlowRateLimiter[string](1*time.Second, 5*time.Second, 5),
 		workqueue.TypedRateLimitingQueueConfig[string]{Name: "egressqos"},
 	)
-	_, err := eqInformer.Informer().AddEventHandler(factory.WithUpdateHandlingForObjReplace(cache.ResourceEventHandlerFuncs{
-		AddFunc:    oc.onEgressQoSAdd,
-		UpdateFunc: oc.onEgressQoSUpdate,
-		DeleteFunc: oc.onEgressQoSDelete,
+	registration, err := eqInformer.Informer().AddEventHandler(factory.WithUpdateHandlingForObjReplace(cache.ResourceEventHandlerFuncs{
+		AddFunc:    bnc.onEgressQoSAdd,
+		UpdateFunc: bnc.onEgressQoSUpdate,
+		DeleteFunc: bnc.onEgressQoSDelete,
 	}))
 	if err != nil {
 		return fmt.Errorf("could not add Event Handler for eqInformer during egressqosController initialization, %w", err)
 
 	}
+	bnc.egressQoSHandlers = append(bnc.egressQoSHandlers, egressQoSHandler{eqInformer.Informer(), registration})
 
-	oc.egressQoSPodLister = podInformer.Lister()
-	oc.egressQoSPodSynced = podInformer.Informer().HasSynced
-	oc.egressQoSPodQueue = workqueue.NewTypedRateLimitingQueueWithConfig(
+	bnc.egressQoSPodLister = podInformer.Lister()
+	bnc.egressQoSPodSynced = podInformer.Informer().HasSynced
+	bnc.egressQoSPodQueue = workqueue.NewTypedRateLimitingQueueWithConfig(
 		workqueue.NewTypedItemFastSlowRateLimiter[string](1*time.Second, 5*time.Second, 5),
 		workqueue.TypedRateLimitingQueueConfig[string]{Name: "egressqospods"},
 	)
-	_, err = podInformer.Informer().AddEventHandler(factory.WithUpdateHandlingForObjReplace(cache.ResourceEventHandlerFuncs{
-		AddFunc:    oc.onEgressQoSPodAdd,
-		UpdateFunc: oc.onEgressQoSPodUpdate,
-		DeleteFunc: oc.onEgressQoSPodDelete,
+	registration, err = podInformer.Informer().AddEventHandler(factory.WithUpdateHandlingForObjReplace(cache.ResourceEventHandlerFuncs{
+		AddFunc:    bnc.onEgressQoSPodAdd,
+		UpdateFunc: bnc.onEgressQoSPodUpdate,
+		DeleteFunc: bnc.onEgressQoSPodDelete,
 	}))
 	if err != nil {
 		return fmt.Errorf("could not add Event Handler for podInformer during egressqosController initialization, %w", err)
 	}
+	bnc.egressQoSHandlers = append(bnc.egressQoSHandlers, egressQoSHandler{podInformer.Informer(), registration})
 
-	oc.egressQoSNodeLister = nodeInformer.Lister()
-	oc.egressQoSNodeSynced = nodeInformer.Informer().HasSynced
-	oc.egressQoSNodeQueue = workqueue.NewTypedRateLimitingQueueWithConfig(
+	bnc.egressQoSNodeLister = nodeInformer.Lister()
+	bnc.egressQoSNodeSynced = nodeInformer.Informer().HasSynced
+	bnc.egressQoSNodeQueue = workqueue.NewTypedRateLimitingQueueWithConfig(
 		workqueue.NewTypedItemFastSlowRateLimiter[string](1*time.Second, 5*time.Second, 5),
 		workqueue.TypedRateLimitingQueueConfig[string]{Name: "egressqosnodes"},
 	)
-	_, err = nodeInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
-		AddFunc:    oc.onEgressQoSNodeAdd,    // we only care about new logical switches being added
-		UpdateFunc: oc.onEgressQoSNodeUpdate, // we care about node's zone changes so that if add event didn't do anything update can take care of it
+	registration, err = nodeInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    bnc.onEgressQoSNodeAdd,    // we only care about new logical switches being added
+		UpdateFunc: bnc.onEgressQoSNodeUpdate, // we care about node's zone changes so that if add event didn't do anything update can take care of it
 		DeleteFunc: func(obj interface{}) {},
 	})
 	if err != nil {
 		return fmt.Errorf("could not add Event Handler for nodeInformer during egressqosController initialization, %w", err)
 	}
+	bnc.egressQoSHandlers = append(bnc.egressQoSHandlers, egressQoSHandler{nodeInformer.Informer(), registration})
 	return nil
 }
 
-func (oc *DefaultNetworkController) runEgressQoSController(wg *sync.WaitGroup, threadiness int, stopCh <-chan struct{}) error {
+func (bnc *BaseNetworkController) runEgressQoSController(wg *sync.WaitGroup, threadiness int, stopCh <-chan struct{}) error {
 	defer utilruntime.HandleCrash()
 
 	klog.Infof("Starting EgressQoS Controller")
 
-	if !util.WaitForInformerCacheSyncWithTimeout("egressqosnodes", stopCh, oc.egressQoSNodeSynced) {
+	if !util.WaitForInformerCacheSyncWithTimeout("egressqosnodes", stopCh, bnc.egressQoSNodeSynced) {
 		return fmt.Errorf("timed out waiting for egress QoS node caches to sync")
 	}
 
-	if !util.WaitForInformerCacheSyncWithTimeout("egressqospods", stopCh, oc.egressQoSPodSynced) {
+	if !util.WaitForInformerCacheSyncWithTimeout("egressqospods", stopCh, bnc.egressQoSPodSynced) {
 		return fmt.Errorf("timed out waiting for egress QoS pods caches to sync")
 	}
 
-	if !util.WaitForInformerCacheSyncWithTimeout("egressqos", stopCh, oc.egressQoSSynced) {
+	if !util.WaitForInformerCacheSyncWithTimeout("egressqos", stopCh, bnc.egressQoSSynced) {
 		return fmt.Errorf("timed out waiting for egress QoS caches to sync")
 	}
 
 	klog.Infof("Repairing EgressQoSes")
-	err := oc.repairEgressQoSes()
+	err := bnc.repairEgressQoSes()
 	if err != nil {
 		return fmt.Errorf("failed to delete stale EgressQoS entries: %v", err)
 	}
@@ -266,7 +301,7 @@ func (oc *DefaultNetworkController) runEgressQoSController(wg *sync.WaitGroup, t
 		go func() {
 			defer wg.Done()
 			wait.Until(func() {
-				oc.runEgressQoSWorker(wg)
+				bnc.runEgressQoSWorker(wg)
 			}, time.Second, stopCh)
 		}()
 	}
@@ -276,7 +311,7 @@ func (oc *DefaultNetworkController) runEgressQoSController(wg *sync.WaitGroup, t
 		go func() {
 			defer wg.Done()
 			wait.Until(func() {
-				oc.runEgressQoSPodWorker(wg)
+				bnc.runEgressQoSPodWorker(wg)
 			}, time.Second, stopCh)
 		}()
 	}
@@ -286,7 +321,7 @@ func (oc *DefaultNetworkController) runEgressQoSController(wg *sync.WaitGroup, t
 		go func() {
 			defer wg.Done()
 			wait.Until(func() {
-				oc.runEgressQoSNodeWorker(wg)
+				bnc.runEgressQoSNodeWorker(wg)
 			}, time.Second, stopCh)
 		}()
 	}
@@ -299,26 +334,31 @@ func (oc *DefaultNetworkController) runEgressQoSController(wg *sync.WaitGroup, t
 		<-stopCh
 
 		klog.Infof("Shutting down EgressQoS controller")
-		oc.egressQoSQueue.ShutDown()
-		oc.egressQoSPodQueue.ShutDown()
-		oc.egressQoSNodeQueue.ShutDown()
+		for _, handler := range bnc.egressQoSHandlers {
+			if err := handler.informer.RemoveEventHandler(handler.registration); err != nil {
+				klog.Errorf("Failed to remove EgressQoS event handler: %v", err)
+			}
+		}
+		bnc.egressQoSQueue.ShutDown()
+		bnc.egressQoSPodQueue.ShutDown()
+		bnc.egressQoSNodeQueue.ShutDown()
 	}()
 
 	return nil
 }
 
 // onEgressQoSAdd queues the EgressQoS for processing.
-func (oc *DefaultNetworkController) onEgressQoSAdd(obj interface{}) {
+func (bnc *BaseNetworkController) onEgressQoSAdd(obj interface{}) {
 	key, err := cache.MetaNamespaceKeyFunc(obj)
 	if err != nil {
 		utilruntime.HandleError(fmt.Errorf("couldn't get key for object %+v: %v", obj, err))
 		return
 	}
-	oc.egressQoSQueue.Add(key)
+	bnc.egressQoSQueue.Add(key)
 }
 
 // onEgressQoSUpdate queues the EgressQoS for processing.
-func (oc *DefaultNetworkController) onEgressQoSUpdate(oldObj, newObj interface{}) {
+func (bnc *BaseNetworkController) onEgressQoSUpdate(oldObj, newObj interface{}) {
 	oldEQ := oldObj.(*egressqosapi.EgressQoS)
 	newEQ := newObj.(*egressqosapi.EgressQoS)
 
@@ -329,47 +369,50 @@ func (oc *DefaultNetworkController) onEgressQoSUpdate(oldObj, newObj interface{}
 
 	key, err := cache.MetaNamespaceKeyFunc(newObj)
 	if err == nil {
-		oc.egressQoSQueue.Add(key)
+		bnc.egressQoSQueue.Add(key)
 	}
 }
 
 // onEgressQoSDelete queues the EgressQoS for processing.
-func (oc *DefaultNetworkController) onEgressQoSDelete(obj interface{}) {
+func (bnc *BaseNetworkController) onEgressQoSDelete(obj interface{}) {
 	key, err := cache.MetaNamespaceKeyFunc(obj)
 	if err != nil {
 		utilruntime.HandleError(fmt.Errorf("couldn't get key for object %+v: %v", obj, err))
 		return
 	}
-	oc.egressQoSQueue.Add(key)
+	bnc.egressQoSQueue.Add(key)
 }
 
-func (oc *DefaultNetworkController) runEgressQoSWorker(wg *sync.WaitGroup) {
-	for oc.processNextEgressQoSWorkItem(wg) {
+func (bnc *BaseNetworkController) runEgressQoSWorker(wg *sync.WaitGroup) {
+	for bnc.processNextEgressQoSWorkItem(wg) {
 	}
 }
 
-func (oc *DefaultNetworkController) processNextEgressQoSWorkItem(wg *sync.WaitGroup) bool {
+func (bnc *BaseNetworkController) processNextEgressQoSWorkItem(wg *sync.WaitGroup) bool {
 	wg.Add(1)
 	defer wg.Done()
 
-	key, quit := oc.egressQoSQueue.Get()
+	key, quit := bnc.egressQoSQueue.Get()
 	if quit {
 		return false
 	}
 
-	defer oc.egressQoSQueue.Done(key)
+	defer bnc.egressQoSQueue.Done(key)
 
-	eq, err := oc.getEgressQoS(key)
+	eq, err := bnc.getEgressQoS(key)
 	if err != nil {
 		utilruntime.HandleError(fmt.Errorf("failed to retrieve %s qos object: %v", key, err))
-		oc.egressQoSQueue.Forget(key)
+		bnc.egressQoSQueue.Forget(key)
 		return true
 	}
 
-	err = oc.syncEgressQoS(key, eq)
+	eq, err = bnc.filterEgressQoSByNetwork(eq)
 	if err == nil {
-		oc.egressQoSQueue.Forget(key)
-		if err = oc.updateEgressQoSZoneStatusToReady(eq); err != nil {
+		err = bnc.syncEgressQoS(key, eq)
+	}
+	if err == nil {
+		bnc.egressQoSQueue.Forget(key)
+		if err = bnc.updateEgressQoSZoneStatusToReady(eq); err != nil {
 			utilruntime.HandleError(fmt.Errorf("failed to update EgressQoS object %s with status: %v", key, err))
 		}
 		return true
@@ -377,30 +420,30 @@ func (oc *DefaultNetworkController) processNextEgressQoSWorkItem(wg *sync.WaitGr
 
 	utilruntime.HandleError(fmt.Errorf("%v failed with : %v", key, err))
 
-	if oc.egressQoSQueue.NumRequeues(key) < maxEgressQoSRetries {
-		oc.egressQoSQueue.AddRateLimited(key)
+	if bnc.egressQoSQueue.NumRequeues(key) < maxEgressQoSRetries {
+		bnc.egressQoSQueue.AddRateLimited(key)
 		return true
 	}
 
-	if err = oc.updateEgressQoSZoneStatusToNotReady(eq, err); err != nil {
+	if err = bnc.updateEgressQoSZoneStatusToNotReady(eq, err); err != nil {
 		utilruntime.HandleError(fmt.Errorf("failed to update EgressQoS object %s with status: %v", key, err))
 	}
 
-	oc.egressQoSQueue.Forget(key)
+	bnc.egressQoSQueue.Forget(key)
 	return true
 }
 
 // This takes care of syncing stale data which we might have in OVN if
 // there's no ovnkube-master running for a while.
 // It deletes all QoSes and Address Sets from OVN that belong to deleted EgressQoSes.
-func (oc *DefaultNetworkController) repairEgressQoSes() error {
+func (bnc *BaseNetworkController) repairEgressQoSes() error {
 	startTime := time.Now()
 	klog.V(4).Infof("Starting repairing loop for egressqos")
 	defer func() {
 		klog.V(4).Infof("Finished repairing loop for egressqos: %v", time.Since(startTime))
 	}()
 
-	existing, err := oc.egressQoSLister.List(labels.Everything())
+	existing, err := bnc.egressQoSLister.List(labels.Everything())
 	if err != nil {
 		return err
 	}
@@ -409,13 +452,13 @@ func (oc *DefaultNetworkController) repairEgressQoSes() error {
 	for _, q := range existing {
 		nsWithQoS[q.Namespace] = true
 	}
-	predicateIDs := libovsdbops.NewDbObjectIDs(libovsdbops.QoSEgressQoS, oc.controllerName, nil)
+	predicateIDs := libovsdbops.NewDbObjectIDs(libovsdbops.QoSEgressQoS, bnc.controllerName, nil)
 	predicateQoSFunc := func(q *nbdb.QoS) bool {
 		// ObjectNameKey is namespace
 		return !nsWithQoS[q.ExternalIDs[libovsdbops.ObjectNameKey.String()]]
 	}
 	qPredicate := libovsdbops.GetPredicate[*nbdb.QoS](predicateIDs, predicateQoSFunc)
-	existingQoSes, err := libovsdbops.FindQoSesWithPredicate(oc.nbClient, qPredicate)
+	existingQoSes, err := libovsdbops.FindQoSesWithPredicate(bnc.nbClient, qPredicate)
 	if err != nil {
 		return err
 	}
@@ -423,37 +466,37 @@ func (oc *DefaultNetworkController) repairEgressQoSes() error {
 	if len(existingQoSes) > 0 {
 		allOps := []ovsdb.Operation{}
 
-		logicalSwitches, err := oc.egressQoSSwitches()
+		logicalSwitches, err := bnc.egressQoSSwitches()
 		if err != nil {
 			return err
 		}
 
 		for _, sw := range logicalSwitches {
-			ops, err := libovsdbops.RemoveQoSesFromLogicalSwitchOps(oc.nbClient, nil, sw, existingQoSes...)
+			ops, err := libovsdbops.RemoveQoSesFromLogicalSwitchOps(bnc.nbClient, nil, sw, existingQoSes...)
 			if err != nil {
 				return err
 			}
 			allOps = append(allOps, ops...)
 		}
 
-		if _, err := libovsdbops.TransactAndCheck(oc.nbClient, allOps); err != nil {
+		if _, err := libovsdbops.TransactAndCheck(bnc.nbClient, allOps); err != nil {
 			return fmt.Errorf("unable to remove stale qoses, err: %v", err)
 		}
 	}
-	predicateIDs = libovsdbops.NewDbObjectIDs(libovsdbops.AddressSetEgressQoS, oc.controllerName, nil)
+	predicateIDs = libovsdbops.NewDbObjectIDs(libovsdbops.AddressSetEgressQoS, bnc.controllerName, nil)
 	predicateFunc := func(as *nbdb.AddressSet) bool {
 		// ObjectNameKey is namespace
 		return !nsWithQoS[as.ExternalIDs[libovsdbops.ObjectNameKey.String()]]
 	}
 	asPredicate := libovsdbops.GetPredicate[*nbdb.AddressSet](predicateIDs, predicateFunc)
-	if err := libovsdbops.DeleteAddressSetsWithPredicate(oc.nbClient, asPredicate); err != nil {
+	if err := libovsdbops.DeleteAddressSetsWithPredicate(bnc.nbClient, asPredicate); err != nil {
 		return fmt.Errorf("failed to remove stale egress qos address sets, err: %v", err)
 	}
 
 	return nil
 }
 
-func (oc *DefaultNetworkController) syncEgressQoS(key string, eq *egressqosapi.EgressQoS) error {
+func (bnc *BaseNetworkController) syncEgressQoS(key string, eq *egressqosapi.EgressQoS) error {
 	startTime := time.Now()
 	namespace, name, err := cache.SplitMetaNamespaceKey(key)
 	if err != nil {
@@ -472,7 +515,7 @@ func (oc *DefaultNetworkController) syncEgressQoS(key string, eq *egressqosapi.E
 
 	// TODO: we should reconcile better by cleaning and creating in one transaction.
 	// that should minimize the window of lost DSCP markings on packets.
-	err = oc.cleanEgressQoSNS(namespace)
+	err = bnc.cleanEgressQoSNS(namespace)
 	if err != nil {
 		return fmt.Errorf("unable to delete EgressQoS %s/%s, err: %v", namespace, name, err)
 	}
@@ -483,24 +526,41 @@ func (oc *DefaultNetworkController) syncEgressQoS(key string, eq *egressqosapi.E
 
 	klog.V(5).Infof("EgressQoS %s retrieved from lister: %v", eq.Name, eq)
 
-	return oc.addEgressQoS(eq)
+	return bnc.addEgressQoS(eq)
 }
 
-func (oc *DefaultNetworkController) getEgressQoS(key string) (*egressqosapi.EgressQoS, error) {
+func (bnc *BaseNetworkController) getEgressQoS(key string) (*egressqosapi.EgressQoS, error) {
 	namespace, name, err := cache.SplitMetaNamespaceKey(key)
 	if err != nil {
 		return nil, err
 	}
 	var eq *egressqosapi.EgressQoS
-	eq, err = oc.egressQoSLister.EgressQoSes(namespace).Get(name)
+	eq, err = bnc.egressQoSLister.EgressQoSes(namespace).Get(name)
 	if err != nil && !apierrors.IsNotFound(err) {
 		return nil, err
 	}
 	return eq, nil
 }
 
-func (oc *DefaultNetworkController) cleanEgressQoSNS(namespace string) error {
-	obj, loaded := oc.egressQoSCache.Load(namespace)
+// filterEgressQoSByNetwork returns the given EgressQoS if its namespace is
+// served by the network of the controller, or nil otherwise, in which case any
+// existing configuration for it is removed.
+func (bnc *BaseNetworkController) filterEgressQoSByNetwork(eq *egressqosapi.EgressQoS) (*egressqosapi.EgressQoS, error) {
+	if eq == nil || !util.IsNetworkSegmentationSupportEnabled() {
+		return eq, nil
+	}
+	netInfo, err := bnc.getActiveNetworkForNamespace(eq.Namespace)
+	if err != nil {
+		return nil, fmt.Errorf("could not get active network for namespace %s: %v", eq.Namespace, err)
+	}
+	if bnc.GetNetworkName() != netInfo.GetNetworkName() {
+		return nil, nil
+	}
+	return eq, nil
+}
+
+func (bnc *BaseNetworkController) cleanEgressQoSNS(namespace string) error {
+	obj, loaded := bnc.egressQoSCache.Load(namespace)
 	if !loaded {
 		// the namespace is clean
 		klog.V(4).Infof("EgressQoS for namespace %s not found in cache", namespace)
@@ -511,12 +571,12 @@ func (oc *DefaultNetworkController) cleanEgressQoSNS(namespace string) error {
 
 	eq.Lock()
 	defer eq.Unlock()
-	predicateIDs := libovsdbops.NewDbObjectIDs(libovsdbops.QoSEgressQoS, oc.controllerName,
+	predicateIDs := libovsdbops.NewDbObjectIDs(libovsdbops.QoSEgressQoS, bnc.controllerName,
 		map[libovsdbops.ExternalIDKey]string{
 			libovsdbops.ObjectNameKey: eq.namespace,
 		})
 	qPredicate := libovsdbops.GetPredicate[*nbdb.QoS](predicateIDs, nil)
-	existingQoSes, err := libovsdbops.FindQoSesWithPredicate(oc.nbClient, qPredicate)
+	existingQoSes, err := libovsdbops.FindQoSesWithPredicate(bnc.nbClient, qPredicate)
 	if err != nil {
 		return err
 	}
@@ -524,49 +584,49 @@ func (oc *DefaultNetworkController) cleanEgressQoSNS(namespace string) error {
 	if len(existingQoSes) > 0 {
 		allOps := []ovsdb.Operation{}
 
-		ops, err := libovsdbops.DeleteQoSesOps(oc.nbClient, nil, existingQoSes...)
+		ops, err := libovsdbops.DeleteQoSesOps(bnc.nbClient, nil, existingQoSes...)
 		if err != nil {
 			return err
 		}
 		allOps = append(allOps, ops...)
 
-		logicalSwitches, err := oc.egressQoSSwitches()
+		logicalSwitches, err := bnc.egressQoSSwitches()
 		if err != nil {
 			return err
 		}
 
 		for _, sw := range logicalSwitches {
-			ops, err := libovsdbops.RemoveQoSesFromLogicalSwitchOps(oc.nbClient, nil, sw, existingQoSes...)
+			ops, err := libovsdbops.RemoveQoSesFromLogicalSwitchOps(bnc.nbClient, nil, sw, existingQoSes...)
 			if err != nil {
 				return err
 			}
 			allOps = append(allOps, ops...)
 		}
 
-		if _, err := libovsdbops.TransactAndCheck(oc.nbClient, allOps); err != nil {
+		if _, err := libovsdbops.TransactAndCheck(bnc.nbClient, allOps); err != nil {
 			return fmt.Errorf("failed to delete qos, err: %s", err)
 		}
 	}
-	predicateIDs = libovsdbops.NewDbObjectIDs(libovsdbops.AddressSetEgressQoS, oc.controllerName,
+	predicateIDs = libovsdbops.NewDbObjectIDs(libovsdbops.AddressSetEgressQoS, bnc.controllerName,
 		map[libovsdbops.ExternalIDKey]string{
 			libovsdbops.ObjectNameKey: eq.namespace,
 		})
 	asPredicate := libovsdbops.GetPredicate[*nbdb.AddressSet](predicateIDs, nil)
-	if err := libovsdbops.DeleteAddressSetsWithPredicate(oc.nbClient, asPredicate); err != nil {
+	if err := libovsdbops.DeleteAddressSetsWithPredicate(bnc.nbClient, asPredicate); err != nil {
 		return fmt.Errorf("failed to remove egress qos address sets, err: %v", err)
 	}
 
 	// we can delete the object from the cache now.
 	// we also mark it as stale to prevent pod processing if RLock
 	// acquired after removal from cache.
-	oc.egressQoSCache.Delete(namespace)
+	bnc.egressQoSCache.Delete(namespace)
 	eq.stale = true
 
 	return nil
 }
 
-func (oc *DefaultNetworkController) addEgressQoS(eqObj *egressqosapi.EgressQoS) error {
-	eq, err := oc.cloneEgressQoS(eqObj)
+func (bnc *BaseNetworkController) addEgressQoS(eqObj *egressqosapi.EgressQoS) error {
+	eq, err := bnc.cloneEgressQoS(eqObj)
 	if err != nil {
 		return err
 	}
@@ -577,19 +637,19 @@ func (oc *DefaultNetworkController) addEgressQoS(eqObj *egressqosapi.EgressQoS)
 
 	// there should not be an item in the cache for the given namespace
 	// as we first attempt to delete before create.
-	if _, loaded := oc.egressQoSCache.LoadOrStore(eq.namespace, eq); loaded {
+	if _, loaded := bnc.egressQoSCache.LoadOrStore(eq.namespace, eq); loaded {
 		return fmt.Errorf("error attempting to add egressQoS %s to namespace %s when it already has an EgressQoS",
 			eq.name, eq.namespace)
 	}
 
 	for _, rule := range eq.rules {
-		rule.addrSet, rule.pods, err = oc.createASForEgressQoSRule(rule.podSelector, eq.namespace, rule.priority)
+		rule.addrSet, rule.pods, err = bnc.createASForEgressQoSRule(rule.podSelector, eq.namespace, rule.priority)
 		if err != nil {
 			return err
 		}
 	}
 
-	logicalSwitches, err := oc.egressQoSSwitches()
+	logicalSwitches, err := bnc.egressQoSSwitches()
 	if err != nil {
 		return err
 	}
@@ -603,27 +663,31 @@ func (oc *DefaultNetworkController) addEgressQoS(eqObj *egressqosapi.EgressQoS)
 			Direction:   nbdb.QoSDirectionToLport,
 			Match:       match,
 			Priority:    r.priority,
-			Action:      map[string]int{nbdb.QoSActionDSCP: r.dscp},
-			ExternalIDs: getEgressQoSRuleDbIDs(eq.namespace, r.priority).GetExternalIDs(),
+			Action:      map[string]int{},
+			Bandwidth:   r.bandwidth,
+			ExternalIDs: getEgressQoSRuleDbIDs(eq.namespace, r.priority, bnc.controllerName).GetExternalIDs(),
+		}
+		if r.dscp != nil {
+			qos.Action[nbdb.QoSActionDSCP] = *r.dscp
 		}
 		qoses = append(qoses, qos)
 	}
 
-	ops, err := libovsdbops.CreateOrUpdateQoSesOps(oc.nbClient, nil, qoses...)
+	ops, err := libovsdbops.CreateOrUpdateQoSesOps(bnc.nbClient, nil, qoses...)
 	if err != nil {
 		return err
 	}
 	allOps = append(allOps, ops...)
 
 	for _, sw := range logicalSwitches {
-		ops, err := libovsdbops.AddQoSesToLogicalSwitchOps(oc.nbClient, nil, sw, qoses...)
+		ops, err := libovsdbops.AddQoSesToLogicalSwitchOps(bnc.nbClient, nil, sw, qoses...)
 		if err != nil {
 			return err
 		}
 		allOps = append(allOps, ops...)
 	}
 
-	if _, err := libovsdbops.TransactAndCheck(oc.nbClient, allOps); err != nil {
+	if _, err := libovsdbops.TransactAndCheck(bnc.nbClient, allOps); err != nil {
 		return fmt.Errorf("failed to create qos, err: %s", err)
 	}
 
@@ -652,19 +716,39 @@ func generateEgressQoSMatch(eq *egressQoSRule, hashedAddressSetNameIPv4, hashedA
 		}
 	}
 
-	return fmt.Sprintf("(%s) && %s", dst, src)
+	match := fmt.Sprintf("(%s) && %s", dst, src)
+	if len(eq.ports) > 0 {
+		// ports are only matched on the destination of the egress traffic,
+		// protocols are OR'ed together
+		l4Matches := libovsdbutil.GetL4MatchesFromNetworkPolicyPorts(eq.ports)
+		protocolMatches := make([]string, 0, len(l4Matches))
+		for _, l4Match := range l4Matches {
+			protocolMatches = append(protocolMatches, l4Match)
+		}
+		sort.Strings(protocolMatches)
+		if len(protocolMatches) == 1 {
+			match = fmt.Sprintf("%s && %s", match, protocolMatches[0])
+		} else {
+			match = fmt.Sprintf("%s && ((%s))", match, strings.Join(protocolMatches, ") || ("))
+		}
+	}
+	return match
 }
 
-func (oc *DefaultNetworkController) egressQoSSwitches() ([]string, error) {
+func (bnc *BaseNetworkController) egressQoSSwitches() ([]string, error) {
 	logicalSwitches := []string{}
 
-	// Find all node switches
+	// Find all node switches of the network
+	networkName := util.GenerateExternalIDsForSwitchOrRouter(bnc.NetInfo)[types.NetworkExternalID]
 	p := func(item *nbdb.LogicalSwitch) bool {
+		if item.ExternalIDs[types.NetworkExternalID] != networkName {
+			return false
+		}
 		// Ignore external and Join switches(both legacy and current)
-		return !(strings.HasPrefix(item.Name, types.JoinSwitchPrefix) || oc.RemoveNetworkScopeFromName(item.Name) == types.OVNJoinSwitch || item.Name == types.TransitSwitch || strings.HasPrefix(item.Name, types.ExternalSwitchPrefix))
+		return !(strings.HasPrefix(item.Name, types.JoinSwitchPrefix) || bnc.RemoveNetworkScopeFromName(item.Name) == types.OVNJoinSwitch || bnc.RemoveNetworkScopeFromName(item.Name) == types.TransitSwitch || strings.HasPrefix(item.Name, types.ExternalSwitchPrefix))
 	}
 
-	nodeLocalSwitches, err := libovsdbops.FindLogicalSwitchesWithPredicate(oc.nbClient, p)
+	nodeLocalSwitches, err := libovsdbops.FindLogicalSwitchesWithPredicate(bnc.nbClient, p)
 	if err != nil {
 		return nil, fmt.Errorf("unable to fetch local switches for EgressQoS, err: %v", err)
 	}
@@ -688,14 +772,14 @@ type mapAndOp struct {
 	op mapOp
 }
 
-func (oc *DefaultNetworkController) syncEgressQoSPod(key string) error {
+func (bnc *BaseNetworkController) syncEgressQoSPod(key string) error {
 	startTime := time.Now()
 	namespace, name, err := cache.SplitMetaNamespaceKey(key)
 	if err != nil {
 		return err
 	}
 
-	obj, loaded := oc.egressQoSCache.Load(namespace)
+	obj, loaded := bnc.egressQoSCache.Load(namespace)
 	if !loaded { // no EgressQoS in the namespace
 		return nil
 	}
@@ -713,7 +797,7 @@ func (oc *DefaultNetworkController) syncEgressQoSPod(key string) error {
 		return nil
 	}
 
-	pod, err := oc.egressQoSPodLister.Pods(namespace).Get(name)
+	pod, err := bnc.egressQoSPodLister.Pods(namespace).Get(name)
 	if err != nil && !apierrors.IsNotFound(err) {
 		return err
 	}
@@ -736,7 +820,7 @@ func (oc *DefaultNetworkController) syncEgressQoSPod(key string) error {
 			podsCaches = append(podsCaches, rule.pods)
 			allOps = append(allOps, ops...)
 		}
-		_, err = libovsdbops.TransactAndCheck(oc.nbClient, allOps)
+		_, err = libovsdbops.TransactAndCheck(bnc.nbClient, allOps)
 		if err != nil {
 			return err
 		}
@@ -750,11 +834,11 @@ func (oc *DefaultNetworkController) syncEgressQoSPod(key string) error {
 
 	klog.V(5).Infof("Pod %s retrieved from lister: %v", pod.Name, pod)
 
-	if util.PodWantsHostNetwork(pod) || !oc.isPodScheduledinLocalZone(pod) { // we don't handle HostNetworked or remote zone pods
+	if util.PodWantsHostNetwork(pod) || !bnc.isPodScheduledinLocalZone(pod) { // we don't handle HostNetworked or remote zone pods
 		return nil
 	}
 
-	podIPs, err := util.GetPodIPsOfNetwork(pod, oc.NetInfo)
+	podIPs, err := util.GetPodIPsOfNetwork(pod, bnc.NetInfo)
 	if errors.Is(err, util.ErrNoPodIPFound) {
 		return nil // reprocess it when it is updated with an IP
 	}
@@ -791,7 +875,7 @@ func (oc *DefaultNetworkController) syncEgressQoSPod(key string) error {
 		}
 	}
 
-	_, err = libovsdbops.TransactAndCheck(oc.nbClient, allOps)
+	_, err = libovsdbops.TransactAndCheck(bnc.nbClient, allOps)
 	if err != nil {
 		return err
 	}
@@ -809,7 +893,7 @@ func (oc *DefaultNetworkController) syncEgressQoSPod(key string) error {
 }
 
 // onEgressQoSPodAdd queues the pod for processing.
-func (oc *DefaultNetworkController) onEgressQoSPodAdd(obj interface{}) {
+func (bnc *BaseNetworkController) onEgressQoSPodAdd(obj interface{}) {
 	key, err := cache.MetaNamespaceKeyFunc(obj)
 	if err != nil {
 		utilruntime.HandleError(fmt.Errorf("couldn't get key for object %+v: %v", obj, err))
@@ -817,7 +901,7 @@ func (oc *DefaultNetworkController) onEgressQoSPodAdd(obj interface{}) {
 	}
 	pod := obj.(*kapi.Pod)
 	// only process this pod if it is local to this zone
-	if !oc.isPodScheduledinLocalZone(pod) {
+	if !bnc.isPodScheduledinLocalZone(pod) {
 		// NOTE: This means we don't handle the case where pod goes from
 		// being local to remote. So far there is no use case for this to happen.
 		// Also when we think about a pod going from local to remote - what does that mean?
@@ -830,11 +914,11 @@ func (oc *DefaultNetworkController) onEgressQoSPodAdd(obj interface{}) {
 		// based on OVN db schema this will remove all referenced QoS rules created on the switch
 		return // not local to this zone, nothing to do; no-op
 	}
-	oc.egressQoSPodQueue.Add(key)
+	bnc.egressQoSPodQueue.Add(key)
 }
 
 // onEgressQoSPodUpdate queues the pod for processing.
-func (oc *DefaultNetworkController) onEgressQoSPodUpdate(oldObj, newObj interface{}) {
+func (bnc *BaseNetworkController) onEgressQoSPodUpdate(oldObj, newObj interface{}) {
 	oldPod := oldObj.(*kapi.Pod)
 	newPod := newObj.(*kapi.Pod)
 
@@ -845,10 +929,10 @@ func (oc *DefaultNetworkController) onEgressQoSPodUpdate(oldObj, newObj interfac
 
 	oldPodLabels := labels.Set(oldPod.Labels)
 	newPodLabels := labels.Set(newPod.Labels)
-	oldPodIPs, _ := util.GetPodIPsOfNetwork(oldPod, oc.NetInfo)
-	newPodIPs, _ := util.GetPodIPsOfNetwork(newPod, oc.NetInfo)
-	isOldPodLocal := oc.isPodScheduledinLocalZone(oldPod)
-	isNewPodLocal := oc.isPodScheduledinLocalZone(newPod)
+	oldPodIPs, _ := util.GetPodIPsOfNetwork(oldPod, bnc.NetInfo)
+	newPodIPs, _ := util.GetPodIPsOfNetwork(newPod, bnc.NetInfo)
+	isOldPodLocal := bnc.isPodScheduledinLocalZone(oldPod)
+	isNewPodLocal := bnc.isPodScheduledinLocalZone(newPod)
 	oldPodCompleted := util.PodCompleted(oldPod)
 	newPodCompleted := util.PodCompleted(newPod)
 	if labels.Equals(oldPodLabels, newPodLabels) &&
@@ -865,10 +949,10 @@ func (oc *DefaultNetworkController) onEgressQoSPodUpdate(oldObj, newObj interfac
 		return
 	}
 
-	oc.egressQoSPodQueue.Add(key)
+	bnc.egressQoSPodQueue.Add(key)
 }
 
-func (oc *DefaultNetworkController) onEgressQoSPodDelete(obj interface{}) {
+func (bnc *BaseNetworkController) onEgressQoSPodDelete(obj interface{}) {
 	key, err := cache.MetaNamespaceKeyFunc(obj)
 	if err != nil {
 		utilruntime.HandleError(fmt.Errorf("couldn't get key for object %+v: %v", obj, err))
@@ -876,7 +960,7 @@ func (oc *DefaultNetworkController) onEgressQoSPodDelete(obj interface{}) {
 	}
 	pod := obj.(*kapi.Pod)
 	// only process this pod if it is local to this zone
-	if !oc.isPodScheduledinLocalZone(pod) {
+	if !bnc.isPodScheduledinLocalZone(pod) {
 		// NOTE: This means we don't handle the case where pod goes from
 		// being local to remote. So far there is no use case for this to happen.
 		// Also when we think about a pod going from local to remote - what does that mean?
@@ -889,56 +973,56 @@ func (oc *DefaultNetworkController) onEgressQoSPodDelete(obj interface{}) {
 		// based on OVN db schema this will remove all referenced QoS rules created on the switch
 		return // not local to this zone, nothing to do; no-op
 	}
-	oc.egressQoSPodQueue.Add(key)
+	bnc.egressQoSPodQueue.Add(key)
 }
 
-func (oc *DefaultNetworkController) runEgressQoSPodWorker(wg *sync.WaitGroup) {
-	for oc.processNextEgressQoSPodWorkItem(wg) {
+func (bnc *BaseNetworkController) runEgressQoSPodWorker(wg *sync.WaitGroup) {
+	for bnc.processNextEgressQoSPodWorkItem(wg) {
 	}
 }
 
-func (oc *DefaultNetworkController) processNextEgressQoSPodWorkItem(wg *sync.WaitGroup) bool {
+func (bnc *BaseNetworkController) processNextEgressQoSPodWorkItem(wg *sync.WaitGroup) bool {
 	wg.Add(1)
 	defer wg.Done()
-	key, quit := oc.egressQoSPodQueue.Get()
+	key, quit := bnc.egressQoSPodQueue.Get()
 	if quit {
 		return false
 	}
-	defer oc.egressQoSPodQueue.Done(key)
+	defer bnc.egressQoSPodQueue.Done(key)
 
-	err := oc.syncEgressQoSPod(key)
+	err := bnc.syncEgressQoSPod(key)
 	if err == nil {
-		oc.egressQoSPodQueue.Forget(key)
+		bnc.egressQoSPodQueue.Forget(key)
 		return true
 	}
 
 	utilruntime.HandleError(fmt.Errorf("%v failed with : %v", key, err))
 
-	if oc.egressQoSPodQueue.NumRequeues(key) < maxEgressQoSRetries {
-		oc.egressQoSPodQueue.AddRateLimited(key)
+	if bnc.egressQoSPodQueue.NumRequeues(key) < maxEgressQoSRetries {
+		bnc.egressQoSPodQueue.AddRateLimited(key)
 		return true
 	}
 
-	oc.egressQoSPodQueue.Forget(key)
+	bnc.egressQoSPodQueue.Forget(key)
 	return true
 }
 
 // onEgressQoSAdd queues the node for processing.
-func (oc *DefaultNetworkController) onEgressQoSNodeAdd(obj interface{}) {
+func (bnc *BaseNetworkController) onEgressQoSNodeAdd(obj interface{}) {
 	key, err := cache.MetaNamespaceKeyFunc(obj)
 	if err != nil {
 		utilruntime.HandleError(fmt.Errorf("couldn't get key for object %+v: %v", obj, err))
 		return
 	}
 	node := obj.(*kapi.Node)
-	if util.GetNodeZone(node) != oc.zone {
+	if util.GetNodeZone(node) != bnc.zone {
 		return
 	}
-	oc.egressQoSNodeQueue.Add(key)
+	bnc.egressQoSNodeQueue.Add(key)
 }
 
 // onEgressQoSNodeUpdate queues the node for processing if it changed zones
-func (oc *DefaultNetworkController) onEgressQoSNodeUpdate(oldObj, newObj interface{}) {
+func (bnc *BaseNetworkController) onEgressQoSNodeUpdate(oldObj, newObj interface{}) {
 	oldNode := oldObj.(*kapi.Node)
 	newNode := newObj.(*kapi.Node)
 	if oldNode.ResourceVersion == newNode.ResourceVersion ||
@@ -955,7 +1039,7 @@ func (oc *DefaultNetworkController) onEgressQoSNodeUpdate(oldObj, newObj interfa
 	// will just cleanup the switch resource for the node.
 	oldNodeZone := util.GetNodeZone(oldNode)
 	newNodeZone := util.GetNodeZone(newNode)
-	if oldNodeZone == newNodeZone || newNodeZone != oc.zone {
+	if oldNodeZone == newNodeZone || newNodeZone != bnc.zone {
 		return
 	}
 	key, err := cache.MetaNamespaceKeyFunc(newObj)
@@ -963,41 +1047,41 @@ func (oc *DefaultNetworkController) onEgressQoSNodeUpdate(oldObj, newObj interfa
 		utilruntime.HandleError(fmt.Errorf("couldn't get key for object %+v: %v", newObj, err))
 		return
 	}
-	oc.egressQoSNodeQueue.Add(key)
+	bnc.egressQoSNodeQueue.Add(key)
 }
 
-func (oc *DefaultNetworkController) runEgressQoSNodeWorker(wg *sync.WaitGroup) {
-	for oc.processNextEgressQoSNodeWorkItem(wg) {
+func (bnc *BaseNetworkController) runEgressQoSNodeWorker(wg *sync.WaitGroup) {
+	for bnc.processNextEgressQoSNodeWorkItem(wg) {
 	}
 }
 
-func (oc *DefaultNetworkController) processNextEgressQoSNodeWorkItem(wg *sync.WaitGroup) bool {
+func (bnc *BaseNetworkController) processNextEgressQoSNodeWorkItem(wg *sync.WaitGroup) bool {
 	wg.Add(1)
 	defer wg.Done()
-	key, quit := oc.egressQoSNodeQueue.Get()
+	key, quit := bnc.egressQoSNodeQueue.Get()
 	if quit {
 		return false
 	}
-	defer oc.egressQoSNodeQueue.Done(key)
+	defer bnc.egressQoSNodeQueue.Done(key)
 
-	err := oc.syncEgressQoSNode(key)
+	err := bnc.syncEgressQoSNode(key)
 	if err == nil {
-		oc.egressQoSNodeQueue.Forget(key)
+		bnc.egressQoSNodeQueue.Forget(key)
 		return true
 	}
 
 	utilruntime.HandleError(fmt.Errorf("%v failed with: %v", key, err))
 
-	if oc.egressQoSNodeQueue.NumRequeues(key) < maxEgressQoSRetries {
-		oc.egressQoSNodeQueue.AddRateLimited(key)
+	if bnc.egressQoSNodeQueue.NumRequeues(key) < maxEgressQoSRetries {
+		bnc.egressQoSNodeQueue.AddRateLimited(key)
 		return true
 	}
 
-	oc.egressQoSNodeQueue.Forget(key)
+	bnc.egressQoSNodeQueue.Forget(key)
 	return true
 }
 
-func (oc *DefaultNetworkController) syncEgressQoSNode(key string) error {
+func (bnc *BaseNetworkController) syncEgressQoSNode(key string) error {
 	startTime := time.Now()
 	_, name, err := cache.SplitMetaNamespaceKey(key)
 	if err != nil {
@@ -1009,7 +1093,7 @@ func (oc *DefaultNetworkController) syncEgressQoSNode(key string) error {
 		klog.V(4).Infof("Finished syncing EgressQoS node %s : %v", name, time.Since(startTime))
 	}()
 
-	n, err := oc.egressQoSNodeLister.Get(name)
+	n, err := bnc.egressQoSNodeLister.Get(name)
 	if err != nil && !apierrors.IsNotFound(err) {
 		return err
 	}
@@ -1021,15 +1105,15 @@ func (oc *DefaultNetworkController) syncEgressQoSNode(key string) error {
 	klog.V(5).Infof("EgressQoS %s node retrieved from lister: %v", n.Name, n)
 
 	nodeSw := &nbdb.LogicalSwitch{
-		Name: oc.GetNetworkScopedSwitchName(n.Name),
+		Name: bnc.GetNetworkScopedSwitchName(n.Name),
 	}
-	nodeSw, err = libovsdbops.GetLogicalSwitch(oc.nbClient, nodeSw)
+	nodeSw, err = libovsdbops.GetLogicalSwitch(bnc.nbClient, nodeSw)
 	if err != nil {
 		return err
 	}
-	predicateIDs := libovsdbops.NewDbObjectIDs(libovsdbops.QoSEgressQoS, oc.controllerName, nil)
+	predicateIDs := libovsdbops.NewDbObjectIDs(libovsdbops.QoSEgressQoS, bnc.controllerName, nil)
 	qPredicate := libovsdbops.GetPredicate[*nbdb.QoS](predicateIDs, nil)
-	existingQoSes, err := libovsdbops.FindQoSesWithPredicate(oc.nbClient, qPredicate)
+	existingQoSes, err := libovsdbops.FindQoSesWithPredicate(bnc.nbClient, qPredicate)
 	if err != nil {
 		return err
 	}
@@ -1038,12 +1122,12 @@ func (oc *DefaultNetworkController) syncEgressQoSNode(key string) error {
 		return nil
 	}
 
-	ops, err := libovsdbops.AddQoSesToLogicalSwitchOps(oc.nbClient, nil, nodeSw.Name, existingQoSes...)
+	ops, err := libovsdbops.AddQoSesToLogicalSwitchOps(bnc.nbClient, nil, nodeSw.Name, existingQoSes...)
 	if err != nil {
 		return err
 	}
 
-	if _, err := libovsdbops.TransactAndCheck(oc.nbClient, ops); err != nil {
+	if _, err := libovsdbops.TransactAndCheck(bnc.nbClient, ops); err != nil {
 		return fmt.Errorf("unable to add existing qoses to new node, err: %v", err)
 	}
 
@@ -1052,40 +1136,40 @@ func (oc *DefaultNetworkController) syncEgressQoSNode(key string) error {
 
 // updateEgressQoSZoneStatusToReady updates the status of the EgressQoS to reflect that it is ready
 // Each zone's ovnkube-controller will call this, hence let's update status using server side apply.
-func (oc *DefaultNetworkController) updateEgressQoSZoneStatusToReady(egressQoS *egressqosapi.EgressQoS) error {
+func (bnc *BaseNetworkController) updateEgressQoSZoneStatusToReady(egressQoS *egressqosapi.EgressQoS) error {
 	if egressQoS == nil {
 		return nil
 	}
 	readyCondition := metav1.Condition{
-		Type:               egressQoSReadyStatusType + oc.zone,
+		Type:               egressQoSReadyStatusType + bnc.zone,
 		Status:             metav1.ConditionTrue,
 		LastTransitionTime: metav1.NewTime(time.Now()),
 		Reason:             egressQoSReadyReason,
 		Message:            egressQoSAppliedCorrectly,
 	}
-	return oc.updateEgressQoSZoneStatusCondition(readyCondition, egressQoS.Namespace, egressQoS.Name)
+	return bnc.updateEgressQoSZoneStatusCondition(readyCondition, egressQoS.Namespace, egressQoS.Name)
 }
 
 // updateEgressQoSZoneStatusToNotReady updates the status of the EgressQoS to reflect that it is not ready
 // Each zone's ovnkube-controller will call this, hence let's update status using server side apply.
-func (oc *DefaultNetworkController) updateEgressQoSZoneStatusToNotReady(egressQoS *egressqosapi.EgressQoS,
+func (bnc *BaseNetworkController) updateEgressQoSZoneStatusToNotReady(egressQoS *egressqosapi.EgressQoS,
 	handlerErr error) error {
 	if egressQoS == nil {
 		return nil
 	}
 	notReadyCondition := metav1.Condition{
-		Type:               egressQoSReadyStatusType + oc.zone,
+		Type:               egressQoSReadyStatusType + bnc.zone,
 		Status:             metav1.ConditionFalse,
 		LastTransitionTime: metav1.NewTime(time.Now()),
 		Reason:             egressQoSNotReadyReason,
 		Message:            types.EgressQoSErrorMsg + ": " + handlerErr.Error(),
 	}
-	return oc.updateEgressQoSZoneStatusCondition(notReadyCondition, egressQoS.Namespace, egressQoS.Name)
+	return bnc.updateEgressQoSZoneStatusCondition(notReadyCondition, egressQoS.Namespace, egressQoS.Name)
 }
 
-func (oc *DefaultNetworkController) updateEgressQoSZoneStatusCondition(newCondition metav1.Condition,
+func (bnc *BaseNetworkController) updateEgressQoSZoneStatusCondition(newCondition metav1.Condition,
 	namespace, name string) error {
-	eq, err := oc.egressQoSLister.EgressQoSes(namespace).Get(name)
+	eq, err := bnc.egressQoSLister.EgressQoSes(namespace).Get(name)
 	if err != nil {
 		return err
 	}
@@ -1104,7 +1188,7 @@ func (oc *DefaultNetworkController) updateEgressQoSZoneStatusCondition(newCondit
 
 	applyObj := egressqosapply.EgressQoS(name, namespace).
 		WithStatus(egressqosapply.EgressQoSStatus().WithConditions(newConditionApply))
-	_, err = oc.kube.EgressQoSClient.K8sV1().EgressQoSes(namespace).ApplyStatus(context.TODO(),
-		applyObj, metav1.ApplyOptions{FieldManager: oc.zone, Force: true})
+	_, err = bnc.kube.EgressQoSClient.K8sV1().EgressQoSes(namespace).ApplyStatus(context.TODO(),
+		applyObj, metav1.ApplyOptions{FieldManager: bnc.zone, Force: true})
 	return err
 }
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	nadapi "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
 	"github.com/onsi/ginkgo/v2"
 	"github.com/onsi/gomega"
 
@@ -16,13 +17,16 @@ import (
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
 	addressset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/address_set"
 	libovsdbtest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
+	fakenad "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/nad"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
 	"github.com/urfave/cli/v2"
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/pointer"
+	"k8s.io/utils/ptr"
 )
 
 func newEgressQoSObject(name, namespace string, egressRules []egressqosapi.EgressQoSRule) *egressqosapi.EgressQoS {
@@ -83,7 +87,7 @@ var _ = ginkgo.Describe("OVN EgressQoS Operations", func() {
 					Match:       "some-match",
 					Priority:    EgressQoSFlowStartPriority,
 					Action:      map[string]int{nbdb.QoSActionDSCP: 50},
-					ExternalIDs: getEgressQoSRuleDbIDs("staleNS", EgressQoSFlowStartPriority).GetExternalIDs(),
+					ExternalIDs: getEgressQoSRuleDbIDs("staleNS", EgressQoSFlowStartPriority, DefaultNetworkControllerName).GetExternalIDs(),
 					UUID:        "staleQoS-UUID",
 				}
 				staleAddrSet, _ := addressset.GetTestDbAddrSets(
@@ -129,11 +133,11 @@ var _ = ginkgo.Describe("OVN EgressQoS Operations", func() {
 				eq := newEgressQoSObject("default", namespaceT.Name, []egressqosapi.EgressQoSRule{
 					{
 						DstCIDR: &dst1,
-						DSCP:    50,
+						DSCP:    ptr.To(50),
 					},
 					{
 						DstCIDR: &dst2,
-						DSCP:    60,
+						DSCP:    ptr.To(60),
 					},
 				})
 				eq.ResourceVersion = "1"
@@ -147,7 +151,7 @@ var _ = ginkgo.Describe("OVN EgressQoS Operations", func() {
 					Match:       match1,
 					Priority:    EgressQoSFlowStartPriority,
 					Action:      map[string]int{nbdb.QoSActionDSCP: 50},
-					ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority).GetExternalIDs(),
+					ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority, DefaultNetworkControllerName).GetExternalIDs(),
 					UUID:        "qos1-UUID",
 				}
 				qos2 := &nbdb.QoS{
@@ -155,7 +159,7 @@ var _ = ginkgo.Describe("OVN EgressQoS Operations", func() {
 					Match:       match2,
 					Priority:    EgressQoSFlowStartPriority - 1,
 					Action:      map[string]int{nbdb.QoSActionDSCP: 60},
-					ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority-1).GetExternalIDs(),
+					ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority-1, DefaultNetworkControllerName).GetExternalIDs(),
 					UUID:        "qos2-UUID",
 				}
 				node1Switch.QOSRules = []string{qos1.UUID, qos2.UUID}
@@ -177,7 +181,7 @@ var _ = ginkgo.Describe("OVN EgressQoS Operations", func() {
 				eq.Spec.Egress = []egressqosapi.EgressQoSRule{
 					{
 						DstCIDR: &dst1,
-						DSCP:    40,
+						DSCP:    ptr.To(40),
 					},
 				}
 				eq.ResourceVersion = "2"
@@ -189,7 +193,7 @@ var _ = ginkgo.Describe("OVN EgressQoS Operations", func() {
 					Match:       match1,
 					Priority:    EgressQoSFlowStartPriority,
 					Action:      map[string]int{nbdb.QoSActionDSCP: 40},
-					ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority).GetExternalIDs(),
+					ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority, DefaultNetworkControllerName).GetExternalIDs(),
 					UUID:        "qos3-UUID",
 				}
 				node1Switch.QOSRules = []string{qos3.UUID}
@@ -243,6 +247,207 @@ var _ = ginkgo.Describe("OVN EgressQoS Operations", func() {
 			fmt.Sprintf("(ip6.dst == 2001:0db8:85a3:0000:0000:8a2e:0370:7335/128) && (ip4.src == $%s || ip6.src == $%s)", asv4, asv6)),
 	)
 
+	ginkgo.It("reconciles egressqoses with ports and bandwidth", func() {
+		app.Action = func(ctx *cli.Context) error {
+			config.IPv4Mode = true
+			config.IPv6Mode = false
+			dst := "1.2.3.4/32"
+
+			node1Switch := &nbdb.LogicalSwitch{
+				UUID: "node1-UUID",
+				Name: node1Name,
+			}
+
+			dbSetup := libovsdbtest.TestSetup{
+				NBData: []libovsdbtest.TestData{
+					node1Switch,
+				},
+			}
+
+			fakeOVN.startWithDBSetup(dbSetup,
+				&v1.NamespaceList{
+					Items: []v1.Namespace{
+						namespaceT,
+					},
+				},
+			)
+
+			eq := newEgressQoSObject("default", namespaceT.Name, []egressqosapi.EgressQoSRule{
+				{
+					DstCIDR: &dst,
+					Bandwidth: &egressqosapi.EgressQoSBandwidth{
+						Rate:  10000,
+						Burst: 1000,
+					},
+					Ports: []egressqosapi.EgressQoSPort{
+						{Protocol: "TCP", Port: 80},
+						{Protocol: "UDP", Port: 5000, EndPort: 5010},
+					},
+				},
+				{
+					DSCP: ptr.To(40),
+					Bandwidth: &egressqosapi.EgressQoSBandwidth{
+						Rate: 5000,
+					},
+					Ports: []egressqosapi.EgressQoSPort{
+						{Protocol: "SCTP"},
+					},
+				},
+				{
+					// an explicit 0 remarks the traffic along with limiting it
+					DSCP: ptr.To(0),
+					Bandwidth: &egressqosapi.EgressQoSBandwidth{
+						Rate: 2000,
+					},
+					Ports: []egressqosapi.EgressQoSPort{
+						{Protocol: "UDP", Port: 53},
+					},
+				},
+			})
+			eq.ResourceVersion = "1"
+			_, err := fakeOVN.fakeClient.EgressQoSClient.K8sV1().EgressQoSes(namespaceT.Name).Create(context.TODO(), eq, metav1.CreateOptions{})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			fakeOVN.InitAndRunEgressQoSController()
+
+			qos1 := &nbdb.QoS{
+				Direction:   nbdb.QoSDirectionToLport,
+				Match:       fmt.Sprintf("(ip4.dst == 1.2.3.4/32) && ip4.src == $%s && ((tcp && tcp.dst==80) || (udp && 5000<=udp.dst<=5010))", asv4),
+				Priority:    EgressQoSFlowStartPriority,
+				Bandwidth:   map[string]int{nbdb.QoSBandwidthRate: 10000, nbdb.QoSBandwidthBurst: 1000},
+				ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority, DefaultNetworkControllerName).GetExternalIDs(),
+				UUID:        "qos1-UUID",
+			}
+			qos2 := &nbdb.QoS{
+				Direction:   nbdb.QoSDirectionToLport,
+				Match:       fmt.Sprintf("(ip4.dst == 0.0.0.0/0 || ip6.dst == ::/0) && ip4.src == $%s && sctp", asv4),
+				Priority:    EgressQoSFlowStartPriority - 1,
+				Action:      map[string]int{nbdb.QoSActionDSCP: 40},
+				Bandwidth:   map[string]int{nbdb.QoSBandwidthRate: 5000},
+				ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority-1, DefaultNetworkControllerName).GetExternalIDs(),
+				UUID:        "qos2-UUID",
+			}
+			qos3 := &nbdb.QoS{
+				Direction:   nbdb.QoSDirectionToLport,
+				Match:       fmt.Sprintf("(ip4.dst == 0.0.0.0/0 || ip6.dst == ::/0) && ip4.src == $%s && udp && udp.dst==53", asv4),
+				Priority:    EgressQoSFlowStartPriority - 2,
+				Action:      map[string]int{nbdb.QoSActionDSCP: 0},
+				Bandwidth:   map[string]int{nbdb.QoSBandwidthRate: 2000},
+				ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority-2, DefaultNetworkControllerName).GetExternalIDs(),
+				UUID:        "qos3-UUID",
+			}
+			node1Switch.QOSRules = []string{qos1.UUID, qos2.UUID, qos3.UUID}
+			expectedDatabaseState := []libovsdbtest.TestData{
+				qos1,
+				qos2,
+				qos3,
+				node1Switch,
+			}
+
+			gomega.Eventually(fakeOVN.nbClient).Should(libovsdbtest.HaveDataIgnoringUUIDs(expectedDatabaseState))
+			expectEgressQoSStatusMessageEventually(fakeOVN, namespaceT.Name, false)
+
+			return nil
+		}
+
+		err := app.Run([]string{app.Name})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	})
+
+	ginkgo.It("reconciles egressqoses on the switches of a primary user defined network", func() {
+		app.Action = func(ctx *cli.Context) error {
+			config.IPv4Mode = true
+			config.IPv6Mode = false
+			config.OVNKubernetesFeature.EnableMultiNetwork = true
+			config.OVNKubernetesFeature.EnableNetworkSegmentation = true
+			dst := "1.2.3.4/32"
+
+			udn := dummyPrimaryLayer3UserDefinedNetwork("192.168.0.0/16", "192.168.1.0/24")
+			udn.nadName = util.GetNADName(namespaceT.Name, "tenant")
+			nad, err := newNetworkAttachmentDefinition(namespaceT.Name, "tenant", *udn.netconf())
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			udnNetInfo, err := util.ParseNADInfo(nad)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			udnControllerName := getNetworkControllerName(udn.netName)
+			udnASv4, _ := addressset.GetHashNamesForAS(getNamespaceAddrSetDbIDs(namespaceT.Name, udnControllerName))
+
+			node1Switch := &nbdb.LogicalSwitch{
+				UUID: "node1-UUID",
+				Name: node1Name,
+			}
+			udnNode1Switch := &nbdb.LogicalSwitch{
+				UUID:        "udn-node1-UUID",
+				Name:        udnNetInfo.GetNetworkScopedSwitchName(node1Name),
+				ExternalIDs: util.GenerateExternalIDsForSwitchOrRouter(udnNetInfo),
+			}
+
+			dbSetup := libovsdbtest.TestSetup{
+				NBData: []libovsdbtest.TestData{
+					node1Switch,
+					udnNode1Switch,
+				},
+			}
+
+			fakeOVN.startWithDBSetup(dbSetup,
+				&v1.NamespaceList{
+					Items: []v1.Namespace{
+						namespaceT,
+					},
+				},
+				&nadapi.NetworkAttachmentDefinitionList{
+					Items: []nadapi.NetworkAttachmentDefinition{*nad},
+				},
+			)
+
+			// the namespace is served by the primary user defined network
+			nadController := &fakenad.FakeNADController{
+				PrimaryNetworks: map[string]util.NetInfo{namespaceT.Name: udnNetInfo},
+			}
+			fakeOVN.controller.nadController = nadController
+			udnController, ok := fakeOVN.secondaryControllers[udn.netName]
+			gomega.Expect(ok).To(gomega.BeTrue())
+			udnController.bnc.nadController = nadController
+
+			eq := newEgressQoSObject("default", namespaceT.Name, []egressqosapi.EgressQoSRule{
+				{
+					DstCIDR: &dst,
+					DSCP:    ptr.To(50),
+				},
+			})
+			eq.ResourceVersion = "1"
+			_, err = fakeOVN.fakeClient.EgressQoSClient.K8sV1().EgressQoSes(namespaceT.Name).Create(context.TODO(), eq, metav1.CreateOptions{})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			fakeOVN.InitAndRunEgressQoSController()
+			gomega.Expect(udnController.bnc.startEgressQoSController()).To(gomega.Succeed())
+
+			// the QoS rows are owned by the controller of the user defined
+			// network and only set on its switches
+			qos := &nbdb.QoS{
+				Direction:   nbdb.QoSDirectionToLport,
+				Match:       fmt.Sprintf("(ip4.dst == 1.2.3.4/32) && ip4.src == $%s", udnASv4),
+				Priority:    EgressQoSFlowStartPriority,
+				Action:      map[string]int{nbdb.QoSActionDSCP: 50},
+				ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority, udnControllerName).GetExternalIDs(),
+				UUID:        "qos-UUID",
+			}
+			udnNode1Switch.QOSRules = []string{qos.UUID}
+			expectedDatabaseState := []libovsdbtest.TestData{
+				qos,
+				node1Switch,
+				udnNode1Switch,
+			}
+
+			gomega.Eventually(fakeOVN.nbClient).Should(libovsdbtest.HaveDataIgnoringUUIDs(expectedDatabaseState))
+			gomega.Consistently(fakeOVN.nbClient).Should(libovsdbtest.HaveDataIgnoringUUIDs(expectedDatabaseState))
+
+			return nil
+		}
+
+		err := app.Run([]string{app.Name})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	})
+
 	ginkgo.DescribeTable("reconciles existing and non-existing egressqoses with PodSelectors",
 		func(ipv4Mode, ipv6Mode bool, podIP, dst1, dst2, match1, match2 string) {
 			app.Action = func(ctx *cli.Context) error {
@@ -254,7 +459,7 @@ var _ = ginkgo.Describe("OVN EgressQoS Operations", func() {
 					Match:       "some-match",
 					Priority:    EgressQoSFlowStartPriority,
 					Action:      map[string]int{nbdb.QoSActionDSCP: 50},
-					ExternalIDs: getEgressQoSRuleDbIDs("staleNS", EgressQoSFlowStartPriority).GetExternalIDs(),
+					ExternalIDs: getEgressQoSRuleDbIDs("staleNS", EgressQoSFlowStartPriority, DefaultNetworkControllerName).GetExternalIDs(),
 					UUID:        "staleQoS-UUID",
 				}
 				staleAddrSet, _ := addressset.GetTestDbAddrSets(
@@ -318,7 +523,7 @@ var _ = ginkgo.Describe("OVN EgressQoS Operations", func() {
 				eq := newEgressQoSObject("default", namespaceT.Name, []egressqosapi.EgressQoSRule{
 					{
 						DstCIDR: &dst1,
-						DSCP:    50,
+						DSCP:    ptr.To(50),
 						PodSelector: metav1.LabelSelector{
 							MatchLabels: map[string]string{
 								"app": "nice",
@@ -327,7 +532,7 @@ var _ = ginkgo.Describe("OVN EgressQoS Operations", func() {
 					},
 					{
 						DstCIDR: &dst2,
-						DSCP:    60,
+						DSCP:    ptr.To(60),
 					},
 				})
 				eq.ResourceVersion = "1"
@@ -341,7 +546,7 @@ var _ = ginkgo.Describe("OVN EgressQoS Operations", func() {
 					Match:       match1,
 					Priority:    EgressQoSFlowStartPriority,
 					Action:      map[string]int{nbdb.QoSActionDSCP: 50},
-					ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority).GetExternalIDs(),
+					ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority, DefaultNetworkControllerName).GetExternalIDs(),
 					UUID:        "qos1-UUID",
 				}
 				qos2 := &nbdb.QoS{
@@ -349,7 +554,7 @@ var _ = ginkgo.Describe("OVN EgressQoS Operations", func() {
 					Match:       match2,
 					Priority:    EgressQoSFlowStartPriority - 1,
 					Action:      map[string]int{nbdb.QoSActionDSCP: 60},
-					ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority-1).GetExternalIDs(),
+					ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority-1, DefaultNetworkControllerName).GetExternalIDs(),
 					UUID:        "qos2-UUID",
 				}
 				node1Switch.QOSRules = []string{qos1.UUID, qos2.UUID}
@@ -371,7 +576,7 @@ var _ = ginkgo.Describe("OVN EgressQoS Operations", func() {
 				eq.Spec.Egress = []egressqosapi.EgressQoSRule{
 					{
 						DstCIDR: &dst1,
-						DSCP:    40,
+						DSCP:    ptr.To(40),
 						PodSelector: metav1.LabelSelector{
 							MatchLabels: map[string]string{
 								"app": "nice",
@@ -388,7 +593,7 @@ var _ = ginkgo.Describe("OVN EgressQoS Operations", func() {
 					Match:       match1,
 					Priority:    EgressQoSFlowStartPriority,
 					Action:      map[string]int{nbdb.QoSActionDSCP: 40},
-					ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority).GetExternalIDs(),
+					ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority, DefaultNetworkControllerName).GetExternalIDs(),
 					UUID:        "qos3-UUID",
 				}
 				node1Switch.QOSRules = []string{qos3.UUID}
@@ -485,7 +690,7 @@ var _ = ginkgo.Describe("OVN EgressQoS Operations", func() {
 			eq := newEgressQoSObject("default", namespaceT.Name, []egressqosapi.EgressQoSRule{
 				{
 					DstCIDR: pointer.String("1.2.3.4/32"),
-					DSCP:    50,
+					DSCP:    ptr.To(50),
 					PodSelector: metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "foo",
 						Operator: "invalid_op", Values: []string{"bar", "bar"}}}},
 				},
@@ -550,11 +755,11 @@ var _ = ginkgo.Describe("OVN EgressQoS Operations", func() {
 			eq := newEgressQoSObject("default", namespaceT.Name, []egressqosapi.EgressQoSRule{
 				{
 					DstCIDR: pointer.String("1.2.3.4/32"),
-					DSCP:    50,
+					DSCP:    ptr.To(50),
 				},
 				{
 					DstCIDR: pointer.String("5.6.7.8/32"),
-					DSCP:    60,
+					DSCP:    ptr.To(60),
 				},
 			})
 			_, err := fakeOVN.fakeClient.EgressQoSClient.K8sV1().EgressQoSes(namespaceT.Name).Create(context.TODO(), eq, metav1.CreateOptions{})
@@ -567,7 +772,7 @@ var _ = ginkgo.Describe("OVN EgressQoS Operations", func() {
 				Match:       fmt.Sprintf("(ip4.dst == 1.2.3.4/32) && ip4.src == $%s", asv4),
 				Priority:    EgressQoSFlowStartPriority,
 				Action:      map[string]int{nbdb.QoSActionDSCP: 50},
-				ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority).GetExternalIDs(),
+				ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority, DefaultNetworkControllerName).GetExternalIDs(),
 				UUID:        "qos1-UUID",
 			}
 			qos2 := &nbdb.QoS{
@@ -575,7 +780,7 @@ var _ = ginkgo.Describe("OVN EgressQoS Operations", func() {
 				Match:       fmt.Sprintf("(ip4.dst == 5.6.7.8/32) && ip4.src == $%s", asv4),
 				Priority:    EgressQoSFlowStartPriority - 1,
 				Action:      map[string]int{nbdb.QoSActionDSCP: 60},
-				ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority-1).GetExternalIDs(),
+				ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority-1, DefaultNetworkControllerName).GetExternalIDs(),
 				UUID:        "qos2-UUID",
 			}
 			node1Switch.QOSRules = append(node1Switch.QOSRules, qos1.UUID, qos2.UUID)
@@ -676,11 +881,11 @@ var _ = ginkgo.Describe("OVN EgressQoS Operations", func() {
 			eq := newEgressQoSObject("default", namespaceT.Name, []egressqosapi.EgressQoSRule{
 				{
 					DstCIDR: pointer.String("1.2.3.4/32"),
-					DSCP:    50,
+					DSCP:    ptr.To(50),
 				},
 				{
 					DstCIDR: pointer.String("5.6.7.8/32"),
-					DSCP:    60,
+					DSCP:    ptr.To(60),
 				},
 			})
 			_, err := fakeOVN.fakeClient.EgressQoSClient.K8sV1().EgressQoSes(namespaceT.Name).Create(context.TODO(), eq, metav1.CreateOptions{})
@@ -693,7 +898,7 @@ var _ = ginkgo.Describe("OVN EgressQoS Operations", func() {
 				Match:       fmt.Sprintf("(ip4.dst == 1.2.3.4/32) && ip4.src == $%s", asv4),
 				Priority:    EgressQoSFlowStartPriority,
 				Action:      map[string]int{nbdb.QoSActionDSCP: 50},
-				ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority).GetExternalIDs(),
+				ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority, DefaultNetworkControllerName).GetExternalIDs(),
 				UUID:        "qos1-UUID",
 			}
 			qos2 := &nbdb.QoS{
@@ -701,7 +906,7 @@ var _ = ginkgo.Describe("OVN EgressQoS Operations", func() {
 				Match:       fmt.Sprintf("(ip4.dst == 5.6.7.8/32) && ip4.src == $%s", asv4),
 				Priority:    EgressQoSFlowStartPriority - 1,
 				Action:      map[string]int{nbdb.QoSActionDSCP: 60},
-				ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority-1).GetExternalIDs(),
+				ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority-1, DefaultNetworkControllerName).GetExternalIDs(),
 				UUID:        "qos2-UUID",
 			}
 			node1Switch.QOSRules = append(node1Switch.QOSRules, qos1.UUID, qos2.UUID)
@@ -823,11 +1028,11 @@ var _ = ginkgo.Describe("OVN EgressQoS Operations", func() {
 			eq := newEgressQoSObject("default", namespaceT.Name, []egressqosapi.EgressQoSRule{
 				{
 					DstCIDR: pointer.String("1.2.3.4/32"),
-					DSCP:    40,
+					DSCP:    ptr.To(40),
 				},
 				{
 					DstCIDR: pointer.String("5.6.7.8/32"),
-					DSCP:    50,
+					DSCP:    ptr.To(50),
 					PodSelector: metav1.LabelSelector{
 						MatchLabels: map[string]string{
 							"rule1": "1",
@@ -836,7 +1041,7 @@ var _ = ginkgo.Describe("OVN EgressQoS Operations", func() {
 				},
 				{
 					DstCIDR: pointer.String("5.6.7.8/32"),
-					DSCP:    60,
+					DSCP:    ptr.To(60),
 					PodSelector: metav1.LabelSelector{
 						MatchLabels: map[string]string{
 							"rule2": "2",
@@ -854,7 +1059,7 @@ var _ = ginkgo.Describe("OVN EgressQoS Operations", func() {
 				Match:       fmt.Sprintf("(ip4.dst == 1.2.3.4/32) && ip4.src == $%s", asv4),
 				Priority:    EgressQoSFlowStartPriority,
 				Action:      map[string]int{nbdb.QoSActionDSCP: 40},
-				ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority).GetExternalIDs(),
+				ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority, DefaultNetworkControllerName).GetExternalIDs(),
 				UUID:        "qos1-UUID",
 			}
 			qosAS := getEgressQosAddrSetDbIDs(namespaceT.Name, fmt.Sprintf("%d", EgressQoSFlowStartPriority-1), controllerName)
@@ -864,7 +1069,7 @@ var _ = ginkgo.Describe("OVN EgressQoS Operations", func() {
 				Match:       fmt.Sprintf("(ip4.dst == 5.6.7.8/32) && ip4.src == $%s", qosASv4),
 				Priority:    EgressQoSFlowStartPriority - 1,
 				Action:      map[string]int{nbdb.QoSActionDSCP: 50},
-				ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority-1).GetExternalIDs(),
+				ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority-1, DefaultNetworkControllerName).GetExternalIDs(),
 				UUID:        "qos2-UUID",
 			}
 			qosAS = getEgressQosAddrSetDbIDs(namespaceT.Name, fmt.Sprintf("%d", EgressQoSFlowStartPriority-2), controllerName)
@@ -874,7 +1079,7 @@ var _ = ginkgo.Describe("OVN EgressQoS Operations", func() {
 				Match:       fmt.Sprintf("(ip4.dst == 5.6.7.8/32) && ip4.src == $%s", qosASv4),
 				Priority:    EgressQoSFlowStartPriority - 2,
 				Action:      map[string]int{nbdb.QoSActionDSCP: 60},
-				ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority-2).GetExternalIDs(),
+				ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority-2, DefaultNetworkControllerName).GetExternalIDs(),
 				UUID:        "qos3-UUID",
 			}
 			node1Switch.QOSRules = append(node1Switch.QOSRules, qos1.UUID, qos2.UUID, qos3.UUID)
@@ -958,11 +1163,11 @@ var _ = ginkgo.Describe("OVN EgressQoS Operations", func() {
 			eq := newEgressQoSObject("default", namespaceT.Name, []egressqosapi.EgressQoSRule{
 				{
 					DstCIDR: pointer.String("1.2.3.4/32"),
-					DSCP:    40,
+					DSCP:    ptr.To(40),
 				},
 				{
 					DstCIDR: pointer.String("5.6.7.8/32"),
-					DSCP:    50,
+					DSCP:    ptr.To(50),
 					PodSelector: metav1.LabelSelector{
 						MatchLabels: map[string]string{
 							"rule1": "1",
@@ -997,7 +1202,7 @@ var _ = ginkgo.Describe("OVN EgressQoS Operations", func() {
 				Match:       fmt.Sprintf("(ip4.dst == 1.2.3.4/32) && ip4.src == $%s", asv4),
 				Priority:    EgressQoSFlowStartPriority,
 				Action:      map[string]int{nbdb.QoSActionDSCP: 40},
-				ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority).GetExternalIDs(),
+				ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority, DefaultNetworkControllerName).GetExternalIDs(),
 				UUID:        "qos1-UUID",
 			}
 			qosAS := getEgressQosAddrSetDbIDs(namespaceT.Name, fmt.Sprintf("%d", EgressQoSFlowStartPriority-1), controllerName)
@@ -1007,7 +1212,7 @@ var _ = ginkgo.Describe("OVN EgressQoS Operations", func() {
 				Match:       fmt.Sprintf("(ip4.dst == 5.6.7.8/32) && ip4.src == $%s", qosASv4),
 				Priority:    EgressQoSFlowStartPriority - 1,
 				Action:      map[string]int{nbdb.QoSActionDSCP: 50},
-				ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority-1).GetExternalIDs(),
+				ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority-1, DefaultNetworkControllerName).GetExternalIDs(),
 				UUID:        "qos2-UUID",
 			}
 			nodeSwitch.QOSRules = append(nodeSwitch.QOSRules, qos1.UUID, qos2.UUID)
@@ -627,7 +627,11 @@ func (gw *GatewayManager) GatewayInit(
 			// If migrating from local to shared gateway, let's remove the static routes towards
 			// management port interface for the hostSubnet prefix before adding the routes
 			// towards join switch.
-			mgmtIfAddr := util.GetNodeManagementIfAddr(hostSubnet)
+			primarySubnet, err := util.MatchFirstIPNetFamily(utilnet.IsIPv6CIDR(hostSubnet), hostSubnets)
+			if err != nil {
+				return err
+			}
+			mgmtIfAddr := util.GetNodeManagementIfAddr(primarySubnet)
 			gw.staticRouteCleanup([]net.IP{mgmtIfAddr.IP}, hostSubnet)
 
 			if err := libovsdbops.CreateOrReplaceLogicalRouterStaticRouteWithPredicate(
@@ -1266,7 +1270,7 @@ func (gw *GatewayManager) syncGatewayLogicalNetwork(
 	if gw.clusterRouterName == "" {
 		routerName = gw.gwRouterName
 	}
-	for _, subnet := range hostSubnets {
+	for _, subnet := range util.GetNodePrimaryHostSubnets(hostSubnets) {
 		mgmtIfAddr := util.GetNodeManagementIfAddr(subnet)
 		if mgmtIfAddr == nil {
 			return fmt.Errorf("management interface address not found for subnet %q on network %q", subnet, gw.netInfo.GetNetworkName())
@@ -4,13 +4,20 @@ import (
 	"fmt"
 	"net"
 
+	utilnet "k8s.io/utils/net"
+
 	ipam "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/allocator/ip"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/allocator/ip/subnet"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
 )
 
 var SwitchNotFound = subnet.ErrSubnetNotFound
 
+// hostSubnetPressureThreshold is the ratio of allocated IPs in the host subnets
+// of an IP family above which the node needs another host subnet of the family
+const hostSubnetPressureThreshold = 0.9
+
 // LogicalSwitchManager provides switch info management APIs including IPAM for the host subnets
 type LogicalSwitchManager struct {
 	allocator  subnet.Allocator
@@ -21,7 +28,7 @@ type LogicalSwitchManager struct {
 // networks.
 func NewLogicalSwitchManager() *LogicalSwitchManager {
 	return &LogicalSwitchManager{
-		allocator:  subnet.NewAllocator(),
+		allocator:  subnet.NewHostSubnetAllocator(),
 		reserveIPs: true,
 	}
 }
@@ -45,7 +52,10 @@ func NewL2SwitchManager() *LogicalSwitchManager {
 // A user defined primary network auto-reserves the .1 and .2 IP addresses,
 // which are required for egressing the cluster over this user defined network.
 func NewL2SwitchManagerForUserDefinedPrimaryNetwork() *LogicalSwitchManager {
-	return NewLogicalSwitchManager()
+	return &LogicalSwitchManager{
+		allocator:  subnet.NewAllocator(),
+		reserveIPs: true,
+	}
 }
 
 // AddOrUpdateSwitch adds/updates a switch to the logical switch manager for subnet
@@ -53,7 +63,11 @@ func NewL2SwitchManagerForUserDefinedPrimaryNetwork() *LogicalSwitchManager {
 func (manager *LogicalSwitchManager) AddOrUpdateSwitch(switchName string, hostSubnets []*net.IPNet, excludeSubnets ...*net.IPNet) error {
 	if manager.reserveIPs {
 		for _, hostSubnet := range hostSubnets {
-			for _, ip := range []*net.IPNet{util.GetNodeGatewayIfAddr(hostSubnet), util.GetNodeManagementIfAddr(hostSubnet)} {
+			reservedIPs := []*net.IPNet{util.GetNodeGatewayIfAddr(hostSubnet), util.GetNodeManagementIfAddr(hostSubnet)}
+			if config.OVNKubernetesFeature.EnableLBHealthChecks {
+				reservedIPs = append(reservedIPs, util.GetNodeServiceMonitorIfAddr(hostSubnet))
+			}
+			for _, ip := range reservedIPs {
 				excludeSubnets = append(excludeSubnets,
 					&net.IPNet{IP: ip.IP, Mask: util.GetIPFullMask(ip.IP)},
 				)
@@ -89,6 +103,36 @@ func (manager *LogicalSwitchManager) GetSwitchSubnets(switchName string) []*net.
 	return subnets
 }
 
+// GetHostSubnetRequest returns the number of host subnets of each IP family the
+// switch needs: one more than it has for the IP families whose host subnets are
+// running out of IPs.
+func (manager *LogicalSwitchManager) GetHostSubnetRequest(switchName string) (util.NodeSubnetRequest, error) {
+	usage, err := manager.allocator.GetSubnetsUsage(switchName)
+	if err != nil {
+		return util.NodeSubnetRequest{}, err
+	}
+	var request util.NodeSubnetRequest
+	var v4Used, v4Total, v6Used, v6Total int
+	for _, subnetUsage := range usage {
+		if utilnet.IsIPv6CIDR(subnetUsage.Subnet) {
+			request.IPv6++
+			v6Used += subnetUsage.Used
+			v6Total += subnetUsage.Total
+		} else {
+			request.IPv4++
+			v4Used += subnetUsage.Used
+			v4Total += subnetUsage.Total
+		}
+	}
+	if v4Total > 0 && float64(v4Used) >= hostSubnetPressureThreshold*float64(v4Total) {
+		request.IPv4++
+	}
+	if v6Total > 0 && float64(v6Used) >= hostSubnetPressureThreshold*float64(v6Total) {
+		request.IPv6++
+	}
+	return request, nil
+}
+
 // AllocateUntilFull used for unit testing only, allocates the rest of the switch subnet
 func (manager *LogicalSwitchManager) AllocateUntilFull(switchName string) error {
 	return manager.allocator.AllocateUntilFull(switchName)
@@ -124,7 +168,7 @@ func (manager *LogicalSwitchManager) AllocateHybridOverlay(switchName string, hy
 	}
 
 	// if we are not provided with any addresses, try to allocate the well known address
-	hostSubnets := manager.GetSwitchSubnets(switchName)
+	hostSubnets := util.GetNodePrimaryHostSubnets(manager.GetSwitchSubnets(switchName))
 	for _, hostSubnet := range hostSubnets {
 		allocatedAddresses = append(allocatedAddresses, util.GetNodeHybridOverlayIfAddr(hostSubnet))
 	}
@@ -152,7 +196,7 @@ func (manager *LogicalSwitchManager) AllocateHybridOverlay(switchName string, hy
 // by releasing them from the IPAM pool of allocated IPs.
 // If there aren't IPs to release the method does not return an error.
 func (manager *LogicalSwitchManager) ReleaseIPs(switchName string, ipnets []*net.IPNet) error {
-	return manager.allocator.ReleaseIPs(switchName, ipnets)
+	return manager.allocator.ReleaseIPs(switchName, manager.filterReservedIPs(switchName, ipnets))
 }
 
 // ConditionalIPRelease determines if any IP is available to be released from an IPAM conditionally if func is true.
@@ -162,6 +206,27 @@ func (manager *LogicalSwitchManager) ConditionalIPRelease(switchName string, ipn
 	return manager.allocator.ConditionalIPRelease(switchName, ipnets, predicate)
 }
 
+// filterReservedIPs returns the given IPs but the service monitor IPs of the
+// switch. On upgrades, the service monitor IP might have been given to a pod
+// before it was reserved: it is kept allocated once the pod releases it so
+// that it is not given to another pod.
+func (manager *LogicalSwitchManager) filterReservedIPs(switchName string, ipnets []*net.IPNet) []*net.IPNet {
+	if !manager.reserveIPs || !config.OVNKubernetesFeature.EnableLBHealthChecks {
+		return ipnets
+	}
+	reserved := map[string]bool{}
+	for _, hostSubnet := range manager.GetSwitchSubnets(switchName) {
+		reserved[util.GetNodeServiceMonitorIfAddr(hostSubnet).IP.String()] = true
+	}
+	filtered := make([]*net.IPNet, 0, len(ipnets))
+	for _, ipnet := range ipnets {
+		if !reserved[ipnet.IP.String()] {
+			filtered = append(filtered, ipnet)
+		}
+	}
+	return filtered
+}
+
 // ForSubnet return an IP allocator for the specified switch
 func (manager *LogicalSwitchManager) ForSwitch(switchName string) subnet.NamedAllocator {
 	return manager.allocator.ForSubnet(switchName)
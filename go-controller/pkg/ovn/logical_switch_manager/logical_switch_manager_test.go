@@ -9,6 +9,7 @@ import (
 	ipallocator "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/allocator/ip"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 	ovntest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
 
 	"github.com/onsi/ginkgo/v2"
 	"github.com/onsi/gomega"
@@ -149,6 +150,69 @@ var _ = ginkgo.Describe("OVN Logical Switch Manager operations", func() {
 			gomega.Expect(err).NotTo(gomega.HaveOccurred())
 		})
 	})
+
+	ginkgo.Context("when LB health checks are enabled", func() {
+		ginkgo.It("keeps the service monitor IP reserved once the pod that had it releases it", func() {
+			config.OVNKubernetesFeature.EnableLBHealthChecks = true
+			switchName := "testNode1"
+			err := lsManager.AddOrUpdateSwitch(switchName, ovntest.MustParseIPNets("10.1.1.0/24"))
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			// a pod got the service monitor IP before the upgrade
+			gomega.Expect(lsManager.isAllocatedIP(switchName, "10.1.1.4/24")).To(gomega.BeTrue())
+			err = lsManager.ReleaseIPs(switchName, ovntest.MustParseIPNets("10.1.1.4/24", "10.1.1.5/24"))
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(lsManager.isAllocatedIP(switchName, "10.1.1.4/24")).To(gomega.BeTrue())
+		})
+	})
+
+	ginkgo.Context("when the node runs out of IPs", func() {
+		ginkgo.It("requests another host subnet of the IP family", func() {
+			app.Action = func(ctx *cli.Context) error {
+				_, err := config.InitConfig(ctx, fexec, nil)
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+				testNode := testNodeSubnetData{
+					switchName: "testNode1",
+					subnets: []string{
+						"10.1.1.0/28",
+						"2000::/64",
+					},
+				}
+				err = lsManager.AddOrUpdateSwitch(testNode.switchName, ovntest.MustParseIPNets(testNode.subnets...))
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				request, err := lsManager.GetHostSubnetRequest(testNode.switchName)
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Expect(request).To(gomega.Equal(util.NodeSubnetRequest{IPv4: 1, IPv6: 1}))
+
+				// 14 usable IPs, .1 and .2 are reserved
+				for i := 0; i < 11; i++ {
+					_, err = lsManager.AllocateNextIPs(testNode.switchName)
+					gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				}
+				request, err = lsManager.GetHostSubnetRequest(testNode.switchName)
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Expect(request).To(gomega.Equal(util.NodeSubnetRequest{IPv4: 2, IPv6: 1}))
+
+				// the pods get IPs from the additional subnet once the first one is full
+				err = lsManager.AddOrUpdateSwitch(testNode.switchName, ovntest.MustParseIPNets(append(testNode.subnets, "10.1.2.0/28")...))
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				request, err = lsManager.GetHostSubnetRequest(testNode.switchName)
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Expect(request).To(gomega.Equal(util.NodeSubnetRequest{IPv4: 2, IPv6: 1}))
+				ips, err := lsManager.AllocateNextIPv4s(testNode.switchName)
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Expect(ips).To(gomega.Equal(ovntest.MustParseIPNets("10.1.1.14/28")))
+				ips, err = lsManager.AllocateNextIPv4s(testNode.switchName)
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Expect(ips).To(gomega.Equal(ovntest.MustParseIPNets("10.1.2.3/28")))
+
+				return nil
+			}
+			err := app.Run([]string{app.Name})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+	})
 })
 
 var _ = ginkgo.Describe("OVN Logical Switch Manager operations for layer2 user defined networks", func() {
@@ -110,6 +110,12 @@ func (oc *DefaultNetworkController) SetupMaster(existingNodeNames []string) erro
 		}
 	}
 
+	// Enable or disable the encryption of the traffic between the nodes, the
+	// IPsec certificates of each node are managed by ovnkube-node.
+	if err := libovsdbops.UpdateNBGlobalIPsec(oc.nbClient, config.IPsec.Enabled); err != nil {
+		return fmt.Errorf("failed to update IPsec to enabled=%t: %w", config.IPsec.Enabled, err)
+	}
+
 	// Create OVNJoinSwitch that will be used to connect gateway routers to the distributed router.
 	return oc.gatewayTopologyFactory.NewJoinSwitch(logicalRouter, oc.NetInfo, oc.ovnClusterLRPToJoinIfAddrs)
 }
@@ -12,6 +12,7 @@ import (
 	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
 	libovsdbutil "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/util"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	ovntest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
@@ -730,4 +731,76 @@ var _ = ginkgo.Describe("OVN Multicast with IP Address Family", func() {
 			})
 		}
 	})
+
+	ginkgo.Context("on secondary networks", func() {
+		const secondaryNetworkName = "bluenet"
+
+		getSecondaryController := func() *BaseSecondaryNetworkController {
+			nad := ovntest.GenerateNAD(secondaryNetworkName, "rednad", namespaceName1,
+				types.Layer2Topology, "100.200.0.0/16", types.NetworkRoleSecondary)
+			gomega.Expect(fakeOvn.NewSecondaryNetworkController(nad)).To(gomega.Succeed())
+			controller, ok := fakeOvn.secondaryControllers[secondaryNetworkName]
+			gomega.Expect(ok).To(gomega.BeTrue())
+			return controller.bnc
+		}
+
+		ginkgo.It("creates and cleans up default Multicast ACLs", func() {
+			app.Action = func(ctx *cli.Context) error {
+				fakeOvn.startWithDBSetup(libovsdb.TestSetup{})
+				controller := getSecondaryController()
+				gomega.Expect(controller.multicastSupport).To(gomega.BeTrue())
+
+				err := controller.initClusterMulticast(false)
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+				acls := []*nbdb.ACL{}
+				for _, aclDir := range []libovsdbutil.ACLDirection{libovsdbutil.ACLEgress, libovsdbutil.ACLIngress} {
+					acl := libovsdbutil.BuildACL(
+						getDefaultMcastACLDbIDs(mcastDefaultDenyID, aclDir, controller.controllerName),
+						types.DefaultMcastDenyPriority,
+						getMulticastACLMatch(),
+						nbdb.ACLActionDrop,
+						nil,
+						libovsdbutil.ACLDirectionToACLPipeline(aclDir),
+					)
+					acl.UUID = string(aclDir) + "-deny-UUID"
+					acls = append(acls, acl)
+				}
+				clusterPortGroup := libovsdbutil.BuildPortGroup(
+					controller.getClusterPortGroupDbIDs(types.ClusterPortGroupNameBase), nil, acls)
+				clusterPortGroup.UUID = clusterPortGroup.Name + "-UUID"
+				gomega.Eventually(fakeOvn.nbClient).Should(libovsdb.HaveData(acls[0], acls[1], clusterPortGroup))
+
+				// the default multicast ACLs are removed with the cluster port
+				// group when multicast is disabled
+				controller.multicastSupport = false
+				err = controller.initClusterMulticast(false)
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Eventually(fakeOvn.nbClient).Should(libovsdb.HaveEmptyData())
+				return nil
+			}
+			err := app.Run([]string{app.Name})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+
+		ginkgo.It("applies the namespace multicast annotation to the attached pods", func() {
+			app.Action = func(ctx *cli.Context) error {
+				fakeOvn.startWithDBSetup(libovsdb.TestSetup{})
+				controller := getSecondaryController()
+
+				namespace1 := newNamespace(namespaceName1)
+				enabled, err := controller.isNamespaceMulticastEnabledForNetwork(namespace1)
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Expect(enabled).To(gomega.BeFalse())
+
+				namespace1.Annotations[util.NsMulticastAnnotation] = "true"
+				enabled, err = controller.isNamespaceMulticastEnabledForNetwork(namespace1)
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Expect(enabled).To(gomega.BeTrue())
+				return nil
+			}
+			err := app.Run([]string{app.Name})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+	})
 })
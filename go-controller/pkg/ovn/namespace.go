@@ -342,7 +342,7 @@ func (oc *DefaultNetworkController) getHostNamespaceAddressesForNode(node *kapi.
 	if err != nil {
 		return nil, err
 	}
-	for _, hostSubnet := range hostSubnets {
+	for _, hostSubnet := range util.GetNodePrimaryHostSubnets(hostSubnets) {
 		mgmtIfAddr := util.GetNodeManagementIfAddr(hostSubnet)
 		ips = append(ips, mgmtIfAddr.IP)
 	}
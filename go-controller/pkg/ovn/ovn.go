@@ -338,13 +338,6 @@ func (oc *DefaultNetworkController) removeRemoteZonePod(pod *kapi.Pod) error {
 	return nil
 }
 
-// WatchEgressFirewall starts the watching of egressfirewall resource and calls
-// back the appropriate handler logic
-func (oc *DefaultNetworkController) WatchEgressFirewall() error {
-	_, err := oc.retryEgressFirewalls.WatchResource()
-	return err
-}
-
 // WatchEgressNodes starts the watching of egress assignable nodes and calls
 // back the appropriate handler logic.
 func (oc *DefaultNetworkController) WatchEgressNodes() error {
@@ -430,6 +423,14 @@ func nodeSubnetChanged(oldNode, node *kapi.Node, netName string) bool {
 	return !reflect.DeepEqual(oldSubnets, newSubnets)
 }
 
+// nodeHostSubnetsAdded returns true when more host subnets have been allocated
+// to a node that already had some
+func nodeHostSubnetsAdded(oldNode, node *kapi.Node, netName string) bool {
+	oldSubnets, _ := util.ParseNodeHostSubnetAnnotation(oldNode, netName)
+	newSubnets, _ := util.ParseNodeHostSubnetAnnotation(node, netName)
+	return len(oldSubnets) > 0 && len(newSubnets) > len(oldSubnets)
+}
+
 func joinCIDRChanged(oldNode, node *kapi.Node, netName string) bool {
 	oldSubnets, _ := util.ParseNodeGatewayRouterJoinNetwork(oldNode, netName)
 	newSubnets, _ := util.ParseNodeGatewayRouterJoinNetwork(node, netName)
@@ -512,6 +513,7 @@ func (oc *DefaultNetworkController) newANPController() error {
 	var err error
 	oc.anpController, err = anpcontroller.NewController(
 		DefaultNetworkControllerName,
+		oc.NetInfo,
 		oc.nbClient,
 		oc.kube.ANPClient,
 		oc.watchFactory.ANPInformer(),
@@ -521,6 +523,7 @@ func (oc *DefaultNetworkController) newANPController() error {
 		oc.watchFactory.NodeCoreInformer(),
 		oc.addressSetFactory,
 		oc.isPodScheduledinLocalZone,
+		oc.getActiveNetworkForNamespace,
 		oc.zone,
 		oc.recorder,
 		oc.observManager,
@@ -441,9 +441,9 @@ func (o *FakeOVN) NewSecondaryNetworkController(netattachdef *nettypes.NetworkAt
 			o.nbClient,
 			o.sbClient,
 			&podRecorder,
-			false, // sctp support
-			false, // multicast support
-			true,  // templates support
+			false,                  // sctp support
+			config.EnableMulticast, // multicast support
+			true,                   // templates support
 		)
 		if err != nil {
 			return err
@@ -209,6 +209,9 @@ func (h *secondaryLayer2NetworkControllerEventHandler) SyncFunc(objs []interface
 		case factory.MultiNetworkPolicyType:
 			syncFunc = h.oc.syncMultiNetworkPolicies
 
+		case factory.EgressFirewallType:
+			syncFunc = h.oc.syncEgressFirewall
+
 		case factory.IPAMClaimsType:
 			syncFunc = h.oc.syncIPAMClaims
 
@@ -276,7 +279,7 @@ func NewSecondaryLayer2NetworkController(cnci *CommonNetworkControllerInfo, netI
 	if util.IsNetworkSegmentationSupportEnabled() {
 		var err error
 		svcController, err = svccontroller.NewController(
-			cnci.client, cnci.nbClient,
+			cnci.client, cnci.nbClient, cnci.sbClient,
 			cnci.watchFactory.ServiceCoreInformer(),
 			cnci.watchFactory.EndpointSliceCoreInformer(),
 			cnci.watchFactory.NodeCoreInformer(),
@@ -341,10 +344,6 @@ func NewSecondaryLayer2NetworkController(cnci *CommonNetworkControllerInfo, netI
 			claimsReconciler)
 	}
 
-	// disable multicast support for secondary networks
-	// TBD: changes needs to be made to support multicast in secondary networks
-	oc.multicastSupport = false
-
 	oc.initRetryFramework()
 	return oc, nil
 }
@@ -437,7 +436,11 @@ func (oc *SecondaryLayer2NetworkController) Init() error {
 		return err
 	}
 
-	return err
+	if err := oc.initClusterMulticast(false); err != nil {
+		return fmt.Errorf("failed to initialize multicast for network %q: %w", oc.GetNetworkName(), err)
+	}
+
+	return nil
 }
 
 func (oc *SecondaryLayer2NetworkController) Stop() {
@@ -457,6 +460,7 @@ func (oc *SecondaryLayer2NetworkController) initRetryFramework() {
 	if oc.IsPrimaryNetwork() {
 		oc.retryNamespaces = oc.newRetryFramework(factory.NamespaceType)
 		oc.retryNetworkPolicies = oc.newRetryFramework(factory.PolicyType)
+		oc.retryEgressFirewalls = oc.newRetryFramework(factory.EgressFirewallType)
 	}
 
 	// For secondary networks, we don't have to watch namespace events if
@@ -466,6 +470,12 @@ func (oc *SecondaryLayer2NetworkController) initRetryFramework() {
 		oc.retryNamespaces = oc.newRetryFramework(factory.NamespaceType)
 		oc.retryMultiNetworkPolicies = oc.newRetryFramework(factory.MultiNetworkPolicyType)
 	}
+
+	// Multicast is enabled per namespace, so watch for namespace events when
+	// multicast is supported.
+	if oc.multicastSupport && oc.retryNamespaces == nil {
+		oc.retryNamespaces = oc.newRetryFramework(factory.NamespaceType)
+	}
 }
 
 // newRetryFramework builds and returns a retry framework for the input resource type;
@@ -166,6 +166,8 @@ func (h *secondaryLayer3NetworkControllerEventHandler) UpdateResource(oldObj, ne
 			if h.oc.isLocalZoneNode(oldNode) {
 				// determine what actually changed in this update
 				_, nodeSync := h.oc.addNodeFailed.Load(newNode.Name)
+				// the node switch IPAM is updated with the additional host subnets
+				nodeSync = nodeSync || nodeHostSubnetsAdded(oldNode, newNode, h.oc.NetInfo.GetNetworkName())
 				_, failed := h.oc.nodeClusterRouterPortFailed.Load(newNode.Name)
 				clusterRtrSync := failed || nodeChassisChanged(oldNode, newNode) || nodeSubnetChanged
 				_, failed = h.oc.mgmtPortFailed.Load(newNode.Name)
@@ -256,6 +258,9 @@ func (h *secondaryLayer3NetworkControllerEventHandler) SyncFunc(objs []interface
 		case factory.MultiNetworkPolicyType:
 			syncFunc = h.oc.syncMultiNetworkPolicies
 
+		case factory.EgressFirewallType:
+			syncFunc = h.oc.syncEgressFirewall
+
 		default:
 			return fmt.Errorf("no sync function for object type %s", h.objType)
 		}
@@ -322,7 +327,7 @@ func NewSecondaryLayer3NetworkController(cnci *CommonNetworkControllerInfo, netI
 	if util.IsNetworkSegmentationSupportEnabled() && netInfo.IsPrimaryNetwork() {
 		var err error
 		svcController, err = svccontroller.NewController(
-			cnci.client, cnci.nbClient,
+			cnci.client, cnci.nbClient, cnci.sbClient,
 			cnci.watchFactory.ServiceCoreInformer(),
 			cnci.watchFactory.EndpointSliceCoreInformer(),
 			cnci.watchFactory.NodeCoreInformer(),
@@ -376,10 +381,6 @@ func NewSecondaryLayer3NetworkController(cnci *CommonNetworkControllerInfo, netI
 		oc.podAnnotationAllocator = podAnnotationAllocator
 	}
 
-	// disable multicast support for secondary networks
-	// TBD: changes needs to be made to support multicast in secondary networks
-	oc.multicastSupport = false
-
 	oc.initRetryFramework()
 	return oc, nil
 }
@@ -393,6 +394,7 @@ func (oc *SecondaryLayer3NetworkController) initRetryFramework() {
 	if oc.IsPrimaryNetwork() {
 		oc.retryNamespaces = oc.newRetryFramework(factory.NamespaceType)
 		oc.retryNetworkPolicies = oc.newRetryFramework(factory.PolicyType)
+		oc.retryEgressFirewalls = oc.newRetryFramework(factory.EgressFirewallType)
 	}
 
 	// For secondary networks, we don't have to watch namespace events if
@@ -402,6 +404,12 @@ func (oc *SecondaryLayer3NetworkController) initRetryFramework() {
 		oc.retryNamespaces = oc.newRetryFramework(factory.NamespaceType)
 		oc.retryMultiNetworkPolicies = oc.newRetryFramework(factory.MultiNetworkPolicyType)
 	}
+
+	// Multicast is enabled per namespace, so watch for namespace events when
+	// multicast is supported.
+	if oc.multicastSupport && oc.retryNamespaces == nil {
+		oc.retryNamespaces = oc.newRetryFramework(factory.NamespaceType)
+	}
 }
 
 // newRetryFramework builds and returns a retry framework for the input resource type;
@@ -449,6 +457,11 @@ func (oc *SecondaryLayer3NetworkController) Stop() {
 	oc.cancelableCtx.Cancel()
 	oc.wg.Wait()
 
+	if oc.networkPeeringController != nil {
+		oc.networkPeeringController.Stop()
+	}
+	oc.stopEgressFirewall()
+
 	if oc.netPolicyHandler != nil {
 		oc.watchFactory.RemovePolicyHandler(oc.netPolicyHandler)
 	}
@@ -525,6 +538,8 @@ func (oc *SecondaryLayer3NetworkController) Cleanup() error {
 			return fmt.Errorf("failed to delete interconnect transit switch of network %s: %v", netName, err)
 		}
 	}
+
+	oc.cleanupANPStatuses()
 	return nil
 }
 
@@ -570,6 +585,20 @@ func (oc *SecondaryLayer3NetworkController) Run() error {
 		if err := oc.WatchNetworkPolicy(); err != nil {
 			return err
 		}
+		// the admin network policy controller depends on pods being set up
+		// on the network
+		if err := oc.startANPController(); err != nil {
+			return err
+		}
+		if err := oc.startEgressQoSController(); err != nil {
+			return err
+		}
+		if err := oc.startEgressFirewallController(); err != nil {
+			return err
+		}
+		if err := oc.startNetworkPeeringController(); err != nil {
+			return err
+		}
 	}
 
 	klog.Infof("Completing all the Watchers for network %s took %v", oc.GetNetworkName(), time.Since(start))
@@ -607,6 +636,10 @@ func (oc *SecondaryLayer3NetworkController) Init(ctx context.Context) error {
 		return fmt.Errorf("failed to create OVN cluster router for network %q: %v", oc.GetNetworkName(), err)
 	}
 
+	if err := oc.initClusterMulticast(true); err != nil {
+		return fmt.Errorf("failed to initialize multicast for network %q: %w", oc.GetNetworkName(), err)
+	}
+
 	// Only configure join switch and GR for user defined primary networks.
 	if util.IsNetworkSegmentationSupportEnabled() && oc.IsPrimaryNetwork() {
 		if err := oc.gatewayTopologyFactory.NewJoinSwitch(clusterRouter, oc.NetInfo, oc.ovnClusterLRPToJoinIfAddrs); err != nil {
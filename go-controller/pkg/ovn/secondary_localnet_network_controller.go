@@ -235,8 +235,8 @@ func NewSecondaryLocalnetNetworkController(cnci *CommonNetworkControllerInfo, ne
 			claimsReconciler)
 	}
 
-	// disable multicast support for secondary networks
-	// TBD: changes needs to be made to support multicast in secondary networks
+	// disable multicast support for localnet networks
+	// TBD: changes needs to be made to support multicast in localnet networks
 	oc.multicastSupport = false
 
 	oc.initRetryFramework()
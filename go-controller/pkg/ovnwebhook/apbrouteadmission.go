@@ -0,0 +1,112 @@
+package ovnwebhook
+
+import (
+	"context"
+	"fmt"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	listers "k8s.io/client-go/listers/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	adminpolicybasedrouteapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/adminpolicybasedroute/v1"
+	adminpolicybasedroutelisters "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/adminpolicybasedroute/v1/apis/listers/adminpolicybasedroute/v1"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/controller/apbroute/gateway_info"
+)
+
+// AdminPolicyBasedExternalRouteAdmission rejects the AdminPolicyBasedExternalRoutes
+// with invalid static hops or targeting namespaces already targeted by another
+// policy
+type AdminPolicyBasedExternalRouteAdmission struct {
+	routeLister     adminpolicybasedroutelisters.AdminPolicyBasedExternalRouteLister
+	namespaceLister listers.NamespaceLister
+}
+
+func NewAdminPolicyBasedExternalRouteAdmissionWebhook(routeLister adminpolicybasedroutelisters.AdminPolicyBasedExternalRouteLister,
+	namespaceLister listers.NamespaceLister) *AdminPolicyBasedExternalRouteAdmission {
+	return &AdminPolicyBasedExternalRouteAdmission{
+		routeLister:     routeLister,
+		namespaceLister: namespaceLister,
+	}
+}
+
+var _ admission.CustomValidator = &AdminPolicyBasedExternalRouteAdmission{}
+
+func (a AdminPolicyBasedExternalRouteAdmission) ValidateCreate(_ context.Context, obj runtime.Object) (warnings admission.Warnings, err error) {
+	return nil, a.validateRoute(obj.(*adminpolicybasedrouteapi.AdminPolicyBasedExternalRoute))
+}
+
+func (a AdminPolicyBasedExternalRouteAdmission) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (warnings admission.Warnings, err error) {
+	oldRoute := oldObj.(*adminpolicybasedrouteapi.AdminPolicyBasedExternalRoute)
+	newRoute := newObj.(*adminpolicybasedrouteapi.AdminPolicyBasedExternalRoute)
+	// skip the metadata and status only updates, e.g. the controller reporting
+	// the policy status, so that a policy that conflicts with another one can
+	// still be updated and deleted
+	if newRoute.DeletionTimestamp != nil || apiequality.Semantic.DeepEqual(oldRoute.Spec, newRoute.Spec) {
+		return nil, nil
+	}
+	return nil, a.validateRoute(newRoute)
+}
+
+func (a AdminPolicyBasedExternalRouteAdmission) ValidateDelete(_ context.Context, _ runtime.Object) (warnings admission.Warnings, err error) {
+	return nil, nil
+}
+
+func (a AdminPolicyBasedExternalRouteAdmission) validateRoute(route *adminpolicybasedrouteapi.AdminPolicyBasedExternalRoute) error {
+	if _, err := gateway_info.NewGatewayInfoListFromStaticHops(route.Spec.NextHops.StaticHops); err != nil {
+		return err
+	}
+	for _, hop := range route.Spec.NextHops.DynamicHops {
+		if _, err := metav1.LabelSelectorAsSelector(&hop.PodSelector); err != nil {
+			return fmt.Errorf("invalid dynamic hop pod selector: %w", err)
+		}
+		if _, err := metav1.LabelSelectorAsSelector(&hop.NamespaceSelector); err != nil {
+			return fmt.Errorf("invalid dynamic hop namespace selector: %w", err)
+		}
+	}
+
+	targetNamespaces, err := a.getTargetNamespaces(route)
+	if err != nil {
+		return err
+	}
+	if targetNamespaces.Len() == 0 {
+		return nil
+	}
+	routes, err := a.routeLister.List(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("failed to list AdminPolicyBasedExternalRoutes: %w", err)
+	}
+	for _, otherRoute := range routes {
+		if otherRoute.Name == route.Name {
+			continue
+		}
+		otherTargetNamespaces, err := a.getTargetNamespaces(otherRoute)
+		if err != nil {
+			// the other policy is not applied, it does not affect any namespace
+			continue
+		}
+		if overlap := targetNamespaces.Intersection(otherTargetNamespaces); overlap.Len() > 0 {
+			return fmt.Errorf("namespaces %v are already affected by another policy: %s", sets.List(overlap), otherRoute.Name)
+		}
+	}
+	return nil
+}
+
+func (a AdminPolicyBasedExternalRouteAdmission) getTargetNamespaces(route *adminpolicybasedrouteapi.AdminPolicyBasedExternalRoute) (sets.Set[string], error) {
+	selector, err := metav1.LabelSelectorAsSelector(&route.Spec.From.NamespaceSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid namespace selector: %w", err)
+	}
+	namespaces, err := a.namespaceLister.List(selector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list namespaces: %w", err)
+	}
+	targetNamespaces := sets.New[string]()
+	for _, namespace := range namespaces {
+		targetNamespaces.Insert(namespace.Name)
+	}
+	return targetNamespaces, nil
+}
@@ -0,0 +1,134 @@
+package ovnwebhook
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	listersv1 "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	adminpolicybasedrouteapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/adminpolicybasedroute/v1"
+	adminpolicybasedroutelisters "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/adminpolicybasedroute/v1/apis/listers/adminpolicybasedroute/v1"
+)
+
+func newAdminPolicyBasedExternalRoute(name string, targetLabels map[string]string, staticHopIPs ...string) *adminpolicybasedrouteapi.AdminPolicyBasedExternalRoute {
+	route := &adminpolicybasedrouteapi.AdminPolicyBasedExternalRoute{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: adminpolicybasedrouteapi.AdminPolicyBasedExternalRouteSpec{
+			From: adminpolicybasedrouteapi.ExternalNetworkSource{
+				NamespaceSelector: metav1.LabelSelector{MatchLabels: targetLabels},
+			},
+		},
+	}
+	for _, ip := range staticHopIPs {
+		route.Spec.NextHops.StaticHops = append(route.Spec.NextHops.StaticHops, &adminpolicybasedrouteapi.StaticHop{IP: ip})
+	}
+	return route
+}
+
+func newTestAdminPolicyBasedExternalRouteAdmission(t *testing.T) *AdminPolicyBasedExternalRouteAdmission {
+	namespaceIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	routeIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, obj := range []interface{}{
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "blue", Labels: map[string]string{"team": "blue"}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "red", Labels: map[string]string{"team": "red"}}},
+	} {
+		if err := namespaceIndexer.Add(obj); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := routeIndexer.Add(newAdminPolicyBasedExternalRoute("blue", map[string]string{"team": "blue"}, "1.1.1.1")); err != nil {
+		t.Fatal(err)
+	}
+	return NewAdminPolicyBasedExternalRouteAdmissionWebhook(
+		adminpolicybasedroutelisters.NewAdminPolicyBasedExternalRouteLister(routeIndexer),
+		listersv1.NewNamespaceLister(namespaceIndexer),
+	)
+}
+
+func TestAdminPolicyBasedExternalRouteAdmission(t *testing.T) {
+	apbAdmission := newTestAdminPolicyBasedExternalRouteAdmission(t)
+
+	tests := []struct {
+		name        string
+		obj         *adminpolicybasedrouteapi.AdminPolicyBasedExternalRoute
+		expectedErr string
+	}{
+		{
+			name: "allows routes targeting other namespaces",
+			obj:  newAdminPolicyBasedExternalRoute("red", map[string]string{"team": "red"}, "1.1.1.2", "fd00::2"),
+		},
+		{
+			name: "allows updates of a route",
+			obj:  newAdminPolicyBasedExternalRoute("blue", map[string]string{"team": "blue"}, "1.1.1.2"),
+		},
+		{
+			name:        "rejects routes targeting namespaces of another route",
+			obj:         newAdminPolicyBasedExternalRoute("all", nil, "1.1.1.2"),
+			expectedErr: "namespaces [blue] are already affected by another policy: blue",
+		},
+		{
+			name:        "rejects invalid static hops",
+			obj:         newAdminPolicyBasedExternalRoute("red", map[string]string{"team": "red"}, "1.1.1"),
+			expectedErr: "could not parse routing static gw annotation value '1.1.1'",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := apbAdmission.ValidateCreate(context.TODO(), tt.obj)
+			if tt.expectedErr == "" && err != nil || tt.expectedErr != "" && (err == nil || err.Error() != tt.expectedErr) {
+				t.Errorf("ValidateCreate() error = %v, expectedErr %v", err, tt.expectedErr)
+			}
+		})
+	}
+}
+
+func TestAdminPolicyBasedExternalRouteAdmissionUpdate(t *testing.T) {
+	apbAdmission := newTestAdminPolicyBasedExternalRouteAdmission(t)
+
+	// the route conflicts with the blue route, e.g. because it was created
+	// before the webhook was deployed
+	conflictingRoute := newAdminPolicyBasedExternalRoute("all", nil, "1.1.1.2")
+	conflictingRoute.Finalizers = []string{"example.com/finalizer"}
+	conflictingRouteWithStatus := conflictingRoute.DeepCopy()
+	conflictingRouteWithStatus.Status.Status = adminpolicybasedrouteapi.FailStatus
+	deletedConflictingRoute := conflictingRoute.DeepCopy()
+	deletedConflictingRoute.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	deletedConflictingRouteWithoutFinalizer := deletedConflictingRoute.DeepCopy()
+	deletedConflictingRouteWithoutFinalizer.Finalizers = nil
+
+	tests := []struct {
+		name        string
+		oldObj      *adminpolicybasedrouteapi.AdminPolicyBasedExternalRoute
+		newObj      *adminpolicybasedrouteapi.AdminPolicyBasedExternalRoute
+		expectedErr string
+	}{
+		{
+			name:   "allows status only updates of a conflicting route",
+			oldObj: conflictingRoute,
+			newObj: conflictingRouteWithStatus,
+		},
+		{
+			name:   "allows the finalizer removal of a conflicting route being deleted",
+			oldObj: deletedConflictingRoute,
+			newObj: deletedConflictingRouteWithoutFinalizer,
+		},
+		{
+			name:        "rejects spec updates making a route conflict with another route",
+			oldObj:      newAdminPolicyBasedExternalRoute("all", map[string]string{"team": "red"}, "1.1.1.2"),
+			newObj:      conflictingRoute,
+			expectedErr: "namespaces [blue] are already affected by another policy: blue",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := apbAdmission.ValidateUpdate(context.TODO(), tt.oldObj, tt.newObj)
+			if tt.expectedErr == "" && err != nil || tt.expectedErr != "" && (err == nil || err.Error() != tt.expectedErr) {
+				t.Errorf("ValidateUpdate() error = %v, expectedErr %v", err, tt.expectedErr)
+			}
+		})
+	}
+}
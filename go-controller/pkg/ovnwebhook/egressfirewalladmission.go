@@ -0,0 +1,66 @@
+package ovnwebhook
+
+import (
+	"context"
+	"fmt"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	egressfirewallapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressfirewall/v1"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+// egressFirewallName is the name of the single EgressFirewall allowed per namespace
+const egressFirewallName = "default"
+
+// EgressFirewallAdmission rejects the EgressFirewalls the network controller
+// would fail to apply
+type EgressFirewallAdmission struct{}
+
+func NewEgressFirewallAdmissionWebhook() *EgressFirewallAdmission {
+	return &EgressFirewallAdmission{}
+}
+
+var _ admission.CustomValidator = &EgressFirewallAdmission{}
+
+func (e EgressFirewallAdmission) ValidateCreate(_ context.Context, obj runtime.Object) (warnings admission.Warnings, err error) {
+	return nil, validateEgressFirewall(obj.(*egressfirewallapi.EgressFirewall))
+}
+
+func (e EgressFirewallAdmission) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (warnings admission.Warnings, err error) {
+	oldEgressFirewall := oldObj.(*egressfirewallapi.EgressFirewall)
+	newEgressFirewall := newObj.(*egressfirewallapi.EgressFirewall)
+	// skip the metadata and status only updates so that an EgressFirewall that
+	// is invalid with the current config can still be updated and deleted
+	if newEgressFirewall.DeletionTimestamp != nil ||
+		apiequality.Semantic.DeepEqual(oldEgressFirewall.Spec, newEgressFirewall.Spec) {
+		return nil, nil
+	}
+	return nil, validateEgressFirewall(newEgressFirewall)
+}
+
+func (e EgressFirewallAdmission) ValidateDelete(_ context.Context, _ runtime.Object) (warnings admission.Warnings, err error) {
+	return nil, nil
+}
+
+func validateEgressFirewall(egressFirewall *egressfirewallapi.EgressFirewall) error {
+	if egressFirewall.Name != egressFirewallName {
+		return fmt.Errorf("only one EgressFirewall is allowed per namespace, it must be named %q", egressFirewallName)
+	}
+	for i, rule := range egressFirewall.Spec.Egress {
+		if i > types.EgressFirewallStartPriority-types.MinimumReservedEgressFirewallPriority {
+			return fmt.Errorf("too many rules, max allowed number is %v",
+				types.EgressFirewallStartPriority-types.MinimumReservedEgressFirewallPriority)
+		}
+		if _, _, _, _, err := util.ValidateAndGetEgressFirewallDestination(rule.To); err != nil {
+			return fmt.Errorf("invalid rule %d: %w", i, err)
+		}
+		if err := util.ValidateEgressFirewallPortsAndICMP(rule.Ports, rule.ICMP); err != nil {
+			return fmt.Errorf("invalid rule %d: %w", i, err)
+		}
+	}
+	return nil
+}
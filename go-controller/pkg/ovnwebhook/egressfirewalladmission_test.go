@@ -0,0 +1,157 @@
+package ovnwebhook
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	egressfirewallapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressfirewall/v1"
+)
+
+func newEgressFirewall(name string, rules ...egressfirewallapi.EgressFirewallRule) *egressfirewallapi.EgressFirewall {
+	return &egressfirewallapi.EgressFirewall{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "namespace"},
+		Spec:       egressfirewallapi.EgressFirewallSpec{Egress: rules},
+	}
+}
+
+func TestEgressFirewallAdmission(t *testing.T) {
+	tests := []struct {
+		name                  string
+		obj                   *egressfirewallapi.EgressFirewall
+		enableDNSNameResolver bool
+		expectedErr           string
+	}{
+		{
+			name: "allows valid egress firewalls",
+			obj: newEgressFirewall("default",
+				egressfirewallapi.EgressFirewallRule{
+					Type: egressfirewallapi.EgressFirewallRuleAllow,
+					To:   egressfirewallapi.EgressFirewallDestination{CIDRSelector: "1.2.3.0/24"},
+					Ports: []egressfirewallapi.EgressFirewallPort{
+						{Protocol: "TCP", Port: 80, EndPort: 90},
+					},
+				},
+				egressfirewallapi.EgressFirewallRule{
+					Type: egressfirewallapi.EgressFirewallRuleDeny,
+					To:   egressfirewallapi.EgressFirewallDestination{DNSName: "www.example.com"},
+				},
+			),
+		},
+		{
+			name:        "rejects egress firewalls not named default",
+			obj:         newEgressFirewall("second"),
+			expectedErr: `only one EgressFirewall is allowed per namespace, it must be named "default"`,
+		},
+		{
+			name: "rejects invalid CIDR selectors",
+			obj: newEgressFirewall("default",
+				egressfirewallapi.EgressFirewallRule{
+					Type: egressfirewallapi.EgressFirewallRuleAllow,
+					To:   egressfirewallapi.EgressFirewallDestination{CIDRSelector: "1.2.3.0/33"},
+				},
+			),
+			expectedErr: "invalid rule 0: invalid CIDR address: 1.2.3.0/33",
+		},
+		{
+			name: "rejects invalid port ranges",
+			obj: newEgressFirewall("default",
+				egressfirewallapi.EgressFirewallRule{
+					Type: egressfirewallapi.EgressFirewallRuleAllow,
+					To:   egressfirewallapi.EgressFirewallDestination{CIDRSelector: "1.2.3.0/24"},
+					Ports: []egressfirewallapi.EgressFirewallPort{
+						{Protocol: "TCP", Port: 90, EndPort: 80},
+					},
+				},
+			),
+			expectedErr: "invalid rule 0: rule port range end 80 is lower than start 90",
+		},
+		{
+			name: "rejects wildcard DNS names without the DNS name resolver",
+			obj: newEgressFirewall("default",
+				egressfirewallapi.EgressFirewallRule{
+					Type: egressfirewallapi.EgressFirewallRuleAllow,
+					To:   egressfirewallapi.EgressFirewallDestination{DNSName: "*.example.com"},
+				},
+			),
+			expectedErr: "invalid rule 0: wildcard dns name *.example.com is only supported as rule destination when the DNS name resolver feature is enabled (--enable-dns-name-resolver)",
+		},
+		{
+			name: "allows wildcard DNS names with the DNS name resolver",
+			obj: newEgressFirewall("default",
+				egressfirewallapi.EgressFirewallRule{
+					Type: egressfirewallapi.EgressFirewallRuleAllow,
+					To:   egressfirewallapi.EgressFirewallDestination{DNSName: "*.example.com"},
+				},
+			),
+			enableDNSNameResolver: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := config.PrepareTestConfig(); err != nil {
+				t.Fatal(err)
+			}
+			config.OVNKubernetesFeature.EnableDNSNameResolver = tt.enableDNSNameResolver
+			_, err := NewEgressFirewallAdmissionWebhook().ValidateCreate(context.TODO(), tt.obj)
+			if tt.expectedErr == "" && err != nil || tt.expectedErr != "" && (err == nil || err.Error() != tt.expectedErr) {
+				t.Errorf("ValidateCreate() error = %v, expectedErr %v", err, tt.expectedErr)
+			}
+		})
+	}
+}
+
+func TestEgressFirewallAdmissionUpdate(t *testing.T) {
+	// wildcard DNS names are invalid once the DNS name resolver is disabled
+	invalidEgressFirewall := newEgressFirewall("default",
+		egressfirewallapi.EgressFirewallRule{
+			Type: egressfirewallapi.EgressFirewallRuleAllow,
+			To:   egressfirewallapi.EgressFirewallDestination{DNSName: "*.example.com"},
+		},
+	)
+	invalidEgressFirewall.Finalizers = []string{"example.com/finalizer"}
+	deletedInvalidEgressFirewall := invalidEgressFirewall.DeepCopy()
+	deletedInvalidEgressFirewall.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	deletedInvalidEgressFirewallWithoutFinalizer := deletedInvalidEgressFirewall.DeepCopy()
+	deletedInvalidEgressFirewallWithoutFinalizer.Finalizers = nil
+	invalidEgressFirewallWithStatus := invalidEgressFirewall.DeepCopy()
+	invalidEgressFirewallWithStatus.Status.Status = "EgressFirewall Rules applied"
+
+	tests := []struct {
+		name        string
+		oldObj      *egressfirewallapi.EgressFirewall
+		newObj      *egressfirewallapi.EgressFirewall
+		expectedErr string
+	}{
+		{
+			name:   "allows the finalizer removal of an invalid egress firewall being deleted",
+			oldObj: deletedInvalidEgressFirewall,
+			newObj: deletedInvalidEgressFirewallWithoutFinalizer,
+		},
+		{
+			name:   "allows status only updates of an invalid egress firewall",
+			oldObj: invalidEgressFirewall,
+			newObj: invalidEgressFirewallWithStatus,
+		},
+		{
+			name:        "rejects spec updates to an invalid egress firewall",
+			oldObj:      newEgressFirewall("default"),
+			newObj:      invalidEgressFirewall,
+			expectedErr: "invalid rule 0: wildcard dns name *.example.com is only supported as rule destination when the DNS name resolver feature is enabled (--enable-dns-name-resolver)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := config.PrepareTestConfig(); err != nil {
+				t.Fatal(err)
+			}
+			_, err := NewEgressFirewallAdmissionWebhook().ValidateUpdate(context.TODO(), tt.oldObj, tt.newObj)
+			if tt.expectedErr == "" && err != nil || tt.expectedErr != "" && (err == nil || err.Error() != tt.expectedErr) {
+				t.Errorf("ValidateUpdate() error = %v, expectedErr %v", err, tt.expectedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package ovnwebhook
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	listers "k8s.io/client-go/listers/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	egressipv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+// EgressIPAdmission rejects the EgressIPs with egress IPs that are invalid or
+// conflicting with the host addresses of the nodes
+type EgressIPAdmission struct {
+	nodeLister listers.NodeLister
+}
+
+func NewEgressIPAdmissionWebhook(nodeLister listers.NodeLister) *EgressIPAdmission {
+	return &EgressIPAdmission{
+		nodeLister: nodeLister,
+	}
+}
+
+var _ admission.CustomValidator = &EgressIPAdmission{}
+
+func (e EgressIPAdmission) ValidateCreate(_ context.Context, obj runtime.Object) (warnings admission.Warnings, err error) {
+	eIP := obj.(*egressipv1.EgressIP)
+	return nil, e.validateEgressIPs(eIP.Spec.EgressIPs)
+}
+
+func (e EgressIPAdmission) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (warnings admission.Warnings, err error) {
+	oldEIP := oldObj.(*egressipv1.EgressIP)
+	newEIP := newObj.(*egressipv1.EgressIP)
+	// skip the metadata and status only updates, e.g. the cluster manager
+	// assigning the egress IPs, and the updates of objects being deleted
+	if newEIP.DeletionTimestamp != nil || apiequality.Semantic.DeepEqual(oldEIP.Spec, newEIP.Spec) {
+		return nil, nil
+	}
+	// only validate the added egress IPs, a node may have been assigned one of the
+	// existing egress IPs since they were validated
+	addedEgressIPs := sets.New(newEIP.Spec.EgressIPs...).Delete(oldEIP.Spec.EgressIPs...)
+	return nil, e.validateEgressIPs(sets.List(addedEgressIPs))
+}
+
+func (e EgressIPAdmission) ValidateDelete(_ context.Context, _ runtime.Object) (warnings admission.Warnings, err error) {
+	return nil, nil
+}
+
+func (e EgressIPAdmission) validateEgressIPs(egressIPs []string) error {
+	if len(egressIPs) == 0 {
+		return nil
+	}
+	nodes, err := e.nodeLister.List(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("failed to list nodes: %w", err)
+	}
+	for _, egressIP := range egressIPs {
+		ip := net.ParseIP(egressIP)
+		if ip == nil {
+			return fmt.Errorf("egress IP %s is not a valid IP address", egressIP)
+		}
+		nodeName, err := util.GetNodeWithHostAddr(nodes, ip)
+		if err != nil {
+			return err
+		}
+		if nodeName != "" {
+			return fmt.Errorf("egress IP %s is conflicting with a host address of node %s", egressIP, nodeName)
+		}
+	}
+	return nil
+}
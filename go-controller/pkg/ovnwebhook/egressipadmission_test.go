@@ -0,0 +1,99 @@
+package ovnwebhook
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	listersv1 "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	egressipv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+func newEgressIP(egressIPs ...string) *egressipv1.EgressIP {
+	return &egressipv1.EgressIP{
+		ObjectMeta: metav1.ObjectMeta{Name: "egressip"},
+		Spec:       egressipv1.EgressIPSpec{EgressIPs: egressIPs},
+	}
+}
+
+func newDeletedEgressIP(finalizers []string, egressIPs ...string) *egressipv1.EgressIP {
+	eIP := newEgressIP(egressIPs...)
+	eIP.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	eIP.Finalizers = finalizers
+	return eIP
+}
+
+func TestEgressIPAdmission(t *testing.T) {
+	nodeIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	err := nodeIndexer.Add(&corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        nodeName,
+			Annotations: map[string]string{util.OVNNodeHostCIDRs: `["192.168.1.10/24","fc00:f853:ccd:e793::3/64"]`},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	eipAdmission := NewEgressIPAdmissionWebhook(listersv1.NewNodeLister(nodeIndexer))
+
+	tests := []struct {
+		name        string
+		oldObj      *egressipv1.EgressIP
+		newObj      *egressipv1.EgressIP
+		expectedErr string
+	}{
+		{
+			name:   "allows egress IPs not used by the nodes",
+			newObj: newEgressIP("192.168.1.100", "fc00:f853:ccd:e793::100"),
+		},
+		{
+			name:        "rejects invalid egress IPs",
+			newObj:      newEgressIP("192.168.1.300"),
+			expectedErr: "egress IP 192.168.1.300 is not a valid IP address",
+		},
+		{
+			name:        "rejects egress IPs conflicting with a node IP",
+			newObj:      newEgressIP("192.168.1.100", "192.168.1.10"),
+			expectedErr: "egress IP 192.168.1.10 is conflicting with a host address of node " + nodeName,
+		},
+		{
+			name:        "rejects IPv6 egress IPs conflicting with a node IP",
+			newObj:      newEgressIP("fc00:f853:ccd:e793::3"),
+			expectedErr: "egress IP fc00:f853:ccd:e793::3 is conflicting with a host address of node " + nodeName,
+		},
+		{
+			name:   "allows updates keeping the existing egress IPs",
+			oldObj: newEgressIP("192.168.1.10"),
+			newObj: newEgressIP("192.168.1.10", "192.168.1.100"),
+		},
+		{
+			name:        "rejects updates adding egress IPs conflicting with a node IP",
+			oldObj:      newEgressIP("192.168.1.100"),
+			newObj:      newEgressIP("192.168.1.100", "192.168.1.10"),
+			expectedErr: "egress IP 192.168.1.10 is conflicting with a host address of node " + nodeName,
+		},
+		{
+			name:   "allows updates of egress IPs being deleted",
+			oldObj: newDeletedEgressIP([]string{"example.com/finalizer"}, "192.168.1.100"),
+			newObj: newDeletedEgressIP(nil, "192.168.1.100", "192.168.1.10"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var err error
+			if tt.oldObj == nil {
+				_, err = eipAdmission.ValidateCreate(context.TODO(), tt.newObj)
+			} else {
+				_, err = eipAdmission.ValidateUpdate(context.TODO(), tt.oldObj, tt.newObj)
+			}
+			if tt.expectedErr == "" && err != nil || tt.expectedErr != "" && (err == nil || err.Error() != tt.expectedErr) {
+				t.Errorf("Validate() error = %v, expectedErr %v", err, tt.expectedErr)
+			}
+		})
+	}
+}
@@ -33,6 +33,7 @@ var commonNodeAnnotationChecks = map[string]checkNodeAnnot{
 	util.OvnNodeMasqCIDR:                   nil,
 	util.OvnNodeGatewayMtuSupport:          nil,
 	util.OvnNodeManagementPort:             nil,
+	util.OVNNodePhysicalNetworks:           nil,
 	util.OvnNodeChassisID: func(v annotationChange, nodeName string) error {
 		if v.action == removed {
 			return fmt.Errorf("%s cannot be removed", util.OvnNodeChassisID)
@@ -63,6 +64,23 @@ var interconnectNodeAnnotationChecks = map[string]checkNodeAnnot{
 
 		return fmt.Errorf("%s can only be set to %s, it cannot be removed", util.OvnNodeMigratedZoneName, nodeName)
 	},
+	// the zone controller of the node requests additional host subnets for the node when it runs out of IPs,
+	// ovnkube-node is only allowed to modify the annotation on its own node
+	util.OvnNodeSubnetRequests: func(v annotationChange, _ string) error {
+		if v.action == removed {
+			return nil
+		}
+		requests, err := util.ParseNodeSubnetRequestsAnnotation(map[string]string{util.OvnNodeSubnetRequests: v.value})
+		if err != nil {
+			return err
+		}
+		for netName, request := range requests {
+			if request.IPv4 < 0 || request.IPv6 < 0 {
+				return fmt.Errorf("%s cannot request a negative number of host subnets for network %s", util.OvnNodeSubnetRequests, netName)
+			}
+		}
+		return nil
+	},
 }
 
 // hybridOverlayNodeAnnotationChecks holds annotations allowed for ovnkube-node:<nodeName> users hybrid overlay environments
@@ -186,6 +204,9 @@ func (p NodeAdmission) ValidateUpdate(ctx context.Context, oldObj, newObj runtim
 			oldNodeShallowCopy.Status.Conditions = conditionsDeepCopy
 		}
 	}
+	// ovnkube-node reports the status of the IPsec tunnels in its own node condition, ignore it
+	oldNodeShallowCopy.Status.Conditions = withoutNodeCondition(oldNodeShallowCopy.Status.Conditions, types.NodeIPsecConditionType)
+	newNodeShallowCopy.Status.Conditions = withoutNodeCondition(newNodeShallowCopy.Status.Conditions, types.NodeIPsecConditionType)
 	if !apiequality.Semantic.DeepEqual(oldNodeShallowCopy.ObjectMeta, newNodeShallowCopy.ObjectMeta) ||
 		!apiequality.Semantic.DeepEqual(oldNodeShallowCopy.Status, newNodeShallowCopy.Status) {
 		return nil, fmt.Errorf("ovnkube-node on node: %q is not allowed to modify anything other than annotations", nodeName)
@@ -193,3 +214,14 @@ func (p NodeAdmission) ValidateUpdate(ctx context.Context, oldObj, newObj runtim
 
 	return nil, nil
 }
+
+// withoutNodeCondition returns a copy of conditions without the conditions of the given type
+func withoutNodeCondition(conditions []corev1.NodeCondition, conditionType corev1.NodeConditionType) []corev1.NodeCondition {
+	var filtered []corev1.NodeCondition
+	for _, condition := range conditions {
+		if condition.Type != conditionType {
+			filtered = append(filtered, condition)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,92 @@
+package ovnwebhook
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	netv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/clustermanager/userdefinednetwork"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/clustermanager/userdefinednetwork/template"
+	userdefinednetworkv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1"
+)
+
+// UserDefinedNetworkAdmission rejects the UserDefinedNetworks and
+// ClusterUserDefinedNetworks the cluster manager would fail to render a
+// NetworkAttachmentDefinition for, e.g. because their subnets overlap with the
+// cluster, service, join or masquerade subnets. It also rejects the spec
+// changes that can't be applied to a network in use, only appending subnets
+// and raising the MTU are allowed.
+type UserDefinedNetworkAdmission struct{}
+
+func NewUserDefinedNetworkAdmissionWebhook() *UserDefinedNetworkAdmission {
+	return &UserDefinedNetworkAdmission{}
+}
+
+var _ admission.CustomValidator = &UserDefinedNetworkAdmission{}
+
+func (u UserDefinedNetworkAdmission) ValidateCreate(_ context.Context, obj runtime.Object) (warnings admission.Warnings, err error) {
+	_, err = renderUserDefinedNetwork(obj)
+	return nil, err
+}
+
+func (u UserDefinedNetworkAdmission) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (warnings admission.Warnings, err error) {
+	// skip the metadata only updates, e.g. the cluster manager removing its
+	// finalizer, so that a network that is invalid with the current config can
+	// still be deleted
+	if !userDefinedNetworkSpecChanged(oldObj, newObj) {
+		return nil, nil
+	}
+	newNAD, err := renderUserDefinedNetwork(newObj)
+	if err != nil {
+		return nil, err
+	}
+	oldNAD, err := renderUserDefinedNetwork(oldObj)
+	if err != nil {
+		// the current network config is invalid, there is no network in use
+		// to protect
+		return nil, nil
+	}
+	_, rejectedChanges := userdefinednetwork.NetAttachDefConfigUpdate(oldNAD.Spec.Config, newNAD.Spec.Config)
+	if len(rejectedChanges) > 0 {
+		return nil, fmt.Errorf("the following spec changes are not supported: [%s]", strings.Join(rejectedChanges, "; "))
+	}
+	return nil, nil
+}
+
+func (u UserDefinedNetworkAdmission) ValidateDelete(_ context.Context, _ runtime.Object) (warnings admission.Warnings, err error) {
+	return nil, nil
+}
+
+// userDefinedNetworkSpecChanged returns whether the update changes the spec
+// of a network that is not being deleted
+func userDefinedNetworkSpecChanged(oldObj, newObj runtime.Object) bool {
+	switch newNetwork := newObj.(type) {
+	case *userdefinednetworkv1.UserDefinedNetwork:
+		oldNetwork, ok := oldObj.(*userdefinednetworkv1.UserDefinedNetwork)
+		return !ok || newNetwork.DeletionTimestamp == nil && !apiequality.Semantic.DeepEqual(oldNetwork.Spec, newNetwork.Spec)
+	case *userdefinednetworkv1.ClusterUserDefinedNetwork:
+		oldNetwork, ok := oldObj.(*userdefinednetworkv1.ClusterUserDefinedNetwork)
+		return !ok || newNetwork.DeletionTimestamp == nil && !apiequality.Semantic.DeepEqual(oldNetwork.Spec, newNetwork.Spec)
+	}
+	return true
+}
+
+// renderUserDefinedNetwork renders the NetworkAttachmentDefinition of the
+// given network, failing when the network config is invalid
+func renderUserDefinedNetwork(obj runtime.Object) (*netv1.NetworkAttachmentDefinition, error) {
+	switch o := obj.(type) {
+	case *userdefinednetworkv1.UserDefinedNetwork:
+		return template.RenderNetAttachDefManifest(o, o.Namespace)
+	case *userdefinednetworkv1.ClusterUserDefinedNetwork:
+		// the network config does not depend on the namespace it is rendered for
+		return template.RenderNetAttachDefManifest(o, metav1.NamespaceDefault)
+	}
+	return nil, fmt.Errorf("unexpected object type %T", obj)
+}
@@ -0,0 +1,185 @@
+package ovnwebhook
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	udnv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1"
+)
+
+func newLayer3UserDefinedNetwork(subnet udnv1.CIDR) *udnv1.UserDefinedNetwork {
+	return &udnv1.UserDefinedNetwork{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenant", Namespace: "blue"},
+		Spec: udnv1.UserDefinedNetworkSpec{
+			Topology: udnv1.NetworkTopologyLayer3,
+			Layer3: &udnv1.Layer3Config{
+				Role:    udnv1.NetworkRolePrimary,
+				Subnets: []udnv1.Layer3Subnet{{CIDR: subnet}},
+			},
+		},
+	}
+}
+
+func newLayer2ClusterUserDefinedNetwork(subnet udnv1.CIDR) *udnv1.ClusterUserDefinedNetwork {
+	return &udnv1.ClusterUserDefinedNetwork{
+		ObjectMeta: metav1.ObjectMeta{Name: "shared"},
+		Spec: udnv1.ClusterUserDefinedNetworkSpec{
+			Network: udnv1.NetworkSpec{
+				Topology: udnv1.NetworkTopologyLayer2,
+				Layer2: &udnv1.Layer2Config{
+					Role:    udnv1.NetworkRolePrimary,
+					Subnets: udnv1.DualStackCIDRs{subnet},
+				},
+			},
+		},
+	}
+}
+
+func TestUserDefinedNetworkAdmission(t *testing.T) {
+	tests := []struct {
+		name        string
+		obj         runtime.Object
+		expectedErr string
+	}{
+		{
+			name: "allows UserDefinedNetworks with valid subnets",
+			obj:  newLayer3UserDefinedNetwork("10.200.0.0/16"),
+		},
+		{
+			name:        "rejects UserDefinedNetworks overlapping with the cluster subnets",
+			obj:         newLayer3UserDefinedNetwork("10.128.0.0/16"),
+			expectedErr: "pod or join subnet overlaps with already configured internal subnets",
+		},
+		{
+			name: "allows ClusterUserDefinedNetworks with valid subnets",
+			obj:  newLayer2ClusterUserDefinedNetwork("10.200.0.0/16"),
+		},
+		{
+			name:        "rejects ClusterUserDefinedNetworks overlapping with the join subnet",
+			obj:         newLayer2ClusterUserDefinedNetwork("100.64.0.0/24"),
+			expectedErr: "pod or join subnet overlaps with already configured internal subnets",
+		},
+		{
+			name:        "rejects ClusterUserDefinedNetworks overlapping with the service CIDRs",
+			obj:         newLayer2ClusterUserDefinedNetwork("172.16.1.0/28"),
+			expectedErr: "pod or join subnet overlaps with already configured internal subnets",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := config.PrepareTestConfig(); err != nil {
+				t.Fatal(err)
+			}
+			config.IPv4Mode = true
+			_, err := NewUserDefinedNetworkAdmissionWebhook().ValidateCreate(context.TODO(), tt.obj)
+			if tt.expectedErr == "" && err != nil || tt.expectedErr != "" && (err == nil || !strings.Contains(err.Error(), tt.expectedErr)) {
+				t.Errorf("ValidateCreate() error = %v, expectedErr %v", err, tt.expectedErr)
+			}
+		})
+	}
+}
+
+func TestUserDefinedNetworkAdmissionUpdate(t *testing.T) {
+	// the subnet of the networks overlaps with the cluster subnets, e.g.
+	// following a config change
+	invalidUDN := newLayer3UserDefinedNetwork("10.128.0.0/16")
+	invalidUDN.Finalizers = []string{"k8s.ovn.org/user-defined-network-protection"}
+	deletedInvalidUDN := invalidUDN.DeepCopy()
+	deletedInvalidUDN.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	deletedInvalidUDNWithoutFinalizer := deletedInvalidUDN.DeepCopy()
+	deletedInvalidUDNWithoutFinalizer.Finalizers = nil
+
+	invalidCUDN := newLayer2ClusterUserDefinedNetwork("100.64.0.0/24")
+	invalidCUDNWithLabel := invalidCUDN.DeepCopy()
+	invalidCUDNWithLabel.Labels = map[string]string{"foo": "bar"}
+
+	udn := newLayer3UserDefinedNetwork("10.200.0.0/16")
+	udnWithAppendedSubnet := udn.DeepCopy()
+	udnWithAppendedSubnet.Spec.Layer3.Subnets = append(udnWithAppendedSubnet.Spec.Layer3.Subnets, udnv1.Layer3Subnet{CIDR: "10.210.0.0/16"})
+	udnWithSecondaryRole := udn.DeepCopy()
+	udnWithSecondaryRole.Spec.Layer3.Role = udnv1.NetworkRoleSecondary
+
+	cudn := newLayer2ClusterUserDefinedNetwork("10.200.0.0/16")
+	cudn.Spec.Network.Layer2.MTU = 1400
+	cudnWithRaisedMTU := cudn.DeepCopy()
+	cudnWithRaisedMTU.Spec.Network.Layer2.MTU = 1500
+	cudnWithDecreasedMTU := cudn.DeepCopy()
+	cudnWithDecreasedMTU.Spec.Network.Layer2.MTU = 1300
+	cudnWithNewSelector := cudn.DeepCopy()
+	cudnWithNewSelector.Spec.NamespaceSelector = metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}}
+
+	tests := []struct {
+		name        string
+		oldObj      runtime.Object
+		newObj      runtime.Object
+		expectedErr string
+	}{
+		{
+			name:   "allows the finalizer removal of an invalid UserDefinedNetwork being deleted",
+			oldObj: deletedInvalidUDN,
+			newObj: deletedInvalidUDNWithoutFinalizer,
+		},
+		{
+			name:   "allows metadata only updates of an invalid ClusterUserDefinedNetwork",
+			oldObj: invalidCUDN,
+			newObj: invalidCUDNWithLabel,
+		},
+		{
+			name:        "rejects spec updates to an invalid UserDefinedNetwork",
+			oldObj:      newLayer3UserDefinedNetwork("10.200.0.0/16"),
+			newObj:      newLayer3UserDefinedNetwork("10.128.0.0/16"),
+			expectedErr: "pod or join subnet overlaps with already configured internal subnets",
+		},
+		{
+			name:   "allows appending subnets to a UserDefinedNetwork",
+			oldObj: udn,
+			newObj: udnWithAppendedSubnet,
+		},
+		{
+			name:        "rejects changing the role of a UserDefinedNetwork",
+			oldObj:      udn,
+			newObj:      udnWithSecondaryRole,
+			expectedErr: "role: can't be changed",
+		},
+		{
+			name:        "rejects removing subnets from a UserDefinedNetwork",
+			oldObj:      udnWithAppendedSubnet,
+			newObj:      udn,
+			expectedErr: "subnets: existing subnets [10.200.0.0/16 10.210.0.0/16] can't be removed or modified",
+		},
+		{
+			name:   "allows raising the MTU of a ClusterUserDefinedNetwork",
+			oldObj: cudn,
+			newObj: cudnWithRaisedMTU,
+		},
+		{
+			name:        "rejects decreasing the MTU of a ClusterUserDefinedNetwork",
+			oldObj:      cudn,
+			newObj:      cudnWithDecreasedMTU,
+			expectedErr: "mtu: can't be decreased from 1400 to 1300",
+		},
+		{
+			name:   "allows changing the namespace selector of a ClusterUserDefinedNetwork",
+			oldObj: cudn,
+			newObj: cudnWithNewSelector,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := config.PrepareTestConfig(); err != nil {
+				t.Fatal(err)
+			}
+			config.IPv4Mode = true
+			_, err := NewUserDefinedNetworkAdmissionWebhook().ValidateUpdate(context.TODO(), tt.oldObj, tt.newObj)
+			if tt.expectedErr == "" && err != nil || tt.expectedErr != "" && (err == nil || !strings.Contains(err.Error(), tt.expectedErr)) {
+				t.Errorf("ValidateUpdate() error = %v, expectedErr %v", err, tt.expectedErr)
+			}
+		})
+	}
+}
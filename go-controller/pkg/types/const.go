@@ -71,6 +71,9 @@ const (
 	TransitSwitchToRouterPrefix = "tstor-"
 	RouterToTransitSwitchPrefix = "rtots-"
 
+	// RouterToPeerPrefix prefixes the router ports connecting the routers of peered networks
+	RouterToPeerPrefix = "rtop-"
+
 	// ACL Default Tier Priorities
 
 	// Default routed multicast allow acl rule priority
@@ -109,6 +112,9 @@ const (
 	NodeSubnetPolicyPriority              = "1004"
 	InterNodePolicyPriority               = "1003"
 	UDNHostCIDRPolicyPriority             = "99"
+	NetworkPeeringAllowPriority           = "1008"
+	NetworkPeeringDropPriority            = "1007"
+	NetworkPeeringReroutePriority         = "1006"
 	HybridOverlaySubnetPriority           = 1002
 	HybridOverlayReroutePriority          = 501
 	DefaultNoRereoutePriority             = 102
@@ -201,6 +207,8 @@ const (
 	LoadBalancerOwnerExternalID = OvnK8sPrefix + "/" + "owner"
 	// key for UDN enabled services routes
 	UDNEnabledServiceExternalID = OvnK8sPrefix + "/" + "udn-enabled-default-service"
+	// key for NetworkPeering name external-id, only used for the router ports connecting peered networks
+	NetworkPeeringExternalID = OvnK8sPrefix + "/" + "network-peering"
 
 	// different secondary network topology type defined in CNI netconf
 	Layer3Topology   = "layer3"
@@ -247,4 +255,11 @@ const (
 	// entry for the gateway routers. After this time, the entry is removed and
 	// may be refreshed with a new ARP request.
 	GRMACBindingAgeThreshold = "300"
+
+	// NodeIPsecConditionType is the node condition ovnkube-node reports with the
+	// status of the IPsec tunnels towards the other nodes
+	NodeIPsecConditionType = "OVNKubeIPsecTunnelsReady"
+	// NBGlobalIPsecManagedExternalID marks the NB_Global entry when IPsec was
+	// enabled by ovnkube-controller, so that it can be disabled again
+	NBGlobalIPsecManagedExternalID = OvnK8sPrefix + "/" + "ipsec-managed"
 )
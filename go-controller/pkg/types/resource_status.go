@@ -10,6 +10,9 @@ const (
 	APBRouteErrorMsg       = "failed to apply policy"
 	EgressFirewallErrorMsg = "EgressFirewall Rules not correctly applied"
 	EgressQoSErrorMsg      = "EgressQoS Rules not correctly applied"
+	EgressIPErrorMsg       = "EgressIP not correctly programmed"
+
+	NetworkObservabilityErrorMsg = "NetworkObservability not correctly applied"
 )
 
 func GetZoneStatus(zoneID, message string) string {
@@ -19,6 +19,9 @@ import (
 const (
 	// dnsRegex gives the regular expression for DNS names when DNSNameResolver is enabled.
 	dnsRegex = `^(\*\.)?([a-zA-Z0-9]([-a-zA-Z0-9]{0,61}[a-zA-Z0-9])?\.)+[a-zA-Z0-9]([-a-zA-Z0-9]{0,61}[a-zA-Z0-9])?\.?$`
+
+	egressFirewallICMPProtocol   = "ICMP"
+	egressFirewallICMPv6Protocol = "ICMPv6"
 )
 
 // ValidateAndGetEgressFirewallDestination validates an egress firewall rule destination and returns
@@ -33,7 +36,7 @@ func ValidateAndGetEgressFirewallDestination(egressFirewallDestination egressfir
 	if egressFirewallDestination.DNSName != "" {
 		// Validate that DNS name is not wildcard when DNSNameResolver is not enabled.
 		if !config.OVNKubernetesFeature.EnableDNSNameResolver && IsWildcard(egressFirewallDestination.DNSName) {
-			return "", "", false, nil, fmt.Errorf("wildcard dns name is not supported as rule destination, %s", egressFirewallDestination.DNSName)
+			return "", "", false, nil, fmt.Errorf("wildcard dns name %s is only supported as rule destination when the DNS name resolver feature is enabled (--enable-dns-name-resolver)", egressFirewallDestination.DNSName)
 		}
 		// Validate that DNS name if DNSNameResolver is enabled.
 		if config.OVNKubernetesFeature.EnableDNSNameResolver {
@@ -68,6 +71,32 @@ func ValidateAndGetEgressFirewallDestination(egressFirewallDestination egressfir
 	return
 }
 
+// ValidateEgressFirewallPortsAndICMP validates the ports and the ICMP messages
+// an egress firewall rule applies to.
+func ValidateEgressFirewallPortsAndICMP(ports []egressfirewallapi.EgressFirewallPort, icmps []egressfirewallapi.EgressFirewallICMP) error {
+	for _, port := range ports {
+		if port.EndPort == 0 {
+			continue
+		}
+		if port.Port == 0 {
+			return fmt.Errorf("rule port range end %d requires a start port", port.EndPort)
+		}
+		if port.EndPort < port.Port {
+			return fmt.Errorf("rule port range end %d is lower than start %d", port.EndPort, port.Port)
+		}
+	}
+	for _, icmp := range icmps {
+		if icmp.Protocol != egressFirewallICMPProtocol && icmp.Protocol != egressFirewallICMPv6Protocol {
+			return fmt.Errorf("rule icmp protocol %s is invalid, must be %s or %s", icmp.Protocol,
+				egressFirewallICMPProtocol, egressFirewallICMPv6Protocol)
+		}
+		if icmp.Code != nil && icmp.Type == nil {
+			return fmt.Errorf("rule icmp code %d requires a type", *icmp.Code)
+		}
+	}
+	return nil
+}
+
 // IsWildcard checks if the domain name is wildcard.
 func IsWildcard(dnsName string) bool {
 	return strings.HasPrefix(dnsName, "*.")
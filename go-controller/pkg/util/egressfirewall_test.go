@@ -10,6 +10,7 @@ import (
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
 )
 
 type output struct {
@@ -189,6 +190,71 @@ func TestValidateAndGetEgressFirewallDestination(t *testing.T) {
 	}
 }
 
+func TestValidateEgressFirewallPortsAndICMP(t *testing.T) {
+	testcases := []struct {
+		name        string
+		ports       []egressfirewallapi.EgressFirewallPort
+		icmps       []egressfirewallapi.EgressFirewallICMP
+		expectedErr bool
+	}{
+		{
+			name: "should validate ports and port ranges",
+			ports: []egressfirewallapi.EgressFirewallPort{
+				{Protocol: "TCP", Port: 80},
+				{Protocol: "TCP", Port: 30000, EndPort: 32767},
+				{Protocol: "UDP", Port: 53, EndPort: 53},
+			},
+		},
+		{
+			name: "should throw an error for a port range ending before its start",
+			ports: []egressfirewallapi.EgressFirewallPort{
+				{Protocol: "TCP", Port: 32767, EndPort: 30000},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "should throw an error for a port range without start",
+			ports: []egressfirewallapi.EgressFirewallPort{
+				{Protocol: "TCP", EndPort: 30000},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "should validate icmp types and codes",
+			icmps: []egressfirewallapi.EgressFirewallICMP{
+				{Protocol: "ICMP"},
+				{Protocol: "ICMP", Type: ptr.To[int32](8)},
+				{Protocol: "ICMPv6", Type: ptr.To[int32](1), Code: ptr.To[int32](4)},
+			},
+		},
+		{
+			name: "should throw an error for an icmp code without type",
+			icmps: []egressfirewallapi.EgressFirewallICMP{
+				{Protocol: "ICMP", Code: ptr.To[int32](0)},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "should throw an error for an invalid icmp protocol",
+			icmps: []egressfirewallapi.EgressFirewallICMP{
+				{Protocol: "TCP"},
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateEgressFirewallPortsAndICMP(tc.ports, tc.icmps)
+			if tc.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestIsWildcard(t *testing.T) {
 	tests := []struct {
 		dnsName        string
@@ -0,0 +1,26 @@
+package util
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	egressipv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1"
+)
+
+// IsEgressIPConditionsOnlyUpdate returns true if the EgressIP objects only
+// differ by their status conditions. The conditions report how the egress IPs
+// are assigned and programmed, hence updating them requires no processing.
+func IsEgressIPConditionsOnlyUpdate(old, new *egressipv1.EgressIP) bool {
+	if reflect.DeepEqual(old.Status.Conditions, new.Status.Conditions) {
+		return false
+	}
+	oldCopy, newCopy := old.DeepCopy(), new.DeepCopy()
+	for _, eIP := range []*egressipv1.EgressIP{oldCopy, newCopy} {
+		eIP.TypeMeta = metav1.TypeMeta{}
+		eIP.Status.Conditions = nil
+		eIP.ResourceVersion = ""
+		eIP.ManagedFields = nil
+	}
+	return reflect.DeepEqual(oldCopy, newCopy)
+}
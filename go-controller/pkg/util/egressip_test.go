@@ -0,0 +1,77 @@
+package util
+
+import (
+	"testing"
+
+	egressipv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1"
+	"github.com/stretchr/testify/assert"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestIsEgressIPConditionsOnlyUpdate(t *testing.T) {
+	newEgressIP := func(resourceVersion string, items []egressipv1.EgressIPStatusItem, conditions ...metav1.Condition) *egressipv1.EgressIP {
+		return &egressipv1.EgressIP{
+			ObjectMeta: metav1.ObjectMeta{Name: "egressip", ResourceVersion: resourceVersion},
+			Spec: egressipv1.EgressIPSpec{
+				EgressIPs: []string{"192.168.126.101"},
+			},
+			Status: egressipv1.EgressIPStatus{
+				Items:      items,
+				Conditions: conditions,
+			},
+		}
+	}
+	items := []egressipv1.EgressIPStatusItem{{Node: "node1", EgressIP: "192.168.126.101"}}
+	programmed := metav1.Condition{
+		Type:   egressipv1.EgressIPConditionProgrammedInZonePrefix + "zone1",
+		Status: metav1.ConditionTrue,
+		Reason: "ProgrammingSucceeded",
+	}
+	testcases := []struct {
+		name     string
+		old      *egressipv1.EgressIP
+		new      *egressipv1.EgressIP
+		expected bool
+	}{
+		{
+			name:     "should be false for equal objects",
+			old:      newEgressIP("1", items),
+			new:      newEgressIP("1", items),
+			expected: false,
+		},
+		{
+			name:     "should be true when only the conditions are updated",
+			old:      newEgressIP("1", items),
+			new:      newEgressIP("2", items, programmed),
+			expected: true,
+		},
+		{
+			name: "should be true when the conditions are applied",
+			old:  newEgressIP("1", items),
+			new: func() *egressipv1.EgressIP {
+				eIP := newEgressIP("2", items, programmed)
+				eIP.TypeMeta = metav1.TypeMeta{Kind: "EgressIP", APIVersion: "k8s.ovn.org/v1"}
+				return eIP
+			}(),
+			expected: true,
+		},
+		{
+			name:     "should be false when the status items are updated",
+			old:      newEgressIP("1", nil),
+			new:      newEgressIP("2", items, programmed),
+			expected: false,
+		},
+		{
+			name:     "should be false when the status items are updated without the conditions",
+			old:      newEgressIP("1", nil, programmed),
+			new:      newEgressIP("2", items, programmed),
+			expected: false,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, IsEgressIPConditionsOnlyUpdate(tc.old, tc.new))
+		})
+	}
+}
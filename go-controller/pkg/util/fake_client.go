@@ -19,6 +19,10 @@ import (
 	egressqosfake "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressqos/v1/apis/clientset/versioned/fake"
 	egressservice "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressservice/v1"
 	egressservicefake "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressservice/v1/apis/clientset/versioned/fake"
+	networkobservabilityapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkobservability/v1"
+	networkobservabilityfake "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkobservability/v1/apis/clientset/versioned/fake"
+	routeadvertisementsapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1"
+	routeadvertisementsfake "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1/apis/clientset/versioned/fake"
 	udnv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1"
 	udnfake "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1/apis/clientset/versioned/fake"
 
@@ -45,9 +49,11 @@ func GetOVNClientset(objects ...runtime.Object) *OVNClientset {
 	cloudObjects := []runtime.Object{}
 	dnsNameResolverObjects := []runtime.Object{}
 	udnObjects := []runtime.Object{}
+	raObjects := []runtime.Object{}
+	networkObservabilityObjects := []runtime.Object{}
 	for _, object := range objects {
 		switch object.(type) {
-		case *egressip.EgressIP:
+		case *egressip.EgressIP, *egressip.EgressIPPool:
 			egressIPObjects = append(egressIPObjects, object)
 		case *egressfirewall.EgressFirewall:
 			egressFirewallObjects = append(egressFirewallObjects, object)
@@ -67,8 +73,12 @@ func GetOVNClientset(objects ...runtime.Object) *OVNClientset {
 			anpObjects = append(anpObjects, object)
 		case *ocpnetworkapiv1alpha1.DNSNameResolver:
 			dnsNameResolverObjects = append(dnsNameResolverObjects, object)
-		case *udnv1.UserDefinedNetwork, *udnv1.ClusterUserDefinedNetwork:
+		case *udnv1.UserDefinedNetwork, *udnv1.ClusterUserDefinedNetwork, *udnv1.NetworkPeering:
 			udnObjects = append(udnObjects, object)
+		case *routeadvertisementsapi.RouteAdvertisements:
+			raObjects = append(raObjects, object)
+		case *networkobservabilityapi.NetworkObservability:
+			networkObservabilityObjects = append(networkObservabilityObjects, object)
 		default:
 			v1Objects = append(v1Objects, object)
 		}
@@ -81,19 +91,31 @@ func GetOVNClientset(objects ...runtime.Object) *OVNClientset {
 	// [2] https://github.com/ovn-org/ovn-kubernetes/commit/434b0590ce8c61ade75edc996b2f7f83d530f840#diff-ae287d8b2b115068905d4b5bf477d0e8cb6586d271fe872ca3b17acc94f21075R140
 	populateTracker(nadClient, nads...)
 
+	// the RouteAdvertisements resource name can't be guessed from its kind
+	// either, so its fake-client tracker is populated manually as well
+	raClient := routeadvertisementsfake.NewSimpleClientset()
+	raGVR := routeadvertisementsapi.SchemeGroupVersion.WithResource("routeadvertisements")
+	for _, obj := range raObjects {
+		if err := raClient.Tracker().Create(raGVR, obj, ""); err != nil {
+			panic(err)
+		}
+	}
+
 	return &OVNClientset{
-		KubeClient:               fake.NewSimpleClientset(v1Objects...),
-		ANPClient:                anpfake.NewSimpleClientset(anpObjects...),
-		EgressIPClient:           egressipfake.NewSimpleClientset(egressIPObjects...),
-		EgressFirewallClient:     egressfirewallfake.NewSimpleClientset(egressFirewallObjects...),
-		CloudNetworkClient:       cloudservicefake.NewSimpleClientset(cloudObjects...),
-		EgressQoSClient:          egressqosfake.NewSimpleClientset(egressQoSObjects...),
-		NetworkAttchDefClient:    nadClient,
-		MultiNetworkPolicyClient: mnpfake.NewSimpleClientset(multiNetworkPolicyObjects...),
-		EgressServiceClient:      egressservicefake.NewSimpleClientset(egressServiceObjects...),
-		AdminPolicyRouteClient:   adminpolicybasedroutefake.NewSimpleClientset(apbExternalRouteObjects...),
-		OCPNetworkClient:         ocpnetworkclientfake.NewSimpleClientset(dnsNameResolverObjects...),
-		UserDefinedNetworkClient: udnfake.NewSimpleClientset(udnObjects...),
+		KubeClient:                fake.NewSimpleClientset(v1Objects...),
+		ANPClient:                 anpfake.NewSimpleClientset(anpObjects...),
+		EgressIPClient:            egressipfake.NewSimpleClientset(egressIPObjects...),
+		EgressFirewallClient:      egressfirewallfake.NewSimpleClientset(egressFirewallObjects...),
+		CloudNetworkClient:        cloudservicefake.NewSimpleClientset(cloudObjects...),
+		EgressQoSClient:           egressqosfake.NewSimpleClientset(egressQoSObjects...),
+		NetworkAttchDefClient:     nadClient,
+		MultiNetworkPolicyClient:  mnpfake.NewSimpleClientset(multiNetworkPolicyObjects...),
+		EgressServiceClient:       egressservicefake.NewSimpleClientset(egressServiceObjects...),
+		AdminPolicyRouteClient:    adminpolicybasedroutefake.NewSimpleClientset(apbExternalRouteObjects...),
+		OCPNetworkClient:          ocpnetworkclientfake.NewSimpleClientset(dnsNameResolverObjects...),
+		UserDefinedNetworkClient:  udnfake.NewSimpleClientset(udnObjects...),
+		RouteAdvertisementsClient: raClient,
+		ObservabilityClient:       networkobservabilityfake.NewSimpleClientset(networkObservabilityObjects...),
 	}
 }
 
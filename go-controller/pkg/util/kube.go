@@ -46,25 +46,29 @@ import (
 	egressipclientset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1/apis/clientset/versioned"
 	egressqosclientset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressqos/v1/apis/clientset/versioned"
 	egressserviceclientset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressservice/v1/apis/clientset/versioned"
+	networkobservabilityclientset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkobservability/v1/apis/clientset/versioned"
+	routeadvertisementsclientset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1/apis/clientset/versioned"
 	userdefinednetworkclientset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1/apis/clientset/versioned"
 	anpclientset "sigs.k8s.io/network-policy-api/pkg/client/clientset/versioned"
 )
 
 // OVNClientset is a wrapper around all clientsets used by OVN-Kubernetes
 type OVNClientset struct {
-	KubeClient               kubernetes.Interface
-	ANPClient                anpclientset.Interface
-	EgressIPClient           egressipclientset.Interface
-	EgressFirewallClient     egressfirewallclientset.Interface
-	OCPNetworkClient         ocpnetworkclientset.Interface
-	CloudNetworkClient       ocpcloudnetworkclientset.Interface
-	EgressQoSClient          egressqosclientset.Interface
-	NetworkAttchDefClient    networkattchmentdefclientset.Interface
-	MultiNetworkPolicyClient multinetworkpolicyclientset.Interface
-	EgressServiceClient      egressserviceclientset.Interface
-	AdminPolicyRouteClient   adminpolicybasedrouteclientset.Interface
-	IPAMClaimsClient         ipamclaimssclientset.Interface
-	UserDefinedNetworkClient userdefinednetworkclientset.Interface
+	KubeClient                kubernetes.Interface
+	ANPClient                 anpclientset.Interface
+	EgressIPClient            egressipclientset.Interface
+	EgressFirewallClient      egressfirewallclientset.Interface
+	OCPNetworkClient          ocpnetworkclientset.Interface
+	CloudNetworkClient        ocpcloudnetworkclientset.Interface
+	EgressQoSClient           egressqosclientset.Interface
+	NetworkAttchDefClient     networkattchmentdefclientset.Interface
+	MultiNetworkPolicyClient  multinetworkpolicyclientset.Interface
+	EgressServiceClient       egressserviceclientset.Interface
+	AdminPolicyRouteClient    adminpolicybasedrouteclientset.Interface
+	IPAMClaimsClient          ipamclaimssclientset.Interface
+	UserDefinedNetworkClient  userdefinednetworkclientset.Interface
+	RouteAdvertisementsClient routeadvertisementsclientset.Interface
+	ObservabilityClient       networkobservabilityclientset.Interface
 }
 
 // OVNMasterClientset
@@ -82,6 +86,7 @@ type OVNMasterClientset struct {
 	IPAMClaimsClient         ipamclaimssclientset.Interface
 	NetworkAttchDefClient    networkattchmentdefclientset.Interface
 	UserDefinedNetworkClient userdefinednetworkclientset.Interface
+	ObservabilityClient      networkobservabilityclientset.Interface
 }
 
 // OVNNetworkControllerManagerClientset
@@ -98,6 +103,7 @@ type OVNKubeControllerClientset struct {
 	IPAMClaimsClient         ipamclaimssclientset.Interface
 	NetworkAttchDefClient    networkattchmentdefclientset.Interface
 	UserDefinedNetworkClient userdefinednetworkclientset.Interface
+	ObservabilityClient      networkobservabilityclientset.Interface
 }
 
 type OVNNodeClientset struct {
@@ -110,18 +116,20 @@ type OVNNodeClientset struct {
 }
 
 type OVNClusterManagerClientset struct {
-	KubeClient               kubernetes.Interface
-	ANPClient                anpclientset.Interface
-	EgressIPClient           egressipclientset.Interface
-	CloudNetworkClient       ocpcloudnetworkclientset.Interface
-	NetworkAttchDefClient    networkattchmentdefclientset.Interface
-	EgressServiceClient      egressserviceclientset.Interface
-	AdminPolicyRouteClient   adminpolicybasedrouteclientset.Interface
-	EgressFirewallClient     egressfirewallclientset.Interface
-	EgressQoSClient          egressqosclientset.Interface
-	IPAMClaimsClient         ipamclaimssclientset.Interface
-	OCPNetworkClient         ocpnetworkclientset.Interface
-	UserDefinedNetworkClient userdefinednetworkclientset.Interface
+	KubeClient                kubernetes.Interface
+	ANPClient                 anpclientset.Interface
+	EgressIPClient            egressipclientset.Interface
+	CloudNetworkClient        ocpcloudnetworkclientset.Interface
+	NetworkAttchDefClient     networkattchmentdefclientset.Interface
+	EgressServiceClient       egressserviceclientset.Interface
+	AdminPolicyRouteClient    adminpolicybasedrouteclientset.Interface
+	EgressFirewallClient      egressfirewallclientset.Interface
+	EgressQoSClient           egressqosclientset.Interface
+	IPAMClaimsClient          ipamclaimssclientset.Interface
+	OCPNetworkClient          ocpnetworkclientset.Interface
+	UserDefinedNetworkClient  userdefinednetworkclientset.Interface
+	RouteAdvertisementsClient routeadvertisementsclientset.Interface
+	ObservabilityClient       networkobservabilityclientset.Interface
 }
 
 const (
@@ -149,6 +157,7 @@ func (cs *OVNClientset) GetMasterClientset() *OVNMasterClientset {
 		IPAMClaimsClient:         cs.IPAMClaimsClient,
 		NetworkAttchDefClient:    cs.NetworkAttchDefClient,
 		UserDefinedNetworkClient: cs.UserDefinedNetworkClient,
+		ObservabilityClient:      cs.ObservabilityClient,
 	}
 }
 
@@ -166,6 +175,7 @@ func (cs *OVNMasterClientset) GetOVNKubeControllerClientset() *OVNKubeController
 		IPAMClaimsClient:         cs.IPAMClaimsClient,
 		NetworkAttchDefClient:    cs.NetworkAttchDefClient,
 		UserDefinedNetworkClient: cs.UserDefinedNetworkClient,
+		ObservabilityClient:      cs.ObservabilityClient,
 	}
 }
 
@@ -183,23 +193,26 @@ func (cs *OVNClientset) GetOVNKubeControllerClientset() *OVNKubeControllerClient
 		IPAMClaimsClient:         cs.IPAMClaimsClient,
 		NetworkAttchDefClient:    cs.NetworkAttchDefClient,
 		UserDefinedNetworkClient: cs.UserDefinedNetworkClient,
+		ObservabilityClient:      cs.ObservabilityClient,
 	}
 }
 
 func (cs *OVNClientset) GetClusterManagerClientset() *OVNClusterManagerClientset {
 	return &OVNClusterManagerClientset{
-		KubeClient:               cs.KubeClient,
-		ANPClient:                cs.ANPClient,
-		EgressIPClient:           cs.EgressIPClient,
-		CloudNetworkClient:       cs.CloudNetworkClient,
-		NetworkAttchDefClient:    cs.NetworkAttchDefClient,
-		EgressServiceClient:      cs.EgressServiceClient,
-		AdminPolicyRouteClient:   cs.AdminPolicyRouteClient,
-		EgressFirewallClient:     cs.EgressFirewallClient,
-		EgressQoSClient:          cs.EgressQoSClient,
-		IPAMClaimsClient:         cs.IPAMClaimsClient,
-		OCPNetworkClient:         cs.OCPNetworkClient,
-		UserDefinedNetworkClient: cs.UserDefinedNetworkClient,
+		KubeClient:                cs.KubeClient,
+		ANPClient:                 cs.ANPClient,
+		EgressIPClient:            cs.EgressIPClient,
+		CloudNetworkClient:        cs.CloudNetworkClient,
+		NetworkAttchDefClient:     cs.NetworkAttchDefClient,
+		EgressServiceClient:       cs.EgressServiceClient,
+		AdminPolicyRouteClient:    cs.AdminPolicyRouteClient,
+		EgressFirewallClient:      cs.EgressFirewallClient,
+		EgressQoSClient:           cs.EgressQoSClient,
+		IPAMClaimsClient:          cs.IPAMClaimsClient,
+		OCPNetworkClient:          cs.OCPNetworkClient,
+		UserDefinedNetworkClient:  cs.UserDefinedNetworkClient,
+		RouteAdvertisementsClient: cs.RouteAdvertisementsClient,
+		ObservabilityClient:       cs.ObservabilityClient,
 	}
 }
 
@@ -492,20 +505,32 @@ func NewOVNClientset(conf *config.KubernetesConfig) (*OVNClientset, error) {
 		return nil, err
 	}
 
+	routeAdvertisementsClientset, err := routeadvertisementsclientset.NewForConfig(kconfig)
+	if err != nil {
+		return nil, err
+	}
+
+	networkObservabilityClientset, err := networkobservabilityclientset.NewForConfig(kconfig)
+	if err != nil {
+		return nil, err
+	}
+
 	return &OVNClientset{
-		KubeClient:               kclientset,
-		ANPClient:                anpClientset,
-		EgressIPClient:           egressIPClientset,
-		EgressFirewallClient:     egressFirewallClientset,
-		OCPNetworkClient:         networkClientset,
-		CloudNetworkClient:       cloudNetworkClientset,
-		EgressQoSClient:          egressqosClientset,
-		NetworkAttchDefClient:    networkAttchmntDefClientset,
-		MultiNetworkPolicyClient: multiNetworkPolicyClientset,
-		EgressServiceClient:      egressserviceClientset,
-		AdminPolicyRouteClient:   adminPolicyBasedRouteClientset,
-		IPAMClaimsClient:         ipamClaimsClientset,
-		UserDefinedNetworkClient: userDefinedNetworkClientSet,
+		KubeClient:                kclientset,
+		ANPClient:                 anpClientset,
+		EgressIPClient:            egressIPClientset,
+		EgressFirewallClient:      egressFirewallClientset,
+		OCPNetworkClient:          networkClientset,
+		CloudNetworkClient:        cloudNetworkClientset,
+		EgressQoSClient:           egressqosClientset,
+		NetworkAttchDefClient:     networkAttchmntDefClientset,
+		MultiNetworkPolicyClient:  multiNetworkPolicyClientset,
+		EgressServiceClient:       egressserviceClientset,
+		AdminPolicyRouteClient:    adminPolicyBasedRouteClientset,
+		IPAMClaimsClient:          ipamClaimsClientset,
+		UserDefinedNetworkClient:  userDefinedNetworkClientSet,
+		RouteAdvertisementsClient: routeAdvertisementsClientset,
+		ObservabilityClient:       networkObservabilityClientset,
 	}, nil
 }
 
@@ -597,6 +622,30 @@ func ServiceInternalTrafficPolicyLocal(service *kapi.Service) bool {
 	return service.Spec.InternalTrafficPolicy != nil && *service.Spec.InternalTrafficPolicy == kapi.ServiceInternalTrafficPolicyLocal
 }
 
+// ServiceTopologyAwareRouting returns true if the traffic to the service should
+// prefer the endpoints hinted for the zone of the client, either because of
+// its PreferClose traffic distribution or of its topology mode annotation.
+func ServiceTopologyAwareRouting(service *kapi.Service) bool {
+	if service.Spec.TrafficDistribution != nil && *service.Spec.TrafficDistribution == kapi.ServiceTrafficDistributionPreferClose {
+		return true
+	}
+	topologyMode := service.Annotations[kapi.AnnotationTopologyMode]
+	if topologyMode == "" {
+		topologyMode = service.Annotations[kapi.DeprecatedAnnotationTopologyAwareHints]
+	}
+	return topologyMode == "Auto" || topologyMode == "auto"
+}
+
+// LBHealthCheckAnnotation is the service annotation that opts the service in
+// to OVN health checks of its backends
+const LBHealthCheckAnnotation = "k8s.ovn.org/lb-health-check"
+
+// ServiceLBHealthCheckEnabled returns true if OVN health checks are enabled in
+// the cluster and the service opted in to them.
+func ServiceLBHealthCheckEnabled(service *kapi.Service) bool {
+	return config.OVNKubernetesFeature.EnableLBHealthChecks && service.Annotations[LBHealthCheckAnnotation] == "true"
+}
+
 // GetClusterSubnets returns the v4&v6 cluster subnets in a cluster separately
 func GetClusterSubnets() ([]*net.IPNet, []*net.IPNet) {
 	var v4ClusterSubnets = []*net.IPNet{}
@@ -549,6 +549,51 @@ func (nInfo *secondaryNetInfo) copy() *secondaryNetInfo {
 	return c
 }
 
+// IsSafeNetworkUpdate returns true if the network can be updated in place from
+// the current configuration to the desired one, without tearing it down: the
+// desired configuration keeps the existing subnets and may only append new
+// subnets or raise the MTU.
+func IsSafeNetworkUpdate(current, desired BasicNetInfo) bool {
+	if current == nil || desired == nil || current.IsDefault() || desired.IsDefault() {
+		return false
+	}
+	if current.Equals(desired) {
+		return false
+	}
+	if current.GetNetworkName() != desired.GetNetworkName() ||
+		current.TopologyType() != desired.TopologyType() ||
+		current.IsPrimaryNetwork() != desired.IsPrimaryNetwork() ||
+		current.Vlan() != desired.Vlan() ||
+		current.AllowsPersistentIPs() != desired.AllowsPersistentIPs() ||
+		current.PhysicalNetworkName() != desired.PhysicalNetworkName() {
+		return false
+	}
+	if desired.MTU() < current.MTU() {
+		return false
+	}
+
+	// a network without subnets does not provide IPAM, adding subnets to it
+	// is not an update
+	if len(current.Subnets()) == 0 {
+		return false
+	}
+	desiredSubnets := sets.New[string]()
+	for _, subnet := range desired.Subnets() {
+		desiredSubnets.Insert(subnet.String())
+	}
+	for _, subnet := range current.Subnets() {
+		if !desiredSubnets.Has(subnet.String()) {
+			return false
+		}
+	}
+
+	lessIPNet := func(a, b *net.IPNet) bool { return a.String() < b.String() }
+	if !cmp.Equal(current.ExcludeSubnets(), desired.ExcludeSubnets(), cmpopts.SortSlices(lessIPNet)) {
+		return false
+	}
+	return cmp.Equal(current.JoinSubnets(), desired.JoinSubnets(), cmpopts.SortSlices(lessIPNet))
+}
+
 func newLayer3NetConfInfo(netconf *ovncnitypes.NetConf) (NetInfo, error) {
 	subnets, _, err := parseSubnets(netconf.Subnets, "", types.Layer3Topology)
 	if err != nil {
@@ -986,6 +1031,12 @@ func GetPodNADToNetworkMappingWithActiveNetwork(pod *kapi.Pod, nInfo NetInfo, ac
 		Name:      activeNetworkNADKey[1],
 	}
 
+	if nInfo.IsPrimaryNetwork() {
+		if err := setPrimaryUDNAddressRequests(pod, networkSelections[activeNetworkNADs[0]]); err != nil {
+			return false, nil, err
+		}
+	}
+
 	if nInfo.IsPrimaryNetwork() && AllowsPersistentIPs(nInfo) {
 		ipamClaimName, wasPersistentIPRequested := pod.Annotations[OvnUDNIPAMClaimName]
 		if wasPersistentIPRequested {
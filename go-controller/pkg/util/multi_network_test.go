@@ -197,7 +197,7 @@ func TestParseNetconf(t *testing.T) {
             "ipam": "this is wrong"
     }
 `,
-			expectedError: fmt.Errorf("error parsing Network Attachment Definition ns1/nad1: json: cannot unmarshal string into Go struct field NetConf.ipam of type types.IPAM"),
+			expectedError: fmt.Errorf("error parsing Network Attachment Definition ns1/nad1: json: cannot unmarshal string into Go struct field .ipam of type types.IPAM"),
 		},
 		{
 			desc: "attachment definition with IPAM key defined",
@@ -1010,6 +1010,55 @@ func TestGetPodNADToNetworkMappingWithActiveNetwork(t *testing.T) {
 				},
 			},
 		},
+		{
+			desc: "the pod requests static IPs and MAC on its primary layer3 UDN",
+			inputNetConf: &ovncnitypes.NetConf{
+				NetConf:  cnitypes.NetConf{Name: networkName},
+				Topology: ovntypes.Layer3Topology,
+				NADName:  GetNADName(namespaceName, attachmentName),
+				Role:     ovntypes.NetworkRolePrimary,
+			},
+			inputPrimaryUDNConfig: &ovncnitypes.NetConf{
+				NetConf:  cnitypes.NetConf{Name: networkName},
+				Topology: ovntypes.Layer3Topology,
+				NADName:  GetNADName(namespaceName, attachmentName),
+				Role:     ovntypes.NetworkRolePrimary,
+			},
+			inputPodAnnotations: map[string]string{
+				OvnUDNIPsAnnotationName: `["10.10.1.5/24","fd10:0:0:1::5/64"]`,
+				OvnUDNMACAnnotationName: "0a:58:0a:0a:01:05",
+			},
+			expectedIsAttachmentRequested: true,
+			expectedNetworkSelectionElements: map[string]*nadv1.NetworkSelectionElement{
+				"ns1/attachment1": {
+					Name:       "attachment1",
+					Namespace:  "ns1",
+					IPRequest:  []string{"10.10.1.5/24", "fd10:0:0:1::5/64"},
+					MacRequest: "0a:58:0a:0a:01:05",
+				},
+			},
+		},
+		{
+			desc: "the pod requests static IPs on its primary layer2 UDN with an invalid annotation",
+			inputNetConf: &ovncnitypes.NetConf{
+				NetConf:  cnitypes.NetConf{Name: networkName},
+				Topology: ovntypes.Layer2Topology,
+				NADName:  GetNADName(namespaceName, attachmentName),
+				Role:     ovntypes.NetworkRolePrimary,
+			},
+			inputPrimaryUDNConfig: &ovncnitypes.NetConf{
+				NetConf:  cnitypes.NetConf{Name: networkName},
+				Topology: ovntypes.Layer2Topology,
+				NADName:  GetNADName(namespaceName, attachmentName),
+				Role:     ovntypes.NetworkRolePrimary,
+			},
+			inputNamespace: namespaceName,
+			inputPodAnnotations: map[string]string{
+				OvnUDNIPsAnnotationName: "10.10.1.5/24",
+			},
+			expectedError: fmt.Errorf("failed to parse pod ns1/test-pod annotation %s: %v", OvnUDNIPsAnnotationName,
+				"invalid character '.' after top-level value"),
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.desc, func(t *testing.T) {
@@ -1255,6 +1304,72 @@ func TestNewNetInfo(t *testing.T) {
 	}
 }
 
+func TestIsSafeNetworkUpdate(t *testing.T) {
+	current := ovncnitypes.NetConf{
+		NetConf:  cnitypes.NetConf{Name: "l3-network"},
+		Topology: ovntypes.Layer3Topology,
+		Role:     ovntypes.NetworkRolePrimary,
+		Subnets:  "192.168.0.0/16/24",
+		MTU:      1400,
+	}
+	tests := []struct {
+		desc     string
+		update   func(*ovncnitypes.NetConf)
+		expected bool
+	}{
+		{
+			desc:   "no change",
+			update: func(*ovncnitypes.NetConf) {},
+		},
+		{
+			desc:     "subnet appended",
+			update:   func(n *ovncnitypes.NetConf) { n.Subnets = "192.168.0.0/16/24,fda6::/48/64" },
+			expected: true,
+		},
+		{
+			desc:     "MTU raised",
+			update:   func(n *ovncnitypes.NetConf) { n.MTU = 9000 },
+			expected: true,
+		},
+		{
+			desc:   "MTU decreased",
+			update: func(n *ovncnitypes.NetConf) { n.MTU = 1300 },
+		},
+		{
+			desc:   "subnet modified",
+			update: func(n *ovncnitypes.NetConf) { n.Subnets = "192.169.0.0/16/24" },
+		},
+		{
+			desc: "role changed",
+			update: func(n *ovncnitypes.NetConf) {
+				n.Role = ovntypes.NetworkRoleSecondary
+				n.MTU = 9000
+			},
+		},
+		{
+			desc: "topology changed",
+			update: func(n *ovncnitypes.NetConf) {
+				n.Topology = ovntypes.Layer2Topology
+				n.Subnets = "192.168.0.0/16"
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			config.IPv4Mode = true
+			config.IPv6Mode = true
+			desired := current
+			test.update(&desired)
+			currentNetInfo, err := NewNetInfo(&current)
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+			desiredNetInfo, err := NewNetInfo(&desired)
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+			g.Expect(IsSafeNetworkUpdate(currentNetInfo, desiredNetInfo)).To(gomega.Equal(test.expected))
+		})
+	}
+}
+
 func applyNADDefaults(nad *nadv1.NetworkAttachmentDefinition) *nadv1.NetworkAttachmentDefinition {
 	const (
 		name      = "nad1"
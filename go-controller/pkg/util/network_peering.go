@@ -0,0 +1,97 @@
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+)
+
+// OvnNetworkPeering is set by the cluster manager on a NetworkPeering once its
+// networks have been validated, with the configuration each zone needs to
+// connect the routers of the peered networks. The networks are in the order of
+// the NetworkPeering spec, e.g.:
+//
+//	k8s.ovn.org/network-peering: {
+//	  "id": 1,
+//	  "networks": [
+//	    {"name": "tenant.blue", "linkIPs": ["100.91.0.2/31"], "subnets": ["10.10.0.0/16"]},
+//	    {"name": "cluster.udn.shared", "linkIPs": ["100.91.0.3/31"], "subnets": ["10.20.0.0/16"]}
+//	  ]
+//	}
+const OvnNetworkPeering = "k8s.ovn.org/network-peering"
+
+// NetworkPeeringConfig is the configuration of a NetworkPeering, stored in the
+// OvnNetworkPeering annotation.
+type NetworkPeeringConfig struct {
+	// ID is the ID allocated to the NetworkPeering, from which the link IPs are derived
+	ID int `json:"id"`
+	// Networks are the two peered networks
+	Networks []PeeredNetworkConfig `json:"networks"`
+}
+
+// PeeredNetworkConfig is the configuration of one of the networks of a NetworkPeering.
+type PeeredNetworkConfig struct {
+	// Name is the name of the network
+	Name string `json:"name"`
+	// LinkIPs are the addresses of the network router port connected to the
+	// peer network router, one per IP family common to both networks
+	LinkIPs []string `json:"linkIPs"`
+	// Subnets are the subnets of the network
+	Subnets []string `json:"subnets"`
+}
+
+// CreateNetworkPeeringAnnotation returns the OvnNetworkPeering annotation value for the given configuration.
+func CreateNetworkPeeringAnnotation(peeringConfig *NetworkPeeringConfig) (string, error) {
+	bytes, err := json.Marshal(peeringConfig)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal network peering configuration %+v: %w", peeringConfig, err)
+	}
+	return string(bytes), nil
+}
+
+// ParseNetworkPeeringAnnotation returns the configuration set on the NetworkPeering
+// by the cluster manager, or an annotationNotSetError if it is not set yet.
+func ParseNetworkPeeringAnnotation(obj metav1.Object) (*NetworkPeeringConfig, error) {
+	annotation, ok := obj.GetAnnotations()[OvnNetworkPeering]
+	if !ok {
+		return nil, newAnnotationNotSetError("%s annotation not found for network peering %q", OvnNetworkPeering, obj.GetName())
+	}
+	peeringConfig := &NetworkPeeringConfig{}
+	if err := json.Unmarshal([]byte(annotation), peeringConfig); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s annotation %q for network peering %q: %w",
+			OvnNetworkPeering, annotation, obj.GetName(), err)
+	}
+	if len(peeringConfig.Networks) != 2 {
+		return nil, fmt.Errorf("invalid %s annotation %q for network peering %q: expected 2 networks",
+			OvnNetworkPeering, annotation, obj.GetName())
+	}
+	for _, network := range peeringConfig.Networks {
+		if len(network.LinkIPs) == 0 {
+			return nil, fmt.Errorf("invalid %s annotation %q for network peering %q: no link IP for network %s",
+				OvnNetworkPeering, annotation, obj.GetName(), network.Name)
+		}
+		for _, ip := range network.LinkIPs {
+			if _, _, err := net.ParseCIDR(ip); err != nil {
+				return nil, fmt.Errorf("invalid %s annotation %q for network peering %q: %w",
+					OvnNetworkPeering, annotation, obj.GetName(), err)
+			}
+		}
+		for _, subnet := range network.Subnets {
+			if _, _, err := net.ParseCIDR(subnet); err != nil {
+				return nil, fmt.Errorf("invalid %s annotation %q for network peering %q: %w",
+					OvnNetworkPeering, annotation, obj.GetName(), err)
+			}
+		}
+	}
+	return peeringConfig, nil
+}
+
+// GetNetworkPeeringPortName returns the name of the router port connecting the
+// router of the given network to the router of its peer in the given NetworkPeering.
+func GetNetworkPeeringPortName(netName, peeringName string) string {
+	return GetSecondaryNetworkPrefix(netName) + types.RouterToPeerPrefix + peeringName
+}
@@ -0,0 +1,69 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestParseNetworkPeeringAnnotation(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		expected    *NetworkPeeringConfig
+		expectErr   bool
+		expectNoSet bool
+	}{
+		{
+			name:        "annotation not set",
+			expectNoSet: true,
+			expectErr:   true,
+		},
+		{
+			name: "valid annotation",
+			annotations: map[string]string{OvnNetworkPeering: `{"id":1,"networks":[` +
+				`{"name":"blue.tenant","linkIPs":["100.91.0.2/31"],"subnets":["10.10.0.0/16"]},` +
+				`{"name":"cluster.udn.shared","linkIPs":["100.91.0.3/31"],"subnets":["10.20.0.0/16"]}]}`},
+			expected: &NetworkPeeringConfig{
+				ID: 1,
+				Networks: []PeeredNetworkConfig{
+					{Name: "blue.tenant", LinkIPs: []string{"100.91.0.2/31"}, Subnets: []string{"10.10.0.0/16"}},
+					{Name: "cluster.udn.shared", LinkIPs: []string{"100.91.0.3/31"}, Subnets: []string{"10.20.0.0/16"}},
+				},
+			},
+		},
+		{
+			name: "single network",
+			annotations: map[string]string{OvnNetworkPeering: `{"id":1,"networks":[` +
+				`{"name":"blue.tenant","linkIPs":["100.91.0.2/31"],"subnets":["10.10.0.0/16"]}]}`},
+			expectErr: true,
+		},
+		{
+			name: "invalid link IP",
+			annotations: map[string]string{OvnNetworkPeering: `{"id":1,"networks":[` +
+				`{"name":"blue.tenant","linkIPs":["100.91.0.2"],"subnets":["10.10.0.0/16"]},` +
+				`{"name":"cluster.udn.shared","linkIPs":["100.91.0.3/31"],"subnets":["10.20.0.0/16"]}]}`},
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			peering := &metav1.ObjectMeta{Name: "peering", Annotations: tt.annotations}
+			peeringConfig, err := ParseNetworkPeeringAnnotation(peering)
+			if tt.expectErr {
+				g.Expect(err).To(gomega.HaveOccurred())
+				g.Expect(IsAnnotationNotSetError(err)).To(gomega.Equal(tt.expectNoSet))
+				return
+			}
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			g.Expect(peeringConfig).To(gomega.Equal(tt.expected))
+
+			annotation, err := CreateNetworkPeeringAnnotation(peeringConfig)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			g.Expect(annotation).To(gomega.Equal(tt.annotations[OvnNetworkPeering]))
+		})
+	}
+}
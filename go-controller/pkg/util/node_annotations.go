@@ -100,6 +100,10 @@ const (
 	// OVNNodeHostCIDRs is used to track the different host IP addresses and subnet masks on the node
 	OVNNodeHostCIDRs = "k8s.ovn.org/host-cidrs"
 
+	// OVNNodePhysicalNetworks is the list of the physical network names mapped to an OVS bridge on the node
+	// by the ovn-bridge-mappings, e.g. '["physnet","tenantblue"]'. It is set by ovnkube-node.
+	OVNNodePhysicalNetworks = "k8s.ovn.org/node-physical-networks"
+
 	// OVNNodeSecondaryHostEgressIPs contains EgressIP addresses that aren't managed by OVN. The EIP addresses are assigned to
 	// standard linux interfaces and not interfaces of type OVS.
 	OVNNodeSecondaryHostEgressIPs = "k8s.ovn.org/secondary-host-egress-ips"
@@ -129,6 +133,10 @@ const (
 	OvnNodeMigratedZoneName = "k8s.ovn.org/remote-zone-migrated"
 	/** HACK END **/
 
+	// OvnNodeRouteAdvertisements holds, for each network, the routes the node advertises to
+	// its BGP peers and the VRF they are advertised in. It is set by cluster manager.
+	OvnNodeRouteAdvertisements = "k8s.ovn.org/route-advertisements"
+
 	// ovnTransitSwitchPortAddr is the annotation to store the node Transit switch port ips.
 	// It is set by cluster manager.
 	ovnTransitSwitchPortAddr = "k8s.ovn.org/node-transit-switch-port-ifaddr"
@@ -1050,6 +1058,30 @@ func ParseNodeHostCIDRs(node *kapi.Node) (sets.Set[string], error) {
 	return sets.New(cfg...), nil
 }
 
+func SetNodePhysicalNetworks(nodeAnnotator kube.Annotator, physicalNetworks sets.Set[string]) error {
+	return nodeAnnotator.Set(OVNNodePhysicalNetworks, sets.List(physicalNetworks))
+}
+
+func NodePhysicalNetworksAnnotationChanged(oldNode, newNode *v1.Node) bool {
+	return oldNode.Annotations[OVNNodePhysicalNetworks] != newNode.Annotations[OVNNodePhysicalNetworks]
+}
+
+// ParseNodePhysicalNetworks returns the names of the physical networks mapped to an OVS bridge on a node
+func ParseNodePhysicalNetworks(node *kapi.Node) (sets.Set[string], error) {
+	annotation, ok := node.Annotations[OVNNodePhysicalNetworks]
+	if !ok {
+		return nil, newAnnotationNotSetError("%s annotation not found for node %q", OVNNodePhysicalNetworks, node.Name)
+	}
+
+	var physicalNetworks []string
+	if err := json.Unmarshal([]byte(annotation), &physicalNetworks); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal physical networks annotation %s for node %q: %v",
+			annotation, node.Name, err)
+	}
+
+	return sets.New(physicalNetworks...), nil
+}
+
 // ParseNodeHostIPDropNetMask returns the parsed host IP addresses found on a node's host CIDR annotation. Removes the mask.
 func ParseNodeHostIPDropNetMask(node *kapi.Node) (sets.Set[string], error) {
 	nodeIfAddrAnnotation, ok := node.Annotations[OvnNodeIfAddr]
@@ -1105,6 +1137,25 @@ func ParseNodeHostCIDRsDropNetMask(node *kapi.Node) (sets.Set[string], error) {
 	return sets.New(cfg...), nil
 }
 
+// GetNodeWithHostAddr returns the name of the node having the given IP address in
+// its host CIDR annotation, or an empty string if there is none. The nodes
+// without host subnet, i.e. hybrid overlay nodes, are ignored.
+func GetNodeWithHostAddr(nodes []*kapi.Node, ip net.IP) (string, error) {
+	for _, node := range nodes {
+		if NoHostSubnet(node) {
+			continue
+		}
+		nodeHostAddrsSet, err := ParseNodeHostCIDRsDropNetMask(node)
+		if err != nil {
+			return "", fmt.Errorf("failed to parse node host cidrs for node %s: %v", node.Name, err)
+		}
+		if nodeHostAddrsSet.Has(ip.String()) {
+			return node.Name, nil
+		}
+	}
+	return "", nil
+}
+
 // GetNodeHostAddrs returns the parsed Host CIDR annotation of the given node
 // as an array of strings. If the annotation is not set, then we return empty list.
 func GetNodeHostAddrs(node *kapi.Node) ([]string, error) {
@@ -1451,6 +1502,48 @@ func NodeNetworkIDAnnotationChanged(oldNode, newNode *corev1.Node, netName strin
 	return oldNodeNetID != newNodeNetID
 }
 
+// NodeNetworkIDsAnnotationChanged returns true if the ovnNetworkIDs annotation of any network
+// in the corev1.Nodes doesn't match
+func NodeNetworkIDsAnnotationChanged(oldNode, newNode *corev1.Node) bool {
+	return oldNode.Annotations[ovnNetworkIDs] != newNode.Annotations[ovnNetworkIDs]
+}
+
+// NodeRouteAdvertisements are the routes a node advertises for a network.
+type NodeRouteAdvertisements struct {
+	// TargetVRF is the VRF the routes are advertised in and learned from,
+	// empty for the default VRF
+	TargetVRF string `json:"targetVRF,omitempty"`
+	// NetworkVRF is the VRF of the network on the node, empty for the
+	// default network
+	NetworkVRF string `json:"networkVRF,omitempty"`
+	// Prefixes are the prefixes advertised by the node
+	Prefixes []string `json:"prefixes,omitempty"`
+	// Subnets are the subnets of the network, routes learned in the target
+	// VRF towards them are imported into the network VRF
+	Subnets []string `json:"subnets,omitempty"`
+}
+
+// ParseNodeRouteAdvertisementsAnnotation parses the OvnNodeRouteAdvertisements
+// annotation of the node and returns the advertisements keyed by network name.
+func ParseNodeRouteAdvertisementsAnnotation(node *corev1.Node) (map[string]NodeRouteAdvertisements, error) {
+	annotation, ok := node.Annotations[OvnNodeRouteAdvertisements]
+	if !ok {
+		return nil, newAnnotationNotSetError("%s annotation not found for node %q", OvnNodeRouteAdvertisements, node.Name)
+	}
+	advertisements := map[string]NodeRouteAdvertisements{}
+	if err := json.Unmarshal([]byte(annotation), &advertisements); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s annotation %q for node %q: %v",
+			OvnNodeRouteAdvertisements, annotation, node.Name, err)
+	}
+	return advertisements, nil
+}
+
+// NodeRouteAdvertisementsAnnotationChanged returns true if the OvnNodeRouteAdvertisements
+// annotation in the corev1.Nodes doesn't match
+func NodeRouteAdvertisementsAnnotationChanged(oldNode, newNode *corev1.Node) bool {
+	return oldNode.Annotations[OvnNodeRouteAdvertisements] != newNode.Annotations[OvnNodeRouteAdvertisements]
+}
+
 func makeCIDRs(s ...string) (cidrs []netip.Prefix, err error) {
 	for _, cidrString := range s {
 		prefix, err := netip.ParsePrefix(cidrString)
@@ -57,6 +57,13 @@ const (
 	// OvnUDNIPAMClaimName is used for workload owners to instruct OVN-K which
 	// IPAMClaim will hold the allocation for the workload
 	OvnUDNIPAMClaimName = "k8s.ovn.org/primary-udn-ipamclaim"
+	// OvnUDNIPsAnnotationName is used for workload owners to request specific
+	// IP addresses for the workload on its primary user defined network, as a
+	// JSON list of IP addresses in CIDR notation
+	OvnUDNIPsAnnotationName = "k8s.ovn.org/primary-udn-ips"
+	// OvnUDNMACAnnotationName is used for workload owners to request a
+	// specific MAC address for the workload on its primary user defined network
+	OvnUDNMACAnnotationName = "k8s.ovn.org/primary-udn-mac"
 	// UDNOpenPortsAnnotationName is the pod annotation to open default network pods on UDN pods.
 	UDNOpenPortsAnnotationName = "k8s.ovn.org/open-default-ports"
 )
@@ -678,3 +685,18 @@ func UnmarshalUDNOpenPortsAnnotation(annotations map[string]string) ([]*OpenPort
 	}
 	return result, nil
 }
+
+// setPrimaryUDNAddressRequests sets the IP and MAC addresses requested through
+// the pod annotations for the pod primary user defined network in the network
+// selection element.
+func setPrimaryUDNAddressRequests(pod *v1.Pod, network *nadapi.NetworkSelectionElement) error {
+	if ips, ok := pod.Annotations[OvnUDNIPsAnnotationName]; ok {
+		if err := json.Unmarshal([]byte(ips), &network.IPRequest); err != nil {
+			return fmt.Errorf("failed to parse pod %s/%s annotation %s: %v", pod.Namespace, pod.Name, OvnUDNIPsAnnotationName, err)
+		}
+	}
+	if mac, ok := pod.Annotations[OvnUDNMACAnnotationName]; ok {
+		network.MacRequest = mac
+	}
+	return nil
+}
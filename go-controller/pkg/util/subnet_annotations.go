@@ -7,6 +7,7 @@ import (
 
 	kapi "k8s.io/api/core/v1"
 	v1 "k8s.io/api/core/v1"
+	utilnet "k8s.io/utils/net"
 
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/kube"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
@@ -32,12 +33,39 @@ import (
 //       {
 //         "default": ["10.130.0.0/23", "fd01:0:0:2::/64"]
 //       }
+//
+// A node can be allocated more than one subnet of an IP family when it runs out
+// of pod IPs. The first subnet of each family is the primary one, from which the
+// node gateway and management port IPs are taken:
+//
+//   annotations:
+//     k8s.ovn.org/node-subnets: |
+//       {
+//         "default": ["10.130.0.0/23", "fd01:0:0:2::/64", "10.130.8.0/23"]
+//       }
+//
+// The additional subnets are requested by the zone controller of the node,
+// which sets the number of subnets of each IP family it needs:
+//
+//   annotations:
+//     k8s.ovn.org/node-subnet-requests: |
+//       {
+//         "default": {"ipv4": 2}
+//       }
 
 const (
 	// ovnNodeSubnets is the constant string representing the node subnets annotation key
 	ovnNodeSubnets = "k8s.ovn.org/node-subnets"
+	// OvnNodeSubnetRequests is the constant string representing the node subnet requests annotation key
+	OvnNodeSubnetRequests = "k8s.ovn.org/node-subnet-requests"
 )
 
+// NodeSubnetRequest is the number of host subnets of each IP family requested for a node
+type NodeSubnetRequest struct {
+	IPv4 int `json:"ipv4,omitempty"`
+	IPv6 int `json:"ipv6,omitempty"`
+}
+
 // updateSubnetAnnotation add the hostSubnets of the given network to the input node annotations;
 // input annotations is not nil
 // if hostSubnets is empty, deletes the existing subnet annotation for given network from the input node annotations.
@@ -209,3 +237,96 @@ func ParseNodesHostSubnetAnnotation(nodes []*kapi.Node, netName string) ([]*net.
 	}
 	return allSubnets, nil
 }
+
+// GetNodePrimaryHostSubnets returns the first host subnet of each IP family, from
+// which the node gateway and management port IPs are taken.
+func GetNodePrimaryHostSubnets(hostSubnets []*net.IPNet) []*net.IPNet {
+	var primarySubnets []*net.IPNet
+	var foundIPv4, foundIPv6 bool
+	for _, hostSubnet := range hostSubnets {
+		if utilnet.IsIPv6CIDR(hostSubnet) {
+			if foundIPv6 {
+				continue
+			}
+			foundIPv6 = true
+		} else {
+			if foundIPv4 {
+				continue
+			}
+			foundIPv4 = true
+		}
+		primarySubnets = append(primarySubnets, hostSubnet)
+	}
+	return primarySubnets
+}
+
+// CountHostSubnets returns the number of IPv4 and IPv6 host subnets
+func CountHostSubnets(hostSubnets []*net.IPNet) NodeSubnetRequest {
+	var count NodeSubnetRequest
+	for _, hostSubnet := range hostSubnets {
+		if utilnet.IsIPv6CIDR(hostSubnet) {
+			count.IPv6++
+		} else {
+			count.IPv4++
+		}
+	}
+	return count
+}
+
+// ParseNodeSubnetRequestsAnnotation parses the "k8s.ovn.org/node-subnet-requests" annotation
+// and returns the number of host subnets requested for each network.
+func ParseNodeSubnetRequestsAnnotation(nodeAnnotations map[string]string) (map[string]NodeSubnetRequest, error) {
+	annotation, ok := nodeAnnotations[OvnNodeSubnetRequests]
+	if !ok {
+		return nil, newAnnotationNotSetError("could not find %q annotation", OvnNodeSubnetRequests)
+	}
+	requests := map[string]NodeSubnetRequest{}
+	if err := json.Unmarshal([]byte(annotation), &requests); err != nil {
+		return nil, fmt.Errorf("could not parse %q annotation %q: %v", OvnNodeSubnetRequests, annotation, err)
+	}
+	return requests, nil
+}
+
+// ParseNodeSubnetRequestAnnotation parses the "k8s.ovn.org/node-subnet-requests" annotation
+// on a node and returns the number of host subnets requested for the given network.
+func ParseNodeSubnetRequestAnnotation(node *kapi.Node, netName string) (NodeSubnetRequest, error) {
+	requests, err := ParseNodeSubnetRequestsAnnotation(node.Annotations)
+	if err != nil {
+		return NodeSubnetRequest{}, err
+	}
+	request, ok := requests[netName]
+	if !ok {
+		return NodeSubnetRequest{}, newAnnotationNotSetError("node %q has no %q annotation for network %s", node.Name, OvnNodeSubnetRequests, netName)
+	}
+	return request, nil
+}
+
+// UpdateNodeSubnetRequestAnnotation updates the "k8s.ovn.org/node-subnet-requests" annotation
+// for network "netName". If the request is empty, it deletes the request of network "netName".
+func UpdateNodeSubnetRequestAnnotation(annotations map[string]string, request NodeSubnetRequest, netName string) (map[string]string, error) {
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	requests, err := ParseNodeSubnetRequestsAnnotation(annotations)
+	if err != nil {
+		if !IsAnnotationNotSetError(err) {
+			return nil, err
+		}
+		requests = map[string]NodeSubnetRequest{}
+	}
+	if request == (NodeSubnetRequest{}) {
+		delete(requests, netName)
+	} else {
+		requests[netName] = request
+	}
+	if len(requests) == 0 {
+		delete(annotations, OvnNodeSubnetRequests)
+		return annotations, nil
+	}
+	bytes, err := json.Marshal(requests)
+	if err != nil {
+		return nil, err
+	}
+	annotations[OvnNodeSubnetRequests] = string(bytes)
+	return annotations, nil
+}
@@ -359,3 +359,55 @@ func TestParseNodeHostSubnetAnnotation(t *testing.T) {
 		})
 	}
 }
+
+func TestNodeSubnetRequestAnnotation(t *testing.T) {
+	tests := []struct {
+		desc        string
+		annotations map[string]string
+		request     NodeSubnetRequest
+		netName     string
+		expected    map[string]string
+	}{
+		{
+			desc:     "adds the request of a network",
+			request:  NodeSubnetRequest{IPv4: 2},
+			netName:  types.DefaultNetworkName,
+			expected: map[string]string{OvnNodeSubnetRequests: `{"default":{"ipv4":2}}`},
+		},
+		{
+			desc:        "updates the request of a network",
+			annotations: map[string]string{OvnNodeSubnetRequests: `{"default":{"ipv4":2},"blue":{"ipv6":2}}`},
+			request:     NodeSubnetRequest{IPv4: 1, IPv6: 3},
+			netName:     "blue",
+			expected:    map[string]string{OvnNodeSubnetRequests: `{"blue":{"ipv4":1,"ipv6":3},"default":{"ipv4":2}}`},
+		},
+		{
+			desc:        "deletes the annotation with the last request",
+			annotations: map[string]string{OvnNodeSubnetRequests: `{"default":{"ipv4":2}}`},
+			netName:     types.DefaultNetworkName,
+			expected:    map[string]string{},
+		},
+	}
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d:%s", i, tc.desc), func(t *testing.T) {
+			annotations, err := UpdateNodeSubnetRequestAnnotation(tc.annotations, tc.request, tc.netName)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, annotations)
+
+			node := &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "testNode", Annotations: annotations}}
+			request, err := ParseNodeSubnetRequestAnnotation(node, tc.netName)
+			if tc.request == (NodeSubnetRequest{}) {
+				assert.True(t, IsAnnotationNotSetError(err))
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.request, request)
+			}
+		})
+	}
+}
+
+func TestGetNodePrimaryHostSubnets(t *testing.T) {
+	hostSubnets := ovntest.MustParseIPNets("10.244.0.0/24", "fd00:10:244:1::/64", "10.244.5.0/24", "fd00:10:244:7::/64")
+	assert.Equal(t, ovntest.MustParseIPNets("10.244.0.0/24", "fd00:10:244:1::/64"), GetNodePrimaryHostSubnets(hostSubnets))
+	assert.Equal(t, NodeSubnetRequest{IPv4: 2, IPv6: 2}, CountHostSubnets(hostSubnets))
+}
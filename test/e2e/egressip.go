@@ -1062,6 +1062,70 @@ spec:
 		}
 	})
 
+	// Validate that the EgressIP status can only be updated through the status subresource
+	/* This test does the following:
+	   0. Add the "k8s.ovn.org/egress-assignable" label to egress1Node
+	   1. Create an EgressIP object with one egress IP defined
+	   2. Check that the status is of length one and that it is assigned to egress1Node
+	   3. Patch the status of the EgressIP object through the main resource, assigning the egress IP to egress2Node
+	   4. Check that the status is not updated and that the egress IP is still assigned to egress1Node
+	*/
+	ginkgo.It("Should ignore the EgressIP status updates of the main resource", func() {
+		ginkgo.By("0. Add the \"k8s.ovn.org/egress-assignable\" label to egress1Node node")
+		e2enode.AddOrUpdateLabelOnNode(f.ClientSet, egress1Node.name, "k8s.ovn.org/egress-assignable", "dummy")
+		framework.Logf("Added egress-assignable label to node %s", egress1Node.name)
+		e2enode.ExpectNodeHasLabel(context.TODO(), f.ClientSet, egress1Node.name, "k8s.ovn.org/egress-assignable", "dummy")
+
+		ginkgo.By("1. Create an EgressIP object with one egress IP defined")
+		// Assign the egress IP without conflicting with any node IP,
+		// the kind subnet is /16 or /64 so the following should be fine.
+		egressNodeIP := net.ParseIP(egress1Node.nodeIP)
+		egressIP1 := dupIP(egressNodeIP)
+		egressIP1[len(egressIP1)-2]++
+
+		var egressIPConfig = fmt.Sprintf(`apiVersion: k8s.ovn.org/v1
+kind: EgressIP
+metadata:
+    name: ` + egressIPName + `
+spec:
+    egressIPs:
+    - ` + egressIP1.String() + `
+    podSelector:
+        matchLabels:
+            wants: egress
+    namespaceSelector:
+        matchLabels:
+            name: ` + f.Namespace.Name + `
+`)
+		if err := os.WriteFile(egressIPYaml, []byte(egressIPConfig), 0644); err != nil {
+			framework.Failf("Unable to write CRD config to disk: %v", err)
+		}
+		defer func() {
+			if err := os.Remove(egressIPYaml); err != nil {
+				framework.Logf("Unable to remove the CRD config from disk: %v", err)
+			}
+		}()
+
+		framework.Logf("Create the EgressIP configuration")
+		e2ekubectl.RunKubectlOrDie("default", "create", "-f", egressIPYaml)
+
+		ginkgo.By("2. Check that the status is of length one and that it is assigned to egress1Node")
+		statuses := verifyEgressIPStatusLengthEquals(1, nil)
+		if statuses[0].Node != egress1Node.name {
+			framework.Failf("Step 2. Check that the status is of length one and that it is assigned to egress1Node, failed")
+		}
+
+		ginkgo.By("3. Patch the status of the EgressIP object through the main resource, assigning the egress IP to egress2Node")
+		statusPatch := fmt.Sprintf(`{"status":{"items":[{"node":"%s","egressIP":"%s"}]}}`, egress2Node.name, egressIP1.String())
+		e2ekubectl.RunKubectlOrDie("default", "patch", "eip", egressIPName, "--type=merge", "-p", statusPatch)
+
+		ginkgo.By("4. Check that the status is not updated and that the egress IP is still assigned to egress1Node")
+		gomega.Consistently(func() []egressIPStatus {
+			return getSpecificEgressIPStatusItems(egressIPName)
+		}, "10s", "1s").Should(gomega.Equal([]egressIPStatus{{Node: egress1Node.name, EgressIP: egressIP1.String()}}),
+			"Step 4. Check that the status is not updated and that the egress IP is still assigned to egress1Node, failed")
+	})
+
 	// Validate the egress IP when a pod is managed by more than one egressIP object
 	/* This test does the following:
 	   0. Add the "k8s.ovn.org/egress-assignable" label to node2 (pod2Node/egress1Node)
@@ -2705,12 +2705,27 @@ var _ = ginkgo.Describe("External Gateway", func() {
 			deleteAPBExternalRouteCR(duplicatedPolicy)
 		})
 
-		ginkgo.It("Should update the status of a successful and failed CRs", func() {
+		ginkgo.It("Should update the status of a successful CR and reject a CR targeting the same namespace", func() {
 			if addressesv4.srcPodIP == "" || addressesv4.nodeIP == "" {
 				skipper.Skipf("Skipping as pod ip / node ip are not set pod ip %s node ip %s", addressesv4.srcPodIP, addressesv4.nodeIP)
 			}
 			createAPBExternalRouteCRWithStaticHopAndStatus(defaultPolicyName, f.Namespace.Name, false, "Success", addressesv4.gatewayIPs...)
-			createAPBExternalRouteCRWithStaticHopAndStatus(duplicatedPolicy, f.Namespace.Name, false, "Fail", addressesv4.gatewayIPs...)
+			data := fmt.Sprintf(`apiVersion: k8s.ovn.org/v1
+kind: AdminPolicyBasedExternalRoute
+metadata:
+  name: %s
+spec:
+  from:
+    namespaceSelector:
+      matchLabels:
+        kubernetes.io/metadata.name: %s
+  nextHops:
+    static:
+%s
+`, duplicatedPolicy, f.Namespace.Name, formatStaticHops(false, addressesv4.gatewayIPs...))
+			_, err := e2ekubectl.RunKubectlInput("", data, "create", "-f", "-")
+			gomega.Expect(err).To(gomega.HaveOccurred())
+			gomega.Expect(err.Error()).To(gomega.ContainSubstring("already affected by another policy"))
 		})
 	})
 })